@@ -67,13 +67,14 @@ func (x *_DataSource_6_list) IsValid() bool {
 }
 
 var (
-	md_DataSource             protoreflect.MessageDescriptor
-	fd_DataSource_owner       protoreflect.FieldDescriptor
-	fd_DataSource_name        protoreflect.FieldDescriptor
-	fd_DataSource_description protoreflect.FieldDescriptor
-	fd_DataSource_filename    protoreflect.FieldDescriptor
-	fd_DataSource_treasury    protoreflect.FieldDescriptor
-	fd_DataSource_fee         protoreflect.FieldDescriptor
+	md_DataSource               protoreflect.MessageDescriptor
+	fd_DataSource_owner         protoreflect.FieldDescriptor
+	fd_DataSource_name          protoreflect.FieldDescriptor
+	fd_DataSource_description   protoreflect.FieldDescriptor
+	fd_DataSource_filename      protoreflect.FieldDescriptor
+	fd_DataSource_treasury      protoreflect.FieldDescriptor
+	fd_DataSource_fee           protoreflect.FieldDescriptor
+	fd_DataSource_access_policy protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -85,6 +86,7 @@ func init() {
 	fd_DataSource_filename = md_DataSource.Fields().ByName("filename")
 	fd_DataSource_treasury = md_DataSource.Fields().ByName("treasury")
 	fd_DataSource_fee = md_DataSource.Fields().ByName("fee")
+	fd_DataSource_access_policy = md_DataSource.Fields().ByName("access_policy")
 }
 
 var _ protoreflect.Message = (*fastReflection_DataSource)(nil)
@@ -188,6 +190,12 @@ func (x *fastReflection_DataSource) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.AccessPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.AccessPolicy.ProtoReflect())
+		if !f(fd_DataSource_access_policy, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -215,6 +223,8 @@ func (x *fastReflection_DataSource) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Treasury != ""
 	case "band.oracle.v1.DataSource.fee":
 		return len(x.Fee) != 0
+	case "band.oracle.v1.DataSource.access_policy":
+		return x.AccessPolicy != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -243,6 +253,8 @@ func (x *fastReflection_DataSource) Clear(fd protoreflect.FieldDescriptor) {
 		x.Treasury = ""
 	case "band.oracle.v1.DataSource.fee":
 		x.Fee = nil
+	case "band.oracle.v1.DataSource.access_policy":
+		x.AccessPolicy = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -280,6 +292,9 @@ func (x *fastReflection_DataSource) Get(descriptor protoreflect.FieldDescriptor)
 		}
 		listValue := &_DataSource_6_list{list: &x.Fee}
 		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.DataSource.access_policy":
+		value := x.AccessPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -314,6 +329,8 @@ func (x *fastReflection_DataSource) Set(fd protoreflect.FieldDescriptor, value p
 		lv := value.List()
 		clv := lv.(*_DataSource_6_list)
 		x.Fee = *clv.list
+	case "band.oracle.v1.DataSource.access_policy":
+		x.AccessPolicy = value.Message().Interface().(*AccessPolicy)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -340,6 +357,11 @@ func (x *fastReflection_DataSource) Mutable(fd protoreflect.FieldDescriptor) pro
 		}
 		value := &_DataSource_6_list{list: &x.Fee}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.DataSource.access_policy":
+		if x.AccessPolicy == nil {
+			x.AccessPolicy = new(AccessPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.AccessPolicy.ProtoReflect())
 	case "band.oracle.v1.DataSource.owner":
 		panic(fmt.Errorf("field owner of message band.oracle.v1.DataSource is not mutable"))
 	case "band.oracle.v1.DataSource.name":
@@ -376,6 +398,9 @@ func (x *fastReflection_DataSource) NewField(fd protoreflect.FieldDescriptor) pr
 	case "band.oracle.v1.DataSource.fee":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_DataSource_6_list{list: &list})
+	case "band.oracle.v1.DataSource.access_policy":
+		m := new(AccessPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.DataSource"))
@@ -471,6 +496,10 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.AccessPolicy != nil {
+			l = options.Size(x.AccessPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -500,6 +529,20 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AccessPolicy != nil {
+			encoded, err := options.Marshal(x.AccessPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.Fee) > 0 {
 			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Fee[iNdEx])
@@ -794,6 +837,42 @@ func (x *fastReflection_DataSource) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AccessPolicy == nil {
+					x.AccessPolicy = &AccessPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccessPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -837,6 +916,7 @@ var (
 	fd_OracleScript_filename        protoreflect.FieldDescriptor
 	fd_OracleScript_schema          protoreflect.FieldDescriptor
 	fd_OracleScript_source_code_url protoreflect.FieldDescriptor
+	fd_OracleScript_access_policy   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -848,6 +928,7 @@ func init() {
 	fd_OracleScript_filename = md_OracleScript.Fields().ByName("filename")
 	fd_OracleScript_schema = md_OracleScript.Fields().ByName("schema")
 	fd_OracleScript_source_code_url = md_OracleScript.Fields().ByName("source_code_url")
+	fd_OracleScript_access_policy = md_OracleScript.Fields().ByName("access_policy")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleScript)(nil)
@@ -951,6 +1032,12 @@ func (x *fastReflection_OracleScript) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.AccessPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.AccessPolicy.ProtoReflect())
+		if !f(fd_OracleScript_access_policy, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -978,6 +1065,8 @@ func (x *fastReflection_OracleScript) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Schema != ""
 	case "band.oracle.v1.OracleScript.source_code_url":
 		return x.SourceCodeUrl != ""
+	case "band.oracle.v1.OracleScript.access_policy":
+		return x.AccessPolicy != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1006,6 +1095,8 @@ func (x *fastReflection_OracleScript) Clear(fd protoreflect.FieldDescriptor) {
 		x.Schema = ""
 	case "band.oracle.v1.OracleScript.source_code_url":
 		x.SourceCodeUrl = ""
+	case "band.oracle.v1.OracleScript.access_policy":
+		x.AccessPolicy = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1040,6 +1131,9 @@ func (x *fastReflection_OracleScript) Get(descriptor protoreflect.FieldDescripto
 	case "band.oracle.v1.OracleScript.source_code_url":
 		value := x.SourceCodeUrl
 		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.OracleScript.access_policy":
+		value := x.AccessPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1072,6 +1166,8 @@ func (x *fastReflection_OracleScript) Set(fd protoreflect.FieldDescriptor, value
 		x.Schema = value.Interface().(string)
 	case "band.oracle.v1.OracleScript.source_code_url":
 		x.SourceCodeUrl = value.Interface().(string)
+	case "band.oracle.v1.OracleScript.access_policy":
+		x.AccessPolicy = value.Message().Interface().(*AccessPolicy)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1092,6 +1188,11 @@ func (x *fastReflection_OracleScript) Set(fd protoreflect.FieldDescriptor, value
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_OracleScript) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "band.oracle.v1.OracleScript.access_policy":
+		if x.AccessPolicy == nil {
+			x.AccessPolicy = new(AccessPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.AccessPolicy.ProtoReflect())
 	case "band.oracle.v1.OracleScript.owner":
 		panic(fmt.Errorf("field owner of message band.oracle.v1.OracleScript is not mutable"))
 	case "band.oracle.v1.OracleScript.name":
@@ -1129,6 +1230,9 @@ func (x *fastReflection_OracleScript) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "band.oracle.v1.OracleScript.source_code_url":
 		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.OracleScript.access_policy":
+		m := new(AccessPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.OracleScript"))
@@ -1222,6 +1326,10 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.AccessPolicy != nil {
+			l = options.Size(x.AccessPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1251,6 +1359,20 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AccessPolicy != nil {
+			encoded, err := options.Marshal(x.AccessPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.SourceCodeUrl) > 0 {
 			i -= len(x.SourceCodeUrl)
 			copy(dAtA[i:], x.SourceCodeUrl)
@@ -1534,6 +1656,42 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 				}
 				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AccessPolicy == nil {
+					x.AccessPolicy = &AccessPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccessPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1569,30 +1727,178 @@ func (x *fastReflection_OracleScript) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_AccessPolicy_1_list)(nil)
+
+type _AccessPolicy_1_list struct {
+	list *[]string
+}
+
+func (x *_AccessPolicy_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessPolicy_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccessPolicy_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessPolicy_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessPolicy_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccessPolicy at list field AllowedRequesters as it is not of Message kind"))
+}
+
+func (x *_AccessPolicy_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessPolicy_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccessPolicy_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AccessPolicy_2_list)(nil)
+
+type _AccessPolicy_2_list struct {
+	list *[]*IBCChannel
+}
+
+func (x *_AccessPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AccessPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IBCChannel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IBCChannel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessPolicy_2_list) AppendMutable() protoreflect.Value {
+	v := new(IBCChannel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AccessPolicy_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessPolicy_2_list) NewElement() protoreflect.Value {
+	v := new(IBCChannel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AccessPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AccessPolicy_3_list)(nil)
+
+type _AccessPolicy_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_AccessPolicy_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessPolicy_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AccessPolicy_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessPolicy_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessPolicy_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AccessPolicy_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessPolicy_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AccessPolicy_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_RawRequest                protoreflect.MessageDescriptor
-	fd_RawRequest_external_id    protoreflect.FieldDescriptor
-	fd_RawRequest_data_source_id protoreflect.FieldDescriptor
-	fd_RawRequest_calldata       protoreflect.FieldDescriptor
+	md_AccessPolicy                    protoreflect.MessageDescriptor
+	fd_AccessPolicy_allowed_requesters protoreflect.FieldDescriptor
+	fd_AccessPolicy_allowed_channels   protoreflect.FieldDescriptor
+	fd_AccessPolicy_min_fee_limit      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_RawRequest = File_band_oracle_v1_oracle_proto.Messages().ByName("RawRequest")
-	fd_RawRequest_external_id = md_RawRequest.Fields().ByName("external_id")
-	fd_RawRequest_data_source_id = md_RawRequest.Fields().ByName("data_source_id")
-	fd_RawRequest_calldata = md_RawRequest.Fields().ByName("calldata")
+	md_AccessPolicy = File_band_oracle_v1_oracle_proto.Messages().ByName("AccessPolicy")
+	fd_AccessPolicy_allowed_requesters = md_AccessPolicy.Fields().ByName("allowed_requesters")
+	fd_AccessPolicy_allowed_channels = md_AccessPolicy.Fields().ByName("allowed_channels")
+	fd_AccessPolicy_min_fee_limit = md_AccessPolicy.Fields().ByName("min_fee_limit")
 }
 
-var _ protoreflect.Message = (*fastReflection_RawRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_AccessPolicy)(nil)
 
-type fastReflection_RawRequest RawRequest
+type fastReflection_AccessPolicy AccessPolicy
 
-func (x *RawRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RawRequest)(x)
+func (x *AccessPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessPolicy)(x)
 }
 
-func (x *RawRequest) slowProtoReflect() protoreflect.Message {
+func (x *AccessPolicy) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1604,43 +1910,43 @@ func (x *RawRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RawRequest_messageType fastReflection_RawRequest_messageType
-var _ protoreflect.MessageType = fastReflection_RawRequest_messageType{}
+var _fastReflection_AccessPolicy_messageType fastReflection_AccessPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_AccessPolicy_messageType{}
 
-type fastReflection_RawRequest_messageType struct{}
+type fastReflection_AccessPolicy_messageType struct{}
 
-func (x fastReflection_RawRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RawRequest)(nil)
+func (x fastReflection_AccessPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessPolicy)(nil)
 }
-func (x fastReflection_RawRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_RawRequest)
+func (x fastReflection_AccessPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessPolicy)
 }
-func (x fastReflection_RawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawRequest
+func (x fastReflection_AccessPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessPolicy
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RawRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawRequest
+func (x *fastReflection_AccessPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessPolicy
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RawRequest) Type() protoreflect.MessageType {
-	return _fastReflection_RawRequest_messageType
+func (x *fastReflection_AccessPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_AccessPolicy_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RawRequest) New() protoreflect.Message {
-	return new(fastReflection_RawRequest)
+func (x *fastReflection_AccessPolicy) New() protoreflect.Message {
+	return new(fastReflection_AccessPolicy)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
-	return (*RawRequest)(x)
+func (x *fastReflection_AccessPolicy) Interface() protoreflect.ProtoMessage {
+	return (*AccessPolicy)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1648,22 +1954,22 @@ func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExternalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_RawRequest_external_id, value) {
+func (x *fastReflection_AccessPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AllowedRequesters) != 0 {
+		value := protoreflect.ValueOfList(&_AccessPolicy_1_list{list: &x.AllowedRequesters})
+		if !f(fd_AccessPolicy_allowed_requesters, value) {
 			return
 		}
 	}
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_RawRequest_data_source_id, value) {
+	if len(x.AllowedChannels) != 0 {
+		value := protoreflect.ValueOfList(&_AccessPolicy_2_list{list: &x.AllowedChannels})
+		if !f(fd_AccessPolicy_allowed_channels, value) {
 			return
 		}
 	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_RawRequest_calldata, value) {
+	if len(x.MinFeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_AccessPolicy_3_list{list: &x.MinFeeLimit})
+		if !f(fd_AccessPolicy_min_fee_limit, value) {
 			return
 		}
 	}
@@ -1680,19 +1986,19 @@ func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccessPolicy) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.RawRequest.data_source_id":
-		return x.DataSourceId != uint64(0)
-	case "band.oracle.v1.RawRequest.calldata":
-		return len(x.Calldata) != 0
+	case "band.oracle.v1.AccessPolicy.allowed_requesters":
+		return len(x.AllowedRequesters) != 0
+	case "band.oracle.v1.AccessPolicy.allowed_channels":
+		return len(x.AllowedChannels) != 0
+	case "band.oracle.v1.AccessPolicy.min_fee_limit":
+		return len(x.MinFeeLimit) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.AccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.AccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1702,19 +2008,19 @@ func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccessPolicy) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		x.ExternalId = uint64(0)
-	case "band.oracle.v1.RawRequest.data_source_id":
-		x.DataSourceId = uint64(0)
-	case "band.oracle.v1.RawRequest.calldata":
-		x.Calldata = nil
+	case "band.oracle.v1.AccessPolicy.allowed_requesters":
+		x.AllowedRequesters = nil
+	case "band.oracle.v1.AccessPolicy.allowed_channels":
+		x.AllowedChannels = nil
+	case "band.oracle.v1.AccessPolicy.min_fee_limit":
+		x.MinFeeLimit = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.AccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.AccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1724,22 +2030,31 @@ func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		value := x.ExternalId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawRequest.data_source_id":
-		value := x.DataSourceId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawRequest.calldata":
-		value := x.Calldata
-		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.AccessPolicy.allowed_requesters":
+		if len(x.AllowedRequesters) == 0 {
+			return protoreflect.ValueOfList(&_AccessPolicy_1_list{})
+		}
+		listValue := &_AccessPolicy_1_list{list: &x.AllowedRequesters}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.AccessPolicy.allowed_channels":
+		if len(x.AllowedChannels) == 0 {
+			return protoreflect.ValueOfList(&_AccessPolicy_2_list{})
+		}
+		listValue := &_AccessPolicy_2_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.AccessPolicy.min_fee_limit":
+		if len(x.MinFeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_AccessPolicy_3_list{})
+		}
+		listValue := &_AccessPolicy_3_list{list: &x.MinFeeLimit}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.AccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.AccessPolicy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1753,19 +2068,25 @@ func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccessPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		x.ExternalId = value.Uint()
-	case "band.oracle.v1.RawRequest.data_source_id":
-		x.DataSourceId = value.Uint()
-	case "band.oracle.v1.RawRequest.calldata":
-		x.Calldata = value.Bytes()
+	case "band.oracle.v1.AccessPolicy.allowed_requesters":
+		lv := value.List()
+		clv := lv.(*_AccessPolicy_1_list)
+		x.AllowedRequesters = *clv.list
+	case "band.oracle.v1.AccessPolicy.allowed_channels":
+		lv := value.List()
+		clv := lv.(*_AccessPolicy_2_list)
+		x.AllowedChannels = *clv.list
+	case "band.oracle.v1.AccessPolicy.min_fee_limit":
+		lv := value.List()
+		clv := lv.(*_AccessPolicy_3_list)
+		x.MinFeeLimit = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.AccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.AccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1779,48 +2100,63 @@ func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawRequest is not mutable"))
-	case "band.oracle.v1.RawRequest.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequest is not mutable"))
-	case "band.oracle.v1.RawRequest.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.AccessPolicy.allowed_requesters":
+		if x.AllowedRequesters == nil {
+			x.AllowedRequesters = []string{}
+		}
+		value := &_AccessPolicy_1_list{list: &x.AllowedRequesters}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.AccessPolicy.allowed_channels":
+		if x.AllowedChannels == nil {
+			x.AllowedChannels = []*IBCChannel{}
+		}
+		value := &_AccessPolicy_2_list{list: &x.AllowedChannels}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.AccessPolicy.min_fee_limit":
+		if x.MinFeeLimit == nil {
+			x.MinFeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_AccessPolicy_3_list{list: &x.MinFeeLimit}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.AccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.AccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawRequest.external_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawRequest.data_source_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawRequest.calldata":
-		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.AccessPolicy.allowed_requesters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccessPolicy_1_list{list: &list})
+	case "band.oracle.v1.AccessPolicy.allowed_channels":
+		list := []*IBCChannel{}
+		return protoreflect.ValueOfList(&_AccessPolicy_2_list{list: &list})
+	case "band.oracle.v1.AccessPolicy.min_fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_AccessPolicy_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.AccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.AccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccessPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.AccessPolicy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1828,7 +2164,7 @@ func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccessPolicy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1839,7 +2175,7 @@ func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccessPolicy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1851,7 +2187,7 @@ func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RawRequest) IsValid() bool {
+func (x *fastReflection_AccessPolicy) IsValid() bool {
 	return x != nil
 }
 
@@ -1861,9 +2197,9 @@ func (x *fastReflection_RawRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccessPolicy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*AccessPolicy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1875,15 +2211,23 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ExternalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExternalId))
+		if len(x.AllowedRequesters) > 0 {
+			for _, s := range x.AllowedRequesters {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.DataSourceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		if len(x.AllowedChannels) > 0 {
+			for _, e := range x.AllowedChannels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Calldata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.MinFeeLimit) > 0 {
+			for _, e := range x.MinFeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1895,7 +2239,7 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*AccessPolicy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1914,22 +2258,46 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.MinFeeLimit) > 0 {
+			for iNdEx := len(x.MinFeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinFeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
-			i--
-			dAtA[i] = 0x10
+		if len(x.AllowedChannels) > 0 {
+			for iNdEx := len(x.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowedChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if x.ExternalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
-			i--
-			dAtA[i] = 0x8
+		if len(x.AllowedRequesters) > 0 {
+			for iNdEx := len(x.AllowedRequesters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedRequesters[iNdEx])
+				copy(dAtA[i:], x.AllowedRequesters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedRequesters[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1942,7 +2310,7 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RawRequest)
+		x := input.Message.Interface().(*AccessPolicy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1974,17 +2342,17 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessPolicy: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedRequesters", wireType)
 				}
-				x.ExternalId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1994,16 +2362,29 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExternalId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedRequesters = append(x.AllowedRequesters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
 				}
-				x.DataSourceId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2013,16 +2394,31 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedChannels = append(x.AllowedChannels, &IBCChannel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedChannels[len(x.AllowedChannels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinFeeLimit", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2032,24 +2428,24 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
+				x.MinFeeLimit = append(x.MinFeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinFeeLimit[len(x.MinFeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -2088,29 +2484,29 @@ func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RawReport             protoreflect.MessageDescriptor
-	fd_RawReport_external_id protoreflect.FieldDescriptor
-	fd_RawReport_exit_code   protoreflect.FieldDescriptor
-	fd_RawReport_data        protoreflect.FieldDescriptor
+	md_RawRequest                protoreflect.MessageDescriptor
+	fd_RawRequest_external_id    protoreflect.FieldDescriptor
+	fd_RawRequest_data_source_id protoreflect.FieldDescriptor
+	fd_RawRequest_calldata       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_RawReport = File_band_oracle_v1_oracle_proto.Messages().ByName("RawReport")
-	fd_RawReport_external_id = md_RawReport.Fields().ByName("external_id")
-	fd_RawReport_exit_code = md_RawReport.Fields().ByName("exit_code")
-	fd_RawReport_data = md_RawReport.Fields().ByName("data")
+	md_RawRequest = File_band_oracle_v1_oracle_proto.Messages().ByName("RawRequest")
+	fd_RawRequest_external_id = md_RawRequest.Fields().ByName("external_id")
+	fd_RawRequest_data_source_id = md_RawRequest.Fields().ByName("data_source_id")
+	fd_RawRequest_calldata = md_RawRequest.Fields().ByName("calldata")
 }
 
-var _ protoreflect.Message = (*fastReflection_RawReport)(nil)
+var _ protoreflect.Message = (*fastReflection_RawRequest)(nil)
 
-type fastReflection_RawReport RawReport
+type fastReflection_RawRequest RawRequest
 
-func (x *RawReport) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RawReport)(x)
+func (x *RawRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawRequest)(x)
 }
 
-func (x *RawReport) slowProtoReflect() protoreflect.Message {
+func (x *RawRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2122,43 +2518,43 @@ func (x *RawReport) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RawReport_messageType fastReflection_RawReport_messageType
-var _ protoreflect.MessageType = fastReflection_RawReport_messageType{}
+var _fastReflection_RawRequest_messageType fastReflection_RawRequest_messageType
+var _ protoreflect.MessageType = fastReflection_RawRequest_messageType{}
 
-type fastReflection_RawReport_messageType struct{}
+type fastReflection_RawRequest_messageType struct{}
 
-func (x fastReflection_RawReport_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RawReport)(nil)
+func (x fastReflection_RawRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawRequest)(nil)
 }
-func (x fastReflection_RawReport_messageType) New() protoreflect.Message {
-	return new(fastReflection_RawReport)
+func (x fastReflection_RawRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_RawRequest)
 }
-func (x fastReflection_RawReport_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawReport
+func (x fastReflection_RawRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RawReport) Descriptor() protoreflect.MessageDescriptor {
-	return md_RawReport
+func (x *fastReflection_RawRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RawReport) Type() protoreflect.MessageType {
-	return _fastReflection_RawReport_messageType
+func (x *fastReflection_RawRequest) Type() protoreflect.MessageType {
+	return _fastReflection_RawRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RawReport) New() protoreflect.Message {
-	return new(fastReflection_RawReport)
+func (x *fastReflection_RawRequest) New() protoreflect.Message {
+	return new(fastReflection_RawRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
-	return (*RawReport)(x)
+func (x *fastReflection_RawRequest) Interface() protoreflect.ProtoMessage {
+	return (*RawRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2166,22 +2562,22 @@ func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_RawRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ExternalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ExternalId)
-		if !f(fd_RawReport_external_id, value) {
+		if !f(fd_RawRequest_external_id, value) {
 			return
 		}
 	}
-	if x.ExitCode != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ExitCode)
-		if !f(fd_RawReport_exit_code, value) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_RawRequest_data_source_id, value) {
 			return
 		}
 	}
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_RawReport_data, value) {
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_RawRequest_calldata, value) {
 			return
 		}
 	}
@@ -2198,19 +2594,19 @@ func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RawRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
+	case "band.oracle.v1.RawRequest.external_id":
 		return x.ExternalId != uint64(0)
-	case "band.oracle.v1.RawReport.exit_code":
-		return x.ExitCode != uint32(0)
-	case "band.oracle.v1.RawReport.data":
-		return len(x.Data) != 0
+	case "band.oracle.v1.RawRequest.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.RawRequest.calldata":
+		return len(x.Calldata) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2220,19 +2616,19 @@ func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RawRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
+	case "band.oracle.v1.RawRequest.external_id":
 		x.ExternalId = uint64(0)
-	case "band.oracle.v1.RawReport.exit_code":
-		x.ExitCode = uint32(0)
-	case "band.oracle.v1.RawReport.data":
-		x.Data = nil
+	case "band.oracle.v1.RawRequest.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.RawRequest.calldata":
+		x.Calldata = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2242,22 +2638,22 @@ func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
+	case "band.oracle.v1.RawRequest.external_id":
 		value := x.ExternalId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.RawReport.exit_code":
-		value := x.ExitCode
-		return protoreflect.ValueOfUint32(value)
-	case "band.oracle.v1.RawReport.data":
-		value := x.Data
+	case "band.oracle.v1.RawRequest.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.RawRequest.calldata":
+		value := x.Calldata
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2271,19 +2667,19 @@ func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RawRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
+	case "band.oracle.v1.RawRequest.external_id":
 		x.ExternalId = value.Uint()
-	case "band.oracle.v1.RawReport.exit_code":
-		x.ExitCode = uint32(value.Uint())
-	case "band.oracle.v1.RawReport.data":
-		x.Data = value.Bytes()
+	case "band.oracle.v1.RawRequest.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.RawRequest.calldata":
+		x.Calldata = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2297,48 +2693,48 @@ func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
-		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawReport is not mutable"))
-	case "band.oracle.v1.RawReport.exit_code":
-		panic(fmt.Errorf("field exit_code of message band.oracle.v1.RawReport is not mutable"))
-	case "band.oracle.v1.RawReport.data":
-		panic(fmt.Errorf("field data of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.RawRequest.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.RawRequest is not mutable"))
+	case "band.oracle.v1.RawRequest.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.RawRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RawReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.RawReport.external_id":
+	case "band.oracle.v1.RawRequest.external_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.RawReport.exit_code":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "band.oracle.v1.RawReport.data":
+	case "band.oracle.v1.RawRequest.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.RawRequest.calldata":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawRequest"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RawRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawReport", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2346,7 +2742,7 @@ func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RawRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2357,7 +2753,7 @@ func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RawRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2369,7 +2765,7 @@ func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RawReport) IsValid() bool {
+func (x *fastReflection_RawRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2379,9 +2775,9 @@ func (x *fastReflection_RawReport) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RawRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*RawRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2396,10 +2792,10 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 		if x.ExternalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ExternalId))
 		}
-		if x.ExitCode != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExitCode))
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
 		}
-		l = len(x.Data)
+		l = len(x.Calldata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2413,7 +2809,7 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*RawRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2432,15 +2828,15 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.ExitCode != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExitCode))
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -2460,7 +2856,7 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RawReport)
+		x := input.Message.Interface().(*RawRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2492,10 +2888,10 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2519,9 +2915,9 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
 				}
-				x.ExitCode = 0
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2531,14 +2927,14 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExitCode |= uint32(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -2565,9 +2961,9 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -2605,246 +3001,78 @@ func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Request_3_list)(nil)
+var (
+	md_RawReport             protoreflect.MessageDescriptor
+	fd_RawReport_external_id protoreflect.FieldDescriptor
+	fd_RawReport_exit_code   protoreflect.FieldDescriptor
+	fd_RawReport_data        protoreflect.FieldDescriptor
+)
 
-type _Request_3_list struct {
-	list *[]string
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_RawReport = File_band_oracle_v1_oracle_proto.Messages().ByName("RawReport")
+	fd_RawReport_external_id = md_RawReport.Fields().ByName("external_id")
+	fd_RawReport_exit_code = md_RawReport.Fields().ByName("exit_code")
+	fd_RawReport_data = md_RawReport.Fields().ByName("data")
 }
 
-func (x *_Request_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_RawReport)(nil)
 
-func (x *_Request_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+type fastReflection_RawReport RawReport
 
-func (x *_Request_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *RawReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawReport)(x)
 }
 
-func (x *_Request_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *RawReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_Request_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Request at list field RequestedValidators as it is not of Message kind"))
-}
+var _fastReflection_RawReport_messageType fastReflection_RawReport_messageType
+var _ protoreflect.MessageType = fastReflection_RawReport_messageType{}
 
-func (x *_Request_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_RawReport_messageType struct{}
 
-func (x *_Request_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x fastReflection_RawReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawReport)(nil)
 }
-
-func (x *_Request_3_list) IsValid() bool {
-	return x.list != nil
+func (x fastReflection_RawReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_RawReport)
+}
+func (x fastReflection_RawReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawReport
 }
 
-var _ protoreflect.List = (*_Request_8_list)(nil)
-
-type _Request_8_list struct {
-	list *[]*RawRequest
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RawReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_RawReport
 }
 
-func (x *_Request_8_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RawReport) Type() protoreflect.MessageType {
+	return _fastReflection_RawReport_messageType
 }
 
-func (x *_Request_8_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Request_8_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawRequest)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Request_8_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawRequest)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Request_8_list) AppendMutable() protoreflect.Value {
-	v := new(RawRequest)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Request_8_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Request_8_list) NewElement() protoreflect.Value {
-	v := new(RawRequest)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Request_8_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_Request_13_list)(nil)
-
-type _Request_13_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Request_13_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Request_13_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Request_13_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Request_13_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Request_13_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Request_13_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Request_13_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Request_13_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Request                      protoreflect.MessageDescriptor
-	fd_Request_oracle_script_id     protoreflect.FieldDescriptor
-	fd_Request_calldata             protoreflect.FieldDescriptor
-	fd_Request_requested_validators protoreflect.FieldDescriptor
-	fd_Request_min_count            protoreflect.FieldDescriptor
-	fd_Request_request_height       protoreflect.FieldDescriptor
-	fd_Request_request_time         protoreflect.FieldDescriptor
-	fd_Request_client_id            protoreflect.FieldDescriptor
-	fd_Request_raw_requests         protoreflect.FieldDescriptor
-	fd_Request_ibc_channel          protoreflect.FieldDescriptor
-	fd_Request_execute_gas          protoreflect.FieldDescriptor
-	fd_Request_tss_encoder          protoreflect.FieldDescriptor
-	fd_Request_requester            protoreflect.FieldDescriptor
-	fd_Request_fee_limit            protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_band_oracle_v1_oracle_proto_init()
-	md_Request = File_band_oracle_v1_oracle_proto.Messages().ByName("Request")
-	fd_Request_oracle_script_id = md_Request.Fields().ByName("oracle_script_id")
-	fd_Request_calldata = md_Request.Fields().ByName("calldata")
-	fd_Request_requested_validators = md_Request.Fields().ByName("requested_validators")
-	fd_Request_min_count = md_Request.Fields().ByName("min_count")
-	fd_Request_request_height = md_Request.Fields().ByName("request_height")
-	fd_Request_request_time = md_Request.Fields().ByName("request_time")
-	fd_Request_client_id = md_Request.Fields().ByName("client_id")
-	fd_Request_raw_requests = md_Request.Fields().ByName("raw_requests")
-	fd_Request_ibc_channel = md_Request.Fields().ByName("ibc_channel")
-	fd_Request_execute_gas = md_Request.Fields().ByName("execute_gas")
-	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
-	fd_Request_requester = md_Request.Fields().ByName("requester")
-	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
-}
-
-var _ protoreflect.Message = (*fastReflection_Request)(nil)
-
-type fastReflection_Request Request
-
-func (x *Request) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Request)(x)
-}
-
-func (x *Request) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Request_messageType fastReflection_Request_messageType
-var _ protoreflect.MessageType = fastReflection_Request_messageType{}
-
-type fastReflection_Request_messageType struct{}
-
-func (x fastReflection_Request_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Request)(nil)
-}
-func (x fastReflection_Request_messageType) New() protoreflect.Message {
-	return new(fastReflection_Request)
-}
-func (x fastReflection_Request_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Request
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Request) Descriptor() protoreflect.MessageDescriptor {
-	return md_Request
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Request) Type() protoreflect.MessageType {
-	return _fastReflection_Request_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Request) New() protoreflect.Message {
-	return new(fastReflection_Request)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RawReport) New() protoreflect.Message {
+	return new(fastReflection_RawReport)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
-	return (*Request)(x)
+func (x *fastReflection_RawReport) Interface() protoreflect.ProtoMessage {
+	return (*RawReport)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2852,82 +3080,22 @@ func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OracleScriptId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_Request_oracle_script_id, value) {
-			return
-		}
-	}
-	if len(x.Calldata) != 0 {
-		value := protoreflect.ValueOfBytes(x.Calldata)
-		if !f(fd_Request_calldata, value) {
-			return
-		}
-	}
-	if len(x.RequestedValidators) != 0 {
-		value := protoreflect.ValueOfList(&_Request_3_list{list: &x.RequestedValidators})
-		if !f(fd_Request_requested_validators, value) {
-			return
-		}
-	}
-	if x.MinCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.MinCount)
-		if !f(fd_Request_min_count, value) {
-			return
-		}
-	}
-	if x.RequestHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestHeight)
-		if !f(fd_Request_request_height, value) {
-			return
-		}
-	}
-	if x.RequestTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RequestTime)
-		if !f(fd_Request_request_time, value) {
-			return
-		}
-	}
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_Request_client_id, value) {
-			return
-		}
-	}
-	if len(x.RawRequests) != 0 {
-		value := protoreflect.ValueOfList(&_Request_8_list{list: &x.RawRequests})
-		if !f(fd_Request_raw_requests, value) {
-			return
-		}
-	}
-	if x.IbcChannel != nil {
-		value := protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
-		if !f(fd_Request_ibc_channel, value) {
-			return
-		}
-	}
-	if x.ExecuteGas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExecuteGas)
-		if !f(fd_Request_execute_gas, value) {
-			return
-		}
-	}
-	if x.TssEncoder != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
-		if !f(fd_Request_tss_encoder, value) {
+func (x *fastReflection_RawReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExternalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExternalId)
+		if !f(fd_RawReport_external_id, value) {
 			return
 		}
 	}
-	if x.Requester != "" {
-		value := protoreflect.ValueOfString(x.Requester)
-		if !f(fd_Request_requester, value) {
+	if x.ExitCode != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExitCode)
+		if !f(fd_RawReport_exit_code, value) {
 			return
 		}
 	}
-	if len(x.FeeLimit) != 0 {
-		value := protoreflect.ValueOfList(&_Request_13_list{list: &x.FeeLimit})
-		if !f(fd_Request_fee_limit, value) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_RawReport_data, value) {
 			return
 		}
 	}
@@ -2944,39 +3112,19 @@ func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RawReport) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.Request.calldata":
-		return len(x.Calldata) != 0
-	case "band.oracle.v1.Request.requested_validators":
-		return len(x.RequestedValidators) != 0
-	case "band.oracle.v1.Request.min_count":
-		return x.MinCount != uint64(0)
-	case "band.oracle.v1.Request.request_height":
-		return x.RequestHeight != int64(0)
-	case "band.oracle.v1.Request.request_time":
-		return x.RequestTime != int64(0)
-	case "band.oracle.v1.Request.client_id":
-		return x.ClientId != ""
-	case "band.oracle.v1.Request.raw_requests":
-		return len(x.RawRequests) != 0
-	case "band.oracle.v1.Request.ibc_channel":
-		return x.IbcChannel != nil
-	case "band.oracle.v1.Request.execute_gas":
-		return x.ExecuteGas != uint64(0)
-	case "band.oracle.v1.Request.tss_encoder":
-		return x.TssEncoder != 0
-	case "band.oracle.v1.Request.requester":
-		return x.Requester != ""
-	case "band.oracle.v1.Request.fee_limit":
-		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.RawReport.external_id":
+		return x.ExternalId != uint64(0)
+	case "band.oracle.v1.RawReport.exit_code":
+		return x.ExitCode != uint32(0)
+	case "band.oracle.v1.RawReport.data":
+		return len(x.Data) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2986,39 +3134,19 @@ func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RawReport) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.Request.calldata":
-		x.Calldata = nil
-	case "band.oracle.v1.Request.requested_validators":
-		x.RequestedValidators = nil
-	case "band.oracle.v1.Request.min_count":
-		x.MinCount = uint64(0)
-	case "band.oracle.v1.Request.request_height":
-		x.RequestHeight = int64(0)
-	case "band.oracle.v1.Request.request_time":
-		x.RequestTime = int64(0)
-	case "band.oracle.v1.Request.client_id":
-		x.ClientId = ""
-	case "band.oracle.v1.Request.raw_requests":
-		x.RawRequests = nil
-	case "band.oracle.v1.Request.ibc_channel":
-		x.IbcChannel = nil
-	case "band.oracle.v1.Request.execute_gas":
-		x.ExecuteGas = uint64(0)
-	case "band.oracle.v1.Request.tss_encoder":
-		x.TssEncoder = 0
-	case "band.oracle.v1.Request.requester":
-		x.Requester = ""
-	case "band.oracle.v1.Request.fee_limit":
-		x.FeeLimit = nil
+	case "band.oracle.v1.RawReport.external_id":
+		x.ExternalId = uint64(0)
+	case "band.oracle.v1.RawReport.exit_code":
+		x.ExitCode = uint32(0)
+	case "band.oracle.v1.RawReport.data":
+		x.Data = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3028,61 +3156,22 @@ func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		value := x.OracleScriptId
+	case "band.oracle.v1.RawReport.external_id":
+		value := x.ExternalId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.calldata":
-		value := x.Calldata
+	case "band.oracle.v1.RawReport.exit_code":
+		value := x.ExitCode
+		return protoreflect.ValueOfUint32(value)
+	case "band.oracle.v1.RawReport.data":
+		value := x.Data
 		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.Request.requested_validators":
-		if len(x.RequestedValidators) == 0 {
-			return protoreflect.ValueOfList(&_Request_3_list{})
-		}
-		listValue := &_Request_3_list{list: &x.RequestedValidators}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.Request.min_count":
-		value := x.MinCount
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.request_height":
-		value := x.RequestHeight
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Request.request_time":
-		value := x.RequestTime
-		return protoreflect.ValueOfInt64(value)
-	case "band.oracle.v1.Request.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Request.raw_requests":
-		if len(x.RawRequests) == 0 {
-			return protoreflect.ValueOfList(&_Request_8_list{})
-		}
-		listValue := &_Request_8_list{list: &x.RawRequests}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.Request.ibc_channel":
-		value := x.IbcChannel
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "band.oracle.v1.Request.execute_gas":
-		value := x.ExecuteGas
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.Request.tss_encoder":
-		value := x.TssEncoder
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "band.oracle.v1.Request.requester":
-		value := x.Requester
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Request.fee_limit":
-		if len(x.FeeLimit) == 0 {
-			return protoreflect.ValueOfList(&_Request_13_list{})
-		}
-		listValue := &_Request_13_list{list: &x.FeeLimit}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3096,45 +3185,19 @@ func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RawReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
-		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.Request.calldata":
-		x.Calldata = value.Bytes()
-	case "band.oracle.v1.Request.requested_validators":
-		lv := value.List()
-		clv := lv.(*_Request_3_list)
-		x.RequestedValidators = *clv.list
-	case "band.oracle.v1.Request.min_count":
-		x.MinCount = value.Uint()
-	case "band.oracle.v1.Request.request_height":
-		x.RequestHeight = value.Int()
-	case "band.oracle.v1.Request.request_time":
-		x.RequestTime = value.Int()
-	case "band.oracle.v1.Request.client_id":
-		x.ClientId = value.Interface().(string)
-	case "band.oracle.v1.Request.raw_requests":
-		lv := value.List()
-		clv := lv.(*_Request_8_list)
-		x.RawRequests = *clv.list
-	case "band.oracle.v1.Request.ibc_channel":
-		x.IbcChannel = value.Message().Interface().(*IBCChannel)
-	case "band.oracle.v1.Request.execute_gas":
-		x.ExecuteGas = value.Uint()
-	case "band.oracle.v1.Request.tss_encoder":
-		x.TssEncoder = (Encoder)(value.Enum())
-	case "band.oracle.v1.Request.requester":
-		x.Requester = value.Interface().(string)
-	case "band.oracle.v1.Request.fee_limit":
-		lv := value.List()
-		clv := lv.(*_Request_13_list)
-		x.FeeLimit = *clv.list
+	case "band.oracle.v1.RawReport.external_id":
+		x.ExternalId = value.Uint()
+	case "band.oracle.v1.RawReport.exit_code":
+		x.ExitCode = uint32(value.Uint())
+	case "band.oracle.v1.RawReport.data":
+		x.Data = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3148,107 +3211,48 @@ func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.requested_validators":
-		if x.RequestedValidators == nil {
-			x.RequestedValidators = []string{}
-		}
-		value := &_Request_3_list{list: &x.RequestedValidators}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.raw_requests":
-		if x.RawRequests == nil {
-			x.RawRequests = []*RawRequest{}
-		}
-		value := &_Request_8_list{list: &x.RawRequests}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.ibc_channel":
-		if x.IbcChannel == nil {
-			x.IbcChannel = new(IBCChannel)
-		}
-		return protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
-	case "band.oracle.v1.Request.fee_limit":
-		if x.FeeLimit == nil {
-			x.FeeLimit = []*v1beta1.Coin{}
-		}
-		value := &_Request_13_list{list: &x.FeeLimit}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Request.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.calldata":
-		panic(fmt.Errorf("field calldata of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.min_count":
-		panic(fmt.Errorf("field min_count of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.request_height":
-		panic(fmt.Errorf("field request_height of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.request_time":
-		panic(fmt.Errorf("field request_time of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.client_id":
-		panic(fmt.Errorf("field client_id of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.execute_gas":
-		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.tss_encoder":
-		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
-	case "band.oracle.v1.Request.requester":
-		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.RawReport.external_id":
+		panic(fmt.Errorf("field external_id of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.RawReport.exit_code":
+		panic(fmt.Errorf("field exit_code of message band.oracle.v1.RawReport is not mutable"))
+	case "band.oracle.v1.RawReport.data":
+		panic(fmt.Errorf("field data of message band.oracle.v1.RawReport is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RawReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Request.oracle_script_id":
+	case "band.oracle.v1.RawReport.external_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.calldata":
+	case "band.oracle.v1.RawReport.exit_code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.oracle.v1.RawReport.data":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.Request.requested_validators":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Request_3_list{list: &list})
-	case "band.oracle.v1.Request.min_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.request_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Request.request_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "band.oracle.v1.Request.client_id":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Request.raw_requests":
-		list := []*RawRequest{}
-		return protoreflect.ValueOfList(&_Request_8_list{list: &list})
-	case "band.oracle.v1.Request.ibc_channel":
-		m := new(IBCChannel)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "band.oracle.v1.Request.execute_gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.Request.tss_encoder":
-		return protoreflect.ValueOfEnum(0)
-	case "band.oracle.v1.Request.requester":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Request.fee_limit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.RawReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.RawReport does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RawReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Request", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.RawReport", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3256,7 +3260,7 @@ func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RawReport) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3267,7 +3271,7 @@ func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RawReport) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3279,7 +3283,7 @@ func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Request) IsValid() bool {
+func (x *fastReflection_RawReport) IsValid() bool {
 	return x != nil
 }
 
@@ -3289,9 +3293,9 @@ func (x *fastReflection_Request) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RawReport) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*RawReport)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3303,58 +3307,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.OracleScriptId != 0 {
-			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
-		}
-		l = len(x.Calldata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.RequestedValidators) > 0 {
-			for _, s := range x.RequestedValidators {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MinCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.MinCount))
-		}
-		if x.RequestHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestHeight))
-		}
-		if x.RequestTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestTime))
-		}
-		l = len(x.ClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.RawRequests) > 0 {
-			for _, e := range x.RawRequests {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.IbcChannel != nil {
-			l = options.Size(x.IbcChannel)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ExecuteGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		if x.ExternalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExternalId))
 		}
-		if x.TssEncoder != 0 {
-			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		if x.ExitCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExitCode))
 		}
-		l = len(x.Requester)
+		l = len(x.Data)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.FeeLimit) > 0 {
-			for _, e := range x.FeeLimit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3365,7 +3327,7 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*RawReport)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3384,109 +3346,20 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.FeeLimit) > 0 {
-			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x6a
-			}
-		}
-		if len(x.Requester) > 0 {
-			i -= len(x.Requester)
-			copy(dAtA[i:], x.Requester)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if x.TssEncoder != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
-			i--
-			dAtA[i] = 0x58
-		}
-		if x.ExecuteGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.IbcChannel != nil {
-			encoded, err := options.Marshal(x.IbcChannel)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.RawRequests) > 0 {
-			for iNdEx := len(x.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RawRequests[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.RequestTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.RequestHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestHeight))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.MinCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
 			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.RequestedValidators) > 0 {
-			for iNdEx := len(x.RequestedValidators) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RequestedValidators[iNdEx])
-				copy(dAtA[i:], x.RequestedValidators[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestedValidators[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
+			dAtA[i] = 0x1a
 		}
-		if len(x.Calldata) > 0 {
-			i -= len(x.Calldata)
-			copy(dAtA[i:], x.Calldata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+		if x.ExitCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExitCode))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.OracleScriptId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+		if x.ExternalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExternalId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3501,7 +3374,7 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Request)
+		x := input.Message.Interface().(*RawReport)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3533,17 +3406,17 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RawReport: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 				}
-				x.OracleScriptId = 0
+				x.ExternalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3553,16 +3426,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
+					x.ExternalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 				}
-				var byteLen int
+				x.ExitCode = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3572,31 +3445,16 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.ExitCode |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
-				if x.Calldata == nil {
-					x.Calldata = []byte{}
-				}
-				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3606,84 +3464,2064 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RequestedValidators = append(x.RequestedValidators, string(dAtA[iNdEx:postIndex]))
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.MinCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MinCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Request_3_list)(nil)
+
+type _Request_3_list struct {
+	list *[]string
+}
+
+func (x *_Request_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Request_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Request_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Request at list field RequestedValidators as it is not of Message kind"))
+}
+
+func (x *_Request_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Request_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Request_8_list)(nil)
+
+type _Request_8_list struct {
+	list *[]*RawRequest
+}
+
+func (x *_Request_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Request_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Request_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_8_list) AppendMutable() protoreflect.Value {
+	v := new(RawRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_8_list) NewElement() protoreflect.Value {
+	v := new(RawRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Request_13_list)(nil)
+
+type _Request_13_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Request_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Request_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Request_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_13_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_13_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Request_14_list)(nil)
+
+type _Request_14_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Request_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Request_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Request_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Request_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Request_14_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Request_14_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Request_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Request                      protoreflect.MessageDescriptor
+	fd_Request_oracle_script_id     protoreflect.FieldDescriptor
+	fd_Request_calldata             protoreflect.FieldDescriptor
+	fd_Request_requested_validators protoreflect.FieldDescriptor
+	fd_Request_min_count            protoreflect.FieldDescriptor
+	fd_Request_request_height       protoreflect.FieldDescriptor
+	fd_Request_request_time         protoreflect.FieldDescriptor
+	fd_Request_client_id            protoreflect.FieldDescriptor
+	fd_Request_raw_requests         protoreflect.FieldDescriptor
+	fd_Request_ibc_channel          protoreflect.FieldDescriptor
+	fd_Request_execute_gas          protoreflect.FieldDescriptor
+	fd_Request_tss_encoder          protoreflect.FieldDescriptor
+	fd_Request_requester            protoreflect.FieldDescriptor
+	fd_Request_fee_limit            protoreflect.FieldDescriptor
+	fd_Request_tip                  protoreflect.FieldDescriptor
+	fd_Request_commit_reveal        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Request = File_band_oracle_v1_oracle_proto.Messages().ByName("Request")
+	fd_Request_oracle_script_id = md_Request.Fields().ByName("oracle_script_id")
+	fd_Request_calldata = md_Request.Fields().ByName("calldata")
+	fd_Request_requested_validators = md_Request.Fields().ByName("requested_validators")
+	fd_Request_min_count = md_Request.Fields().ByName("min_count")
+	fd_Request_request_height = md_Request.Fields().ByName("request_height")
+	fd_Request_request_time = md_Request.Fields().ByName("request_time")
+	fd_Request_client_id = md_Request.Fields().ByName("client_id")
+	fd_Request_raw_requests = md_Request.Fields().ByName("raw_requests")
+	fd_Request_ibc_channel = md_Request.Fields().ByName("ibc_channel")
+	fd_Request_execute_gas = md_Request.Fields().ByName("execute_gas")
+	fd_Request_tss_encoder = md_Request.Fields().ByName("tss_encoder")
+	fd_Request_requester = md_Request.Fields().ByName("requester")
+	fd_Request_fee_limit = md_Request.Fields().ByName("fee_limit")
+	fd_Request_tip = md_Request.Fields().ByName("tip")
+	fd_Request_commit_reveal = md_Request.Fields().ByName("commit_reveal")
+}
+
+var _ protoreflect.Message = (*fastReflection_Request)(nil)
+
+type fastReflection_Request Request
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Request)(x)
+}
+
+func (x *Request) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Request_messageType fastReflection_Request_messageType
+var _ protoreflect.MessageType = fastReflection_Request_messageType{}
+
+type fastReflection_Request_messageType struct{}
+
+func (x fastReflection_Request_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Request)(nil)
+}
+func (x fastReflection_Request_messageType) New() protoreflect.Message {
+	return new(fastReflection_Request)
+}
+func (x fastReflection_Request_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Request
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Request) Descriptor() protoreflect.MessageDescriptor {
+	return md_Request
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Request) Type() protoreflect.MessageType {
+	return _fastReflection_Request_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Request) New() protoreflect.Message {
+	return new(fastReflection_Request)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Request) Interface() protoreflect.ProtoMessage {
+	return (*Request)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Request) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_Request_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_Request_calldata, value) {
+			return
+		}
+	}
+	if len(x.RequestedValidators) != 0 {
+		value := protoreflect.ValueOfList(&_Request_3_list{list: &x.RequestedValidators})
+		if !f(fd_Request_requested_validators, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_Request_min_count, value) {
+			return
+		}
+	}
+	if x.RequestHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestHeight)
+		if !f(fd_Request_request_height, value) {
+			return
+		}
+	}
+	if x.RequestTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RequestTime)
+		if !f(fd_Request_request_time, value) {
+			return
+		}
+	}
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_Request_client_id, value) {
+			return
+		}
+	}
+	if len(x.RawRequests) != 0 {
+		value := protoreflect.ValueOfList(&_Request_8_list{list: &x.RawRequests})
+		if !f(fd_Request_raw_requests, value) {
+			return
+		}
+	}
+	if x.IbcChannel != nil {
+		value := protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
+		if !f(fd_Request_ibc_channel, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_Request_execute_gas, value) {
+			return
+		}
+	}
+	if x.TssEncoder != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TssEncoder))
+		if !f(fd_Request_tss_encoder, value) {
+			return
+		}
+	}
+	if x.Requester != "" {
+		value := protoreflect.ValueOfString(x.Requester)
+		if !f(fd_Request_requester, value) {
+			return
+		}
+	}
+	if len(x.FeeLimit) != 0 {
+		value := protoreflect.ValueOfList(&_Request_13_list{list: &x.FeeLimit})
+		if !f(fd_Request_fee_limit, value) {
+			return
+		}
+	}
+	if len(x.Tip) != 0 {
+		value := protoreflect.ValueOfList(&_Request_14_list{list: &x.Tip})
+		if !f(fd_Request_tip, value) {
+			return
+		}
+	}
+	if x.CommitReveal != false {
+		value := protoreflect.ValueOfBool(x.CommitReveal)
+		if !f(fd_Request_commit_reveal, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Request) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.Request.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.Request.requested_validators":
+		return len(x.RequestedValidators) != 0
+	case "band.oracle.v1.Request.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.Request.request_height":
+		return x.RequestHeight != int64(0)
+	case "band.oracle.v1.Request.request_time":
+		return x.RequestTime != int64(0)
+	case "band.oracle.v1.Request.client_id":
+		return x.ClientId != ""
+	case "band.oracle.v1.Request.raw_requests":
+		return len(x.RawRequests) != 0
+	case "band.oracle.v1.Request.ibc_channel":
+		return x.IbcChannel != nil
+	case "band.oracle.v1.Request.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.Request.tss_encoder":
+		return x.TssEncoder != 0
+	case "band.oracle.v1.Request.requester":
+		return x.Requester != ""
+	case "band.oracle.v1.Request.fee_limit":
+		return len(x.FeeLimit) != 0
+	case "band.oracle.v1.Request.tip":
+		return len(x.Tip) != 0
+	case "band.oracle.v1.Request.commit_reveal":
+		return x.CommitReveal != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Request) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.Request.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.Request.requested_validators":
+		x.RequestedValidators = nil
+	case "band.oracle.v1.Request.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.Request.request_height":
+		x.RequestHeight = int64(0)
+	case "band.oracle.v1.Request.request_time":
+		x.RequestTime = int64(0)
+	case "band.oracle.v1.Request.client_id":
+		x.ClientId = ""
+	case "band.oracle.v1.Request.raw_requests":
+		x.RawRequests = nil
+	case "band.oracle.v1.Request.ibc_channel":
+		x.IbcChannel = nil
+	case "band.oracle.v1.Request.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.Request.tss_encoder":
+		x.TssEncoder = 0
+	case "band.oracle.v1.Request.requester":
+		x.Requester = ""
+	case "band.oracle.v1.Request.fee_limit":
+		x.FeeLimit = nil
+	case "band.oracle.v1.Request.tip":
+		x.Tip = nil
+	case "band.oracle.v1.Request.commit_reveal":
+		x.CommitReveal = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Request) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Request.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.Request.requested_validators":
+		if len(x.RequestedValidators) == 0 {
+			return protoreflect.ValueOfList(&_Request_3_list{})
+		}
+		listValue := &_Request_3_list{list: &x.RequestedValidators}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Request.request_height":
+		value := x.RequestHeight
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Request.request_time":
+		value := x.RequestTime
+		return protoreflect.ValueOfInt64(value)
+	case "band.oracle.v1.Request.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Request.raw_requests":
+		if len(x.RawRequests) == 0 {
+			return protoreflect.ValueOfList(&_Request_8_list{})
+		}
+		listValue := &_Request_8_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.ibc_channel":
+		value := x.IbcChannel
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.Request.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.Request.tss_encoder":
+		value := x.TssEncoder
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.Request.requester":
+		value := x.Requester
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Request.fee_limit":
+		if len(x.FeeLimit) == 0 {
+			return protoreflect.ValueOfList(&_Request_13_list{})
+		}
+		listValue := &_Request_13_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.tip":
+		if len(x.Tip) == 0 {
+			return protoreflect.ValueOfList(&_Request_14_list{})
+		}
+		listValue := &_Request_14_list{list: &x.Tip}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.Request.commit_reveal":
+		value := x.CommitReveal
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Request) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.Request.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.Request.requested_validators":
+		lv := value.List()
+		clv := lv.(*_Request_3_list)
+		x.RequestedValidators = *clv.list
+	case "band.oracle.v1.Request.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.Request.request_height":
+		x.RequestHeight = value.Int()
+	case "band.oracle.v1.Request.request_time":
+		x.RequestTime = value.Int()
+	case "band.oracle.v1.Request.client_id":
+		x.ClientId = value.Interface().(string)
+	case "band.oracle.v1.Request.raw_requests":
+		lv := value.List()
+		clv := lv.(*_Request_8_list)
+		x.RawRequests = *clv.list
+	case "band.oracle.v1.Request.ibc_channel":
+		x.IbcChannel = value.Message().Interface().(*IBCChannel)
+	case "band.oracle.v1.Request.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.Request.tss_encoder":
+		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.Request.requester":
+		x.Requester = value.Interface().(string)
+	case "band.oracle.v1.Request.fee_limit":
+		lv := value.List()
+		clv := lv.(*_Request_13_list)
+		x.FeeLimit = *clv.list
+	case "band.oracle.v1.Request.tip":
+		lv := value.List()
+		clv := lv.(*_Request_14_list)
+		x.Tip = *clv.list
+	case "band.oracle.v1.Request.commit_reveal":
+		x.CommitReveal = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Request) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.requested_validators":
+		if x.RequestedValidators == nil {
+			x.RequestedValidators = []string{}
+		}
+		value := &_Request_3_list{list: &x.RequestedValidators}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.raw_requests":
+		if x.RawRequests == nil {
+			x.RawRequests = []*RawRequest{}
+		}
+		value := &_Request_8_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.ibc_channel":
+		if x.IbcChannel == nil {
+			x.IbcChannel = new(IBCChannel)
+		}
+		return protoreflect.ValueOfMessage(x.IbcChannel.ProtoReflect())
+	case "band.oracle.v1.Request.fee_limit":
+		if x.FeeLimit == nil {
+			x.FeeLimit = []*v1beta1.Coin{}
+		}
+		value := &_Request_13_list{list: &x.FeeLimit}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.tip":
+		if x.Tip == nil {
+			x.Tip = []*v1beta1.Coin{}
+		}
+		value := &_Request_14_list{list: &x.Tip}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Request.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.request_height":
+		panic(fmt.Errorf("field request_height of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.request_time":
+		panic(fmt.Errorf("field request_time of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.client_id":
+		panic(fmt.Errorf("field client_id of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.tss_encoder":
+		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.requester":
+		panic(fmt.Errorf("field requester of message band.oracle.v1.Request is not mutable"))
+	case "band.oracle.v1.Request.commit_reveal":
+		panic(fmt.Errorf("field commit_reveal of message band.oracle.v1.Request is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Request) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Request.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Request.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.Request.requested_validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Request_3_list{list: &list})
+	case "band.oracle.v1.Request.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Request.request_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Request.request_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "band.oracle.v1.Request.client_id":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Request.raw_requests":
+		list := []*RawRequest{}
+		return protoreflect.ValueOfList(&_Request_8_list{list: &list})
+	case "band.oracle.v1.Request.ibc_channel":
+		m := new(IBCChannel)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.Request.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.Request.tss_encoder":
+		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.Request.requester":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Request.fee_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Request_13_list{list: &list})
+	case "band.oracle.v1.Request.tip":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Request_14_list{list: &list})
+	case "band.oracle.v1.Request.commit_reveal":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Request"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Request) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Request", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Request) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Request) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Request) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Request)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RequestedValidators) > 0 {
+			for _, s := range x.RequestedValidators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if x.RequestHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestHeight))
+		}
+		if x.RequestTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestTime))
+		}
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RawRequests) > 0 {
+			for _, e := range x.RawRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.IbcChannel != nil {
+			l = options.Size(x.IbcChannel)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if x.TssEncoder != 0 {
+			n += 1 + runtime.Sov(uint64(x.TssEncoder))
+		}
+		l = len(x.Requester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FeeLimit) > 0 {
+			for _, e := range x.FeeLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Tip) > 0 {
+			for _, e := range x.Tip {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommitReveal {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Request)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CommitReveal {
+			i--
+			if x.CommitReveal {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.Tip) > 0 {
+			for iNdEx := len(x.Tip) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tip[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.FeeLimit) > 0 {
+			for iNdEx := len(x.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.Requester) > 0 {
+			i -= len(x.Requester)
+			copy(dAtA[i:], x.Requester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.TssEncoder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.IbcChannel != nil {
+			encoded, err := options.Marshal(x.IbcChannel)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.RawRequests) > 0 {
+			for iNdEx := len(x.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.RequestTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestTime))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.RequestHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.RequestedValidators) > 0 {
+			for iNdEx := len(x.RequestedValidators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequestedValidators[iNdEx])
+				copy(dAtA[i:], x.RequestedValidators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequestedValidators[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Request)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedValidators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequestedValidators = append(x.RequestedValidators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+				}
+				x.RequestHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				}
+				x.RequestTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawRequests = append(x.RawRequests, &RawRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequests[len(x.RawRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IbcChannel == nil {
+					x.IbcChannel = &IBCChannel{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcChannel); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
+				}
+				x.TssEncoder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TssEncoder |= Encoder(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Requester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tip = append(x.Tip, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tip[len(x.Tip)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CommitReveal = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Report_3_list)(nil)
+
+type _Report_3_list struct {
+	list *[]*RawReport
+}
+
+func (x *_Report_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Report_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Report_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Report_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawReport)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Report_3_list) AppendMutable() protoreflect.Value {
+	v := new(RawReport)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Report_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Report_3_list) NewElement() protoreflect.Value {
+	v := new(RawReport)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Report_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Report                   protoreflect.MessageDescriptor
+	fd_Report_validator         protoreflect.FieldDescriptor
+	fd_Report_in_before_resolve protoreflect.FieldDescriptor
+	fd_Report_raw_reports       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_oracle_proto_init()
+	md_Report = File_band_oracle_v1_oracle_proto.Messages().ByName("Report")
+	fd_Report_validator = md_Report.Fields().ByName("validator")
+	fd_Report_in_before_resolve = md_Report.Fields().ByName("in_before_resolve")
+	fd_Report_raw_reports = md_Report.Fields().ByName("raw_reports")
+}
+
+var _ protoreflect.Message = (*fastReflection_Report)(nil)
+
+type fastReflection_Report Report
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Report)(x)
+}
+
+func (x *Report) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Report_messageType fastReflection_Report_messageType
+var _ protoreflect.MessageType = fastReflection_Report_messageType{}
+
+type fastReflection_Report_messageType struct{}
+
+func (x fastReflection_Report_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Report)(nil)
+}
+func (x fastReflection_Report_messageType) New() protoreflect.Message {
+	return new(fastReflection_Report)
+}
+func (x fastReflection_Report_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Report
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Report) Descriptor() protoreflect.MessageDescriptor {
+	return md_Report
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Report) Type() protoreflect.MessageType {
+	return _fastReflection_Report_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Report) New() protoreflect.Message {
+	return new(fastReflection_Report)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
+	return (*Report)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_Report_validator, value) {
+			return
+		}
+	}
+	if x.InBeforeResolve != false {
+		value := protoreflect.ValueOfBool(x.InBeforeResolve)
+		if !f(fd_Report_in_before_resolve, value) {
+			return
+		}
+	}
+	if len(x.RawReports) != 0 {
+		value := protoreflect.ValueOfList(&_Report_3_list{list: &x.RawReports})
+		if !f(fd_Report_raw_reports, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		return x.Validator != ""
+	case "band.oracle.v1.Report.in_before_resolve":
+		return x.InBeforeResolve != false
+	case "band.oracle.v1.Report.raw_reports":
+		return len(x.RawReports) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		x.Validator = ""
+	case "band.oracle.v1.Report.in_before_resolve":
+		x.InBeforeResolve = false
+	case "band.oracle.v1.Report.raw_reports":
+		x.RawReports = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.Report.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.Report.in_before_resolve":
+		value := x.InBeforeResolve
+		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Report.raw_reports":
+		if len(x.RawReports) == 0 {
+			return protoreflect.ValueOfList(&_Report_3_list{})
+		}
+		listValue := &_Report_3_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.Report.in_before_resolve":
+		x.InBeforeResolve = value.Bool()
+	case "band.oracle.v1.Report.raw_reports":
+		lv := value.List()
+		clv := lv.(*_Report_3_list)
+		x.RawReports = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.raw_reports":
+		if x.RawReports == nil {
+			x.RawReports = []*RawReport{}
+		}
+		value := &_Report_3_list{list: &x.RawReports}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.Report.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.Report is not mutable"))
+	case "band.oracle.v1.Report.in_before_resolve":
+		panic(fmt.Errorf("field in_before_resolve of message band.oracle.v1.Report is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Report) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.Report.validator":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.Report.in_before_resolve":
+		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Report.raw_reports":
+		list := []*RawReport{}
+		return protoreflect.ValueOfList(&_Report_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Report", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Report) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InBeforeResolve {
+			n += 2
+		}
+		if len(x.RawReports) > 0 {
+			for _, e := range x.RawReports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RawReports) > 0 {
+			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawReports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
 				}
-				x.RequestHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.InBeforeResolve {
+			i--
+			if x.InBeforeResolve {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Report)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RequestTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RequestTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 7:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3711,121 +5549,13 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RawRequests = append(x.RawRequests, &RawRequest{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequests[len(x.RawRequests)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.IbcChannel == nil {
-					x.IbcChannel = &IBCChannel{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcChannel); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
-				}
-				x.ExecuteGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExecuteGas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 11:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TssEncoder", wireType)
-				}
-				x.TssEncoder = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TssEncoder |= Encoder(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 12:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3835,27 +5565,15 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Requester = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 13:
+				x.InBeforeResolve = bool(v != 0)
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3882,8 +5600,8 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FeeLimit = append(x.FeeLimit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeLimit[len(x.FeeLimit)-1]); err != nil {
+				x.RawReports = append(x.RawReports, &RawReport{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3922,82 +5640,29 @@ func (x *fastReflection_Request) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Report_3_list)(nil)
-
-type _Report_3_list struct {
-	list *[]*RawReport
-}
-
-func (x *_Report_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Report_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Report_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawReport)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Report_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*RawReport)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Report_3_list) AppendMutable() protoreflect.Value {
-	v := new(RawReport)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Report_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Report_3_list) NewElement() protoreflect.Value {
-	v := new(RawReport)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Report_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Report                   protoreflect.MessageDescriptor
-	fd_Report_validator         protoreflect.FieldDescriptor
-	fd_Report_in_before_resolve protoreflect.FieldDescriptor
-	fd_Report_raw_reports       protoreflect.FieldDescriptor
+	md_ReportCommit           protoreflect.MessageDescriptor
+	fd_ReportCommit_validator protoreflect.FieldDescriptor
+	fd_ReportCommit_hash      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_oracle_proto_init()
-	md_Report = File_band_oracle_v1_oracle_proto.Messages().ByName("Report")
-	fd_Report_validator = md_Report.Fields().ByName("validator")
-	fd_Report_in_before_resolve = md_Report.Fields().ByName("in_before_resolve")
-	fd_Report_raw_reports = md_Report.Fields().ByName("raw_reports")
+	md_ReportCommit = File_band_oracle_v1_oracle_proto.Messages().ByName("ReportCommit")
+	fd_ReportCommit_validator = md_ReportCommit.Fields().ByName("validator")
+	fd_ReportCommit_hash = md_ReportCommit.Fields().ByName("hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_Report)(nil)
+var _ protoreflect.Message = (*fastReflection_ReportCommit)(nil)
 
-type fastReflection_Report Report
+type fastReflection_ReportCommit ReportCommit
 
-func (x *Report) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Report)(x)
+func (x *ReportCommit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReportCommit)(x)
 }
 
-func (x *Report) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+func (x *ReportCommit) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4008,43 +5673,43 @@ func (x *Report) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Report_messageType fastReflection_Report_messageType
-var _ protoreflect.MessageType = fastReflection_Report_messageType{}
+var _fastReflection_ReportCommit_messageType fastReflection_ReportCommit_messageType
+var _ protoreflect.MessageType = fastReflection_ReportCommit_messageType{}
 
-type fastReflection_Report_messageType struct{}
+type fastReflection_ReportCommit_messageType struct{}
 
-func (x fastReflection_Report_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Report)(nil)
+func (x fastReflection_ReportCommit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReportCommit)(nil)
 }
-func (x fastReflection_Report_messageType) New() protoreflect.Message {
-	return new(fastReflection_Report)
+func (x fastReflection_ReportCommit_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReportCommit)
 }
-func (x fastReflection_Report_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Report
+func (x fastReflection_ReportCommit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReportCommit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Report) Descriptor() protoreflect.MessageDescriptor {
-	return md_Report
+func (x *fastReflection_ReportCommit) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReportCommit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Report) Type() protoreflect.MessageType {
-	return _fastReflection_Report_messageType
+func (x *fastReflection_ReportCommit) Type() protoreflect.MessageType {
+	return _fastReflection_ReportCommit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Report) New() protoreflect.Message {
-	return new(fastReflection_Report)
+func (x *fastReflection_ReportCommit) New() protoreflect.Message {
+	return new(fastReflection_ReportCommit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
-	return (*Report)(x)
+func (x *fastReflection_ReportCommit) Interface() protoreflect.ProtoMessage {
+	return (*ReportCommit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4052,22 +5717,16 @@ func (x *fastReflection_Report) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_ReportCommit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Validator != "" {
 		value := protoreflect.ValueOfString(x.Validator)
-		if !f(fd_Report_validator, value) {
-			return
-		}
-	}
-	if x.InBeforeResolve != false {
-		value := protoreflect.ValueOfBool(x.InBeforeResolve)
-		if !f(fd_Report_in_before_resolve, value) {
+		if !f(fd_ReportCommit_validator, value) {
 			return
 		}
 	}
-	if len(x.RawReports) != 0 {
-		value := protoreflect.ValueOfList(&_Report_3_list{list: &x.RawReports})
-		if !f(fd_Report_raw_reports, value) {
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_ReportCommit_hash, value) {
 			return
 		}
 	}
@@ -4084,19 +5743,17 @@ func (x *fastReflection_Report) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ReportCommit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
+	case "band.oracle.v1.ReportCommit.validator":
 		return x.Validator != ""
-	case "band.oracle.v1.Report.in_before_resolve":
-		return x.InBeforeResolve != false
-	case "band.oracle.v1.Report.raw_reports":
-		return len(x.RawReports) != 0
+	case "band.oracle.v1.ReportCommit.hash":
+		return len(x.Hash) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ReportCommit"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ReportCommit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4106,19 +5763,17 @@ func (x *fastReflection_Report) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ReportCommit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
+	case "band.oracle.v1.ReportCommit.validator":
 		x.Validator = ""
-	case "band.oracle.v1.Report.in_before_resolve":
-		x.InBeforeResolve = false
-	case "band.oracle.v1.Report.raw_reports":
-		x.RawReports = nil
+	case "band.oracle.v1.ReportCommit.hash":
+		x.Hash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ReportCommit"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ReportCommit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4128,25 +5783,19 @@ func (x *fastReflection_Report) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ReportCommit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.Report.validator":
+	case "band.oracle.v1.ReportCommit.validator":
 		value := x.Validator
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.Report.in_before_resolve":
-		value := x.InBeforeResolve
-		return protoreflect.ValueOfBool(value)
-	case "band.oracle.v1.Report.raw_reports":
-		if len(x.RawReports) == 0 {
-			return protoreflect.ValueOfList(&_Report_3_list{})
-		}
-		listValue := &_Report_3_list{list: &x.RawReports}
-		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.ReportCommit.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ReportCommit"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ReportCommit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4160,21 +5809,17 @@ func (x *fastReflection_Report) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ReportCommit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
+	case "band.oracle.v1.ReportCommit.validator":
 		x.Validator = value.Interface().(string)
-	case "band.oracle.v1.Report.in_before_resolve":
-		x.InBeforeResolve = value.Bool()
-	case "band.oracle.v1.Report.raw_reports":
-		lv := value.List()
-		clv := lv.(*_Report_3_list)
-		x.RawReports = *clv.list
+	case "band.oracle.v1.ReportCommit.hash":
+		x.Hash = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ReportCommit"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ReportCommit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4188,53 +5833,44 @@ func (x *fastReflection_Report) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ReportCommit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.raw_reports":
-		if x.RawReports == nil {
-			x.RawReports = []*RawReport{}
-		}
-		value := &_Report_3_list{list: &x.RawReports}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.Report.validator":
-		panic(fmt.Errorf("field validator of message band.oracle.v1.Report is not mutable"))
-	case "band.oracle.v1.Report.in_before_resolve":
-		panic(fmt.Errorf("field in_before_resolve of message band.oracle.v1.Report is not mutable"))
+	case "band.oracle.v1.ReportCommit.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.ReportCommit is not mutable"))
+	case "band.oracle.v1.ReportCommit.hash":
+		panic(fmt.Errorf("field hash of message band.oracle.v1.ReportCommit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ReportCommit"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ReportCommit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Report) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ReportCommit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.Report.validator":
+	case "band.oracle.v1.ReportCommit.validator":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.Report.in_before_resolve":
-		return protoreflect.ValueOfBool(false)
-	case "band.oracle.v1.Report.raw_reports":
-		list := []*RawReport{}
-		return protoreflect.ValueOfList(&_Report_3_list{list: &list})
+	case "band.oracle.v1.ReportCommit.hash":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Report"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.ReportCommit"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.Report does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.ReportCommit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ReportCommit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.Report", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.ReportCommit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4242,7 +5878,7 @@ func (x *fastReflection_Report) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ReportCommit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4253,7 +5889,7 @@ func (x *fastReflection_Report) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ReportCommit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4265,7 +5901,7 @@ func (x *fastReflection_Report) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Report) IsValid() bool {
+func (x *fastReflection_ReportCommit) IsValid() bool {
 	return x != nil
 }
 
@@ -4275,9 +5911,9 @@ func (x *fastReflection_Report) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ReportCommit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*ReportCommit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4293,14 +5929,9 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.InBeforeResolve {
-			n += 2
-		}
-		if len(x.RawReports) > 0 {
-			for _, e := range x.RawReports {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4312,7 +5943,7 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*ReportCommit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4331,31 +5962,12 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RawReports) > 0 {
-			for iNdEx := len(x.RawReports) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RawReports[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if x.InBeforeResolve {
-			i--
-			if x.InBeforeResolve {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
 		if len(x.Validator) > 0 {
 			i -= len(x.Validator)
@@ -4375,7 +5987,7 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Report)
+		x := input.Message.Interface().(*ReportCommit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4407,10 +6019,10 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReportCommit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReportCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4446,30 +6058,10 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InBeforeResolve", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.InBeforeResolve = bool(v != 0)
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawReports", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4479,24 +6071,24 @@ func (x *fastReflection_Report) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RawReports = append(x.RawReports, &RawReport{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawReports[len(x.RawReports)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -4621,7 +6213,7 @@ func (x *OracleRequestPacketData) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OracleRequestPacketData) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5466,7 +7058,7 @@ func (x *OracleRequestPacketAcknowledgement) ProtoReflect() protoreflect.Message
 }
 
 func (x *OracleRequestPacketAcknowledgement) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5882,7 +7474,7 @@ func (x *OracleResponsePacketData) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OracleResponsePacketData) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6616,7 +8208,7 @@ func (x *Result) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Result) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7536,7 +9128,7 @@ func (x *SigningResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SigningResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8050,7 +9642,7 @@ func (x *ValidatorStatus) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorStatus) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8539,7 +10131,7 @@ func (x *ActiveValidator) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ActiveValidator) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8998,6 +10590,7 @@ var (
 	fd_Params_oracle_reward_percentage  protoreflect.FieldDescriptor
 	fd_Params_inactive_penalty_duration protoreflect.FieldDescriptor
 	fd_Params_ibc_request_enabled       protoreflect.FieldDescriptor
+	fd_Params_commit_reveal_block_count protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -9014,6 +10607,7 @@ func init() {
 	fd_Params_oracle_reward_percentage = md_Params.Fields().ByName("oracle_reward_percentage")
 	fd_Params_inactive_penalty_duration = md_Params.Fields().ByName("inactive_penalty_duration")
 	fd_Params_ibc_request_enabled = md_Params.Fields().ByName("ibc_request_enabled")
+	fd_Params_commit_reveal_block_count = md_Params.Fields().ByName("commit_reveal_block_count")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -9025,7 +10619,7 @@ func (x *Params) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9147,6 +10741,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.CommitRevealBlockCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CommitRevealBlockCount)
+		if !f(fd_Params_commit_reveal_block_count, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9184,6 +10784,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InactivePenaltyDuration != uint64(0)
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		return x.IbcRequestEnabled != false
+	case "band.oracle.v1.Params.commit_reveal_block_count":
+		return x.CommitRevealBlockCount != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9222,6 +10824,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InactivePenaltyDuration = uint64(0)
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		x.IbcRequestEnabled = false
+	case "band.oracle.v1.Params.commit_reveal_block_count":
+		x.CommitRevealBlockCount = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9271,6 +10875,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		value := x.IbcRequestEnabled
 		return protoreflect.ValueOfBool(value)
+	case "band.oracle.v1.Params.commit_reveal_block_count":
+		value := x.CommitRevealBlockCount
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9313,6 +10920,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InactivePenaltyDuration = value.Uint()
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		x.IbcRequestEnabled = value.Bool()
+	case "band.oracle.v1.Params.commit_reveal_block_count":
+		x.CommitRevealBlockCount = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9355,6 +10964,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field inactive_penalty_duration of message band.oracle.v1.Params is not mutable"))
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		panic(fmt.Errorf("field ibc_request_enabled of message band.oracle.v1.Params is not mutable"))
+	case "band.oracle.v1.Params.commit_reveal_block_count":
+		panic(fmt.Errorf("field commit_reveal_block_count of message band.oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9390,6 +11001,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "band.oracle.v1.Params.ibc_request_enabled":
 		return protoreflect.ValueOfBool(false)
+	case "band.oracle.v1.Params.commit_reveal_block_count":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.Params"))
@@ -9492,6 +11105,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.IbcRequestEnabled {
 			n += 2
 		}
+		if x.CommitRevealBlockCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitRevealBlockCount))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9521,6 +11137,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CommitRevealBlockCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitRevealBlockCount))
+			i--
+			dAtA[i] = 0x60
+		}
 		if x.IbcRequestEnabled {
 			i--
 			if x.IbcRequestEnabled {
@@ -9840,6 +11461,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.IbcRequestEnabled = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitRevealBlockCount", wireType)
+				}
+				x.CommitRevealBlockCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitRevealBlockCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9941,7 +11581,7 @@ func (x *PendingResolveList) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PendingResolveList) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10433,7 +12073,7 @@ func (x *IBCChannel) ProtoReflect() protoreflect.Message {
 }
 
 func (x *IBCChannel) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10923,7 +12563,7 @@ func (x *RequestVerification) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RequestVerification) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11551,7 +13191,7 @@ func (x *PriceResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PriceResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12157,7 +13797,7 @@ func (x *OracleResultSignatureOrder) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OracleResultSignatureOrder) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+	mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12608,7 +14248,7 @@ const (
 	ResolveStatus_RESOLVE_STATUS_OPEN_UNSPECIFIED ResolveStatus = 0
 	// Success - the request has been resolved successfully with no errors.
 	ResolveStatus_RESOLVE_STATUS_SUCCESS ResolveStatus = 1
-	// Failure - an error occured during the request's resolve call.
+	// Failure - an error occurred during the request's resolve call.
 	ResolveStatus_RESOLVE_STATUS_FAILURE ResolveStatus = 2
 	// Expired - the request does not get enough reports from validator within the
 	// timeframe.
@@ -12735,6 +14375,8 @@ type DataSource struct {
 	// Fee is the data source fee per ask_count that data provider will receive
 	// from requester.
 	Fee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee,proto3" json:"fee,omitempty"`
+	// AccessPolicy restricts who can request data from the data source.
+	AccessPolicy *AccessPolicy `protobuf:"bytes,7,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy,omitempty"`
 }
 
 func (x *DataSource) Reset() {
@@ -12799,6 +14441,13 @@ func (x *DataSource) GetFee() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *DataSource) GetAccessPolicy() *AccessPolicy {
+	if x != nil {
+		return x.AccessPolicy
+	}
+	return nil
+}
+
 // OracleScript is the data structure for storing oracle scripts in the storage.
 type OracleScript struct {
 	state         protoimpl.MessageState
@@ -12822,6 +14471,8 @@ type OracleScript struct {
 	// It is recommendded to store source code on IPFS and get its URL to preserve
 	// decentralization.
 	SourceCodeUrl string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// AccessPolicy restricts who can request the oracle script.
+	AccessPolicy *AccessPolicy `protobuf:"bytes,7,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy,omitempty"`
 }
 
 func (x *OracleScript) Reset() {
@@ -12886,6 +14537,72 @@ func (x *OracleScript) GetSourceCodeUrl() string {
 	return ""
 }
 
+func (x *OracleScript) GetAccessPolicy() *AccessPolicy {
+	if x != nil {
+		return x.AccessPolicy
+	}
+	return nil
+}
+
+// AccessPolicy is the data structure for restricting the usage of an oracle
+// script or a data source. An empty access policy allows everyone.
+type AccessPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AllowedRequesters is the list of account addresses that can send requests
+	// directly. If empty while AllowedChannels is not, no direct request is
+	// allowed.
+	AllowedRequesters []string `protobuf:"bytes,1,rep,name=allowed_requesters,json=allowedRequesters,proto3" json:"allowed_requesters,omitempty"`
+	// AllowedChannels is the list of IBC channels that can send requests. If
+	// empty while AllowedRequesters is not, no IBC request is allowed.
+	AllowedChannels []*IBCChannel `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// MinFeeLimit is the minimum fee limit that requests must be willing to pay.
+	MinFeeLimit []*v1beta1.Coin `protobuf:"bytes,3,rep,name=min_fee_limit,json=minFeeLimit,proto3" json:"min_fee_limit,omitempty"`
+}
+
+func (x *AccessPolicy) Reset() {
+	*x = AccessPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicy) ProtoMessage() {}
+
+// Deprecated: Use AccessPolicy.ProtoReflect.Descriptor instead.
+func (*AccessPolicy) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccessPolicy) GetAllowedRequesters() []string {
+	if x != nil {
+		return x.AllowedRequesters
+	}
+	return nil
+}
+
+func (x *AccessPolicy) GetAllowedChannels() []*IBCChannel {
+	if x != nil {
+		return x.AllowedChannels
+	}
+	return nil
+}
+
+func (x *AccessPolicy) GetMinFeeLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.MinFeeLimit
+	}
+	return nil
+}
+
 // RawRequest is the data structure for storing raw requests in the storage.
 type RawRequest struct {
 	state         protoimpl.MessageState
@@ -12904,7 +14621,7 @@ type RawRequest struct {
 func (x *RawRequest) Reset() {
 	*x = RawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[2]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12918,7 +14635,7 @@ func (*RawRequest) ProtoMessage() {}
 
 // Deprecated: Use RawRequest.ProtoReflect.Descriptor instead.
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RawRequest) GetExternalId() uint64 {
@@ -12963,7 +14680,7 @@ type RawReport struct {
 func (x *RawReport) Reset() {
 	*x = RawReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12977,7 +14694,7 @@ func (*RawReport) ProtoMessage() {}
 
 // Deprecated: Use RawReport.ProtoReflect.Descriptor instead.
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RawReport) GetExternalId() uint64 {
@@ -13039,12 +14756,18 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit []*v1beta1.Coin `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// Tip is the amount escrowed by the requester to be split among the
+	// validators who report before the request is resolved.
+	Tip []*v1beta1.Coin `protobuf:"bytes,14,rep,name=tip,proto3" json:"tip,omitempty"`
+	// CommitReveal is a flag indicating whether validators must commit to their
+	// reports before revealing them.
+	CommitReveal bool `protobuf:"varint,15,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
 }
 
 func (x *Request) Reset() {
 	*x = Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13058,7 +14781,7 @@ func (*Request) ProtoMessage() {}
 
 // Deprecated: Use Request.ProtoReflect.Descriptor instead.
 func (*Request) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Request) GetOracleScriptId() uint64 {
@@ -13152,6 +14875,20 @@ func (x *Request) GetFeeLimit() []*v1beta1.Coin {
 	return nil
 }
 
+func (x *Request) GetTip() []*v1beta1.Coin {
+	if x != nil {
+		return x.Tip
+	}
+	return nil
+}
+
+func (x *Request) GetCommitReveal() bool {
+	if x != nil {
+		return x.CommitReveal
+	}
+	return false
+}
+
 // Report is the data structure for storing reports in the storage.
 type Report struct {
 	state         protoimpl.MessageState
@@ -13171,7 +14908,7 @@ type Report struct {
 func (x *Report) Reset() {
 	*x = Report{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13185,7 +14922,7 @@ func (*Report) ProtoMessage() {}
 
 // Deprecated: Use Report.ProtoReflect.Descriptor instead.
 func (*Report) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Report) GetValidator() string {
@@ -13209,6 +14946,53 @@ func (x *Report) GetRawReports() []*RawReport {
 	return nil
 }
 
+// ReportCommit is the data structure for storing the commit of a report in
+// commit-reveal mode.
+type ReportCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Validator is a validator address who submit the commit
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Hash is the SHA256 hash of the report to be revealed
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *ReportCommit) Reset() {
+	*x = ReportCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCommit) ProtoMessage() {}
+
+// Deprecated: Use ReportCommit.ProtoReflect.Descriptor instead.
+func (*ReportCommit) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReportCommit) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ReportCommit) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 // OracleRequestPacketData encodes an oracle request sent from other blockchains
 // to BandChain.
 type OracleRequestPacketData struct {
@@ -13247,7 +15031,7 @@ type OracleRequestPacketData struct {
 func (x *OracleRequestPacketData) Reset() {
 	*x = OracleRequestPacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13261,7 +15045,7 @@ func (*OracleRequestPacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketData.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OracleRequestPacketData) GetClientId() string {
@@ -13341,7 +15125,7 @@ type OracleRequestPacketAcknowledgement struct {
 func (x *OracleRequestPacketAcknowledgement) Reset() {
 	*x = OracleRequestPacketAcknowledgement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13355,7 +15139,7 @@ func (*OracleRequestPacketAcknowledgement) ProtoMessage() {}
 
 // Deprecated: Use OracleRequestPacketAcknowledgement.ProtoReflect.Descriptor instead.
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OracleRequestPacketAcknowledgement) GetRequestId() uint64 {
@@ -13398,7 +15182,7 @@ type OracleResponsePacketData struct {
 func (x *OracleResponsePacketData) Reset() {
 	*x = OracleResponsePacketData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13412,7 +15196,7 @@ func (*OracleResponsePacketData) ProtoMessage() {}
 
 // Deprecated: Use OracleResponsePacketData.ProtoReflect.Descriptor instead.
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *OracleResponsePacketData) GetClientId() string {
@@ -13508,7 +15292,7 @@ type Result struct {
 func (x *Result) Reset() {
 	*x = Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13522,7 +15306,7 @@ func (*Result) ProtoMessage() {}
 
 // Deprecated: Use Result.ProtoReflect.Descriptor instead.
 func (*Result) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Result) GetClientId() string {
@@ -13619,7 +15403,7 @@ type SigningResult struct {
 func (x *SigningResult) Reset() {
 	*x = SigningResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13633,7 +15417,7 @@ func (*SigningResult) ProtoMessage() {}
 
 // Deprecated: Use SigningResult.ProtoReflect.Descriptor instead.
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SigningResult) GetSigningId() uint64 {
@@ -13674,7 +15458,7 @@ type ValidatorStatus struct {
 func (x *ValidatorStatus) Reset() {
 	*x = ValidatorStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13688,7 +15472,7 @@ func (*ValidatorStatus) ProtoMessage() {}
 
 // Deprecated: Use ValidatorStatus.ProtoReflect.Descriptor instead.
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ValidatorStatus) GetIsActive() bool {
@@ -13720,7 +15504,7 @@ type ActiveValidator struct {
 func (x *ActiveValidator) Reset() {
 	*x = ActiveValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13734,7 +15518,7 @@ func (*ActiveValidator) ProtoMessage() {}
 
 // Deprecated: Use ActiveValidator.ProtoReflect.Descriptor instead.
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ActiveValidator) GetAddress() string {
@@ -13788,12 +15572,17 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IbcRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// CommitRevealBlockCount is the number of blocks after a request in
+	// commit-reveal mode is made during which the requested validators can
+	// commit, after which its reveal phase starts. The reveal phase starts
+	// earlier if all requested validators have committed.
+	CommitRevealBlockCount uint64 `protobuf:"varint,12,opt,name=commit_reveal_block_count,json=commitRevealBlockCount,proto3" json:"commit_reveal_block_count,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13807,7 +15596,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Params) GetMaxRawRequestCount() uint64 {
@@ -13887,6 +15676,13 @@ func (x *Params) GetIbcRequestEnabled() bool {
 	return false
 }
 
+func (x *Params) GetCommitRevealBlockCount() uint64 {
+	if x != nil {
+		return x.CommitRevealBlockCount
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	state         protoimpl.MessageState
@@ -13900,7 +15696,7 @@ type PendingResolveList struct {
 func (x *PendingResolveList) Reset() {
 	*x = PendingResolveList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13914,7 +15710,7 @@ func (*PendingResolveList) ProtoMessage() {}
 
 // Deprecated: Use PendingResolveList.ProtoReflect.Descriptor instead.
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PendingResolveList) GetRequestIds() []uint64 {
@@ -13942,7 +15738,7 @@ type IBCChannel struct {
 func (x *IBCChannel) Reset() {
 	*x = IBCChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13956,7 +15752,7 @@ func (*IBCChannel) ProtoMessage() {}
 
 // Deprecated: Use IBCChannel.ProtoReflect.Descriptor instead.
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *IBCChannel) GetPortId() string {
@@ -13995,7 +15791,7 @@ type RequestVerification struct {
 func (x *RequestVerification) Reset() {
 	*x = RequestVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14009,7 +15805,7 @@ func (*RequestVerification) ProtoMessage() {}
 
 // Deprecated: Use RequestVerification.ProtoReflect.Descriptor instead.
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RequestVerification) GetChainId() string {
@@ -14071,7 +15867,7 @@ type PriceResult struct {
 func (x *PriceResult) Reset() {
 	*x = PriceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[17]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14085,7 +15881,7 @@ func (*PriceResult) ProtoMessage() {}
 
 // Deprecated: Use PriceResult.ProtoReflect.Descriptor instead.
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PriceResult) GetSymbol() string {
@@ -14138,7 +15934,7 @@ type OracleResultSignatureOrder struct {
 func (x *OracleResultSignatureOrder) Reset() {
 	*x = OracleResultSignatureOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_oracle_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_oracle_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14152,7 +15948,7 @@ func (*OracleResultSignatureOrder) ProtoMessage() {}
 
 // Deprecated: Use OracleResultSignatureOrder.ProtoReflect.Descriptor instead.
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
+	return file_band_oracle_v1_oracle_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *OracleResultSignatureOrder) GetRequestId() uint64 {
@@ -14180,7 +15976,7 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
 	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x02, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
@@ -14195,325 +15991,367 @@ var file_band_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcf, 0x01, 0x0a,
-	0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a,
-	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5,
-	0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44,
-	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5, 0x05, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0b, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b,
-	0x69, 0x62, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2,
-	0xde, 0x1f, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69,
-	0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73,
-	0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53,
-	0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69,
-	0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72,
-	0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x09,
-	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x98, 0x02, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55,
+	0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0x81, 0x02, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x4b, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x6f, 0x0a,
+	0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5, 0x01, 0x0a, 0x0a, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x81, 0x01, 0x0a,
+	0x09, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xc9, 0x06, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a,
+	0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x69, 0x62, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x69, 0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73,
+	0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e,
+	0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a,
+	0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x5d, 0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
 	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x65,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73, 0x73, 0x5f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41,
-	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x74, 0x69,
+	0x70, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9a, 0x01, 0x0a,
+	0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x46, 0x0a, 0x0c, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xe6, 0x03, 0x0a, 0x17, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x68,
+	0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08,
+	0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x73,
+	0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53,
+	0x53, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x65, 0x0a, 0x22, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0xc7, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2,
+	0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f,
+	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa,
+	0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
+	0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
+	0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f,
 	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
 	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
 	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
 	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
 	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65,
 	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f,
-	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0x52,
-	0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61,
-	0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4b, 0xe2,
-	0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x3a,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc4, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d,
-	0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
-	0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x64, 0x61,
-	0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61, 0x73, 0x6d,
-	0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2c,
-	0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
-	0x3b, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a,
-	0x49, 0x42, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
-	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3,
-	0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6a, 0x0a, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x4b, 0xe2, 0xde, 0x1f, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x76, 0x33, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x74, 0x73, 0x73, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xff, 0x04, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x77,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x41, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x67, 0x61,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x61,
+	0x73, 0x6d, 0x47, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x67,
+	0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x79,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38,
+	0x0a, 0x18, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x15, 0xe2, 0xde, 0x1f, 0x11, 0x49, 0x42, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x11, 0x69, 0x62, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x3b, 0x0a, 0x12,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x4a, 0x0a, 0x0a, 0x49, 0x42, 0x43,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0b, 0xe2, 0xde, 0x1f, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53,
-	0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17,
-	0x8a, 0x9d, 0x20, 0x13, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12,
-	0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20,
-	0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x03, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-	0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43,
-	0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
-	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49,
-	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
-	0x00, 0x42, 0xb9, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61,
-	0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61,
-	0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42,
-	0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
-	0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e,
-	0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3d,
+	0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1c, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x44, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a,
+	0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb3, 0x01, 0x0a, 0x0b,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x70, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a, 0x04,
+	0x88, 0xa0, 0x1f, 0x00, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17, 0x8a, 0x9d, 0x20,
+	0x13, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01,
+	0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x12, 0x36, 0x0a, 0x16,
+	0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45,
+	0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x55, 0x52, 0x45, 0x12, 0x36, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03,
+	0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x2a, 0x6a, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45,
+	0x52, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x43,
+	0x4f, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49,
+	0x41, 0x4c, 0x5f, 0x41, 0x42, 0x49, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xb9,
+	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64,
+	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e,
+	0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -14529,50 +16367,57 @@ func file_band_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 }
 
 var file_band_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_band_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_band_oracle_v1_oracle_proto_goTypes = []interface{}{
 	(ResolveStatus)(0),                         // 0: band.oracle.v1.ResolveStatus
 	(Encoder)(0),                               // 1: band.oracle.v1.Encoder
 	(*DataSource)(nil),                         // 2: band.oracle.v1.DataSource
 	(*OracleScript)(nil),                       // 3: band.oracle.v1.OracleScript
-	(*RawRequest)(nil),                         // 4: band.oracle.v1.RawRequest
-	(*RawReport)(nil),                          // 5: band.oracle.v1.RawReport
-	(*Request)(nil),                            // 6: band.oracle.v1.Request
-	(*Report)(nil),                             // 7: band.oracle.v1.Report
-	(*OracleRequestPacketData)(nil),            // 8: band.oracle.v1.OracleRequestPacketData
-	(*OracleRequestPacketAcknowledgement)(nil), // 9: band.oracle.v1.OracleRequestPacketAcknowledgement
-	(*OracleResponsePacketData)(nil),           // 10: band.oracle.v1.OracleResponsePacketData
-	(*Result)(nil),                             // 11: band.oracle.v1.Result
-	(*SigningResult)(nil),                      // 12: band.oracle.v1.SigningResult
-	(*ValidatorStatus)(nil),                    // 13: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                    // 14: band.oracle.v1.ActiveValidator
-	(*Params)(nil),                             // 15: band.oracle.v1.Params
-	(*PendingResolveList)(nil),                 // 16: band.oracle.v1.PendingResolveList
-	(*IBCChannel)(nil),                         // 17: band.oracle.v1.IBCChannel
-	(*RequestVerification)(nil),                // 18: band.oracle.v1.RequestVerification
-	(*PriceResult)(nil),                        // 19: band.oracle.v1.PriceResult
-	(*OracleResultSignatureOrder)(nil),         // 20: band.oracle.v1.OracleResultSignatureOrder
-	(*v1beta1.Coin)(nil),                       // 21: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil),              // 22: google.protobuf.Timestamp
+	(*AccessPolicy)(nil),                       // 4: band.oracle.v1.AccessPolicy
+	(*RawRequest)(nil),                         // 5: band.oracle.v1.RawRequest
+	(*RawReport)(nil),                          // 6: band.oracle.v1.RawReport
+	(*Request)(nil),                            // 7: band.oracle.v1.Request
+	(*Report)(nil),                             // 8: band.oracle.v1.Report
+	(*ReportCommit)(nil),                       // 9: band.oracle.v1.ReportCommit
+	(*OracleRequestPacketData)(nil),            // 10: band.oracle.v1.OracleRequestPacketData
+	(*OracleRequestPacketAcknowledgement)(nil), // 11: band.oracle.v1.OracleRequestPacketAcknowledgement
+	(*OracleResponsePacketData)(nil),           // 12: band.oracle.v1.OracleResponsePacketData
+	(*Result)(nil),                             // 13: band.oracle.v1.Result
+	(*SigningResult)(nil),                      // 14: band.oracle.v1.SigningResult
+	(*ValidatorStatus)(nil),                    // 15: band.oracle.v1.ValidatorStatus
+	(*ActiveValidator)(nil),                    // 16: band.oracle.v1.ActiveValidator
+	(*Params)(nil),                             // 17: band.oracle.v1.Params
+	(*PendingResolveList)(nil),                 // 18: band.oracle.v1.PendingResolveList
+	(*IBCChannel)(nil),                         // 19: band.oracle.v1.IBCChannel
+	(*RequestVerification)(nil),                // 20: band.oracle.v1.RequestVerification
+	(*PriceResult)(nil),                        // 21: band.oracle.v1.PriceResult
+	(*OracleResultSignatureOrder)(nil),         // 22: band.oracle.v1.OracleResultSignatureOrder
+	(*v1beta1.Coin)(nil),                       // 23: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),              // 24: google.protobuf.Timestamp
 }
 var file_band_oracle_v1_oracle_proto_depIdxs = []int32{
-	21, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
-	4,  // 1: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
-	17, // 2: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
-	1,  // 3: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
-	21, // 4: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	5,  // 5: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
-	21, // 6: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	1,  // 7: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
-	0,  // 8: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	0,  // 9: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
-	22, // 10: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
-	1,  // 11: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	23, // 0: band.oracle.v1.DataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	4,  // 1: band.oracle.v1.DataSource.access_policy:type_name -> band.oracle.v1.AccessPolicy
+	4,  // 2: band.oracle.v1.OracleScript.access_policy:type_name -> band.oracle.v1.AccessPolicy
+	19, // 3: band.oracle.v1.AccessPolicy.allowed_channels:type_name -> band.oracle.v1.IBCChannel
+	23, // 4: band.oracle.v1.AccessPolicy.min_fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	5,  // 5: band.oracle.v1.Request.raw_requests:type_name -> band.oracle.v1.RawRequest
+	19, // 6: band.oracle.v1.Request.ibc_channel:type_name -> band.oracle.v1.IBCChannel
+	1,  // 7: band.oracle.v1.Request.tss_encoder:type_name -> band.oracle.v1.Encoder
+	23, // 8: band.oracle.v1.Request.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	23, // 9: band.oracle.v1.Request.tip:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 10: band.oracle.v1.Report.raw_reports:type_name -> band.oracle.v1.RawReport
+	23, // 11: band.oracle.v1.OracleRequestPacketData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 12: band.oracle.v1.OracleRequestPacketData.tss_encoder:type_name -> band.oracle.v1.Encoder
+	0,  // 13: band.oracle.v1.OracleResponsePacketData.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	0,  // 14: band.oracle.v1.Result.resolve_status:type_name -> band.oracle.v1.ResolveStatus
+	24, // 15: band.oracle.v1.ValidatorStatus.since:type_name -> google.protobuf.Timestamp
+	1,  // 16: band.oracle.v1.OracleResultSignatureOrder.encoder:type_name -> band.oracle.v1.Encoder
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_oracle_proto_init() }
@@ -14606,7 +16451,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawRequest); i {
+			switch v := v.(*AccessPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14618,7 +16463,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawReport); i {
+			switch v := v.(*RawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14630,7 +16475,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
+			switch v := v.(*RawReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14642,7 +16487,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Report); i {
+			switch v := v.(*Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14654,7 +16499,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketData); i {
+			switch v := v.(*Report); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14666,7 +16511,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleRequestPacketAcknowledgement); i {
+			switch v := v.(*ReportCommit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14678,7 +16523,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleResponsePacketData); i {
+			switch v := v.(*OracleRequestPacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14690,7 +16535,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
+			switch v := v.(*OracleRequestPacketAcknowledgement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14702,7 +16547,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigningResult); i {
+			switch v := v.(*OracleResponsePacketData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14714,7 +16559,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorStatus); i {
+			switch v := v.(*Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14726,7 +16571,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveValidator); i {
+			switch v := v.(*SigningResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14738,7 +16583,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*ValidatorStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14750,7 +16595,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingResolveList); i {
+			switch v := v.(*ActiveValidator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14762,7 +16607,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IBCChannel); i {
+			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14774,7 +16619,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestVerification); i {
+			switch v := v.(*PendingResolveList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14786,7 +16631,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceResult); i {
+			switch v := v.(*IBCChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14798,6 +16643,30 @@ func file_band_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_band_oracle_v1_oracle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_oracle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OracleResultSignatureOrder); i {
 			case 0:
 				return &v.state
@@ -14816,7 +16685,7 @@ func file_band_oracle_v1_oracle_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_oracle_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
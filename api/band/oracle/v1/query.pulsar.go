@@ -2,6 +2,7 @@
 package oraclev1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -13329,1249 +13330,6329 @@ func (x *fastReflection_QueryRequestVerificationResponse) ProtoMethods() *protoi
 	}
 }
 
+var (
+	md_QueryTipPercentilesRequest       protoreflect.MessageDescriptor
+	fd_QueryTipPercentilesRequest_denom protoreflect.FieldDescriptor
+	fd_QueryTipPercentilesRequest_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryTipPercentilesRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryTipPercentilesRequest")
+	fd_QueryTipPercentilesRequest_denom = md_QueryTipPercentilesRequest.Fields().ByName("denom")
+	fd_QueryTipPercentilesRequest_limit = md_QueryTipPercentilesRequest.Fields().ByName("limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTipPercentilesRequest)(nil)
+
+type fastReflection_QueryTipPercentilesRequest QueryTipPercentilesRequest
+
+func (x *QueryTipPercentilesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTipPercentilesRequest)(x)
+}
+
+func (x *QueryTipPercentilesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTipPercentilesRequest_messageType fastReflection_QueryTipPercentilesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTipPercentilesRequest_messageType{}
+
+type fastReflection_QueryTipPercentilesRequest_messageType struct{}
+
+func (x fastReflection_QueryTipPercentilesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTipPercentilesRequest)(nil)
+}
+func (x fastReflection_QueryTipPercentilesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTipPercentilesRequest)
+}
+func (x fastReflection_QueryTipPercentilesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTipPercentilesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTipPercentilesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTipPercentilesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTipPercentilesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTipPercentilesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTipPercentilesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTipPercentilesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTipPercentilesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTipPercentilesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTipPercentilesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryTipPercentilesRequest_denom, value) {
+			return
+		}
+	}
+	if x.Limit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Limit)
+		if !f(fd_QueryTipPercentilesRequest_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTipPercentilesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesRequest.denom":
+		return x.Denom != ""
+	case "band.oracle.v1.QueryTipPercentilesRequest.limit":
+		return x.Limit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTipPercentilesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesRequest.denom":
+		x.Denom = ""
+	case "band.oracle.v1.QueryTipPercentilesRequest.limit":
+		x.Limit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTipPercentilesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QueryTipPercentilesRequest.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTipPercentilesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesRequest.denom":
+		x.Denom = value.Interface().(string)
+	case "band.oracle.v1.QueryTipPercentilesRequest.limit":
+		x.Limit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTipPercentilesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesRequest.denom":
+		panic(fmt.Errorf("field denom of message band.oracle.v1.QueryTipPercentilesRequest is not mutable"))
+	case "band.oracle.v1.QueryTipPercentilesRequest.limit":
+		panic(fmt.Errorf("field limit of message band.oracle.v1.QueryTipPercentilesRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTipPercentilesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesRequest.denom":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QueryTipPercentilesRequest.limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTipPercentilesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryTipPercentilesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTipPercentilesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTipPercentilesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTipPercentilesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTipPercentilesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTipPercentilesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTipPercentilesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTipPercentilesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTipPercentilesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTipPercentilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTipPercentilesResponse_2_list)(nil)
+
+type _QueryTipPercentilesResponse_2_list struct {
+	list *[]*TipPercentile
+}
+
+func (x *_QueryTipPercentilesResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTipPercentilesResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTipPercentilesResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TipPercentile)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTipPercentilesResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TipPercentile)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTipPercentilesResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(TipPercentile)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTipPercentilesResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTipPercentilesResponse_2_list) NewElement() protoreflect.Value {
+	v := new(TipPercentile)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTipPercentilesResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTipPercentilesResponse              protoreflect.MessageDescriptor
+	fd_QueryTipPercentilesResponse_sample_count protoreflect.FieldDescriptor
+	fd_QueryTipPercentilesResponse_percentiles  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryTipPercentilesResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryTipPercentilesResponse")
+	fd_QueryTipPercentilesResponse_sample_count = md_QueryTipPercentilesResponse.Fields().ByName("sample_count")
+	fd_QueryTipPercentilesResponse_percentiles = md_QueryTipPercentilesResponse.Fields().ByName("percentiles")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTipPercentilesResponse)(nil)
+
+type fastReflection_QueryTipPercentilesResponse QueryTipPercentilesResponse
+
+func (x *QueryTipPercentilesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTipPercentilesResponse)(x)
+}
+
+func (x *QueryTipPercentilesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTipPercentilesResponse_messageType fastReflection_QueryTipPercentilesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTipPercentilesResponse_messageType{}
+
+type fastReflection_QueryTipPercentilesResponse_messageType struct{}
+
+func (x fastReflection_QueryTipPercentilesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTipPercentilesResponse)(nil)
+}
+func (x fastReflection_QueryTipPercentilesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTipPercentilesResponse)
+}
+func (x fastReflection_QueryTipPercentilesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTipPercentilesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTipPercentilesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTipPercentilesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTipPercentilesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTipPercentilesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTipPercentilesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTipPercentilesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTipPercentilesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTipPercentilesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTipPercentilesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SampleCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SampleCount)
+		if !f(fd_QueryTipPercentilesResponse_sample_count, value) {
+			return
+		}
+	}
+	if len(x.Percentiles) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTipPercentilesResponse_2_list{list: &x.Percentiles})
+		if !f(fd_QueryTipPercentilesResponse_percentiles, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTipPercentilesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesResponse.sample_count":
+		return x.SampleCount != uint64(0)
+	case "band.oracle.v1.QueryTipPercentilesResponse.percentiles":
+		return len(x.Percentiles) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTipPercentilesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesResponse.sample_count":
+		x.SampleCount = uint64(0)
+	case "band.oracle.v1.QueryTipPercentilesResponse.percentiles":
+		x.Percentiles = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTipPercentilesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesResponse.sample_count":
+		value := x.SampleCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QueryTipPercentilesResponse.percentiles":
+		if len(x.Percentiles) == 0 {
+			return protoreflect.ValueOfList(&_QueryTipPercentilesResponse_2_list{})
+		}
+		listValue := &_QueryTipPercentilesResponse_2_list{list: &x.Percentiles}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTipPercentilesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesResponse.sample_count":
+		x.SampleCount = value.Uint()
+	case "band.oracle.v1.QueryTipPercentilesResponse.percentiles":
+		lv := value.List()
+		clv := lv.(*_QueryTipPercentilesResponse_2_list)
+		x.Percentiles = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTipPercentilesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesResponse.percentiles":
+		if x.Percentiles == nil {
+			x.Percentiles = []*TipPercentile{}
+		}
+		value := &_QueryTipPercentilesResponse_2_list{list: &x.Percentiles}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryTipPercentilesResponse.sample_count":
+		panic(fmt.Errorf("field sample_count of message band.oracle.v1.QueryTipPercentilesResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTipPercentilesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryTipPercentilesResponse.sample_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QueryTipPercentilesResponse.percentiles":
+		list := []*TipPercentile{}
+		return protoreflect.ValueOfList(&_QueryTipPercentilesResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryTipPercentilesResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryTipPercentilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTipPercentilesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryTipPercentilesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTipPercentilesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTipPercentilesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTipPercentilesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTipPercentilesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTipPercentilesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SampleCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SampleCount))
+		}
+		if len(x.Percentiles) > 0 {
+			for _, e := range x.Percentiles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTipPercentilesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Percentiles) > 0 {
+			for iNdEx := len(x.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Percentiles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.SampleCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SampleCount))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTipPercentilesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTipPercentilesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTipPercentilesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SampleCount", wireType)
+				}
+				x.SampleCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SampleCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Percentiles = append(x.Percentiles, &TipPercentile{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Percentiles[len(x.Percentiles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TipPercentile            protoreflect.MessageDescriptor
+	fd_TipPercentile_percentile protoreflect.FieldDescriptor
+	fd_TipPercentile_tip        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_TipPercentile = File_band_oracle_v1_query_proto.Messages().ByName("TipPercentile")
+	fd_TipPercentile_percentile = md_TipPercentile.Fields().ByName("percentile")
+	fd_TipPercentile_tip = md_TipPercentile.Fields().ByName("tip")
+}
+
+var _ protoreflect.Message = (*fastReflection_TipPercentile)(nil)
+
+type fastReflection_TipPercentile TipPercentile
+
+func (x *TipPercentile) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TipPercentile)(x)
+}
+
+func (x *TipPercentile) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TipPercentile_messageType fastReflection_TipPercentile_messageType
+var _ protoreflect.MessageType = fastReflection_TipPercentile_messageType{}
+
+type fastReflection_TipPercentile_messageType struct{}
+
+func (x fastReflection_TipPercentile_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TipPercentile)(nil)
+}
+func (x fastReflection_TipPercentile_messageType) New() protoreflect.Message {
+	return new(fastReflection_TipPercentile)
+}
+func (x fastReflection_TipPercentile_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TipPercentile
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TipPercentile) Descriptor() protoreflect.MessageDescriptor {
+	return md_TipPercentile
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TipPercentile) Type() protoreflect.MessageType {
+	return _fastReflection_TipPercentile_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TipPercentile) New() protoreflect.Message {
+	return new(fastReflection_TipPercentile)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TipPercentile) Interface() protoreflect.ProtoMessage {
+	return (*TipPercentile)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TipPercentile) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Percentile != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Percentile)
+		if !f(fd_TipPercentile_percentile, value) {
+			return
+		}
+	}
+	if x.Tip != nil {
+		value := protoreflect.ValueOfMessage(x.Tip.ProtoReflect())
+		if !f(fd_TipPercentile_tip, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TipPercentile) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.TipPercentile.percentile":
+		return x.Percentile != uint64(0)
+	case "band.oracle.v1.TipPercentile.tip":
+		return x.Tip != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TipPercentile"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TipPercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TipPercentile) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.TipPercentile.percentile":
+		x.Percentile = uint64(0)
+	case "band.oracle.v1.TipPercentile.tip":
+		x.Tip = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TipPercentile"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TipPercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TipPercentile) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.TipPercentile.percentile":
+		value := x.Percentile
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.TipPercentile.tip":
+		value := x.Tip
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TipPercentile"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TipPercentile does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TipPercentile) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.TipPercentile.percentile":
+		x.Percentile = value.Uint()
+	case "band.oracle.v1.TipPercentile.tip":
+		x.Tip = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TipPercentile"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TipPercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TipPercentile) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.TipPercentile.tip":
+		if x.Tip == nil {
+			x.Tip = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Tip.ProtoReflect())
+	case "band.oracle.v1.TipPercentile.percentile":
+		panic(fmt.Errorf("field percentile of message band.oracle.v1.TipPercentile is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TipPercentile"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TipPercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TipPercentile) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.TipPercentile.percentile":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.TipPercentile.tip":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.TipPercentile"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.TipPercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TipPercentile) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.TipPercentile", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TipPercentile) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TipPercentile) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TipPercentile) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TipPercentile) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TipPercentile)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Percentile != 0 {
+			n += 1 + runtime.Sov(uint64(x.Percentile))
+		}
+		if x.Tip != nil {
+			l = options.Size(x.Tip)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TipPercentile)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Tip != nil {
+			encoded, err := options.Marshal(x.Tip)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Percentile != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Percentile))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TipPercentile)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TipPercentile: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TipPercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+				}
+				x.Percentile = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Percentile |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Tip == nil {
+					x.Tip = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tip); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateRequestRequest_7_list)(nil)
+
+type _QuerySimulateRequestRequest_7_list struct {
+	list *[]*MockExternalData
+}
+
+func (x *_QuerySimulateRequestRequest_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateRequestRequest_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestRequest_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MockExternalData)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateRequestRequest_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MockExternalData)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateRequestRequest_7_list) AppendMutable() protoreflect.Value {
+	v := new(MockExternalData)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestRequest_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateRequestRequest_7_list) NewElement() protoreflect.Value {
+	v := new(MockExternalData)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestRequest_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateRequestRequest                  protoreflect.MessageDescriptor
+	fd_QuerySimulateRequestRequest_oracle_script_id protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_calldata         protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_ask_count        protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_min_count        protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_prepare_gas      protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_execute_gas      protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestRequest_external_data    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QuerySimulateRequestRequest = File_band_oracle_v1_query_proto.Messages().ByName("QuerySimulateRequestRequest")
+	fd_QuerySimulateRequestRequest_oracle_script_id = md_QuerySimulateRequestRequest.Fields().ByName("oracle_script_id")
+	fd_QuerySimulateRequestRequest_calldata = md_QuerySimulateRequestRequest.Fields().ByName("calldata")
+	fd_QuerySimulateRequestRequest_ask_count = md_QuerySimulateRequestRequest.Fields().ByName("ask_count")
+	fd_QuerySimulateRequestRequest_min_count = md_QuerySimulateRequestRequest.Fields().ByName("min_count")
+	fd_QuerySimulateRequestRequest_prepare_gas = md_QuerySimulateRequestRequest.Fields().ByName("prepare_gas")
+	fd_QuerySimulateRequestRequest_execute_gas = md_QuerySimulateRequestRequest.Fields().ByName("execute_gas")
+	fd_QuerySimulateRequestRequest_external_data = md_QuerySimulateRequestRequest.Fields().ByName("external_data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateRequestRequest)(nil)
+
+type fastReflection_QuerySimulateRequestRequest QuerySimulateRequestRequest
+
+func (x *QuerySimulateRequestRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateRequestRequest)(x)
+}
+
+func (x *QuerySimulateRequestRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateRequestRequest_messageType fastReflection_QuerySimulateRequestRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateRequestRequest_messageType{}
+
+type fastReflection_QuerySimulateRequestRequest_messageType struct{}
+
+func (x fastReflection_QuerySimulateRequestRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateRequestRequest)(nil)
+}
+func (x fastReflection_QuerySimulateRequestRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateRequestRequest)
+}
+func (x fastReflection_QuerySimulateRequestRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateRequestRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateRequestRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateRequestRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateRequestRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateRequestRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateRequestRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateRequestRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateRequestRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateRequestRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateRequestRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_QuerySimulateRequestRequest_oracle_script_id, value) {
+			return
+		}
+	}
+	if len(x.Calldata) != 0 {
+		value := protoreflect.ValueOfBytes(x.Calldata)
+		if !f(fd_QuerySimulateRequestRequest_calldata, value) {
+			return
+		}
+	}
+	if x.AskCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AskCount)
+		if !f(fd_QuerySimulateRequestRequest_ask_count, value) {
+			return
+		}
+	}
+	if x.MinCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinCount)
+		if !f(fd_QuerySimulateRequestRequest_min_count, value) {
+			return
+		}
+	}
+	if x.PrepareGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGas)
+		if !f(fd_QuerySimulateRequestRequest_prepare_gas, value) {
+			return
+		}
+	}
+	if x.ExecuteGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGas)
+		if !f(fd_QuerySimulateRequestRequest_execute_gas, value) {
+			return
+		}
+	}
+	if len(x.ExternalData) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateRequestRequest_7_list{list: &x.ExternalData})
+		if !f(fd_QuerySimulateRequestRequest_external_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateRequestRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		return len(x.Calldata) != 0
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		return x.AskCount != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		return x.MinCount != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		return x.PrepareGas != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		return x.ExecuteGas != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.external_data":
+		return len(x.ExternalData) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateRequestRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		x.Calldata = nil
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		x.AskCount = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		x.MinCount = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		x.PrepareGas = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		x.ExecuteGas = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestRequest.external_data":
+		x.ExternalData = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateRequestRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		value := x.Calldata
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		value := x.AskCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		value := x.MinCount
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		value := x.PrepareGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		value := x.ExecuteGas
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.external_data":
+		if len(x.ExternalData) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateRequestRequest_7_list{})
+		}
+		listValue := &_QuerySimulateRequestRequest_7_list{list: &x.ExternalData}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateRequestRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		x.Calldata = value.Bytes()
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		x.AskCount = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		x.MinCount = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		x.PrepareGas = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		x.ExecuteGas = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestRequest.external_data":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateRequestRequest_7_list)
+		x.ExternalData = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateRequestRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestRequest.external_data":
+		if x.ExternalData == nil {
+			x.ExternalData = []*MockExternalData{}
+		}
+		value := &_QuerySimulateRequestRequest_7_list{list: &x.ExternalData}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		panic(fmt.Errorf("field calldata of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		panic(fmt.Errorf("field ask_count of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		panic(fmt.Errorf("field min_count of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		panic(fmt.Errorf("field prepare_gas of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		panic(fmt.Errorf("field execute_gas of message band.oracle.v1.QuerySimulateRequestRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateRequestRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestRequest.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.calldata":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.QuerySimulateRequestRequest.ask_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.min_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.prepare_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.execute_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestRequest.external_data":
+		list := []*MockExternalData{}
+		return protoreflect.ValueOfList(&_QuerySimulateRequestRequest_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateRequestRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QuerySimulateRequestRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateRequestRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateRequestRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateRequestRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateRequestRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateRequestRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Calldata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AskCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AskCount))
+		}
+		if x.MinCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinCount))
+		}
+		if x.PrepareGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGas))
+		}
+		if x.ExecuteGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGas))
+		}
+		if len(x.ExternalData) > 0 {
+			for _, e := range x.ExternalData {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateRequestRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExternalData) > 0 {
+			for iNdEx := len(x.ExternalData) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExternalData[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.ExecuteGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGas))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.PrepareGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGas))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AskCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AskCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Calldata) > 0 {
+			i -= len(x.Calldata)
+			copy(dAtA[i:], x.Calldata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Calldata)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateRequestRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateRequestRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Calldata = append(x.Calldata[:0], dAtA[iNdEx:postIndex]...)
+				if x.Calldata == nil {
+					x.Calldata = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				}
+				x.AskCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AskCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				}
+				x.MinCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				}
+				x.PrepareGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+				}
+				x.ExecuteGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalData", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExternalData = append(x.ExternalData, &MockExternalData{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExternalData[len(x.ExternalData)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MockExternalData                protoreflect.MessageDescriptor
+	fd_MockExternalData_data_source_id protoreflect.FieldDescriptor
+	fd_MockExternalData_exit_code      protoreflect.FieldDescriptor
+	fd_MockExternalData_data           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_MockExternalData = File_band_oracle_v1_query_proto.Messages().ByName("MockExternalData")
+	fd_MockExternalData_data_source_id = md_MockExternalData.Fields().ByName("data_source_id")
+	fd_MockExternalData_exit_code = md_MockExternalData.Fields().ByName("exit_code")
+	fd_MockExternalData_data = md_MockExternalData.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_MockExternalData)(nil)
+
+type fastReflection_MockExternalData MockExternalData
+
+func (x *MockExternalData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MockExternalData)(x)
+}
+
+func (x *MockExternalData) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MockExternalData_messageType fastReflection_MockExternalData_messageType
+var _ protoreflect.MessageType = fastReflection_MockExternalData_messageType{}
+
+type fastReflection_MockExternalData_messageType struct{}
+
+func (x fastReflection_MockExternalData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MockExternalData)(nil)
+}
+func (x fastReflection_MockExternalData_messageType) New() protoreflect.Message {
+	return new(fastReflection_MockExternalData)
+}
+func (x fastReflection_MockExternalData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MockExternalData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MockExternalData) Descriptor() protoreflect.MessageDescriptor {
+	return md_MockExternalData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MockExternalData) Type() protoreflect.MessageType {
+	return _fastReflection_MockExternalData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MockExternalData) New() protoreflect.Message {
+	return new(fastReflection_MockExternalData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MockExternalData) Interface() protoreflect.ProtoMessage {
+	return (*MockExternalData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MockExternalData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_MockExternalData_data_source_id, value) {
+			return
+		}
+	}
+	if x.ExitCode != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExitCode)
+		if !f(fd_MockExternalData_exit_code, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_MockExternalData_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MockExternalData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.MockExternalData.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.MockExternalData.exit_code":
+		return x.ExitCode != uint32(0)
+	case "band.oracle.v1.MockExternalData.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MockExternalData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MockExternalData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MockExternalData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MockExternalData.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.MockExternalData.exit_code":
+		x.ExitCode = uint32(0)
+	case "band.oracle.v1.MockExternalData.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MockExternalData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MockExternalData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MockExternalData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.MockExternalData.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MockExternalData.exit_code":
+		value := x.ExitCode
+		return protoreflect.ValueOfUint32(value)
+	case "band.oracle.v1.MockExternalData.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MockExternalData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MockExternalData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MockExternalData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MockExternalData.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.MockExternalData.exit_code":
+		x.ExitCode = uint32(value.Uint())
+	case "band.oracle.v1.MockExternalData.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MockExternalData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MockExternalData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MockExternalData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MockExternalData.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.MockExternalData is not mutable"))
+	case "band.oracle.v1.MockExternalData.exit_code":
+		panic(fmt.Errorf("field exit_code of message band.oracle.v1.MockExternalData is not mutable"))
+	case "band.oracle.v1.MockExternalData.data":
+		panic(fmt.Errorf("field data of message band.oracle.v1.MockExternalData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MockExternalData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MockExternalData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MockExternalData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MockExternalData.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MockExternalData.exit_code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "band.oracle.v1.MockExternalData.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MockExternalData"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MockExternalData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MockExternalData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MockExternalData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MockExternalData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MockExternalData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MockExternalData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MockExternalData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MockExternalData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		if x.ExitCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExitCode))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MockExternalData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ExitCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExitCode))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MockExternalData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MockExternalData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MockExternalData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				}
+				x.ExitCode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExitCode |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateRequestResponse_1_list)(nil)
+
+type _QuerySimulateRequestResponse_1_list struct {
+	list *[]*RawRequest
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RawRequest)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(RawRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) NewElement() protoreflect.Value {
+	v := new(RawRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateRequestResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateRequestResponse                  protoreflect.MessageDescriptor
+	fd_QuerySimulateRequestResponse_raw_requests     protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_prepare_gas_used protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_prepare_error    protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_execute_gas_used protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_execute_error    protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_result           protoreflect.FieldDescriptor
+	fd_QuerySimulateRequestResponse_decoded_result   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QuerySimulateRequestResponse = File_band_oracle_v1_query_proto.Messages().ByName("QuerySimulateRequestResponse")
+	fd_QuerySimulateRequestResponse_raw_requests = md_QuerySimulateRequestResponse.Fields().ByName("raw_requests")
+	fd_QuerySimulateRequestResponse_prepare_gas_used = md_QuerySimulateRequestResponse.Fields().ByName("prepare_gas_used")
+	fd_QuerySimulateRequestResponse_prepare_error = md_QuerySimulateRequestResponse.Fields().ByName("prepare_error")
+	fd_QuerySimulateRequestResponse_execute_gas_used = md_QuerySimulateRequestResponse.Fields().ByName("execute_gas_used")
+	fd_QuerySimulateRequestResponse_execute_error = md_QuerySimulateRequestResponse.Fields().ByName("execute_error")
+	fd_QuerySimulateRequestResponse_result = md_QuerySimulateRequestResponse.Fields().ByName("result")
+	fd_QuerySimulateRequestResponse_decoded_result = md_QuerySimulateRequestResponse.Fields().ByName("decoded_result")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateRequestResponse)(nil)
+
+type fastReflection_QuerySimulateRequestResponse QuerySimulateRequestResponse
+
+func (x *QuerySimulateRequestResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateRequestResponse)(x)
+}
+
+func (x *QuerySimulateRequestResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateRequestResponse_messageType fastReflection_QuerySimulateRequestResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateRequestResponse_messageType{}
+
+type fastReflection_QuerySimulateRequestResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulateRequestResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateRequestResponse)(nil)
+}
+func (x fastReflection_QuerySimulateRequestResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateRequestResponse)
+}
+func (x fastReflection_QuerySimulateRequestResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateRequestResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateRequestResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateRequestResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateRequestResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateRequestResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateRequestResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateRequestResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateRequestResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateRequestResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateRequestResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.RawRequests) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateRequestResponse_1_list{list: &x.RawRequests})
+		if !f(fd_QuerySimulateRequestResponse_raw_requests, value) {
+			return
+		}
+	}
+	if x.PrepareGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepareGasUsed)
+		if !f(fd_QuerySimulateRequestResponse_prepare_gas_used, value) {
+			return
+		}
+	}
+	if x.PrepareError != "" {
+		value := protoreflect.ValueOfString(x.PrepareError)
+		if !f(fd_QuerySimulateRequestResponse_prepare_error, value) {
+			return
+		}
+	}
+	if x.ExecuteGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecuteGasUsed)
+		if !f(fd_QuerySimulateRequestResponse_execute_gas_used, value) {
+			return
+		}
+	}
+	if x.ExecuteError != "" {
+		value := protoreflect.ValueOfString(x.ExecuteError)
+		if !f(fd_QuerySimulateRequestResponse_execute_error, value) {
+			return
+		}
+	}
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_QuerySimulateRequestResponse_result, value) {
+			return
+		}
+	}
+	if x.DecodedResult != "" {
+		value := protoreflect.ValueOfString(x.DecodedResult)
+		if !f(fd_QuerySimulateRequestResponse_decoded_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateRequestResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		return len(x.RawRequests) != 0
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		return x.PrepareGasUsed != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_error":
+		return x.PrepareError != ""
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		return x.ExecuteGasUsed != uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_error":
+		return x.ExecuteError != ""
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		return len(x.Result) != 0
+	case "band.oracle.v1.QuerySimulateRequestResponse.decoded_result":
+		return x.DecodedResult != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateRequestResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		x.RawRequests = nil
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		x.PrepareGasUsed = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_error":
+		x.PrepareError = ""
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		x.ExecuteGasUsed = uint64(0)
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_error":
+		x.ExecuteError = ""
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		x.Result = nil
+	case "band.oracle.v1.QuerySimulateRequestResponse.decoded_result":
+		x.DecodedResult = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateRequestResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		if len(x.RawRequests) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateRequestResponse_1_list{})
+		}
+		listValue := &_QuerySimulateRequestResponse_1_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		value := x.PrepareGasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_error":
+		value := x.PrepareError
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		value := x.ExecuteGasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_error":
+		value := x.ExecuteError
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.decoded_result":
+		value := x.DecodedResult
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateRequestResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateRequestResponse_1_list)
+		x.RawRequests = *clv.list
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		x.PrepareGasUsed = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_error":
+		x.PrepareError = value.Interface().(string)
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		x.ExecuteGasUsed = value.Uint()
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_error":
+		x.ExecuteError = value.Interface().(string)
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		x.Result = value.Bytes()
+	case "band.oracle.v1.QuerySimulateRequestResponse.decoded_result":
+		x.DecodedResult = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateRequestResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		if x.RawRequests == nil {
+			x.RawRequests = []*RawRequest{}
+		}
+		value := &_QuerySimulateRequestResponse_1_list{list: &x.RawRequests}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		panic(fmt.Errorf("field prepare_gas_used of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_error":
+		panic(fmt.Errorf("field prepare_error of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		panic(fmt.Errorf("field execute_gas_used of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_error":
+		panic(fmt.Errorf("field execute_error of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		panic(fmt.Errorf("field result of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	case "band.oracle.v1.QuerySimulateRequestResponse.decoded_result":
+		panic(fmt.Errorf("field decoded_result of message band.oracle.v1.QuerySimulateRequestResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateRequestResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QuerySimulateRequestResponse.raw_requests":
+		list := []*RawRequest{}
+		return protoreflect.ValueOfList(&_QuerySimulateRequestResponse_1_list{list: &list})
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestResponse.prepare_error":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.QuerySimulateRequestResponse.execute_error":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.QuerySimulateRequestResponse.result":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.QuerySimulateRequestResponse.decoded_result":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QuerySimulateRequestResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QuerySimulateRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateRequestResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QuerySimulateRequestResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateRequestResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateRequestResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateRequestResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateRequestResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateRequestResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.RawRequests) > 0 {
+			for _, e := range x.RawRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PrepareGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrepareGasUsed))
+		}
+		l = len(x.PrepareError)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecuteGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteGasUsed))
+		}
+		l = len(x.ExecuteError)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DecodedResult)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateRequestResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DecodedResult) > 0 {
+			i -= len(x.DecodedResult)
+			copy(dAtA[i:], x.DecodedResult)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DecodedResult)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ExecuteError) > 0 {
+			i -= len(x.ExecuteError)
+			copy(dAtA[i:], x.ExecuteError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExecuteError)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ExecuteGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteGasUsed))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.PrepareError) > 0 {
+			i -= len(x.PrepareError)
+			copy(dAtA[i:], x.PrepareError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PrepareError)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PrepareGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepareGasUsed))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.RawRequests) > 0 {
+			for iNdEx := len(x.RawRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RawRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateRequestResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateRequestResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawRequests = append(x.RawRequests, &RawRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RawRequests[len(x.RawRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareGasUsed", wireType)
+				}
+				x.PrepareGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepareGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepareError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PrepareError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteGasUsed", wireType)
+				}
+				x.ExecuteGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecuteError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecodedResult", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DecodedResult = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryReportCommitsRequest            protoreflect.MessageDescriptor
+	fd_QueryReportCommitsRequest_request_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryReportCommitsRequest = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportCommitsRequest")
+	fd_QueryReportCommitsRequest_request_id = md_QueryReportCommitsRequest.Fields().ByName("request_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryReportCommitsRequest)(nil)
+
+type fastReflection_QueryReportCommitsRequest QueryReportCommitsRequest
+
+func (x *QueryReportCommitsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryReportCommitsRequest)(x)
+}
+
+func (x *QueryReportCommitsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryReportCommitsRequest_messageType fastReflection_QueryReportCommitsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryReportCommitsRequest_messageType{}
+
+type fastReflection_QueryReportCommitsRequest_messageType struct{}
+
+func (x fastReflection_QueryReportCommitsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryReportCommitsRequest)(nil)
+}
+func (x fastReflection_QueryReportCommitsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryReportCommitsRequest)
+}
+func (x fastReflection_QueryReportCommitsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportCommitsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryReportCommitsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportCommitsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryReportCommitsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryReportCommitsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryReportCommitsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryReportCommitsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryReportCommitsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryReportCommitsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryReportCommitsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_QueryReportCommitsRequest_request_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryReportCommitsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsRequest.request_id":
+		return x.RequestId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportCommitsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsRequest.request_id":
+		x.RequestId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryReportCommitsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryReportCommitsRequest.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportCommitsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsRequest.request_id":
+		x.RequestId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportCommitsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsRequest.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.QueryReportCommitsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryReportCommitsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsRequest.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsRequest"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryReportCommitsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportCommitsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryReportCommitsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportCommitsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryReportCommitsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryReportCommitsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryReportCommitsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryReportCommitsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryReportCommitsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportCommitsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportCommitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryReportCommitsResponse_1_list)(nil)
+
+type _QueryReportCommitsResponse_1_list struct {
+	list *[]*ReportCommit
+}
+
+func (x *_QueryReportCommitsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryReportCommitsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryReportCommitsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReportCommit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryReportCommitsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReportCommit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryReportCommitsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ReportCommit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryReportCommitsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryReportCommitsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ReportCommit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryReportCommitsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryReportCommitsResponse                protoreflect.MessageDescriptor
+	fd_QueryReportCommitsResponse_commits        protoreflect.FieldDescriptor
+	fd_QueryReportCommitsResponse_reveal_started protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_query_proto_init()
+	md_QueryReportCommitsResponse = File_band_oracle_v1_query_proto.Messages().ByName("QueryReportCommitsResponse")
+	fd_QueryReportCommitsResponse_commits = md_QueryReportCommitsResponse.Fields().ByName("commits")
+	fd_QueryReportCommitsResponse_reveal_started = md_QueryReportCommitsResponse.Fields().ByName("reveal_started")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryReportCommitsResponse)(nil)
+
+type fastReflection_QueryReportCommitsResponse QueryReportCommitsResponse
+
+func (x *QueryReportCommitsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryReportCommitsResponse)(x)
+}
+
+func (x *QueryReportCommitsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryReportCommitsResponse_messageType fastReflection_QueryReportCommitsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryReportCommitsResponse_messageType{}
+
+type fastReflection_QueryReportCommitsResponse_messageType struct{}
+
+func (x fastReflection_QueryReportCommitsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryReportCommitsResponse)(nil)
+}
+func (x fastReflection_QueryReportCommitsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryReportCommitsResponse)
+}
+func (x fastReflection_QueryReportCommitsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportCommitsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryReportCommitsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryReportCommitsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryReportCommitsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryReportCommitsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryReportCommitsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryReportCommitsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryReportCommitsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryReportCommitsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryReportCommitsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Commits) != 0 {
+		value := protoreflect.ValueOfList(&_QueryReportCommitsResponse_1_list{list: &x.Commits})
+		if !f(fd_QueryReportCommitsResponse_commits, value) {
+			return
+		}
+	}
+	if x.RevealStarted != false {
+		value := protoreflect.ValueOfBool(x.RevealStarted)
+		if !f(fd_QueryReportCommitsResponse_reveal_started, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryReportCommitsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsResponse.commits":
+		return len(x.Commits) != 0
+	case "band.oracle.v1.QueryReportCommitsResponse.reveal_started":
+		return x.RevealStarted != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportCommitsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsResponse.commits":
+		x.Commits = nil
+	case "band.oracle.v1.QueryReportCommitsResponse.reveal_started":
+		x.RevealStarted = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryReportCommitsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.QueryReportCommitsResponse.commits":
+		if len(x.Commits) == 0 {
+			return protoreflect.ValueOfList(&_QueryReportCommitsResponse_1_list{})
+		}
+		listValue := &_QueryReportCommitsResponse_1_list{list: &x.Commits}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.QueryReportCommitsResponse.reveal_started":
+		value := x.RevealStarted
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportCommitsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsResponse.commits":
+		lv := value.List()
+		clv := lv.(*_QueryReportCommitsResponse_1_list)
+		x.Commits = *clv.list
+	case "band.oracle.v1.QueryReportCommitsResponse.reveal_started":
+		x.RevealStarted = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportCommitsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsResponse.commits":
+		if x.Commits == nil {
+			x.Commits = []*ReportCommit{}
+		}
+		value := &_QueryReportCommitsResponse_1_list{list: &x.Commits}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.QueryReportCommitsResponse.reveal_started":
+		panic(fmt.Errorf("field reveal_started of message band.oracle.v1.QueryReportCommitsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryReportCommitsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.QueryReportCommitsResponse.commits":
+		list := []*ReportCommit{}
+		return protoreflect.ValueOfList(&_QueryReportCommitsResponse_1_list{list: &list})
+	case "band.oracle.v1.QueryReportCommitsResponse.reveal_started":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.QueryReportCommitsResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.QueryReportCommitsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryReportCommitsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.QueryReportCommitsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryReportCommitsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryReportCommitsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryReportCommitsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryReportCommitsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryReportCommitsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Commits) > 0 {
+			for _, e := range x.Commits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RevealStarted {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryReportCommitsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RevealStarted {
+			i--
+			if x.RevealStarted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Commits) > 0 {
+			for iNdEx := len(x.Commits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Commits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryReportCommitsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportCommitsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryReportCommitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commits = append(x.Commits, &ReportCommit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commits[len(x.Commits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealStarted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RevealStarted = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: band/oracle/v1/query.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryCountsRequest is request type for the Query/Count RPC method.
+type QueryCountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryCountsRequest) Reset() {
+	*x = QueryCountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCountsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCountsRequest.ProtoReflect.Descriptor instead.
+func (*QueryCountsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryCountsResponse is response type for the Query/Count RPC method.
+type QueryCountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceCount is total number of data sources available on the chain
+	DataSourceCount uint64 `protobuf:"varint,1,opt,name=data_source_count,json=dataSourceCount,proto3" json:"data_source_count,omitempty"`
+	// OracleScriptCount is total number of oracle scripts available on the chain
+	OracleScriptCount uint64 `protobuf:"varint,2,opt,name=oracle_script_count,json=oracleScriptCount,proto3" json:"oracle_script_count,omitempty"`
+	// RequestCount is total number of requests submitted to the chain
+	RequestCount uint64 `protobuf:"varint,3,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+}
+
+func (x *QueryCountsResponse) Reset() {
+	*x = QueryCountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCountsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCountsResponse.ProtoReflect.Descriptor instead.
+func (*QueryCountsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryCountsResponse) GetDataSourceCount() uint64 {
+	if x != nil {
+		return x.DataSourceCount
+	}
+	return 0
+}
+
+func (x *QueryCountsResponse) GetOracleScriptCount() uint64 {
+	if x != nil {
+		return x.OracleScriptCount
+	}
+	return 0
+}
+
+func (x *QueryCountsResponse) GetRequestCount() uint64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+// QueryDataRequest is request type for the Query/Data RPC method.
+type QueryDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataHash is SHA256 hash of the file's content, which can be data source or
+	// oracle script
+	DataHash string `protobuf:"bytes,1,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+}
+
+func (x *QueryDataRequest) Reset() {
+	*x = QueryDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDataRequest.ProtoReflect.Descriptor instead.
+func (*QueryDataRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryDataRequest) GetDataHash() string {
+	if x != nil {
+		return x.DataHash
+	}
+	return ""
+}
+
+// QueryDataResponse is response type for the Query/Data RPC method.
+type QueryDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data is file's content, which can be data source or oracle script
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *QueryDataResponse) Reset() {
+	*x = QueryDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDataResponse.ProtoReflect.Descriptor instead.
+func (*QueryDataResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// QueryDataSourceRequest is request type for the Query/DataSource RPC method.
+type QueryDataSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceID is ID of a data source script
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+}
+
+func (x *QueryDataSourceRequest) Reset() {
+	*x = QueryDataSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataSourceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryDataSourceRequest) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+// QueryDataSourceResponse is response type for the Query/DataSource RPC method.
+type QueryDataSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSource is summary information of a data source
+	DataSource *DataSource `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+}
+
+func (x *QueryDataSourceResponse) Reset() {
+	*x = QueryDataSourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDataSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDataSourceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*QueryDataSourceResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryDataSourceResponse) GetDataSource() *DataSource {
+	if x != nil {
+		return x.DataSource
+	}
+	return nil
+}
+
+// QueryOracleScriptRequest is request type for the Query/OracleScript RPC
+// method.
+type QueryOracleScriptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+}
+
+func (x *QueryOracleScriptRequest) Reset() {
+	*x = QueryOracleScriptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOracleScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptRequest.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryOracleScriptRequest) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+// QueryOracleScriptResponse is response type for the Query/OracleScript RPC
+// method.
+type QueryOracleScriptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScript is summary information of an oracle script
+	OracleScript *OracleScript `protobuf:"bytes,1,opt,name=oracle_script,json=oracleScript,proto3" json:"oracle_script,omitempty"`
+}
+
+func (x *QueryOracleScriptResponse) Reset() {
+	*x = QueryOracleScriptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOracleScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOracleScriptResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOracleScriptResponse.ProtoReflect.Descriptor instead.
+func (*QueryOracleScriptResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
+	if x != nil {
+		return x.OracleScript
+	}
+	return nil
+}
+
+// QueryRequestRequest is request type for the Query/Request RPC method.
+type QueryRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is ID of an oracle request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *QueryRequestRequest) Reset() {
+	*x = QueryRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequestRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRequestRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryRequestRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+// QueryRequestResponse is response type for the Query/Request RPC method.
+type QueryRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Request is an oracle request
+	Request *Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Reports is list of result data as raw reports that are fulfilled by
+	// assigned validators
+	Reports []*Report `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	// Result is a final form of result data
+	Result *Result `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	// Signing is the signing detail in the bandtss module.
+	Signing *SigningResult `protobuf:"bytes,4,opt,name=signing,proto3" json:"signing,omitempty"`
+}
 
-// QueryCountsRequest is request type for the Query/Count RPC method.
-type QueryCountsRequest struct {
+func (x *QueryRequestResponse) Reset() {
+	*x = QueryRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequestResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRequestResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryRequestResponse) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *QueryRequestResponse) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *QueryRequestResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *QueryRequestResponse) GetSigning() *SigningResult {
+	if x != nil {
+		return x.Signing
+	}
+	return nil
+}
+
+// QueryPendingRequestRequest is request type for the Query/PendingRequests RPC
+// method.
+type QueryPendingRequestsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ValidatorAddress is address of a validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (x *QueryCountsRequest) Reset() {
-	*x = QueryCountsRequest{}
+func (x *QueryPendingRequestsRequest) Reset() {
+	*x = QueryPendingRequestsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[0]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCountsRequest) String() string {
+func (x *QueryPendingRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCountsRequest) ProtoMessage() {}
+func (*QueryPendingRequestsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryCountsRequest.ProtoReflect.Descriptor instead.
-func (*QueryCountsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryPendingRequestsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
-// QueryCountsResponse is response type for the Query/Count RPC method.
-type QueryCountsResponse struct {
+func (x *QueryPendingRequestsRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryPendingRequestResponse is response type for the Query/PendingRequests
+// RPC method.
+type QueryPendingRequestsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataSourceCount is total number of data sources available on the chain
-	DataSourceCount uint64 `protobuf:"varint,1,opt,name=data_source_count,json=dataSourceCount,proto3" json:"data_source_count,omitempty"`
-	// OracleScriptCount is total number of oracle scripts available on the chain
-	OracleScriptCount uint64 `protobuf:"varint,2,opt,name=oracle_script_count,json=oracleScriptCount,proto3" json:"oracle_script_count,omitempty"`
-	// RequestCount is total number of requests submitted to the chain
-	RequestCount uint64 `protobuf:"varint,3,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// RequestIDs is a list of pending request IDs assigned to the given validator
+	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
 }
 
-func (x *QueryCountsResponse) Reset() {
-	*x = QueryCountsResponse{}
+func (x *QueryPendingRequestsResponse) Reset() {
+	*x = QueryPendingRequestsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[1]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCountsResponse) String() string {
+func (x *QueryPendingRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCountsResponse) ProtoMessage() {}
+func (*QueryPendingRequestsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryCountsResponse.ProtoReflect.Descriptor instead.
-func (*QueryCountsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryPendingRequestsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryCountsResponse) GetDataSourceCount() uint64 {
+func (x *QueryPendingRequestsResponse) GetRequestIds() []uint64 {
 	if x != nil {
-		return x.DataSourceCount
+		return x.RequestIds
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryCountsResponse) GetOracleScriptCount() uint64 {
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination defines an optional pagination for the request.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
 	if x != nil {
-		return x.OracleScriptCount
+		return x.Params
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryCountsResponse) GetRequestCount() uint64 {
+// QueryValidatorRequest is request type for the Query/Validator RPC method.
+type QueryValidatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ValidatorAddress is address of a validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (x *QueryValidatorRequest) Reset() {
+	*x = QueryValidatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryValidatorRequest) GetValidatorAddress() string {
 	if x != nil {
-		return x.RequestCount
+		return x.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-// QueryDataRequest is request type for the Query/Data RPC method.
-type QueryDataRequest struct {
+// QueryValidatorResponse is response type for the Query/Validator RPC method.
+type QueryValidatorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataHash is SHA256 hash of the file's content, which can be data source or
-	// oracle script
-	DataHash string `protobuf:"bytes,1,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	// Status is status of a validator e.g. active/inactive
+	Status *ValidatorStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *QueryDataRequest) Reset() {
-	*x = QueryDataRequest{}
+func (x *QueryValidatorResponse) Reset() {
+	*x = QueryValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[2]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataRequest) String() string {
+func (x *QueryValidatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataRequest) ProtoMessage() {}
+func (*QueryValidatorResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDataRequest.ProtoReflect.Descriptor instead.
-func (*QueryDataRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryValidatorResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryDataRequest) GetDataHash() string {
+func (x *QueryValidatorResponse) GetStatus() *ValidatorStatus {
 	if x != nil {
-		return x.DataHash
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-// QueryDataResponse is response type for the Query/Data RPC method.
-type QueryDataResponse struct {
+// QueryIsReporterRequest is request type for the Query/Reporter RPC method.
+type QueryIsReporterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data is file's content, which can be data source or oracle script
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// ValidatorAddress is a validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// ReporterAddress is a candidate account
+	ReporterAddress string `protobuf:"bytes,2,opt,name=reporter_address,json=reporterAddress,proto3" json:"reporter_address,omitempty"`
 }
 
-func (x *QueryDataResponse) Reset() {
-	*x = QueryDataResponse{}
+func (x *QueryIsReporterRequest) Reset() {
+	*x = QueryIsReporterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[3]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataResponse) String() string {
+func (x *QueryIsReporterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataResponse) ProtoMessage() {}
+func (*QueryIsReporterRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryDataResponse.ProtoReflect.Descriptor instead.
-func (*QueryDataResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryIsReporterRequest.ProtoReflect.Descriptor instead.
+func (*QueryIsReporterRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryDataResponse) GetData() []byte {
+func (x *QueryIsReporterRequest) GetValidatorAddress() string {
 	if x != nil {
-		return x.Data
+		return x.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-// QueryDataSourceRequest is request type for the Query/DataSource RPC method.
-type QueryDataSourceRequest struct {
+func (x *QueryIsReporterRequest) GetReporterAddress() string {
+	if x != nil {
+		return x.ReporterAddress
+	}
+	return ""
+}
+
+// QueryIsReporterResponse is response type for the Query/Reporter RPC method.
+type QueryIsReporterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataSourceID is ID of a data source script
-	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// IsReporter is true if this account has been granted by validator
+	IsReporter bool `protobuf:"varint,1,opt,name=is_reporter,json=isReporter,proto3" json:"is_reporter,omitempty"`
 }
 
-func (x *QueryDataSourceRequest) Reset() {
-	*x = QueryDataSourceRequest{}
+func (x *QueryIsReporterResponse) Reset() {
+	*x = QueryIsReporterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataSourceRequest) String() string {
+func (x *QueryIsReporterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataSourceRequest) ProtoMessage() {}
+func (*QueryIsReporterResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDataSourceRequest.ProtoReflect.Descriptor instead.
-func (*QueryDataSourceRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryIsReporterResponse.ProtoReflect.Descriptor instead.
+func (*QueryIsReporterResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryDataSourceRequest) GetDataSourceId() uint64 {
+func (x *QueryIsReporterResponse) GetIsReporter() bool {
 	if x != nil {
-		return x.DataSourceId
+		return x.IsReporter
 	}
-	return 0
+	return false
 }
 
-// QueryDataSourceResponse is response type for the Query/DataSource RPC method.
-type QueryDataSourceResponse struct {
+// QueryReportersRequest is request type for the Query/Reporters RPC method.
+type QueryReportersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DataSource is summary information of a data source
-	DataSource *DataSource `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+	// ValidatorAddress is a validator address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (x *QueryDataSourceResponse) Reset() {
-	*x = QueryDataSourceResponse{}
+func (x *QueryReportersRequest) Reset() {
+	*x = QueryReportersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDataSourceResponse) String() string {
+func (x *QueryReportersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDataSourceResponse) ProtoMessage() {}
+func (*QueryReportersRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*QueryDataSourceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryReportersRequest.ProtoReflect.Descriptor instead.
+func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryDataSourceResponse) GetDataSource() *DataSource {
+func (x *QueryReportersRequest) GetValidatorAddress() string {
 	if x != nil {
-		return x.DataSource
+		return x.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-// QueryOracleScriptRequest is request type for the Query/OracleScript RPC
-// method.
-type QueryOracleScriptRequest struct {
+// QueryReportersResponse is response type for the Query/Reporters RPC method.
+type QueryReportersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScriptID is ID of an oracle script
-	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Reporter is a list of account addresses of reporters
+	Reporter []string `protobuf:"bytes,1,rep,name=reporter,proto3" json:"reporter,omitempty"`
 }
 
-func (x *QueryOracleScriptRequest) Reset() {
-	*x = QueryOracleScriptRequest{}
+func (x *QueryReportersResponse) Reset() {
+	*x = QueryReportersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOracleScriptRequest) String() string {
+func (x *QueryReportersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOracleScriptRequest) ProtoMessage() {}
+func (*QueryReportersResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryOracleScriptRequest.ProtoReflect.Descriptor instead.
-func (*QueryOracleScriptRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryReportersResponse.ProtoReflect.Descriptor instead.
+func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryOracleScriptRequest) GetOracleScriptId() uint64 {
+func (x *QueryReportersResponse) GetReporter() []string {
 	if x != nil {
-		return x.OracleScriptId
+		return x.Reporter
 	}
-	return 0
+	return nil
 }
 
-// QueryOracleScriptResponse is response type for the Query/OracleScript RPC
-// method.
-type QueryOracleScriptResponse struct {
+// QueryActiveValidatorsRequest is request type for the Query/ActiveValidators
+// RPC method.
+type QueryActiveValidatorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// OracleScript is summary information of an oracle script
-	OracleScript *OracleScript `protobuf:"bytes,1,opt,name=oracle_script,json=oracleScript,proto3" json:"oracle_script,omitempty"`
 }
 
-func (x *QueryOracleScriptResponse) Reset() {
-	*x = QueryOracleScriptResponse{}
+func (x *QueryActiveValidatorsRequest) Reset() {
+	*x = QueryActiveValidatorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOracleScriptResponse) String() string {
+func (x *QueryActiveValidatorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOracleScriptResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryOracleScriptResponse.ProtoReflect.Descriptor instead.
-func (*QueryOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{7}
-}
+func (*QueryActiveValidatorsRequest) ProtoMessage() {}
 
-func (x *QueryOracleScriptResponse) GetOracleScript() *OracleScript {
-	if x != nil {
-		return x.OracleScript
-	}
-	return nil
+// Deprecated: Use QueryActiveValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*QueryActiveValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-// QueryRequestRequest is request type for the Query/Request RPC method.
-type QueryRequestRequest struct {
+// QueryActiveValidatorsResponse is response type for the Query/ActiveValidators
+// RPC method.
+type QueryActiveValidatorsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// RequestID is ID of an oracle request
-	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Validators is a list of active validators
+	Validators []*ActiveValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (x *QueryRequestRequest) Reset() {
-	*x = QueryRequestRequest{}
+func (x *QueryActiveValidatorsResponse) Reset() {
+	*x = QueryActiveValidatorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestRequest) String() string {
+func (x *QueryActiveValidatorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestRequest) ProtoMessage() {}
+func (*QueryActiveValidatorsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryActiveValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*QueryActiveValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryRequestRequest) GetRequestId() uint64 {
+func (x *QueryActiveValidatorsResponse) GetValidators() []*ActiveValidator {
 	if x != nil {
-		return x.RequestId
+		return x.Validators
 	}
-	return 0
+	return nil
 }
 
-// QueryRequestResponse is response type for the Query/Request RPC method.
-type QueryRequestResponse struct {
+// QueryRequestSearchRequest is request type for the Query/RequestSearch RPC
+// method.
+type QueryRequestSearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Request is an oracle request
-	Request *Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	// Reports is list of result data as raw reports that are fulfilled by
-	// assigned validators
-	Reports []*Report `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
-	// Result is a final form of result data
-	Result *Result `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
-	// Signing is the signing detail in the bandtss module.
-	Signing *SigningResult `protobuf:"bytes,4,opt,name=signing,proto3" json:"signing,omitempty"`
+	// OracleScriptID is ID of an oracle script
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Calldata is OBI-encoded data in hex format as argument params for the
+	// oracle script
+	Calldata string `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is number of validators allowed for fulfilling the request
+	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is number of validators required for fulfilling the request
+	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
 }
 
-func (x *QueryRequestResponse) Reset() {
-	*x = QueryRequestResponse{}
+func (x *QueryRequestSearchRequest) Reset() {
+	*x = QueryRequestSearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestResponse) String() string {
+func (x *QueryRequestSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestResponse) ProtoMessage() {}
+func (*QueryRequestSearchRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryRequestSearchRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestSearchRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryRequestResponse) GetRequest() *Request {
+func (x *QueryRequestSearchRequest) GetOracleScriptId() uint64 {
 	if x != nil {
-		return x.Request
+		return x.OracleScriptId
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryRequestResponse) GetReports() []*Report {
+func (x *QueryRequestSearchRequest) GetCalldata() string {
 	if x != nil {
-		return x.Reports
+		return x.Calldata
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryRequestResponse) GetResult() *Result {
+func (x *QueryRequestSearchRequest) GetAskCount() uint64 {
 	if x != nil {
-		return x.Result
+		return x.AskCount
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryRequestResponse) GetSigning() *SigningResult {
+func (x *QueryRequestSearchRequest) GetMinCount() uint64 {
 	if x != nil {
-		return x.Signing
+		return x.MinCount
 	}
-	return nil
+	return 0
 }
 
-// QueryPendingRequestRequest is request type for the Query/PendingRequests RPC
+// QueryRequestSearchResponse is response type for the Query/RequestSearch RPC
 // method.
-type QueryPendingRequestsRequest struct {
+type QueryRequestSearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is address of a validator
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Request is details of an oracle request
+	Request *QueryRequestResponse `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *QueryPendingRequestsRequest) Reset() {
-	*x = QueryPendingRequestsRequest{}
+func (x *QueryRequestSearchResponse) Reset() {
+	*x = QueryRequestSearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPendingRequestsRequest) String() string {
+func (x *QueryRequestSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPendingRequestsRequest) ProtoMessage() {}
+func (*QueryRequestSearchResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPendingRequestsRequest.ProtoReflect.Descriptor instead.
-func (*QueryPendingRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryRequestSearchResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestSearchResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryPendingRequestsRequest) GetValidatorAddress() string {
+func (x *QueryRequestSearchResponse) GetRequest() *QueryRequestResponse {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-// QueryPendingRequestResponse is response type for the Query/PendingRequests
-// RPC method.
-type QueryPendingRequestsResponse struct {
+// QueryRequestPriceRequest is request type for the Query/RequestPrice RPC
+// method.
+type QueryRequestPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// RequestIDs is a list of pending request IDs assigned to the given validator
-	RequestIds []uint64 `protobuf:"varint,1,rep,packed,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+	// Symbol is unit of data indicating what the data is
+	Symbols []string `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// AskCount is number of validators allowed for fulfilling the request
+	AskCount uint64 `protobuf:"varint,2,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is number of validators required for fulfilling the request
+	MinCount uint64 `protobuf:"varint,3,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
 }
 
-func (x *QueryPendingRequestsResponse) Reset() {
-	*x = QueryPendingRequestsResponse{}
+func (x *QueryRequestPriceRequest) Reset() {
+	*x = QueryRequestPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPendingRequestsResponse) String() string {
+func (x *QueryRequestPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPendingRequestsResponse) ProtoMessage() {}
+func (*QueryRequestPriceRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPendingRequestsResponse.ProtoReflect.Descriptor instead.
-func (*QueryPendingRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryRequestPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestPriceRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryPendingRequestsResponse) GetRequestIds() []uint64 {
+func (x *QueryRequestPriceRequest) GetSymbols() []string {
 	if x != nil {
-		return x.RequestIds
+		return x.Symbols
 	}
 	return nil
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryRequestPriceRequest) GetAskCount() uint64 {
+	if x != nil {
+		return x.AskCount
 	}
+	return 0
 }
 
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryParamsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{12}
+func (x *QueryRequestPriceRequest) GetMinCount() uint64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+// QueryRequestPriceResponse is response type for the Query/RequestPrice RPC
+// method.
+type QueryRequestPriceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pagination defines an optional pagination for the request.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// PriceResult is a list of price results for given symbols
+	PriceResults []*PriceResult `protobuf:"bytes,1,rep,name=price_results,json=priceResults,proto3" json:"price_results,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryRequestPriceResponse) Reset() {
+	*x = QueryRequestPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryRequestPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryRequestPriceResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryRequestPriceResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestPriceResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryRequestPriceResponse) GetPriceResults() []*PriceResult {
 	if x != nil {
-		return x.Params
+		return x.PriceResults
 	}
 	return nil
 }
 
-// QueryValidatorRequest is request type for the Query/Validator RPC method.
-type QueryValidatorRequest struct {
+// QueryRequestVerificationRequest is request type for the
+// Query/RequestVerification RPC
+type QueryRequestVerificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is address of a validator
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// ChainID is the chain ID to identify which chain ID is used for the
+	// verification
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Validator is a validator address
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// RequestID is oracle request ID
+	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExternalID is an oracle's external ID
+	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is the ID of data source
+	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// Reporter is an bech32-encoded public key of the reporter authorized by the
+	// validator
+	Reporter string `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// Signature is a signature signed by the reporter using reporter's private
+	// key
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// MaxDelay is a max number of RequestID delay acceptable in case of node
+	// delay
+	MaxDelay uint64 `protobuf:"varint,8,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
 }
 
-func (x *QueryValidatorRequest) Reset() {
-	*x = QueryValidatorRequest{}
+func (x *QueryRequestVerificationRequest) Reset() {
+	*x = QueryRequestVerificationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorRequest) String() string {
+func (x *QueryRequestVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorRequest) ProtoMessage() {}
+func (*QueryRequestVerificationRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorRequest.ProtoReflect.Descriptor instead.
-func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QueryRequestVerificationRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequestVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryValidatorRequest) GetValidatorAddress() string {
+func (x *QueryRequestVerificationRequest) GetChainId() string {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.ChainId
 	}
 	return ""
 }
 
-// QueryValidatorResponse is response type for the Query/Validator RPC method.
-type QueryValidatorResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Status is status of a validator e.g. active/inactive
-	Status *ValidatorStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (x *QueryRequestVerificationRequest) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
 }
 
-func (x *QueryValidatorResponse) Reset() {
-	*x = QueryValidatorResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryRequestVerificationRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
 	}
+	return 0
 }
 
-func (x *QueryValidatorResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryRequestVerificationRequest) GetExternalId() uint64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
 }
 
-func (*QueryValidatorResponse) ProtoMessage() {}
+func (x *QueryRequestVerificationRequest) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
 
-// Deprecated: Use QueryValidatorResponse.ProtoReflect.Descriptor instead.
-func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{15}
+func (x *QueryRequestVerificationRequest) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
 }
 
-func (x *QueryValidatorResponse) GetStatus() *ValidatorStatus {
+func (x *QueryRequestVerificationRequest) GetSignature() []byte {
 	if x != nil {
-		return x.Status
+		return x.Signature
 	}
 	return nil
 }
 
-// QueryIsReporterRequest is request type for the Query/Reporter RPC method.
-type QueryIsReporterRequest struct {
+func (x *QueryRequestVerificationRequest) GetMaxDelay() uint64 {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return 0
+}
+
+// QueryRequestVerificationResponse is response type for the
+// Query/RequestVerification RPC
+type QueryRequestVerificationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is a validator address
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// ReporterAddress is a candidate account
-	ReporterAddress string `protobuf:"bytes,2,opt,name=reporter_address,json=reporterAddress,proto3" json:"reporter_address,omitempty"`
+	// ChainID is the targeted chain ID
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Validator is the targeted validator address
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// RequestID is the ID of targeted request
+	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExternalID is the ID of targeted oracle's external data source
+	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DataSourceID is the ID of a data source that relates to the targeted
+	// external ID
+	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// IsDelay is a flag to tell that this request is not exist yet but in
+	// MaxDelay range
+	IsDelay bool `protobuf:"varint,6,opt,name=is_delay,json=isDelay,proto3" json:"is_delay,omitempty"`
 }
 
-func (x *QueryIsReporterRequest) Reset() {
-	*x = QueryIsReporterRequest{}
+func (x *QueryRequestVerificationResponse) Reset() {
+	*x = QueryRequestVerificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[16]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIsReporterRequest) String() string {
+func (x *QueryRequestVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIsReporterRequest) ProtoMessage() {}
+func (*QueryRequestVerificationResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryIsReporterRequest.ProtoReflect.Descriptor instead.
-func (*QueryIsReporterRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryRequestVerificationResponse.ProtoReflect.Descriptor instead.
+func (*QueryRequestVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryIsReporterRequest) GetValidatorAddress() string {
+func (x *QueryRequestVerificationResponse) GetChainId() string {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *QueryIsReporterRequest) GetReporterAddress() string {
+func (x *QueryRequestVerificationResponse) GetValidator() string {
 	if x != nil {
-		return x.ReporterAddress
+		return x.Validator
 	}
 	return ""
 }
 
-// QueryIsReporterResponse is response type for the Query/Reporter RPC method.
-type QueryIsReporterResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// IsReporter is true if this account has been granted by validator
-	IsReporter bool `protobuf:"varint,1,opt,name=is_reporter,json=isReporter,proto3" json:"is_reporter,omitempty"`
-}
-
-func (x *QueryIsReporterResponse) Reset() {
-	*x = QueryIsReporterResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryRequestVerificationResponse) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
 	}
+	return 0
 }
 
-func (x *QueryIsReporterResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryRequestVerificationResponse) GetExternalId() uint64 {
+	if x != nil {
+		return x.ExternalId
+	}
+	return 0
 }
 
-func (*QueryIsReporterResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryIsReporterResponse.ProtoReflect.Descriptor instead.
-func (*QueryIsReporterResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{17}
+func (x *QueryRequestVerificationResponse) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
 }
 
-func (x *QueryIsReporterResponse) GetIsReporter() bool {
+func (x *QueryRequestVerificationResponse) GetIsDelay() bool {
 	if x != nil {
-		return x.IsReporter
+		return x.IsDelay
 	}
 	return false
 }
 
-// QueryReportersRequest is request type for the Query/Reporters RPC method.
-type QueryReportersRequest struct {
+// QueryTipPercentilesRequest is request type for the Query/TipPercentiles RPC
+// method.
+type QueryTipPercentilesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ValidatorAddress is a validator address
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Denom is the denomination of the tips to be considered
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Limit is the maximum number of the most recent requests to be sampled
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *QueryReportersRequest) Reset() {
-	*x = QueryReportersRequest{}
+func (x *QueryTipPercentilesRequest) Reset() {
+	*x = QueryTipPercentilesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[18]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryReportersRequest) String() string {
+func (x *QueryTipPercentilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReportersRequest) ProtoMessage() {}
+func (*QueryTipPercentilesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryReportersRequest.ProtoReflect.Descriptor instead.
-func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use QueryTipPercentilesRequest.ProtoReflect.Descriptor instead.
+func (*QueryTipPercentilesRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryReportersRequest) GetValidatorAddress() string {
+func (x *QueryTipPercentilesRequest) GetDenom() string {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.Denom
 	}
 	return ""
 }
 
-// QueryReportersResponse is response type for the Query/Reporters RPC method.
-type QueryReportersResponse struct {
+func (x *QueryTipPercentilesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// QueryTipPercentilesResponse is response type for the Query/TipPercentiles
+// RPC method.
+type QueryTipPercentilesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reporter is a list of account addresses of reporters
-	Reporter []string `protobuf:"bytes,1,rep,name=reporter,proto3" json:"reporter,omitempty"`
+	// SampleCount is the number of requests used to compute the percentiles
+	SampleCount uint64 `protobuf:"varint,1,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	// Percentiles is the list of tip amounts at each reported percentile
+	Percentiles []*TipPercentile `protobuf:"bytes,2,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
 }
 
-func (x *QueryReportersResponse) Reset() {
-	*x = QueryReportersResponse{}
+func (x *QueryTipPercentilesResponse) Reset() {
+	*x = QueryTipPercentilesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[19]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryReportersResponse) String() string {
+func (x *QueryTipPercentilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReportersResponse) ProtoMessage() {}
+func (*QueryTipPercentilesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryReportersResponse.ProtoReflect.Descriptor instead.
-func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QueryTipPercentilesResponse.ProtoReflect.Descriptor instead.
+func (*QueryTipPercentilesResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *QueryReportersResponse) GetReporter() []string {
+func (x *QueryTipPercentilesResponse) GetSampleCount() uint64 {
 	if x != nil {
-		return x.Reporter
+		return x.SampleCount
 	}
-	return nil
-}
-
-// QueryActiveValidatorsRequest is request type for the Query/ActiveValidators
-// RPC method.
-type QueryActiveValidatorsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *QueryActiveValidatorsRequest) Reset() {
-	*x = QueryActiveValidatorsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryTipPercentilesResponse) GetPercentiles() []*TipPercentile {
+	if x != nil {
+		return x.Percentiles
 	}
+	return nil
 }
 
-func (x *QueryActiveValidatorsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryActiveValidatorsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryActiveValidatorsRequest.ProtoReflect.Descriptor instead.
-func (*QueryActiveValidatorsRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{20}
-}
-
-// QueryActiveValidatorsResponse is response type for the Query/ActiveValidators
-// RPC method.
-type QueryActiveValidatorsResponse struct {
+// TipPercentile is the tip amount at a given percentile of recent requests.
+type TipPercentile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Validators is a list of active validators
-	Validators []*ActiveValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	// Percentile is the percentile in the range of 0 to 100
+	Percentile uint64 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// Tip is the tip amount at this percentile
+	Tip *v1beta1.Coin `protobuf:"bytes,2,opt,name=tip,proto3" json:"tip,omitempty"`
 }
 
-func (x *QueryActiveValidatorsResponse) Reset() {
-	*x = QueryActiveValidatorsResponse{}
+func (x *TipPercentile) Reset() {
+	*x = TipPercentile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[21]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryActiveValidatorsResponse) String() string {
+func (x *TipPercentile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryActiveValidatorsResponse) ProtoMessage() {}
+func (*TipPercentile) ProtoMessage() {}
 
-// Deprecated: Use QueryActiveValidatorsResponse.ProtoReflect.Descriptor instead.
-func (*QueryActiveValidatorsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use TipPercentile.ProtoReflect.Descriptor instead.
+func (*TipPercentile) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *QueryActiveValidatorsResponse) GetValidators() []*ActiveValidator {
+func (x *TipPercentile) GetPercentile() uint64 {
 	if x != nil {
-		return x.Validators
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *TipPercentile) GetTip() *v1beta1.Coin {
+	if x != nil {
+		return x.Tip
 	}
 	return nil
 }
 
-// QueryRequestSearchRequest is request type for the Query/RequestSearch RPC
+// QuerySimulateRequestRequest is request type for the Query/SimulateRequest RPC
 // method.
-type QueryRequestSearchRequest struct {
+type QuerySimulateRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// OracleScriptID is ID of an oracle script
+	// OracleScriptID is the ID of an oracle script to be simulated
 	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Calldata is OBI-encoded data in hex format as argument params for the
-	// oracle script
-	Calldata string `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
-	// AskCount is number of validators allowed for fulfilling the request
+	// Calldata is the OBI-encoded call parameters for the oracle script
+	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// AskCount is the number of validators requested to report data
 	AskCount uint64 `protobuf:"varint,3,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is number of validators required for fulfilling the request
+	// MinCount is the minimum number of validators necessary for the request to
+	// proceed to the execution phase
 	MinCount uint64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
-}
-
-func (x *QueryRequestSearchRequest) Reset() {
-	*x = QueryRequestSearchRequest{}
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,5,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,6,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// ExternalData is the mock external data reported by every requested
+	// validator for each data source. The execute function is only run if it is
+	// given.
+	ExternalData []*MockExternalData `protobuf:"bytes,7,rep,name=external_data,json=externalData,proto3" json:"external_data,omitempty"`
+}
+
+func (x *QuerySimulateRequestRequest) Reset() {
+	*x = QuerySimulateRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[22]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestSearchRequest) String() string {
+func (x *QuerySimulateRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestSearchRequest) ProtoMessage() {}
+func (*QuerySimulateRequestRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestSearchRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestSearchRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use QuerySimulateRequestRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateRequestRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *QueryRequestSearchRequest) GetOracleScriptId() uint64 {
+func (x *QuerySimulateRequestRequest) GetOracleScriptId() uint64 {
 	if x != nil {
 		return x.OracleScriptId
 	}
 	return 0
 }
 
-func (x *QueryRequestSearchRequest) GetCalldata() string {
+func (x *QuerySimulateRequestRequest) GetCalldata() []byte {
 	if x != nil {
 		return x.Calldata
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryRequestSearchRequest) GetAskCount() uint64 {
+func (x *QuerySimulateRequestRequest) GetAskCount() uint64 {
 	if x != nil {
 		return x.AskCount
 	}
 	return 0
 }
 
-func (x *QueryRequestSearchRequest) GetMinCount() uint64 {
+func (x *QuerySimulateRequestRequest) GetMinCount() uint64 {
 	if x != nil {
 		return x.MinCount
 	}
 	return 0
 }
 
-// QueryRequestSearchResponse is response type for the Query/RequestSearch RPC
-// method.
-type QueryRequestSearchResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Request is details of an oracle request
-	Request *QueryRequestResponse `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-}
-
-func (x *QueryRequestSearchResponse) Reset() {
-	*x = QueryRequestSearchResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *QueryRequestSearchResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryRequestSearchResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryRequestSearchResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestSearchResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *QueryRequestSearchResponse) GetRequest() *QueryRequestResponse {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-// QueryRequestPriceRequest is request type for the Query/RequestPrice RPC
-// method.
-type QueryRequestPriceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Symbol is unit of data indicating what the data is
-	Symbols []string `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	// AskCount is number of validators allowed for fulfilling the request
-	AskCount uint64 `protobuf:"varint,2,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is number of validators required for fulfilling the request
-	MinCount uint64 `protobuf:"varint,3,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
-}
-
-func (x *QueryRequestPriceRequest) Reset() {
-	*x = QueryRequestPriceRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *QueryRequestPriceRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryRequestPriceRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryRequestPriceRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestPriceRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *QueryRequestPriceRequest) GetSymbols() []string {
+func (x *QuerySimulateRequestRequest) GetPrepareGas() uint64 {
 	if x != nil {
-		return x.Symbols
+		return x.PrepareGas
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryRequestPriceRequest) GetAskCount() uint64 {
+func (x *QuerySimulateRequestRequest) GetExecuteGas() uint64 {
 	if x != nil {
-		return x.AskCount
+		return x.ExecuteGas
 	}
 	return 0
 }
 
-func (x *QueryRequestPriceRequest) GetMinCount() uint64 {
+func (x *QuerySimulateRequestRequest) GetExternalData() []*MockExternalData {
 	if x != nil {
-		return x.MinCount
+		return x.ExternalData
 	}
-	return 0
+	return nil
 }
 
-// QueryRequestPriceResponse is response type for the Query/RequestPrice RPC
-// method.
-type QueryRequestPriceResponse struct {
+// MockExternalData is the mock result of a data source used in a simulation.
+type MockExternalData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// PriceResult is a list of price results for given symbols
-	PriceResults []*PriceResult `protobuf:"bytes,1,rep,name=price_results,json=priceResults,proto3" json:"price_results,omitempty"`
+	// DataSourceID is the ID of the data source
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// ExitCode is the exit code of the data source execution
+	ExitCode uint32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Data is the output of the data source execution
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *QueryRequestPriceResponse) Reset() {
-	*x = QueryRequestPriceResponse{}
+func (x *MockExternalData) Reset() {
+	*x = MockExternalData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[25]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestPriceResponse) String() string {
+func (x *MockExternalData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestPriceResponse) ProtoMessage() {}
+func (*MockExternalData) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestPriceResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestPriceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MockExternalData.ProtoReflect.Descriptor instead.
+func (*MockExternalData) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *QueryRequestPriceResponse) GetPriceResults() []*PriceResult {
+func (x *MockExternalData) GetDataSourceId() uint64 {
 	if x != nil {
-		return x.PriceResults
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *MockExternalData) GetExitCode() uint32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *MockExternalData) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-// QueryRequestVerificationRequest is request type for the
-// Query/RequestVerification RPC
-type QueryRequestVerificationRequest struct {
+// QuerySimulateRequestResponse is response type for the Query/SimulateRequest
+// RPC method.
+type QuerySimulateRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ChainID is the chain ID to identify which chain ID is used for the
-	// verification
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Validator is a validator address
-	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	// RequestID is oracle request ID
-	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// ExternalID is an oracle's external ID
-	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// DataSourceID is the ID of data source
-	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-	// Reporter is an bech32-encoded public key of the reporter authorized by the
-	// validator
-	Reporter string `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
-	// Signature is a signature signed by the reporter using reporter's private
-	// key
-	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
-	// MaxDelay is a max number of RequestID delay acceptable in case of node
-	// delay
-	MaxDelay uint64 `protobuf:"varint,8,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
-}
-
-func (x *QueryRequestVerificationRequest) Reset() {
-	*x = QueryRequestVerificationRequest{}
+	// RawRequests is the list of raw requests asked by the prepare function
+	RawRequests []*RawRequest `protobuf:"bytes,1,rep,name=raw_requests,json=rawRequests,proto3" json:"raw_requests,omitempty"`
+	// PrepareGasUsed is the owasm gas used by the prepare function
+	PrepareGasUsed uint64 `protobuf:"varint,2,opt,name=prepare_gas_used,json=prepareGasUsed,proto3" json:"prepare_gas_used,omitempty"`
+	// PrepareError is the error of the prepare function, if any
+	PrepareError string `protobuf:"bytes,3,opt,name=prepare_error,json=prepareError,proto3" json:"prepare_error,omitempty"`
+	// ExecuteGasUsed is the owasm gas used by the execute function
+	ExecuteGasUsed uint64 `protobuf:"varint,4,opt,name=execute_gas_used,json=executeGasUsed,proto3" json:"execute_gas_used,omitempty"`
+	// ExecuteError is the error of the execute function, if any
+	ExecuteError string `protobuf:"bytes,5,opt,name=execute_error,json=executeError,proto3" json:"execute_error,omitempty"`
+	// Result is the OBI-encoded result of the execute function
+	Result []byte `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	// DecodedResult is the JSON of the result decoded with the output schema of
+	// the oracle script, if the oracle script has a schema
+	DecodedResult string `protobuf:"bytes,7,opt,name=decoded_result,json=decodedResult,proto3" json:"decoded_result,omitempty"`
+}
+
+func (x *QuerySimulateRequestResponse) Reset() {
+	*x = QuerySimulateRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[26]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestVerificationRequest) String() string {
+func (x *QuerySimulateRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestVerificationRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryRequestVerificationRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequestVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{26}
-}
+func (*QuerySimulateRequestResponse) ProtoMessage() {}
 
-func (x *QueryRequestVerificationRequest) GetChainId() string {
-	if x != nil {
-		return x.ChainId
-	}
-	return ""
+// Deprecated: Use QuerySimulateRequestResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateRequestResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QueryRequestVerificationRequest) GetValidator() string {
+func (x *QuerySimulateRequestResponse) GetRawRequests() []*RawRequest {
 	if x != nil {
-		return x.Validator
+		return x.RawRequests
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryRequestVerificationRequest) GetRequestId() uint64 {
+func (x *QuerySimulateRequestResponse) GetPrepareGasUsed() uint64 {
 	if x != nil {
-		return x.RequestId
+		return x.PrepareGasUsed
 	}
 	return 0
 }
 
-func (x *QueryRequestVerificationRequest) GetExternalId() uint64 {
+func (x *QuerySimulateRequestResponse) GetPrepareError() string {
 	if x != nil {
-		return x.ExternalId
+		return x.PrepareError
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryRequestVerificationRequest) GetDataSourceId() uint64 {
+func (x *QuerySimulateRequestResponse) GetExecuteGasUsed() uint64 {
 	if x != nil {
-		return x.DataSourceId
+		return x.ExecuteGasUsed
 	}
 	return 0
 }
 
-func (x *QueryRequestVerificationRequest) GetReporter() string {
+func (x *QuerySimulateRequestResponse) GetExecuteError() string {
 	if x != nil {
-		return x.Reporter
+		return x.ExecuteError
 	}
 	return ""
 }
 
-func (x *QueryRequestVerificationRequest) GetSignature() []byte {
+func (x *QuerySimulateRequestResponse) GetResult() []byte {
 	if x != nil {
-		return x.Signature
+		return x.Result
 	}
 	return nil
 }
 
-func (x *QueryRequestVerificationRequest) GetMaxDelay() uint64 {
+func (x *QuerySimulateRequestResponse) GetDecodedResult() string {
 	if x != nil {
-		return x.MaxDelay
+		return x.DecodedResult
 	}
-	return 0
+	return ""
 }
 
-// QueryRequestVerificationResponse is response type for the
-// Query/RequestVerification RPC
-type QueryRequestVerificationResponse struct {
+// QueryReportCommitsRequest is request type for the Query/ReportCommits RPC
+// method.
+type QueryReportCommitsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ChainID is the targeted chain ID
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Validator is the targeted validator address
-	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	// RequestID is the ID of targeted request
-	RequestId uint64 `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// ExternalID is the ID of targeted oracle's external data source
-	ExternalId uint64 `protobuf:"varint,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// DataSourceID is the ID of a data source that relates to the targeted
-	// external ID
-	DataSourceId uint64 `protobuf:"varint,5,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
-	// IsDelay is a flag to tell that this request is not exist yet but in
-	// MaxDelay range
-	IsDelay bool `protobuf:"varint,6,opt,name=is_delay,json=isDelay,proto3" json:"is_delay,omitempty"`
+	// RequestID is ID of an oracle request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (x *QueryRequestVerificationResponse) Reset() {
-	*x = QueryRequestVerificationResponse{}
+func (x *QueryReportCommitsRequest) Reset() {
+	*x = QueryReportCommitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_query_proto_msgTypes[27]
+		mi := &file_band_oracle_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequestVerificationResponse) String() string {
+func (x *QueryReportCommitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequestVerificationResponse) ProtoMessage() {}
+func (*QueryReportCommitsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRequestVerificationResponse.ProtoReflect.Descriptor instead.
-func (*QueryRequestVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use QueryReportCommitsRequest.ProtoReflect.Descriptor instead.
+func (*QueryReportCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *QueryRequestVerificationResponse) GetChainId() string {
+func (x *QueryReportCommitsRequest) GetRequestId() uint64 {
 	if x != nil {
-		return x.ChainId
+		return x.RequestId
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryRequestVerificationResponse) GetValidator() string {
-	if x != nil {
-		return x.Validator
-	}
-	return ""
+// QueryReportCommitsResponse is response type for the Query/ReportCommits RPC
+// method.
+type QueryReportCommitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Commits is the list of report commits of the request
+	Commits []*ReportCommit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	// RevealStarted is a flag indicating whether validators can reveal their
+	// reports
+	RevealStarted bool `protobuf:"varint,2,opt,name=reveal_started,json=revealStarted,proto3" json:"reveal_started,omitempty"`
 }
 
-func (x *QueryRequestVerificationResponse) GetRequestId() uint64 {
-	if x != nil {
-		return x.RequestId
+func (x *QueryReportCommitsResponse) Reset() {
+	*x = QueryReportCommitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_query_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *QueryRequestVerificationResponse) GetExternalId() uint64 {
-	if x != nil {
-		return x.ExternalId
-	}
-	return 0
+func (x *QueryReportCommitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QueryRequestVerificationResponse) GetDataSourceId() uint64 {
+func (*QueryReportCommitsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryReportCommitsResponse.ProtoReflect.Descriptor instead.
+func (*QueryReportCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_query_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *QueryReportCommitsResponse) GetCommits() []*ReportCommit {
 	if x != nil {
-		return x.DataSourceId
+		return x.Commits
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryRequestVerificationResponse) GetIsDelay() bool {
+func (x *QueryReportCommitsResponse) GetRevealStarted() bool {
 	if x != nil {
-		return x.IsDelay
+		return x.RevealStarted
 	}
 	return false
 }
@@ -14586,7 +19667,9 @@ var file_band_oracle_v1_query_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x61, 0x6e,
 	0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96,
@@ -14772,143 +19855,248 @@ var file_band_oracle_v1_query_proto_rawDesc = []byte{
 	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x64, 0x65,
 	0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x32, 0xb9, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x06,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
-	0x1b, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61,
-	0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x8f, 0x01, 0x0a,
-	0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x62, 0x61,
+	0x61, 0x79, 0x22, 0x48, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x70, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x87, 0x01, 0x0a,
+	0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x45, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x0d, 0x54, 0x69, 0x70, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x74, 0x69, 0x70, 0x22, 0xac, 0x02, 0x0a, 0x1b, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x4b, 0x0a, 0x0d,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x22, 0x69, 0x0a, 0x10, 0x4d, 0x6f, 0x63,
+	0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a,
+	0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xc0, 0x02, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x47, 0x61, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x47, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3a, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x32, 0xfb, 0x12, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x6c, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61,
 	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x99,
-	0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
-	0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x70, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x61, 0x6e, 0x64,
 	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x61, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2e, 0x12, 0x2c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x7e, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x0f, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2b,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xa5, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x31, 0x12, 0x2f, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x12, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12,
+	0x3a, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x09,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64,
 	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2f,
-	0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x62, 0x61, 0x6e,
-	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
-	0x1c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x6c, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x0d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x29, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a,
+	0x12, 0x28, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x86, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x6c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x89, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0xb8,
-	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64,
-	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x86, 0x01, 0x0a,
+	0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x8d, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x70,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x70, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x98, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x62,
+	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0xb8, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02,
+	0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10,
+	0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14923,7 +20111,7 @@ func file_band_oracle_v1_query_proto_rawDescGZIP() []byte {
 	return file_band_oracle_v1_query_proto_rawDescData
 }
 
-var file_band_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_band_oracle_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_band_oracle_v1_query_proto_goTypes = []interface{}{
 	(*QueryCountsRequest)(nil),               // 0: band.oracle.v1.QueryCountsRequest
 	(*QueryCountsResponse)(nil),              // 1: band.oracle.v1.QueryCountsResponse
@@ -14953,62 +20141,84 @@ var file_band_oracle_v1_query_proto_goTypes = []interface{}{
 	(*QueryRequestPriceResponse)(nil),        // 25: band.oracle.v1.QueryRequestPriceResponse
 	(*QueryRequestVerificationRequest)(nil),  // 26: band.oracle.v1.QueryRequestVerificationRequest
 	(*QueryRequestVerificationResponse)(nil), // 27: band.oracle.v1.QueryRequestVerificationResponse
-	(*DataSource)(nil),                       // 28: band.oracle.v1.DataSource
-	(*OracleScript)(nil),                     // 29: band.oracle.v1.OracleScript
-	(*Request)(nil),                          // 30: band.oracle.v1.Request
-	(*Report)(nil),                           // 31: band.oracle.v1.Report
-	(*Result)(nil),                           // 32: band.oracle.v1.Result
-	(*SigningResult)(nil),                    // 33: band.oracle.v1.SigningResult
-	(*Params)(nil),                           // 34: band.oracle.v1.Params
-	(*ValidatorStatus)(nil),                  // 35: band.oracle.v1.ValidatorStatus
-	(*ActiveValidator)(nil),                  // 36: band.oracle.v1.ActiveValidator
-	(*PriceResult)(nil),                      // 37: band.oracle.v1.PriceResult
+	(*QueryTipPercentilesRequest)(nil),       // 28: band.oracle.v1.QueryTipPercentilesRequest
+	(*QueryTipPercentilesResponse)(nil),      // 29: band.oracle.v1.QueryTipPercentilesResponse
+	(*TipPercentile)(nil),                    // 30: band.oracle.v1.TipPercentile
+	(*QuerySimulateRequestRequest)(nil),      // 31: band.oracle.v1.QuerySimulateRequestRequest
+	(*MockExternalData)(nil),                 // 32: band.oracle.v1.MockExternalData
+	(*QuerySimulateRequestResponse)(nil),     // 33: band.oracle.v1.QuerySimulateRequestResponse
+	(*QueryReportCommitsRequest)(nil),        // 34: band.oracle.v1.QueryReportCommitsRequest
+	(*QueryReportCommitsResponse)(nil),       // 35: band.oracle.v1.QueryReportCommitsResponse
+	(*DataSource)(nil),                       // 36: band.oracle.v1.DataSource
+	(*OracleScript)(nil),                     // 37: band.oracle.v1.OracleScript
+	(*Request)(nil),                          // 38: band.oracle.v1.Request
+	(*Report)(nil),                           // 39: band.oracle.v1.Report
+	(*Result)(nil),                           // 40: band.oracle.v1.Result
+	(*SigningResult)(nil),                    // 41: band.oracle.v1.SigningResult
+	(*Params)(nil),                           // 42: band.oracle.v1.Params
+	(*ValidatorStatus)(nil),                  // 43: band.oracle.v1.ValidatorStatus
+	(*ActiveValidator)(nil),                  // 44: band.oracle.v1.ActiveValidator
+	(*PriceResult)(nil),                      // 45: band.oracle.v1.PriceResult
+	(*v1beta1.Coin)(nil),                     // 46: cosmos.base.v1beta1.Coin
+	(*RawRequest)(nil),                       // 47: band.oracle.v1.RawRequest
+	(*ReportCommit)(nil),                     // 48: band.oracle.v1.ReportCommit
 }
 var file_band_oracle_v1_query_proto_depIdxs = []int32{
-	28, // 0: band.oracle.v1.QueryDataSourceResponse.data_source:type_name -> band.oracle.v1.DataSource
-	29, // 1: band.oracle.v1.QueryOracleScriptResponse.oracle_script:type_name -> band.oracle.v1.OracleScript
-	30, // 2: band.oracle.v1.QueryRequestResponse.request:type_name -> band.oracle.v1.Request
-	31, // 3: band.oracle.v1.QueryRequestResponse.reports:type_name -> band.oracle.v1.Report
-	32, // 4: band.oracle.v1.QueryRequestResponse.result:type_name -> band.oracle.v1.Result
-	33, // 5: band.oracle.v1.QueryRequestResponse.signing:type_name -> band.oracle.v1.SigningResult
-	34, // 6: band.oracle.v1.QueryParamsResponse.params:type_name -> band.oracle.v1.Params
-	35, // 7: band.oracle.v1.QueryValidatorResponse.status:type_name -> band.oracle.v1.ValidatorStatus
-	36, // 8: band.oracle.v1.QueryActiveValidatorsResponse.validators:type_name -> band.oracle.v1.ActiveValidator
+	36, // 0: band.oracle.v1.QueryDataSourceResponse.data_source:type_name -> band.oracle.v1.DataSource
+	37, // 1: band.oracle.v1.QueryOracleScriptResponse.oracle_script:type_name -> band.oracle.v1.OracleScript
+	38, // 2: band.oracle.v1.QueryRequestResponse.request:type_name -> band.oracle.v1.Request
+	39, // 3: band.oracle.v1.QueryRequestResponse.reports:type_name -> band.oracle.v1.Report
+	40, // 4: band.oracle.v1.QueryRequestResponse.result:type_name -> band.oracle.v1.Result
+	41, // 5: band.oracle.v1.QueryRequestResponse.signing:type_name -> band.oracle.v1.SigningResult
+	42, // 6: band.oracle.v1.QueryParamsResponse.params:type_name -> band.oracle.v1.Params
+	43, // 7: band.oracle.v1.QueryValidatorResponse.status:type_name -> band.oracle.v1.ValidatorStatus
+	44, // 8: band.oracle.v1.QueryActiveValidatorsResponse.validators:type_name -> band.oracle.v1.ActiveValidator
 	9,  // 9: band.oracle.v1.QueryRequestSearchResponse.request:type_name -> band.oracle.v1.QueryRequestResponse
-	37, // 10: band.oracle.v1.QueryRequestPriceResponse.price_results:type_name -> band.oracle.v1.PriceResult
-	0,  // 11: band.oracle.v1.Query.Counts:input_type -> band.oracle.v1.QueryCountsRequest
-	2,  // 12: band.oracle.v1.Query.Data:input_type -> band.oracle.v1.QueryDataRequest
-	4,  // 13: band.oracle.v1.Query.DataSource:input_type -> band.oracle.v1.QueryDataSourceRequest
-	6,  // 14: band.oracle.v1.Query.OracleScript:input_type -> band.oracle.v1.QueryOracleScriptRequest
-	8,  // 15: band.oracle.v1.Query.Request:input_type -> band.oracle.v1.QueryRequestRequest
-	10, // 16: band.oracle.v1.Query.PendingRequests:input_type -> band.oracle.v1.QueryPendingRequestsRequest
-	14, // 17: band.oracle.v1.Query.Validator:input_type -> band.oracle.v1.QueryValidatorRequest
-	16, // 18: band.oracle.v1.Query.IsReporter:input_type -> band.oracle.v1.QueryIsReporterRequest
-	18, // 19: band.oracle.v1.Query.Reporters:input_type -> band.oracle.v1.QueryReportersRequest
-	20, // 20: band.oracle.v1.Query.ActiveValidators:input_type -> band.oracle.v1.QueryActiveValidatorsRequest
-	12, // 21: band.oracle.v1.Query.Params:input_type -> band.oracle.v1.QueryParamsRequest
-	22, // 22: band.oracle.v1.Query.RequestSearch:input_type -> band.oracle.v1.QueryRequestSearchRequest
-	24, // 23: band.oracle.v1.Query.RequestPrice:input_type -> band.oracle.v1.QueryRequestPriceRequest
-	26, // 24: band.oracle.v1.Query.RequestVerification:input_type -> band.oracle.v1.QueryRequestVerificationRequest
-	1,  // 25: band.oracle.v1.Query.Counts:output_type -> band.oracle.v1.QueryCountsResponse
-	3,  // 26: band.oracle.v1.Query.Data:output_type -> band.oracle.v1.QueryDataResponse
-	5,  // 27: band.oracle.v1.Query.DataSource:output_type -> band.oracle.v1.QueryDataSourceResponse
-	7,  // 28: band.oracle.v1.Query.OracleScript:output_type -> band.oracle.v1.QueryOracleScriptResponse
-	9,  // 29: band.oracle.v1.Query.Request:output_type -> band.oracle.v1.QueryRequestResponse
-	11, // 30: band.oracle.v1.Query.PendingRequests:output_type -> band.oracle.v1.QueryPendingRequestsResponse
-	15, // 31: band.oracle.v1.Query.Validator:output_type -> band.oracle.v1.QueryValidatorResponse
-	17, // 32: band.oracle.v1.Query.IsReporter:output_type -> band.oracle.v1.QueryIsReporterResponse
-	19, // 33: band.oracle.v1.Query.Reporters:output_type -> band.oracle.v1.QueryReportersResponse
-	21, // 34: band.oracle.v1.Query.ActiveValidators:output_type -> band.oracle.v1.QueryActiveValidatorsResponse
-	13, // 35: band.oracle.v1.Query.Params:output_type -> band.oracle.v1.QueryParamsResponse
-	23, // 36: band.oracle.v1.Query.RequestSearch:output_type -> band.oracle.v1.QueryRequestSearchResponse
-	25, // 37: band.oracle.v1.Query.RequestPrice:output_type -> band.oracle.v1.QueryRequestPriceResponse
-	27, // 38: band.oracle.v1.Query.RequestVerification:output_type -> band.oracle.v1.QueryRequestVerificationResponse
-	25, // [25:39] is the sub-list for method output_type
-	11, // [11:25] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	45, // 10: band.oracle.v1.QueryRequestPriceResponse.price_results:type_name -> band.oracle.v1.PriceResult
+	30, // 11: band.oracle.v1.QueryTipPercentilesResponse.percentiles:type_name -> band.oracle.v1.TipPercentile
+	46, // 12: band.oracle.v1.TipPercentile.tip:type_name -> cosmos.base.v1beta1.Coin
+	32, // 13: band.oracle.v1.QuerySimulateRequestRequest.external_data:type_name -> band.oracle.v1.MockExternalData
+	47, // 14: band.oracle.v1.QuerySimulateRequestResponse.raw_requests:type_name -> band.oracle.v1.RawRequest
+	48, // 15: band.oracle.v1.QueryReportCommitsResponse.commits:type_name -> band.oracle.v1.ReportCommit
+	0,  // 16: band.oracle.v1.Query.Counts:input_type -> band.oracle.v1.QueryCountsRequest
+	2,  // 17: band.oracle.v1.Query.Data:input_type -> band.oracle.v1.QueryDataRequest
+	4,  // 18: band.oracle.v1.Query.DataSource:input_type -> band.oracle.v1.QueryDataSourceRequest
+	6,  // 19: band.oracle.v1.Query.OracleScript:input_type -> band.oracle.v1.QueryOracleScriptRequest
+	8,  // 20: band.oracle.v1.Query.Request:input_type -> band.oracle.v1.QueryRequestRequest
+	10, // 21: band.oracle.v1.Query.PendingRequests:input_type -> band.oracle.v1.QueryPendingRequestsRequest
+	14, // 22: band.oracle.v1.Query.Validator:input_type -> band.oracle.v1.QueryValidatorRequest
+	16, // 23: band.oracle.v1.Query.IsReporter:input_type -> band.oracle.v1.QueryIsReporterRequest
+	18, // 24: band.oracle.v1.Query.Reporters:input_type -> band.oracle.v1.QueryReportersRequest
+	20, // 25: band.oracle.v1.Query.ActiveValidators:input_type -> band.oracle.v1.QueryActiveValidatorsRequest
+	12, // 26: band.oracle.v1.Query.Params:input_type -> band.oracle.v1.QueryParamsRequest
+	22, // 27: band.oracle.v1.Query.RequestSearch:input_type -> band.oracle.v1.QueryRequestSearchRequest
+	24, // 28: band.oracle.v1.Query.RequestPrice:input_type -> band.oracle.v1.QueryRequestPriceRequest
+	26, // 29: band.oracle.v1.Query.RequestVerification:input_type -> band.oracle.v1.QueryRequestVerificationRequest
+	28, // 30: band.oracle.v1.Query.TipPercentiles:input_type -> band.oracle.v1.QueryTipPercentilesRequest
+	31, // 31: band.oracle.v1.Query.SimulateRequest:input_type -> band.oracle.v1.QuerySimulateRequestRequest
+	34, // 32: band.oracle.v1.Query.ReportCommits:input_type -> band.oracle.v1.QueryReportCommitsRequest
+	1,  // 33: band.oracle.v1.Query.Counts:output_type -> band.oracle.v1.QueryCountsResponse
+	3,  // 34: band.oracle.v1.Query.Data:output_type -> band.oracle.v1.QueryDataResponse
+	5,  // 35: band.oracle.v1.Query.DataSource:output_type -> band.oracle.v1.QueryDataSourceResponse
+	7,  // 36: band.oracle.v1.Query.OracleScript:output_type -> band.oracle.v1.QueryOracleScriptResponse
+	9,  // 37: band.oracle.v1.Query.Request:output_type -> band.oracle.v1.QueryRequestResponse
+	11, // 38: band.oracle.v1.Query.PendingRequests:output_type -> band.oracle.v1.QueryPendingRequestsResponse
+	15, // 39: band.oracle.v1.Query.Validator:output_type -> band.oracle.v1.QueryValidatorResponse
+	17, // 40: band.oracle.v1.Query.IsReporter:output_type -> band.oracle.v1.QueryIsReporterResponse
+	19, // 41: band.oracle.v1.Query.Reporters:output_type -> band.oracle.v1.QueryReportersResponse
+	21, // 42: band.oracle.v1.Query.ActiveValidators:output_type -> band.oracle.v1.QueryActiveValidatorsResponse
+	13, // 43: band.oracle.v1.Query.Params:output_type -> band.oracle.v1.QueryParamsResponse
+	23, // 44: band.oracle.v1.Query.RequestSearch:output_type -> band.oracle.v1.QueryRequestSearchResponse
+	25, // 45: band.oracle.v1.Query.RequestPrice:output_type -> band.oracle.v1.QueryRequestPriceResponse
+	27, // 46: band.oracle.v1.Query.RequestVerification:output_type -> band.oracle.v1.QueryRequestVerificationResponse
+	29, // 47: band.oracle.v1.Query.TipPercentiles:output_type -> band.oracle.v1.QueryTipPercentilesResponse
+	33, // 48: band.oracle.v1.Query.SimulateRequest:output_type -> band.oracle.v1.QuerySimulateRequestResponse
+	35, // 49: band.oracle.v1.Query.ReportCommits:output_type -> band.oracle.v1.QueryReportCommitsResponse
+	33, // [33:50] is the sub-list for method output_type
+	16, // [16:33] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_query_proto_init() }
@@ -15354,6 +20564,102 @@ func file_band_oracle_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_band_oracle_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTipPercentilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTipPercentilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipPercentile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockExternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryReportCommitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryReportCommitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -15361,7 +20667,7 @@ func file_band_oracle_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,6 +33,9 @@ const (
 	Query_RequestSearch_FullMethodName       = "/band.oracle.v1.Query/RequestSearch"
 	Query_RequestPrice_FullMethodName        = "/band.oracle.v1.Query/RequestPrice"
 	Query_RequestVerification_FullMethodName = "/band.oracle.v1.Query/RequestVerification"
+	Query_TipPercentiles_FullMethodName      = "/band.oracle.v1.Query/TipPercentiles"
+	Query_SimulateRequest_FullMethodName     = "/band.oracle.v1.Query/SimulateRequest"
+	Query_ReportCommits_FullMethodName       = "/band.oracle.v1.Query/ReportCommits"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +75,16 @@ type QueryClient interface {
 	// RequestVerification verifies a request to make sure that
 	// all information that will be used to report the data is valid
 	RequestVerification(ctx context.Context, in *QueryRequestVerificationRequest, opts ...grpc.CallOption) (*QueryRequestVerificationResponse, error)
+	// TipPercentiles queries the percentiles of tips paid by the most recent
+	// unexpired requests, which can be used to price a new request.
+	TipPercentiles(ctx context.Context, in *QueryTipPercentilesRequest, opts ...grpc.CallOption) (*QueryTipPercentilesResponse, error)
+	// SimulateRequest runs the prepare function of an oracle script against the
+	// current state and, if mock external data is given, its execute function
+	// without creating a request.
+	SimulateRequest(ctx context.Context, in *QuerySimulateRequestRequest, opts ...grpc.CallOption) (*QuerySimulateRequestResponse, error)
+	// ReportCommits queries the report commits of a request in commit-reveal
+	// mode and whether its reveal phase has started.
+	ReportCommits(ctx context.Context, in *QueryReportCommitsRequest, opts ...grpc.CallOption) (*QueryReportCommitsResponse, error)
 }
 
 type queryClient struct {
@@ -208,6 +221,33 @@ func (c *queryClient) RequestVerification(ctx context.Context, in *QueryRequestV
 	return out, nil
 }
 
+func (c *queryClient) TipPercentiles(ctx context.Context, in *QueryTipPercentilesRequest, opts ...grpc.CallOption) (*QueryTipPercentilesResponse, error) {
+	out := new(QueryTipPercentilesResponse)
+	err := c.cc.Invoke(ctx, Query_TipPercentiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateRequest(ctx context.Context, in *QuerySimulateRequestRequest, opts ...grpc.CallOption) (*QuerySimulateRequestResponse, error) {
+	out := new(QuerySimulateRequestResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReportCommits(ctx context.Context, in *QueryReportCommitsRequest, opts ...grpc.CallOption) (*QueryReportCommitsResponse, error) {
+	out := new(QueryReportCommitsResponse)
+	err := c.cc.Invoke(ctx, Query_ReportCommits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -245,6 +285,16 @@ type QueryServer interface {
 	// RequestVerification verifies a request to make sure that
 	// all information that will be used to report the data is valid
 	RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error)
+	// TipPercentiles queries the percentiles of tips paid by the most recent
+	// unexpired requests, which can be used to price a new request.
+	TipPercentiles(context.Context, *QueryTipPercentilesRequest) (*QueryTipPercentilesResponse, error)
+	// SimulateRequest runs the prepare function of an oracle script against the
+	// current state and, if mock external data is given, its execute function
+	// without creating a request.
+	SimulateRequest(context.Context, *QuerySimulateRequestRequest) (*QuerySimulateRequestResponse, error)
+	// ReportCommits queries the report commits of a request in commit-reveal
+	// mode and whether its reveal phase has started.
+	ReportCommits(context.Context, *QueryReportCommitsRequest) (*QueryReportCommitsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -294,6 +344,15 @@ func (UnimplementedQueryServer) RequestPrice(context.Context, *QueryRequestPrice
 func (UnimplementedQueryServer) RequestVerification(context.Context, *QueryRequestVerificationRequest) (*QueryRequestVerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
 }
+func (UnimplementedQueryServer) TipPercentiles(context.Context, *QueryTipPercentilesRequest) (*QueryTipPercentilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TipPercentiles not implemented")
+}
+func (UnimplementedQueryServer) SimulateRequest(context.Context, *QuerySimulateRequestRequest) (*QuerySimulateRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRequest not implemented")
+}
+func (UnimplementedQueryServer) ReportCommits(context.Context, *QueryReportCommitsRequest) (*QueryReportCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCommits not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -559,6 +618,60 @@ func _Query_RequestVerification_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TipPercentiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTipPercentilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TipPercentiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TipPercentiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TipPercentiles(ctx, req.(*QueryTipPercentilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateRequest(ctx, req.(*QuerySimulateRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReportCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReportCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ReportCommits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReportCommits(ctx, req.(*QueryReportCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -622,6 +735,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestVerification",
 			Handler:    _Query_RequestVerification_Handler,
 		},
+		{
+			MethodName: "TipPercentiles",
+			Handler:    _Query_TipPercentiles_Handler,
+		},
+		{
+			MethodName: "SimulateRequest",
+			Handler:    _Query_SimulateRequest_Handler,
+		},
+		{
+			MethodName: "ReportCommits",
+			Handler:    _Query_ReportCommits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "band/oracle/v1/query.proto",
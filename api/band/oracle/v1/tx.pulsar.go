@@ -68,6 +68,57 @@ func (x *_MsgRequestData_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_MsgRequestData_11_list)(nil)
+
+type _MsgRequestData_11_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgRequestData_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRequestData_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgRequestData_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRequestData_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRequestData_11_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRequestData_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRequestData_11_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRequestData_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgRequestData                  protoreflect.MessageDescriptor
 	fd_MsgRequestData_oracle_script_id protoreflect.FieldDescriptor
@@ -80,6 +131,8 @@ var (
 	fd_MsgRequestData_execute_gas      protoreflect.FieldDescriptor
 	fd_MsgRequestData_sender           protoreflect.FieldDescriptor
 	fd_MsgRequestData_tss_encoder      protoreflect.FieldDescriptor
+	fd_MsgRequestData_tip              protoreflect.FieldDescriptor
+	fd_MsgRequestData_commit_reveal    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -95,6 +148,8 @@ func init() {
 	fd_MsgRequestData_execute_gas = md_MsgRequestData.Fields().ByName("execute_gas")
 	fd_MsgRequestData_sender = md_MsgRequestData.Fields().ByName("sender")
 	fd_MsgRequestData_tss_encoder = md_MsgRequestData.Fields().ByName("tss_encoder")
+	fd_MsgRequestData_tip = md_MsgRequestData.Fields().ByName("tip")
+	fd_MsgRequestData_commit_reveal = md_MsgRequestData.Fields().ByName("commit_reveal")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRequestData)(nil)
@@ -222,6 +277,18 @@ func (x *fastReflection_MsgRequestData) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.Tip) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRequestData_11_list{list: &x.Tip})
+		if !f(fd_MsgRequestData_tip, value) {
+			return
+		}
+	}
+	if x.CommitReveal != false {
+		value := protoreflect.ValueOfBool(x.CommitReveal)
+		if !f(fd_MsgRequestData_commit_reveal, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -257,6 +324,10 @@ func (x *fastReflection_MsgRequestData) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Sender != ""
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		return x.TssEncoder != 0
+	case "band.oracle.v1.MsgRequestData.tip":
+		return len(x.Tip) != 0
+	case "band.oracle.v1.MsgRequestData.commit_reveal":
+		return x.CommitReveal != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -293,6 +364,10 @@ func (x *fastReflection_MsgRequestData) Clear(fd protoreflect.FieldDescriptor) {
 		x.Sender = ""
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		x.TssEncoder = 0
+	case "band.oracle.v1.MsgRequestData.tip":
+		x.Tip = nil
+	case "band.oracle.v1.MsgRequestData.commit_reveal":
+		x.CommitReveal = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -342,6 +417,15 @@ func (x *fastReflection_MsgRequestData) Get(descriptor protoreflect.FieldDescrip
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		value := x.TssEncoder
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "band.oracle.v1.MsgRequestData.tip":
+		if len(x.Tip) == 0 {
+			return protoreflect.ValueOfList(&_MsgRequestData_11_list{})
+		}
+		listValue := &_MsgRequestData_11_list{list: &x.Tip}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.MsgRequestData.commit_reveal":
+		value := x.CommitReveal
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -384,6 +468,12 @@ func (x *fastReflection_MsgRequestData) Set(fd protoreflect.FieldDescriptor, val
 		x.Sender = value.Interface().(string)
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		x.TssEncoder = (Encoder)(value.Enum())
+	case "band.oracle.v1.MsgRequestData.tip":
+		lv := value.List()
+		clv := lv.(*_MsgRequestData_11_list)
+		x.Tip = *clv.list
+	case "band.oracle.v1.MsgRequestData.commit_reveal":
+		x.CommitReveal = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -410,6 +500,12 @@ func (x *fastReflection_MsgRequestData) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_MsgRequestData_6_list{list: &x.FeeLimit}
 		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.MsgRequestData.tip":
+		if x.Tip == nil {
+			x.Tip = []*v1beta1.Coin{}
+		}
+		value := &_MsgRequestData_11_list{list: &x.Tip}
+		return protoreflect.ValueOfList(value)
 	case "band.oracle.v1.MsgRequestData.oracle_script_id":
 		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.MsgRequestData is not mutable"))
 	case "band.oracle.v1.MsgRequestData.calldata":
@@ -428,6 +524,8 @@ func (x *fastReflection_MsgRequestData) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgRequestData is not mutable"))
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		panic(fmt.Errorf("field tss_encoder of message band.oracle.v1.MsgRequestData is not mutable"))
+	case "band.oracle.v1.MsgRequestData.commit_reveal":
+		panic(fmt.Errorf("field commit_reveal of message band.oracle.v1.MsgRequestData is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -462,6 +560,11 @@ func (x *fastReflection_MsgRequestData) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "band.oracle.v1.MsgRequestData.tss_encoder":
 		return protoreflect.ValueOfEnum(0)
+	case "band.oracle.v1.MsgRequestData.tip":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgRequestData_11_list{list: &list})
+	case "band.oracle.v1.MsgRequestData.commit_reveal":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgRequestData"))
@@ -567,6 +670,15 @@ func (x *fastReflection_MsgRequestData) ProtoMethods() *protoiface.Methods {
 		if x.TssEncoder != 0 {
 			n += 1 + runtime.Sov(uint64(x.TssEncoder))
 		}
+		if len(x.Tip) > 0 {
+			for _, e := range x.Tip {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommitReveal {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -596,6 +708,32 @@ func (x *fastReflection_MsgRequestData) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CommitReveal {
+			i--
+			if x.CommitReveal {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.Tip) > 0 {
+			for iNdEx := len(x.Tip) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tip[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if x.TssEncoder != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TssEncoder))
 			i--
@@ -958,6 +1096,60 @@ func (x *fastReflection_MsgRequestData) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tip = append(x.Tip, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tip[len(x.Tip)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CommitReveal = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1405,6 +1597,7 @@ var (
 	fd_MsgReportData_request_id  protoreflect.FieldDescriptor
 	fd_MsgReportData_raw_reports protoreflect.FieldDescriptor
 	fd_MsgReportData_validator   protoreflect.FieldDescriptor
+	fd_MsgReportData_salt        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1413,6 +1606,7 @@ func init() {
 	fd_MsgReportData_request_id = md_MsgReportData.Fields().ByName("request_id")
 	fd_MsgReportData_raw_reports = md_MsgReportData.Fields().ByName("raw_reports")
 	fd_MsgReportData_validator = md_MsgReportData.Fields().ByName("validator")
+	fd_MsgReportData_salt = md_MsgReportData.Fields().ByName("salt")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgReportData)(nil)
@@ -1498,6 +1692,12 @@ func (x *fastReflection_MsgReportData) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if len(x.Salt) != 0 {
+		value := protoreflect.ValueOfBytes(x.Salt)
+		if !f(fd_MsgReportData_salt, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1519,6 +1719,8 @@ func (x *fastReflection_MsgReportData) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.RawReports) != 0
 	case "band.oracle.v1.MsgReportData.validator":
 		return x.Validator != ""
+	case "band.oracle.v1.MsgReportData.salt":
+		return len(x.Salt) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgReportData"))
@@ -1541,6 +1743,8 @@ func (x *fastReflection_MsgReportData) Clear(fd protoreflect.FieldDescriptor) {
 		x.RawReports = nil
 	case "band.oracle.v1.MsgReportData.validator":
 		x.Validator = ""
+	case "band.oracle.v1.MsgReportData.salt":
+		x.Salt = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgReportData"))
@@ -1569,6 +1773,9 @@ func (x *fastReflection_MsgReportData) Get(descriptor protoreflect.FieldDescript
 	case "band.oracle.v1.MsgReportData.validator":
 		value := x.Validator
 		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgReportData.salt":
+		value := x.Salt
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgReportData"))
@@ -1597,6 +1804,8 @@ func (x *fastReflection_MsgReportData) Set(fd protoreflect.FieldDescriptor, valu
 		x.RawReports = *clv.list
 	case "band.oracle.v1.MsgReportData.validator":
 		x.Validator = value.Interface().(string)
+	case "band.oracle.v1.MsgReportData.salt":
+		x.Salt = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgReportData"))
@@ -1627,6 +1836,8 @@ func (x *fastReflection_MsgReportData) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field request_id of message band.oracle.v1.MsgReportData is not mutable"))
 	case "band.oracle.v1.MsgReportData.validator":
 		panic(fmt.Errorf("field validator of message band.oracle.v1.MsgReportData is not mutable"))
+	case "band.oracle.v1.MsgReportData.salt":
+		panic(fmt.Errorf("field salt of message band.oracle.v1.MsgReportData is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgReportData"))
@@ -1647,6 +1858,8 @@ func (x *fastReflection_MsgReportData) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_MsgReportData_2_list{list: &list})
 	case "band.oracle.v1.MsgReportData.validator":
 		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgReportData.salt":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgReportData"))
@@ -1729,6 +1942,10 @@ func (x *fastReflection_MsgReportData) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1758,6 +1975,13 @@ func (x *fastReflection_MsgReportData) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.Validator) > 0 {
 			i -= len(x.Validator)
 			copy(dAtA[i:], x.Validator)
@@ -1920,6 +2144,40 @@ func (x *fastReflection_MsgReportData) ProtoMethods() *protoiface.Methods {
 				}
 				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = append(x.Salt[:0], dAtA[iNdEx:postIndex]...)
+				if x.Salt == nil {
+					x.Salt = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2311,89 +2569,30 @@ func (x *fastReflection_MsgReportDataResponse) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_MsgCreateDataSource_4_list)(nil)
-
-type _MsgCreateDataSource_4_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_MsgCreateDataSource_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgCreateDataSource_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgCreateDataSource_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgCreateDataSource_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgCreateDataSource_4_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgCreateDataSource_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgCreateDataSource_4_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgCreateDataSource_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgCreateDataSource             protoreflect.MessageDescriptor
-	fd_MsgCreateDataSource_name        protoreflect.FieldDescriptor
-	fd_MsgCreateDataSource_description protoreflect.FieldDescriptor
-	fd_MsgCreateDataSource_executable  protoreflect.FieldDescriptor
-	fd_MsgCreateDataSource_fee         protoreflect.FieldDescriptor
-	fd_MsgCreateDataSource_treasury    protoreflect.FieldDescriptor
-	fd_MsgCreateDataSource_owner       protoreflect.FieldDescriptor
-	fd_MsgCreateDataSource_sender      protoreflect.FieldDescriptor
+	md_MsgCommitReport            protoreflect.MessageDescriptor
+	fd_MsgCommitReport_request_id protoreflect.FieldDescriptor
+	fd_MsgCommitReport_hash       protoreflect.FieldDescriptor
+	fd_MsgCommitReport_validator  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgCreateDataSource = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateDataSource")
-	fd_MsgCreateDataSource_name = md_MsgCreateDataSource.Fields().ByName("name")
-	fd_MsgCreateDataSource_description = md_MsgCreateDataSource.Fields().ByName("description")
-	fd_MsgCreateDataSource_executable = md_MsgCreateDataSource.Fields().ByName("executable")
-	fd_MsgCreateDataSource_fee = md_MsgCreateDataSource.Fields().ByName("fee")
-	fd_MsgCreateDataSource_treasury = md_MsgCreateDataSource.Fields().ByName("treasury")
-	fd_MsgCreateDataSource_owner = md_MsgCreateDataSource.Fields().ByName("owner")
-	fd_MsgCreateDataSource_sender = md_MsgCreateDataSource.Fields().ByName("sender")
+	md_MsgCommitReport = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCommitReport")
+	fd_MsgCommitReport_request_id = md_MsgCommitReport.Fields().ByName("request_id")
+	fd_MsgCommitReport_hash = md_MsgCommitReport.Fields().ByName("hash")
+	fd_MsgCommitReport_validator = md_MsgCommitReport.Fields().ByName("validator")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateDataSource)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCommitReport)(nil)
 
-type fastReflection_MsgCreateDataSource MsgCreateDataSource
+type fastReflection_MsgCommitReport MsgCommitReport
 
-func (x *MsgCreateDataSource) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateDataSource)(x)
+func (x *MsgCommitReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCommitReport)(x)
 }
 
-func (x *MsgCreateDataSource) slowProtoReflect() protoreflect.Message {
+func (x *MsgCommitReport) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2405,43 +2604,43 @@ func (x *MsgCreateDataSource) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateDataSource_messageType fastReflection_MsgCreateDataSource_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateDataSource_messageType{}
+var _fastReflection_MsgCommitReport_messageType fastReflection_MsgCommitReport_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCommitReport_messageType{}
 
-type fastReflection_MsgCreateDataSource_messageType struct{}
+type fastReflection_MsgCommitReport_messageType struct{}
 
-func (x fastReflection_MsgCreateDataSource_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateDataSource)(nil)
+func (x fastReflection_MsgCommitReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCommitReport)(nil)
 }
-func (x fastReflection_MsgCreateDataSource_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateDataSource)
+func (x fastReflection_MsgCommitReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCommitReport)
 }
-func (x fastReflection_MsgCreateDataSource_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateDataSource
+func (x fastReflection_MsgCommitReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCommitReport
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateDataSource) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateDataSource
+func (x *fastReflection_MsgCommitReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCommitReport
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateDataSource) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateDataSource_messageType
+func (x *fastReflection_MsgCommitReport) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCommitReport_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateDataSource) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateDataSource)
+func (x *fastReflection_MsgCommitReport) New() protoreflect.Message {
+	return new(fastReflection_MsgCommitReport)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateDataSource) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateDataSource)(x)
+func (x *fastReflection_MsgCommitReport) Interface() protoreflect.ProtoMessage {
+	return (*MsgCommitReport)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2449,46 +2648,22 @@ func (x *fastReflection_MsgCreateDataSource) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateDataSource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgCreateDataSource_name, value) {
+func (x *fastReflection_MsgCommitReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_MsgCommitReport_request_id, value) {
 			return
 		}
 	}
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_MsgCreateDataSource_description, value) {
-			return
-		}
-	}
-	if len(x.Executable) != 0 {
-		value := protoreflect.ValueOfBytes(x.Executable)
-		if !f(fd_MsgCreateDataSource_executable, value) {
-			return
-		}
-	}
-	if len(x.Fee) != 0 {
-		value := protoreflect.ValueOfList(&_MsgCreateDataSource_4_list{list: &x.Fee})
-		if !f(fd_MsgCreateDataSource_fee, value) {
-			return
-		}
-	}
-	if x.Treasury != "" {
-		value := protoreflect.ValueOfString(x.Treasury)
-		if !f(fd_MsgCreateDataSource_treasury, value) {
-			return
-		}
-	}
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_MsgCreateDataSource_owner, value) {
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_MsgCommitReport_hash, value) {
 			return
 		}
 	}
-	if x.Sender != "" {
-		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_MsgCreateDataSource_sender, value) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_MsgCommitReport_validator, value) {
 			return
 		}
 	}
@@ -2505,27 +2680,19 @@ func (x *fastReflection_MsgCreateDataSource) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateDataSource) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCommitReport) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateDataSource.name":
-		return x.Name != ""
-	case "band.oracle.v1.MsgCreateDataSource.description":
-		return x.Description != ""
-	case "band.oracle.v1.MsgCreateDataSource.executable":
-		return len(x.Executable) != 0
-	case "band.oracle.v1.MsgCreateDataSource.fee":
-		return len(x.Fee) != 0
-	case "band.oracle.v1.MsgCreateDataSource.treasury":
-		return x.Treasury != ""
-	case "band.oracle.v1.MsgCreateDataSource.owner":
-		return x.Owner != ""
-	case "band.oracle.v1.MsgCreateDataSource.sender":
-		return x.Sender != ""
+	case "band.oracle.v1.MsgCommitReport.request_id":
+		return x.RequestId != uint64(0)
+	case "band.oracle.v1.MsgCommitReport.hash":
+		return len(x.Hash) != 0
+	case "band.oracle.v1.MsgCommitReport.validator":
+		return x.Validator != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2535,27 +2702,19 @@ func (x *fastReflection_MsgCreateDataSource) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDataSource) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCommitReport) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateDataSource.name":
-		x.Name = ""
-	case "band.oracle.v1.MsgCreateDataSource.description":
-		x.Description = ""
-	case "band.oracle.v1.MsgCreateDataSource.executable":
-		x.Executable = nil
-	case "band.oracle.v1.MsgCreateDataSource.fee":
-		x.Fee = nil
-	case "band.oracle.v1.MsgCreateDataSource.treasury":
-		x.Treasury = ""
-	case "band.oracle.v1.MsgCreateDataSource.owner":
-		x.Owner = ""
-	case "band.oracle.v1.MsgCreateDataSource.sender":
-		x.Sender = ""
+	case "band.oracle.v1.MsgCommitReport.request_id":
+		x.RequestId = uint64(0)
+	case "band.oracle.v1.MsgCommitReport.hash":
+		x.Hash = nil
+	case "band.oracle.v1.MsgCommitReport.validator":
+		x.Validator = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2565,37 +2724,22 @@ func (x *fastReflection_MsgCreateDataSource) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateDataSource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.MsgCreateDataSource.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateDataSource.description":
-		value := x.Description
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateDataSource.executable":
-		value := x.Executable
+	case "band.oracle.v1.MsgCommitReport.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgCommitReport.hash":
+		value := x.Hash
 		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.MsgCreateDataSource.fee":
-		if len(x.Fee) == 0 {
-			return protoreflect.ValueOfList(&_MsgCreateDataSource_4_list{})
-		}
-		listValue := &_MsgCreateDataSource_4_list{list: &x.Fee}
-		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.MsgCreateDataSource.treasury":
-		value := x.Treasury
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateDataSource.owner":
-		value := x.Owner
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateDataSource.sender":
-		value := x.Sender
+	case "band.oracle.v1.MsgCommitReport.validator":
+		value := x.Validator
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReport does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2609,29 +2753,19 @@ func (x *fastReflection_MsgCreateDataSource) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDataSource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCommitReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateDataSource.name":
-		x.Name = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateDataSource.description":
-		x.Description = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateDataSource.executable":
-		x.Executable = value.Bytes()
-	case "band.oracle.v1.MsgCreateDataSource.fee":
-		lv := value.List()
-		clv := lv.(*_MsgCreateDataSource_4_list)
-		x.Fee = *clv.list
-	case "band.oracle.v1.MsgCreateDataSource.treasury":
-		x.Treasury = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateDataSource.owner":
-		x.Owner = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateDataSource.sender":
-		x.Sender = value.Interface().(string)
+	case "band.oracle.v1.MsgCommitReport.request_id":
+		x.RequestId = value.Uint()
+	case "band.oracle.v1.MsgCommitReport.hash":
+		x.Hash = value.Bytes()
+	case "band.oracle.v1.MsgCommitReport.validator":
+		x.Validator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReport does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2645,69 +2779,48 @@ func (x *fastReflection_MsgCreateDataSource) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDataSource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateDataSource.fee":
-		if x.Fee == nil {
-			x.Fee = []*v1beta1.Coin{}
-		}
-		value := &_MsgCreateDataSource_4_list{list: &x.Fee}
-		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.MsgCreateDataSource.name":
-		panic(fmt.Errorf("field name of message band.oracle.v1.MsgCreateDataSource is not mutable"))
-	case "band.oracle.v1.MsgCreateDataSource.description":
-		panic(fmt.Errorf("field description of message band.oracle.v1.MsgCreateDataSource is not mutable"))
-	case "band.oracle.v1.MsgCreateDataSource.executable":
-		panic(fmt.Errorf("field executable of message band.oracle.v1.MsgCreateDataSource is not mutable"))
-	case "band.oracle.v1.MsgCreateDataSource.treasury":
-		panic(fmt.Errorf("field treasury of message band.oracle.v1.MsgCreateDataSource is not mutable"))
-	case "band.oracle.v1.MsgCreateDataSource.owner":
-		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgCreateDataSource is not mutable"))
-	case "band.oracle.v1.MsgCreateDataSource.sender":
-		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgCreateDataSource is not mutable"))
+	case "band.oracle.v1.MsgCommitReport.request_id":
+		panic(fmt.Errorf("field request_id of message band.oracle.v1.MsgCommitReport is not mutable"))
+	case "band.oracle.v1.MsgCommitReport.hash":
+		panic(fmt.Errorf("field hash of message band.oracle.v1.MsgCommitReport is not mutable"))
+	case "band.oracle.v1.MsgCommitReport.validator":
+		panic(fmt.Errorf("field validator of message band.oracle.v1.MsgCommitReport is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReport does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateDataSource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateDataSource.name":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateDataSource.description":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateDataSource.executable":
+	case "band.oracle.v1.MsgCommitReport.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgCommitReport.hash":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.MsgCreateDataSource.fee":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgCreateDataSource_4_list{list: &list})
-	case "band.oracle.v1.MsgCreateDataSource.treasury":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateDataSource.owner":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateDataSource.sender":
+	case "band.oracle.v1.MsgCommitReport.validator":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReport"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReport does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateDataSource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCommitReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateDataSource", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCommitReport", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2715,7 +2828,7 @@ func (x *fastReflection_MsgCreateDataSource) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateDataSource) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCommitReport) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2726,7 +2839,7 @@ func (x *fastReflection_MsgCreateDataSource) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDataSource) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCommitReport) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2738,7 +2851,7 @@ func (x *fastReflection_MsgCreateDataSource) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateDataSource) IsValid() bool {
+func (x *fastReflection_MsgCommitReport) IsValid() bool {
 	return x != nil
 }
 
@@ -2748,9 +2861,9 @@ func (x *fastReflection_MsgCreateDataSource) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCommitReport) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateDataSource)
+		x := input.Message.Interface().(*MsgCommitReport)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2762,33 +2875,14 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Description)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Executable)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Fee) > 0 {
-			for _, e := range x.Fee {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Treasury)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
 		}
-		l = len(x.Owner)
+		l = len(x.Hash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Sender)
+		l = len(x.Validator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2802,7 +2896,7 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateDataSource)
+		x := input.Message.Interface().(*MsgCommitReport)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2821,63 +2915,24 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Treasury) > 0 {
-			i -= len(x.Treasury)
-			copy(dAtA[i:], x.Treasury)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Fee) > 0 {
-			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Fee[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.Executable) > 0 {
-			i -= len(x.Executable)
-			copy(dAtA[i:], x.Executable)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executable)))
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2890,7 +2945,7 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateDataSource)
+		x := input.Message.Interface().(*MsgCommitReport)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2922,17 +2977,17 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitReport: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitReport: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 				}
-				var stringLen uint64
+				x.RequestId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2942,59 +2997,14 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.RequestId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Description = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -3021,112 +3031,14 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Executable = append(x.Executable[:0], dAtA[iNdEx:postIndex]...)
-				if x.Executable == nil {
-					x.Executable = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Fee = append(x.Fee, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Treasury = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
 				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3154,7 +3066,7 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Sender = string(dAtA[iNdEx:postIndex])
+				x.Validator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3192,23 +3104,23 @@ func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgCreateDataSourceResponse protoreflect.MessageDescriptor
+	md_MsgCommitReportResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgCreateDataSourceResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateDataSourceResponse")
+	md_MsgCommitReportResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCommitReportResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateDataSourceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCommitReportResponse)(nil)
 
-type fastReflection_MsgCreateDataSourceResponse MsgCreateDataSourceResponse
+type fastReflection_MsgCommitReportResponse MsgCommitReportResponse
 
-func (x *MsgCreateDataSourceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateDataSourceResponse)(x)
+func (x *MsgCommitReportResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCommitReportResponse)(x)
 }
 
-func (x *MsgCreateDataSourceResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgCommitReportResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3220,43 +3132,43 @@ func (x *MsgCreateDataSourceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateDataSourceResponse_messageType fastReflection_MsgCreateDataSourceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateDataSourceResponse_messageType{}
+var _fastReflection_MsgCommitReportResponse_messageType fastReflection_MsgCommitReportResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCommitReportResponse_messageType{}
 
-type fastReflection_MsgCreateDataSourceResponse_messageType struct{}
+type fastReflection_MsgCommitReportResponse_messageType struct{}
 
-func (x fastReflection_MsgCreateDataSourceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateDataSourceResponse)(nil)
+func (x fastReflection_MsgCommitReportResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCommitReportResponse)(nil)
 }
-func (x fastReflection_MsgCreateDataSourceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateDataSourceResponse)
+func (x fastReflection_MsgCommitReportResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCommitReportResponse)
 }
-func (x fastReflection_MsgCreateDataSourceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateDataSourceResponse
+func (x fastReflection_MsgCommitReportResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCommitReportResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateDataSourceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateDataSourceResponse
+func (x *fastReflection_MsgCommitReportResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCommitReportResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateDataSourceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateDataSourceResponse_messageType
+func (x *fastReflection_MsgCommitReportResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCommitReportResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateDataSourceResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateDataSourceResponse)
+func (x *fastReflection_MsgCommitReportResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCommitReportResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateDataSourceResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateDataSourceResponse)(x)
+func (x *fastReflection_MsgCommitReportResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCommitReportResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3264,7 +3176,7 @@ func (x *fastReflection_MsgCreateDataSourceResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateDataSourceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCommitReportResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3278,13 +3190,13 @@ func (x *fastReflection_MsgCreateDataSourceResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateDataSourceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCommitReportResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReportResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReportResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3294,13 +3206,13 @@ func (x *fastReflection_MsgCreateDataSourceResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDataSourceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCommitReportResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReportResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReportResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3310,13 +3222,13 @@ func (x *fastReflection_MsgCreateDataSourceResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateDataSourceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitReportResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReportResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReportResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3330,13 +3242,13 @@ func (x *fastReflection_MsgCreateDataSourceResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDataSourceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCommitReportResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReportResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReportResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3350,36 +3262,36 @@ func (x *fastReflection_MsgCreateDataSourceResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDataSourceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitReportResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReportResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReportResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateDataSourceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCommitReportResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCommitReportResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCommitReportResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateDataSourceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCommitReportResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateDataSourceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCommitReportResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3387,7 +3299,7 @@ func (x *fastReflection_MsgCreateDataSourceResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateDataSourceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCommitReportResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3398,7 +3310,7 @@ func (x *fastReflection_MsgCreateDataSourceResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateDataSourceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCommitReportResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3410,7 +3322,7 @@ func (x *fastReflection_MsgCreateDataSourceResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateDataSourceResponse) IsValid() bool {
+func (x *fastReflection_MsgCommitReportResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3420,9 +3332,9 @@ func (x *fastReflection_MsgCreateDataSourceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateDataSourceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCommitReportResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateDataSourceResponse)
+		x := input.Message.Interface().(*MsgCommitReportResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3444,7 +3356,7 @@ func (x *fastReflection_MsgCreateDataSourceResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateDataSourceResponse)
+		x := input.Message.Interface().(*MsgCommitReportResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3474,7 +3386,7 @@ func (x *fastReflection_MsgCreateDataSourceResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateDataSourceResponse)
+		x := input.Message.Interface().(*MsgCommitReportResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3506,10 +3418,10 @@ func (x *fastReflection_MsgCreateDataSourceResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDataSourceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitReportResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCommitReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -3547,91 +3459,89 @@ func (x *fastReflection_MsgCreateDataSourceResponse) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_MsgEditDataSource_5_list)(nil)
+var _ protoreflect.List = (*_MsgCreateDataSource_4_list)(nil)
 
-type _MsgEditDataSource_5_list struct {
+type _MsgCreateDataSource_4_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_MsgEditDataSource_5_list) Len() int {
+func (x *_MsgCreateDataSource_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgEditDataSource_5_list) Get(i int) protoreflect.Value {
+func (x *_MsgCreateDataSource_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgEditDataSource_5_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgCreateDataSource_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgEditDataSource_5_list) Append(value protoreflect.Value) {
+func (x *_MsgCreateDataSource_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgEditDataSource_5_list) AppendMutable() protoreflect.Value {
+func (x *_MsgCreateDataSource_4_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgEditDataSource_5_list) Truncate(n int) {
+func (x *_MsgCreateDataSource_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgEditDataSource_5_list) NewElement() protoreflect.Value {
+func (x *_MsgCreateDataSource_4_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgEditDataSource_5_list) IsValid() bool {
+func (x *_MsgCreateDataSource_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgEditDataSource                protoreflect.MessageDescriptor
-	fd_MsgEditDataSource_data_source_id protoreflect.FieldDescriptor
-	fd_MsgEditDataSource_name           protoreflect.FieldDescriptor
-	fd_MsgEditDataSource_description    protoreflect.FieldDescriptor
-	fd_MsgEditDataSource_executable     protoreflect.FieldDescriptor
-	fd_MsgEditDataSource_fee            protoreflect.FieldDescriptor
-	fd_MsgEditDataSource_treasury       protoreflect.FieldDescriptor
-	fd_MsgEditDataSource_owner          protoreflect.FieldDescriptor
-	fd_MsgEditDataSource_sender         protoreflect.FieldDescriptor
+	md_MsgCreateDataSource             protoreflect.MessageDescriptor
+	fd_MsgCreateDataSource_name        protoreflect.FieldDescriptor
+	fd_MsgCreateDataSource_description protoreflect.FieldDescriptor
+	fd_MsgCreateDataSource_executable  protoreflect.FieldDescriptor
+	fd_MsgCreateDataSource_fee         protoreflect.FieldDescriptor
+	fd_MsgCreateDataSource_treasury    protoreflect.FieldDescriptor
+	fd_MsgCreateDataSource_owner       protoreflect.FieldDescriptor
+	fd_MsgCreateDataSource_sender      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgEditDataSource = File_band_oracle_v1_tx_proto.Messages().ByName("MsgEditDataSource")
-	fd_MsgEditDataSource_data_source_id = md_MsgEditDataSource.Fields().ByName("data_source_id")
-	fd_MsgEditDataSource_name = md_MsgEditDataSource.Fields().ByName("name")
-	fd_MsgEditDataSource_description = md_MsgEditDataSource.Fields().ByName("description")
-	fd_MsgEditDataSource_executable = md_MsgEditDataSource.Fields().ByName("executable")
-	fd_MsgEditDataSource_fee = md_MsgEditDataSource.Fields().ByName("fee")
-	fd_MsgEditDataSource_treasury = md_MsgEditDataSource.Fields().ByName("treasury")
-	fd_MsgEditDataSource_owner = md_MsgEditDataSource.Fields().ByName("owner")
-	fd_MsgEditDataSource_sender = md_MsgEditDataSource.Fields().ByName("sender")
-}
+	md_MsgCreateDataSource = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateDataSource")
+	fd_MsgCreateDataSource_name = md_MsgCreateDataSource.Fields().ByName("name")
+	fd_MsgCreateDataSource_description = md_MsgCreateDataSource.Fields().ByName("description")
+	fd_MsgCreateDataSource_executable = md_MsgCreateDataSource.Fields().ByName("executable")
+	fd_MsgCreateDataSource_fee = md_MsgCreateDataSource.Fields().ByName("fee")
+	fd_MsgCreateDataSource_treasury = md_MsgCreateDataSource.Fields().ByName("treasury")
+	fd_MsgCreateDataSource_owner = md_MsgCreateDataSource.Fields().ByName("owner")
+	fd_MsgCreateDataSource_sender = md_MsgCreateDataSource.Fields().ByName("sender")
+}
 
-var _ protoreflect.Message = (*fastReflection_MsgEditDataSource)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCreateDataSource)(nil)
 
-type fastReflection_MsgEditDataSource MsgEditDataSource
+type fastReflection_MsgCreateDataSource MsgCreateDataSource
 
-func (x *MsgEditDataSource) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgEditDataSource)(x)
+func (x *MsgCreateDataSource) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateDataSource)(x)
 }
 
-func (x *MsgEditDataSource) slowProtoReflect() protoreflect.Message {
+func (x *MsgCreateDataSource) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3643,43 +3553,43 @@ func (x *MsgEditDataSource) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgEditDataSource_messageType fastReflection_MsgEditDataSource_messageType
-var _ protoreflect.MessageType = fastReflection_MsgEditDataSource_messageType{}
+var _fastReflection_MsgCreateDataSource_messageType fastReflection_MsgCreateDataSource_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateDataSource_messageType{}
 
-type fastReflection_MsgEditDataSource_messageType struct{}
+type fastReflection_MsgCreateDataSource_messageType struct{}
 
-func (x fastReflection_MsgEditDataSource_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgEditDataSource)(nil)
+func (x fastReflection_MsgCreateDataSource_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateDataSource)(nil)
 }
-func (x fastReflection_MsgEditDataSource_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgEditDataSource)
+func (x fastReflection_MsgCreateDataSource_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateDataSource)
 }
-func (x fastReflection_MsgEditDataSource_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgEditDataSource
+func (x fastReflection_MsgCreateDataSource_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateDataSource
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgEditDataSource) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgEditDataSource
+func (x *fastReflection_MsgCreateDataSource) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateDataSource
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgEditDataSource) Type() protoreflect.MessageType {
-	return _fastReflection_MsgEditDataSource_messageType
+func (x *fastReflection_MsgCreateDataSource) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateDataSource_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgEditDataSource) New() protoreflect.Message {
-	return new(fastReflection_MsgEditDataSource)
+func (x *fastReflection_MsgCreateDataSource) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateDataSource)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgEditDataSource) Interface() protoreflect.ProtoMessage {
-	return (*MsgEditDataSource)(x)
+func (x *fastReflection_MsgCreateDataSource) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateDataSource)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3687,52 +3597,46 @@ func (x *fastReflection_MsgEditDataSource) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgEditDataSource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DataSourceId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.DataSourceId)
-		if !f(fd_MsgEditDataSource_data_source_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgCreateDataSource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Name != "" {
 		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgEditDataSource_name, value) {
+		if !f(fd_MsgCreateDataSource_name, value) {
 			return
 		}
 	}
 	if x.Description != "" {
 		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_MsgEditDataSource_description, value) {
+		if !f(fd_MsgCreateDataSource_description, value) {
 			return
 		}
 	}
 	if len(x.Executable) != 0 {
 		value := protoreflect.ValueOfBytes(x.Executable)
-		if !f(fd_MsgEditDataSource_executable, value) {
+		if !f(fd_MsgCreateDataSource_executable, value) {
 			return
 		}
 	}
 	if len(x.Fee) != 0 {
-		value := protoreflect.ValueOfList(&_MsgEditDataSource_5_list{list: &x.Fee})
-		if !f(fd_MsgEditDataSource_fee, value) {
+		value := protoreflect.ValueOfList(&_MsgCreateDataSource_4_list{list: &x.Fee})
+		if !f(fd_MsgCreateDataSource_fee, value) {
 			return
 		}
 	}
 	if x.Treasury != "" {
 		value := protoreflect.ValueOfString(x.Treasury)
-		if !f(fd_MsgEditDataSource_treasury, value) {
+		if !f(fd_MsgCreateDataSource_treasury, value) {
 			return
 		}
 	}
 	if x.Owner != "" {
 		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_MsgEditDataSource_owner, value) {
+		if !f(fd_MsgCreateDataSource_owner, value) {
 			return
 		}
 	}
 	if x.Sender != "" {
 		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_MsgEditDataSource_sender, value) {
+		if !f(fd_MsgCreateDataSource_sender, value) {
 			return
 		}
 	}
@@ -3749,29 +3653,27 @@ func (x *fastReflection_MsgEditDataSource) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgEditDataSource) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCreateDataSource) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditDataSource.data_source_id":
-		return x.DataSourceId != uint64(0)
-	case "band.oracle.v1.MsgEditDataSource.name":
+	case "band.oracle.v1.MsgCreateDataSource.name":
 		return x.Name != ""
-	case "band.oracle.v1.MsgEditDataSource.description":
+	case "band.oracle.v1.MsgCreateDataSource.description":
 		return x.Description != ""
-	case "band.oracle.v1.MsgEditDataSource.executable":
+	case "band.oracle.v1.MsgCreateDataSource.executable":
 		return len(x.Executable) != 0
-	case "band.oracle.v1.MsgEditDataSource.fee":
+	case "band.oracle.v1.MsgCreateDataSource.fee":
 		return len(x.Fee) != 0
-	case "band.oracle.v1.MsgEditDataSource.treasury":
+	case "band.oracle.v1.MsgCreateDataSource.treasury":
 		return x.Treasury != ""
-	case "band.oracle.v1.MsgEditDataSource.owner":
+	case "band.oracle.v1.MsgCreateDataSource.owner":
 		return x.Owner != ""
-	case "band.oracle.v1.MsgEditDataSource.sender":
+	case "band.oracle.v1.MsgCreateDataSource.sender":
 		return x.Sender != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3781,29 +3683,27 @@ func (x *fastReflection_MsgEditDataSource) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditDataSource) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCreateDataSource) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditDataSource.data_source_id":
-		x.DataSourceId = uint64(0)
-	case "band.oracle.v1.MsgEditDataSource.name":
+	case "band.oracle.v1.MsgCreateDataSource.name":
 		x.Name = ""
-	case "band.oracle.v1.MsgEditDataSource.description":
+	case "band.oracle.v1.MsgCreateDataSource.description":
 		x.Description = ""
-	case "band.oracle.v1.MsgEditDataSource.executable":
+	case "band.oracle.v1.MsgCreateDataSource.executable":
 		x.Executable = nil
-	case "band.oracle.v1.MsgEditDataSource.fee":
+	case "band.oracle.v1.MsgCreateDataSource.fee":
 		x.Fee = nil
-	case "band.oracle.v1.MsgEditDataSource.treasury":
+	case "band.oracle.v1.MsgCreateDataSource.treasury":
 		x.Treasury = ""
-	case "band.oracle.v1.MsgEditDataSource.owner":
+	case "band.oracle.v1.MsgCreateDataSource.owner":
 		x.Owner = ""
-	case "band.oracle.v1.MsgEditDataSource.sender":
+	case "band.oracle.v1.MsgCreateDataSource.sender":
 		x.Sender = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3813,40 +3713,37 @@ func (x *fastReflection_MsgEditDataSource) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgEditDataSource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateDataSource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.MsgEditDataSource.data_source_id":
-		value := x.DataSourceId
-		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.MsgEditDataSource.name":
+	case "band.oracle.v1.MsgCreateDataSource.name":
 		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditDataSource.description":
+	case "band.oracle.v1.MsgCreateDataSource.description":
 		value := x.Description
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditDataSource.executable":
+	case "band.oracle.v1.MsgCreateDataSource.executable":
 		value := x.Executable
 		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.MsgEditDataSource.fee":
+	case "band.oracle.v1.MsgCreateDataSource.fee":
 		if len(x.Fee) == 0 {
-			return protoreflect.ValueOfList(&_MsgEditDataSource_5_list{})
+			return protoreflect.ValueOfList(&_MsgCreateDataSource_4_list{})
 		}
-		listValue := &_MsgEditDataSource_5_list{list: &x.Fee}
+		listValue := &_MsgCreateDataSource_4_list{list: &x.Fee}
 		return protoreflect.ValueOfList(listValue)
-	case "band.oracle.v1.MsgEditDataSource.treasury":
+	case "band.oracle.v1.MsgCreateDataSource.treasury":
 		value := x.Treasury
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditDataSource.owner":
+	case "band.oracle.v1.MsgCreateDataSource.owner":
 		value := x.Owner
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditDataSource.sender":
+	case "band.oracle.v1.MsgCreateDataSource.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3860,31 +3757,29 @@ func (x *fastReflection_MsgEditDataSource) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditDataSource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCreateDataSource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditDataSource.data_source_id":
-		x.DataSourceId = value.Uint()
-	case "band.oracle.v1.MsgEditDataSource.name":
+	case "band.oracle.v1.MsgCreateDataSource.name":
 		x.Name = value.Interface().(string)
-	case "band.oracle.v1.MsgEditDataSource.description":
+	case "band.oracle.v1.MsgCreateDataSource.description":
 		x.Description = value.Interface().(string)
-	case "band.oracle.v1.MsgEditDataSource.executable":
+	case "band.oracle.v1.MsgCreateDataSource.executable":
 		x.Executable = value.Bytes()
-	case "band.oracle.v1.MsgEditDataSource.fee":
+	case "band.oracle.v1.MsgCreateDataSource.fee":
 		lv := value.List()
-		clv := lv.(*_MsgEditDataSource_5_list)
+		clv := lv.(*_MsgCreateDataSource_4_list)
 		x.Fee = *clv.list
-	case "band.oracle.v1.MsgEditDataSource.treasury":
+	case "band.oracle.v1.MsgCreateDataSource.treasury":
 		x.Treasury = value.Interface().(string)
-	case "band.oracle.v1.MsgEditDataSource.owner":
+	case "band.oracle.v1.MsgCreateDataSource.owner":
 		x.Owner = value.Interface().(string)
-	case "band.oracle.v1.MsgEditDataSource.sender":
+	case "band.oracle.v1.MsgCreateDataSource.sender":
 		x.Sender = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3898,73 +3793,69 @@ func (x *fastReflection_MsgEditDataSource) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditDataSource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateDataSource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditDataSource.fee":
+	case "band.oracle.v1.MsgCreateDataSource.fee":
 		if x.Fee == nil {
 			x.Fee = []*v1beta1.Coin{}
 		}
-		value := &_MsgEditDataSource_5_list{list: &x.Fee}
+		value := &_MsgCreateDataSource_4_list{list: &x.Fee}
 		return protoreflect.ValueOfList(value)
-	case "band.oracle.v1.MsgEditDataSource.data_source_id":
-		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.MsgEditDataSource is not mutable"))
-	case "band.oracle.v1.MsgEditDataSource.name":
-		panic(fmt.Errorf("field name of message band.oracle.v1.MsgEditDataSource is not mutable"))
-	case "band.oracle.v1.MsgEditDataSource.description":
-		panic(fmt.Errorf("field description of message band.oracle.v1.MsgEditDataSource is not mutable"))
-	case "band.oracle.v1.MsgEditDataSource.executable":
-		panic(fmt.Errorf("field executable of message band.oracle.v1.MsgEditDataSource is not mutable"))
-	case "band.oracle.v1.MsgEditDataSource.treasury":
-		panic(fmt.Errorf("field treasury of message band.oracle.v1.MsgEditDataSource is not mutable"))
-	case "band.oracle.v1.MsgEditDataSource.owner":
-		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgEditDataSource is not mutable"))
-	case "band.oracle.v1.MsgEditDataSource.sender":
-		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	case "band.oracle.v1.MsgCreateDataSource.name":
+		panic(fmt.Errorf("field name of message band.oracle.v1.MsgCreateDataSource is not mutable"))
+	case "band.oracle.v1.MsgCreateDataSource.description":
+		panic(fmt.Errorf("field description of message band.oracle.v1.MsgCreateDataSource is not mutable"))
+	case "band.oracle.v1.MsgCreateDataSource.executable":
+		panic(fmt.Errorf("field executable of message band.oracle.v1.MsgCreateDataSource is not mutable"))
+	case "band.oracle.v1.MsgCreateDataSource.treasury":
+		panic(fmt.Errorf("field treasury of message band.oracle.v1.MsgCreateDataSource is not mutable"))
+	case "band.oracle.v1.MsgCreateDataSource.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgCreateDataSource is not mutable"))
+	case "band.oracle.v1.MsgCreateDataSource.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgCreateDataSource is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgEditDataSource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateDataSource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditDataSource.data_source_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.MsgEditDataSource.name":
+	case "band.oracle.v1.MsgCreateDataSource.name":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditDataSource.description":
+	case "band.oracle.v1.MsgCreateDataSource.description":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditDataSource.executable":
+	case "band.oracle.v1.MsgCreateDataSource.executable":
 		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.MsgEditDataSource.fee":
+	case "band.oracle.v1.MsgCreateDataSource.fee":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgEditDataSource_5_list{list: &list})
-	case "band.oracle.v1.MsgEditDataSource.treasury":
+		return protoreflect.ValueOfList(&_MsgCreateDataSource_4_list{list: &list})
+	case "band.oracle.v1.MsgCreateDataSource.treasury":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditDataSource.owner":
+	case "band.oracle.v1.MsgCreateDataSource.owner":
 		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditDataSource.sender":
+	case "band.oracle.v1.MsgCreateDataSource.sender":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSource"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSource does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgEditDataSource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCreateDataSource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgEditDataSource", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateDataSource", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3972,7 +3863,7 @@ func (x *fastReflection_MsgEditDataSource) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgEditDataSource) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCreateDataSource) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3983,7 +3874,7 @@ func (x *fastReflection_MsgEditDataSource) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditDataSource) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCreateDataSource) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3995,7 +3886,7 @@ func (x *fastReflection_MsgEditDataSource) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgEditDataSource) IsValid() bool {
+func (x *fastReflection_MsgCreateDataSource) IsValid() bool {
 	return x != nil
 }
 
@@ -4005,9 +3896,9 @@ func (x *fastReflection_MsgEditDataSource) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCreateDataSource) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgEditDataSource)
+		x := input.Message.Interface().(*MsgCreateDataSource)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4019,9 +3910,6 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.DataSourceId != 0 {
-			n += 1 + runtime.Sov(uint64(x.DataSourceId))
-		}
 		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -4062,7 +3950,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgEditDataSource)
+		x := input.Message.Interface().(*MsgCreateDataSource)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4086,21 +3974,21 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.Sender)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x3a
 		}
 		if len(x.Owner) > 0 {
 			i -= len(x.Owner)
 			copy(dAtA[i:], x.Owner)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x32
 		}
 		if len(x.Treasury) > 0 {
 			i -= len(x.Treasury)
 			copy(dAtA[i:], x.Treasury)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x2a
 		}
 		if len(x.Fee) > 0 {
 			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
@@ -4115,7 +4003,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x2a
+				dAtA[i] = 0x22
 			}
 		}
 		if len(x.Executable) > 0 {
@@ -4123,26 +4011,21 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.Executable)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executable)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1a
 		}
 		if len(x.Description) > 0 {
 			i -= len(x.Description)
 			copy(dAtA[i:], x.Description)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 		if len(x.Name) > 0 {
 			i -= len(x.Name)
 			copy(dAtA[i:], x.Name)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.DataSourceId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4155,7 +4038,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgEditDataSource)
+		x := input.Message.Interface().(*MsgCreateDataSource)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4187,32 +4070,13 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDataSource: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
-				}
-				x.DataSourceId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DataSourceId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
@@ -4244,7 +4108,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 				}
 				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 				}
@@ -4276,7 +4140,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 				}
 				x.Description = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
 				}
@@ -4310,7 +4174,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 					x.Executable = []byte{}
 				}
 				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 				}
@@ -4344,7 +4208,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 5:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 				}
@@ -4376,7 +4240,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 				}
 				x.Treasury = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 6:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 				}
@@ -4408,7 +4272,7 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 				}
 				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
+			case 7:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
@@ -4476,23 +4340,23 @@ func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgEditDataSourceResponse protoreflect.MessageDescriptor
+	md_MsgCreateDataSourceResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgEditDataSourceResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgEditDataSourceResponse")
+	md_MsgCreateDataSourceResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateDataSourceResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgEditDataSourceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCreateDataSourceResponse)(nil)
 
-type fastReflection_MsgEditDataSourceResponse MsgEditDataSourceResponse
+type fastReflection_MsgCreateDataSourceResponse MsgCreateDataSourceResponse
 
-func (x *MsgEditDataSourceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgEditDataSourceResponse)(x)
+func (x *MsgCreateDataSourceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateDataSourceResponse)(x)
 }
 
-func (x *MsgEditDataSourceResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgCreateDataSourceResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4504,43 +4368,43 @@ func (x *MsgEditDataSourceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgEditDataSourceResponse_messageType fastReflection_MsgEditDataSourceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgEditDataSourceResponse_messageType{}
+var _fastReflection_MsgCreateDataSourceResponse_messageType fastReflection_MsgCreateDataSourceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateDataSourceResponse_messageType{}
 
-type fastReflection_MsgEditDataSourceResponse_messageType struct{}
+type fastReflection_MsgCreateDataSourceResponse_messageType struct{}
 
-func (x fastReflection_MsgEditDataSourceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgEditDataSourceResponse)(nil)
+func (x fastReflection_MsgCreateDataSourceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateDataSourceResponse)(nil)
 }
-func (x fastReflection_MsgEditDataSourceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgEditDataSourceResponse)
+func (x fastReflection_MsgCreateDataSourceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateDataSourceResponse)
 }
-func (x fastReflection_MsgEditDataSourceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgEditDataSourceResponse
+func (x fastReflection_MsgCreateDataSourceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateDataSourceResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgEditDataSourceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgEditDataSourceResponse
+func (x *fastReflection_MsgCreateDataSourceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateDataSourceResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgEditDataSourceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgEditDataSourceResponse_messageType
+func (x *fastReflection_MsgCreateDataSourceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateDataSourceResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgEditDataSourceResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgEditDataSourceResponse)
+func (x *fastReflection_MsgCreateDataSourceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateDataSourceResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgEditDataSourceResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgEditDataSourceResponse)(x)
+func (x *fastReflection_MsgCreateDataSourceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateDataSourceResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4548,7 +4412,7 @@ func (x *fastReflection_MsgEditDataSourceResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgEditDataSourceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCreateDataSourceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4562,13 +4426,13 @@ func (x *fastReflection_MsgEditDataSourceResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgEditDataSourceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCreateDataSourceResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4578,13 +4442,13 @@ func (x *fastReflection_MsgEditDataSourceResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditDataSourceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCreateDataSourceResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4594,13 +4458,13 @@ func (x *fastReflection_MsgEditDataSourceResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgEditDataSourceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateDataSourceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4614,13 +4478,13 @@ func (x *fastReflection_MsgEditDataSourceResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditDataSourceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCreateDataSourceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4634,36 +4498,36 @@ func (x *fastReflection_MsgEditDataSourceResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditDataSourceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateDataSourceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgEditDataSourceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateDataSourceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateDataSourceResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateDataSourceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgEditDataSourceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCreateDataSourceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgEditDataSourceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateDataSourceResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4671,7 +4535,7 @@ func (x *fastReflection_MsgEditDataSourceResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgEditDataSourceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCreateDataSourceResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4682,7 +4546,7 @@ func (x *fastReflection_MsgEditDataSourceResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditDataSourceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCreateDataSourceResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4694,7 +4558,7 @@ func (x *fastReflection_MsgEditDataSourceResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgEditDataSourceResponse) IsValid() bool {
+func (x *fastReflection_MsgCreateDataSourceResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4704,9 +4568,9 @@ func (x *fastReflection_MsgEditDataSourceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgEditDataSourceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCreateDataSourceResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgEditDataSourceResponse)
+		x := input.Message.Interface().(*MsgCreateDataSourceResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4728,7 +4592,7 @@ func (x *fastReflection_MsgEditDataSourceResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgEditDataSourceResponse)
+		x := input.Message.Interface().(*MsgCreateDataSourceResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4758,7 +4622,7 @@ func (x *fastReflection_MsgEditDataSourceResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgEditDataSourceResponse)
+		x := input.Message.Interface().(*MsgCreateDataSourceResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4790,10 +4654,10 @@ func (x *fastReflection_MsgEditDataSourceResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditDataSourceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDataSourceResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4831,38 +4695,91 @@ func (x *fastReflection_MsgEditDataSourceResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_MsgEditDataSource_5_list)(nil)
+
+type _MsgEditDataSource_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgEditDataSource_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgEditDataSource_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgEditDataSource_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgEditDataSource_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgEditDataSource_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgEditDataSource_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgEditDataSource_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgEditDataSource_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgCreateOracleScript                 protoreflect.MessageDescriptor
-	fd_MsgCreateOracleScript_name            protoreflect.FieldDescriptor
-	fd_MsgCreateOracleScript_description     protoreflect.FieldDescriptor
-	fd_MsgCreateOracleScript_schema          protoreflect.FieldDescriptor
-	fd_MsgCreateOracleScript_source_code_url protoreflect.FieldDescriptor
-	fd_MsgCreateOracleScript_code            protoreflect.FieldDescriptor
-	fd_MsgCreateOracleScript_owner           protoreflect.FieldDescriptor
-	fd_MsgCreateOracleScript_sender          protoreflect.FieldDescriptor
+	md_MsgEditDataSource                protoreflect.MessageDescriptor
+	fd_MsgEditDataSource_data_source_id protoreflect.FieldDescriptor
+	fd_MsgEditDataSource_name           protoreflect.FieldDescriptor
+	fd_MsgEditDataSource_description    protoreflect.FieldDescriptor
+	fd_MsgEditDataSource_executable     protoreflect.FieldDescriptor
+	fd_MsgEditDataSource_fee            protoreflect.FieldDescriptor
+	fd_MsgEditDataSource_treasury       protoreflect.FieldDescriptor
+	fd_MsgEditDataSource_owner          protoreflect.FieldDescriptor
+	fd_MsgEditDataSource_sender         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgCreateOracleScript = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateOracleScript")
-	fd_MsgCreateOracleScript_name = md_MsgCreateOracleScript.Fields().ByName("name")
-	fd_MsgCreateOracleScript_description = md_MsgCreateOracleScript.Fields().ByName("description")
-	fd_MsgCreateOracleScript_schema = md_MsgCreateOracleScript.Fields().ByName("schema")
-	fd_MsgCreateOracleScript_source_code_url = md_MsgCreateOracleScript.Fields().ByName("source_code_url")
-	fd_MsgCreateOracleScript_code = md_MsgCreateOracleScript.Fields().ByName("code")
-	fd_MsgCreateOracleScript_owner = md_MsgCreateOracleScript.Fields().ByName("owner")
-	fd_MsgCreateOracleScript_sender = md_MsgCreateOracleScript.Fields().ByName("sender")
+	md_MsgEditDataSource = File_band_oracle_v1_tx_proto.Messages().ByName("MsgEditDataSource")
+	fd_MsgEditDataSource_data_source_id = md_MsgEditDataSource.Fields().ByName("data_source_id")
+	fd_MsgEditDataSource_name = md_MsgEditDataSource.Fields().ByName("name")
+	fd_MsgEditDataSource_description = md_MsgEditDataSource.Fields().ByName("description")
+	fd_MsgEditDataSource_executable = md_MsgEditDataSource.Fields().ByName("executable")
+	fd_MsgEditDataSource_fee = md_MsgEditDataSource.Fields().ByName("fee")
+	fd_MsgEditDataSource_treasury = md_MsgEditDataSource.Fields().ByName("treasury")
+	fd_MsgEditDataSource_owner = md_MsgEditDataSource.Fields().ByName("owner")
+	fd_MsgEditDataSource_sender = md_MsgEditDataSource.Fields().ByName("sender")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateOracleScript)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgEditDataSource)(nil)
 
-type fastReflection_MsgCreateOracleScript MsgCreateOracleScript
+type fastReflection_MsgEditDataSource MsgEditDataSource
 
-func (x *MsgCreateOracleScript) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateOracleScript)(x)
+func (x *MsgEditDataSource) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgEditDataSource)(x)
 }
 
-func (x *MsgCreateOracleScript) slowProtoReflect() protoreflect.Message {
+func (x *MsgEditDataSource) slowProtoReflect() protoreflect.Message {
 	mi := &file_band_oracle_v1_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4874,43 +4791,43 @@ func (x *MsgCreateOracleScript) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateOracleScript_messageType fastReflection_MsgCreateOracleScript_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateOracleScript_messageType{}
+var _fastReflection_MsgEditDataSource_messageType fastReflection_MsgEditDataSource_messageType
+var _ protoreflect.MessageType = fastReflection_MsgEditDataSource_messageType{}
 
-type fastReflection_MsgCreateOracleScript_messageType struct{}
+type fastReflection_MsgEditDataSource_messageType struct{}
 
-func (x fastReflection_MsgCreateOracleScript_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateOracleScript)(nil)
+func (x fastReflection_MsgEditDataSource_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgEditDataSource)(nil)
 }
-func (x fastReflection_MsgCreateOracleScript_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateOracleScript)
+func (x fastReflection_MsgEditDataSource_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgEditDataSource)
 }
-func (x fastReflection_MsgCreateOracleScript_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateOracleScript
+func (x fastReflection_MsgEditDataSource_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEditDataSource
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateOracleScript) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateOracleScript
+func (x *fastReflection_MsgEditDataSource) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEditDataSource
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateOracleScript) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateOracleScript_messageType
+func (x *fastReflection_MsgEditDataSource) Type() protoreflect.MessageType {
+	return _fastReflection_MsgEditDataSource_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateOracleScript) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateOracleScript)
+func (x *fastReflection_MsgEditDataSource) New() protoreflect.Message {
+	return new(fastReflection_MsgEditDataSource)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateOracleScript) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateOracleScript)(x)
+func (x *fastReflection_MsgEditDataSource) Interface() protoreflect.ProtoMessage {
+	return (*MsgEditDataSource)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4918,46 +4835,52 @@ func (x *fastReflection_MsgCreateOracleScript) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateOracleScript) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgEditDataSource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_MsgEditDataSource_data_source_id, value) {
+			return
+		}
+	}
 	if x.Name != "" {
 		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgCreateOracleScript_name, value) {
+		if !f(fd_MsgEditDataSource_name, value) {
 			return
 		}
 	}
 	if x.Description != "" {
 		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_MsgCreateOracleScript_description, value) {
+		if !f(fd_MsgEditDataSource_description, value) {
 			return
 		}
 	}
-	if x.Schema != "" {
-		value := protoreflect.ValueOfString(x.Schema)
-		if !f(fd_MsgCreateOracleScript_schema, value) {
+	if len(x.Executable) != 0 {
+		value := protoreflect.ValueOfBytes(x.Executable)
+		if !f(fd_MsgEditDataSource_executable, value) {
 			return
 		}
 	}
-	if x.SourceCodeUrl != "" {
-		value := protoreflect.ValueOfString(x.SourceCodeUrl)
-		if !f(fd_MsgCreateOracleScript_source_code_url, value) {
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgEditDataSource_5_list{list: &x.Fee})
+		if !f(fd_MsgEditDataSource_fee, value) {
 			return
 		}
 	}
-	if len(x.Code) != 0 {
-		value := protoreflect.ValueOfBytes(x.Code)
-		if !f(fd_MsgCreateOracleScript_code, value) {
+	if x.Treasury != "" {
+		value := protoreflect.ValueOfString(x.Treasury)
+		if !f(fd_MsgEditDataSource_treasury, value) {
 			return
 		}
 	}
 	if x.Owner != "" {
 		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_MsgCreateOracleScript_owner, value) {
+		if !f(fd_MsgEditDataSource_owner, value) {
 			return
 		}
 	}
 	if x.Sender != "" {
 		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_MsgCreateOracleScript_sender, value) {
+		if !f(fd_MsgEditDataSource_sender, value) {
 			return
 		}
 	}
@@ -4974,27 +4897,29 @@ func (x *fastReflection_MsgCreateOracleScript) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateOracleScript) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgEditDataSource) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateOracleScript.name":
+	case "band.oracle.v1.MsgEditDataSource.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.MsgEditDataSource.name":
 		return x.Name != ""
-	case "band.oracle.v1.MsgCreateOracleScript.description":
+	case "band.oracle.v1.MsgEditDataSource.description":
 		return x.Description != ""
-	case "band.oracle.v1.MsgCreateOracleScript.schema":
-		return x.Schema != ""
-	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
-		return x.SourceCodeUrl != ""
-	case "band.oracle.v1.MsgCreateOracleScript.code":
-		return len(x.Code) != 0
-	case "band.oracle.v1.MsgCreateOracleScript.owner":
+	case "band.oracle.v1.MsgEditDataSource.executable":
+		return len(x.Executable) != 0
+	case "band.oracle.v1.MsgEditDataSource.fee":
+		return len(x.Fee) != 0
+	case "band.oracle.v1.MsgEditDataSource.treasury":
+		return x.Treasury != ""
+	case "band.oracle.v1.MsgEditDataSource.owner":
 		return x.Owner != ""
-	case "band.oracle.v1.MsgCreateOracleScript.sender":
+	case "band.oracle.v1.MsgEditDataSource.sender":
 		return x.Sender != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5004,27 +4929,29 @@ func (x *fastReflection_MsgCreateOracleScript) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateOracleScript) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgEditDataSource) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateOracleScript.name":
+	case "band.oracle.v1.MsgEditDataSource.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.MsgEditDataSource.name":
 		x.Name = ""
-	case "band.oracle.v1.MsgCreateOracleScript.description":
+	case "band.oracle.v1.MsgEditDataSource.description":
 		x.Description = ""
-	case "band.oracle.v1.MsgCreateOracleScript.schema":
-		x.Schema = ""
-	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
-		x.SourceCodeUrl = ""
-	case "band.oracle.v1.MsgCreateOracleScript.code":
-		x.Code = nil
-	case "band.oracle.v1.MsgCreateOracleScript.owner":
+	case "band.oracle.v1.MsgEditDataSource.executable":
+		x.Executable = nil
+	case "band.oracle.v1.MsgEditDataSource.fee":
+		x.Fee = nil
+	case "band.oracle.v1.MsgEditDataSource.treasury":
+		x.Treasury = ""
+	case "band.oracle.v1.MsgEditDataSource.owner":
 		x.Owner = ""
-	case "band.oracle.v1.MsgCreateOracleScript.sender":
+	case "band.oracle.v1.MsgEditDataSource.sender":
 		x.Sender = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5034,34 +4961,3567 @@ func (x *fastReflection_MsgCreateOracleScript) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateOracleScript) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgEditDataSource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.MsgCreateOracleScript.name":
+	case "band.oracle.v1.MsgEditDataSource.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgEditDataSource.name":
 		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateOracleScript.description":
+	case "band.oracle.v1.MsgEditDataSource.description":
 		value := x.Description
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateOracleScript.schema":
-		value := x.Schema
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
-		value := x.SourceCodeUrl
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateOracleScript.code":
-		value := x.Code
+	case "band.oracle.v1.MsgEditDataSource.executable":
+		value := x.Executable
 		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.MsgCreateOracleScript.owner":
+	case "band.oracle.v1.MsgEditDataSource.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_MsgEditDataSource_5_list{})
+		}
+		listValue := &_MsgEditDataSource_5_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
+	case "band.oracle.v1.MsgEditDataSource.treasury":
+		value := x.Treasury
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgEditDataSource.owner":
 		value := x.Owner
 		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgCreateOracleScript.sender":
+	case "band.oracle.v1.MsgEditDataSource.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditDataSource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgEditDataSource.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.MsgEditDataSource.name":
+		x.Name = value.Interface().(string)
+	case "band.oracle.v1.MsgEditDataSource.description":
+		x.Description = value.Interface().(string)
+	case "band.oracle.v1.MsgEditDataSource.executable":
+		x.Executable = value.Bytes()
+	case "band.oracle.v1.MsgEditDataSource.fee":
+		lv := value.List()
+		clv := lv.(*_MsgEditDataSource_5_list)
+		x.Fee = *clv.list
+	case "band.oracle.v1.MsgEditDataSource.treasury":
+		x.Treasury = value.Interface().(string)
+	case "band.oracle.v1.MsgEditDataSource.owner":
+		x.Owner = value.Interface().(string)
+	case "band.oracle.v1.MsgEditDataSource.sender":
+		x.Sender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditDataSource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgEditDataSource.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_MsgEditDataSource_5_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "band.oracle.v1.MsgEditDataSource.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	case "band.oracle.v1.MsgEditDataSource.name":
+		panic(fmt.Errorf("field name of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	case "band.oracle.v1.MsgEditDataSource.description":
+		panic(fmt.Errorf("field description of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	case "band.oracle.v1.MsgEditDataSource.executable":
+		panic(fmt.Errorf("field executable of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	case "band.oracle.v1.MsgEditDataSource.treasury":
+		panic(fmt.Errorf("field treasury of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	case "band.oracle.v1.MsgEditDataSource.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	case "band.oracle.v1.MsgEditDataSource.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgEditDataSource is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgEditDataSource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgEditDataSource.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgEditDataSource.name":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditDataSource.description":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditDataSource.executable":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.MsgEditDataSource.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgEditDataSource_5_list{list: &list})
+	case "band.oracle.v1.MsgEditDataSource.treasury":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditDataSource.owner":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditDataSource.sender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSource"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgEditDataSource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgEditDataSource", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgEditDataSource) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditDataSource) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgEditDataSource) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgEditDataSource) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgEditDataSource)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Executable)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Treasury)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEditDataSource)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Treasury) > 0 {
+			i -= len(x.Treasury)
+			copy(dAtA[i:], x.Treasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Executable) > 0 {
+			i -= len(x.Executable)
+			copy(dAtA[i:], x.Executable)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executable)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEditDataSource)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditDataSource: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DataSourceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executable = append(x.Executable[:0], dAtA[iNdEx:postIndex]...)
+				if x.Executable == nil {
+					x.Executable = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Treasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgEditDataSourceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgEditDataSourceResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgEditDataSourceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgEditDataSourceResponse)(nil)
+
+type fastReflection_MsgEditDataSourceResponse MsgEditDataSourceResponse
+
+func (x *MsgEditDataSourceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgEditDataSourceResponse)(x)
+}
+
+func (x *MsgEditDataSourceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgEditDataSourceResponse_messageType fastReflection_MsgEditDataSourceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgEditDataSourceResponse_messageType{}
+
+type fastReflection_MsgEditDataSourceResponse_messageType struct{}
+
+func (x fastReflection_MsgEditDataSourceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgEditDataSourceResponse)(nil)
+}
+func (x fastReflection_MsgEditDataSourceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgEditDataSourceResponse)
+}
+func (x fastReflection_MsgEditDataSourceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEditDataSourceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgEditDataSourceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEditDataSourceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgEditDataSourceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgEditDataSourceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgEditDataSourceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgEditDataSourceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgEditDataSourceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgEditDataSourceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgEditDataSourceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgEditDataSourceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditDataSourceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgEditDataSourceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditDataSourceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditDataSourceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgEditDataSourceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditDataSourceResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditDataSourceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgEditDataSourceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgEditDataSourceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgEditDataSourceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditDataSourceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgEditDataSourceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgEditDataSourceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgEditDataSourceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEditDataSourceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEditDataSourceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditDataSourceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditDataSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateOracleScript                 protoreflect.MessageDescriptor
+	fd_MsgCreateOracleScript_name            protoreflect.FieldDescriptor
+	fd_MsgCreateOracleScript_description     protoreflect.FieldDescriptor
+	fd_MsgCreateOracleScript_schema          protoreflect.FieldDescriptor
+	fd_MsgCreateOracleScript_source_code_url protoreflect.FieldDescriptor
+	fd_MsgCreateOracleScript_code            protoreflect.FieldDescriptor
+	fd_MsgCreateOracleScript_owner           protoreflect.FieldDescriptor
+	fd_MsgCreateOracleScript_sender          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgCreateOracleScript = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateOracleScript")
+	fd_MsgCreateOracleScript_name = md_MsgCreateOracleScript.Fields().ByName("name")
+	fd_MsgCreateOracleScript_description = md_MsgCreateOracleScript.Fields().ByName("description")
+	fd_MsgCreateOracleScript_schema = md_MsgCreateOracleScript.Fields().ByName("schema")
+	fd_MsgCreateOracleScript_source_code_url = md_MsgCreateOracleScript.Fields().ByName("source_code_url")
+	fd_MsgCreateOracleScript_code = md_MsgCreateOracleScript.Fields().ByName("code")
+	fd_MsgCreateOracleScript_owner = md_MsgCreateOracleScript.Fields().ByName("owner")
+	fd_MsgCreateOracleScript_sender = md_MsgCreateOracleScript.Fields().ByName("sender")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateOracleScript)(nil)
+
+type fastReflection_MsgCreateOracleScript MsgCreateOracleScript
+
+func (x *MsgCreateOracleScript) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateOracleScript)(x)
+}
+
+func (x *MsgCreateOracleScript) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateOracleScript_messageType fastReflection_MsgCreateOracleScript_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateOracleScript_messageType{}
+
+type fastReflection_MsgCreateOracleScript_messageType struct{}
+
+func (x fastReflection_MsgCreateOracleScript_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateOracleScript)(nil)
+}
+func (x fastReflection_MsgCreateOracleScript_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateOracleScript)
+}
+func (x fastReflection_MsgCreateOracleScript_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateOracleScript
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateOracleScript) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateOracleScript
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateOracleScript) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateOracleScript_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateOracleScript) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateOracleScript)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateOracleScript) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateOracleScript)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateOracleScript) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgCreateOracleScript_name, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_MsgCreateOracleScript_description, value) {
+			return
+		}
+	}
+	if x.Schema != "" {
+		value := protoreflect.ValueOfString(x.Schema)
+		if !f(fd_MsgCreateOracleScript_schema, value) {
+			return
+		}
+	}
+	if x.SourceCodeUrl != "" {
+		value := protoreflect.ValueOfString(x.SourceCodeUrl)
+		if !f(fd_MsgCreateOracleScript_source_code_url, value) {
+			return
+		}
+	}
+	if len(x.Code) != 0 {
+		value := protoreflect.ValueOfBytes(x.Code)
+		if !f(fd_MsgCreateOracleScript_code, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_MsgCreateOracleScript_owner, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgCreateOracleScript_sender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateOracleScript) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateOracleScript.name":
+		return x.Name != ""
+	case "band.oracle.v1.MsgCreateOracleScript.description":
+		return x.Description != ""
+	case "band.oracle.v1.MsgCreateOracleScript.schema":
+		return x.Schema != ""
+	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
+		return x.SourceCodeUrl != ""
+	case "band.oracle.v1.MsgCreateOracleScript.code":
+		return len(x.Code) != 0
+	case "band.oracle.v1.MsgCreateOracleScript.owner":
+		return x.Owner != ""
+	case "band.oracle.v1.MsgCreateOracleScript.sender":
+		return x.Sender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOracleScript) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateOracleScript.name":
+		x.Name = ""
+	case "band.oracle.v1.MsgCreateOracleScript.description":
+		x.Description = ""
+	case "band.oracle.v1.MsgCreateOracleScript.schema":
+		x.Schema = ""
+	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
+		x.SourceCodeUrl = ""
+	case "band.oracle.v1.MsgCreateOracleScript.code":
+		x.Code = nil
+	case "band.oracle.v1.MsgCreateOracleScript.owner":
+		x.Owner = ""
+	case "band.oracle.v1.MsgCreateOracleScript.sender":
+		x.Sender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateOracleScript) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.MsgCreateOracleScript.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgCreateOracleScript.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgCreateOracleScript.schema":
+		value := x.Schema
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
+		value := x.SourceCodeUrl
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgCreateOracleScript.code":
+		value := x.Code
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.MsgCreateOracleScript.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgCreateOracleScript.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOracleScript) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateOracleScript.name":
+		x.Name = value.Interface().(string)
+	case "band.oracle.v1.MsgCreateOracleScript.description":
+		x.Description = value.Interface().(string)
+	case "band.oracle.v1.MsgCreateOracleScript.schema":
+		x.Schema = value.Interface().(string)
+	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
+		x.SourceCodeUrl = value.Interface().(string)
+	case "band.oracle.v1.MsgCreateOracleScript.code":
+		x.Code = value.Bytes()
+	case "band.oracle.v1.MsgCreateOracleScript.owner":
+		x.Owner = value.Interface().(string)
+	case "band.oracle.v1.MsgCreateOracleScript.sender":
+		x.Sender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOracleScript) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateOracleScript.name":
+		panic(fmt.Errorf("field name of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
+	case "band.oracle.v1.MsgCreateOracleScript.description":
+		panic(fmt.Errorf("field description of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
+	case "band.oracle.v1.MsgCreateOracleScript.schema":
+		panic(fmt.Errorf("field schema of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
+	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
+		panic(fmt.Errorf("field source_code_url of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
+	case "band.oracle.v1.MsgCreateOracleScript.code":
+		panic(fmt.Errorf("field code of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
+	case "band.oracle.v1.MsgCreateOracleScript.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
+	case "band.oracle.v1.MsgCreateOracleScript.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateOracleScript) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgCreateOracleScript.name":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgCreateOracleScript.description":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgCreateOracleScript.schema":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgCreateOracleScript.code":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.MsgCreateOracleScript.owner":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgCreateOracleScript.sender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateOracleScript) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateOracleScript", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateOracleScript) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOracleScript) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateOracleScript) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateOracleScript)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Schema)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceCodeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Code)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateOracleScript)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SourceCodeUrl) > 0 {
+			i -= len(x.SourceCodeUrl)
+			copy(dAtA[i:], x.SourceCodeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceCodeUrl)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Schema) > 0 {
+			i -= len(x.Schema)
+			copy(dAtA[i:], x.Schema)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateOracleScript)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schema = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceCodeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
+				if x.Code == nil {
+					x.Code = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateOracleScriptResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgCreateOracleScriptResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateOracleScriptResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateOracleScriptResponse)(nil)
+
+type fastReflection_MsgCreateOracleScriptResponse MsgCreateOracleScriptResponse
+
+func (x *MsgCreateOracleScriptResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateOracleScriptResponse)(x)
+}
+
+func (x *MsgCreateOracleScriptResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateOracleScriptResponse_messageType fastReflection_MsgCreateOracleScriptResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateOracleScriptResponse_messageType{}
+
+type fastReflection_MsgCreateOracleScriptResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateOracleScriptResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateOracleScriptResponse)(nil)
+}
+func (x fastReflection_MsgCreateOracleScriptResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateOracleScriptResponse)
+}
+func (x fastReflection_MsgCreateOracleScriptResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateOracleScriptResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateOracleScriptResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateOracleScriptResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateOracleScriptResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateOracleScriptResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateOracleScriptResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOracleScriptResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateOracleScriptResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateOracleScriptResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateOracleScriptResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateOracleScriptResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateOracleScriptResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateOracleScriptResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateOracleScriptResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateOracleScriptResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateOracleScriptResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateOracleScriptResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOracleScriptResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgEditOracleScript                  protoreflect.MessageDescriptor
+	fd_MsgEditOracleScript_oracle_script_id protoreflect.FieldDescriptor
+	fd_MsgEditOracleScript_name             protoreflect.FieldDescriptor
+	fd_MsgEditOracleScript_description      protoreflect.FieldDescriptor
+	fd_MsgEditOracleScript_schema           protoreflect.FieldDescriptor
+	fd_MsgEditOracleScript_source_code_url  protoreflect.FieldDescriptor
+	fd_MsgEditOracleScript_code             protoreflect.FieldDescriptor
+	fd_MsgEditOracleScript_owner            protoreflect.FieldDescriptor
+	fd_MsgEditOracleScript_sender           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgEditOracleScript = File_band_oracle_v1_tx_proto.Messages().ByName("MsgEditOracleScript")
+	fd_MsgEditOracleScript_oracle_script_id = md_MsgEditOracleScript.Fields().ByName("oracle_script_id")
+	fd_MsgEditOracleScript_name = md_MsgEditOracleScript.Fields().ByName("name")
+	fd_MsgEditOracleScript_description = md_MsgEditOracleScript.Fields().ByName("description")
+	fd_MsgEditOracleScript_schema = md_MsgEditOracleScript.Fields().ByName("schema")
+	fd_MsgEditOracleScript_source_code_url = md_MsgEditOracleScript.Fields().ByName("source_code_url")
+	fd_MsgEditOracleScript_code = md_MsgEditOracleScript.Fields().ByName("code")
+	fd_MsgEditOracleScript_owner = md_MsgEditOracleScript.Fields().ByName("owner")
+	fd_MsgEditOracleScript_sender = md_MsgEditOracleScript.Fields().ByName("sender")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgEditOracleScript)(nil)
+
+type fastReflection_MsgEditOracleScript MsgEditOracleScript
+
+func (x *MsgEditOracleScript) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgEditOracleScript)(x)
+}
+
+func (x *MsgEditOracleScript) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgEditOracleScript_messageType fastReflection_MsgEditOracleScript_messageType
+var _ protoreflect.MessageType = fastReflection_MsgEditOracleScript_messageType{}
+
+type fastReflection_MsgEditOracleScript_messageType struct{}
+
+func (x fastReflection_MsgEditOracleScript_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgEditOracleScript)(nil)
+}
+func (x fastReflection_MsgEditOracleScript_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgEditOracleScript)
+}
+func (x fastReflection_MsgEditOracleScript_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEditOracleScript
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgEditOracleScript) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEditOracleScript
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgEditOracleScript) Type() protoreflect.MessageType {
+	return _fastReflection_MsgEditOracleScript_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgEditOracleScript) New() protoreflect.Message {
+	return new(fastReflection_MsgEditOracleScript)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgEditOracleScript) Interface() protoreflect.ProtoMessage {
+	return (*MsgEditOracleScript)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgEditOracleScript) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OracleScriptId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleScriptId)
+		if !f(fd_MsgEditOracleScript_oracle_script_id, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgEditOracleScript_name, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_MsgEditOracleScript_description, value) {
+			return
+		}
+	}
+	if x.Schema != "" {
+		value := protoreflect.ValueOfString(x.Schema)
+		if !f(fd_MsgEditOracleScript_schema, value) {
+			return
+		}
+	}
+	if x.SourceCodeUrl != "" {
+		value := protoreflect.ValueOfString(x.SourceCodeUrl)
+		if !f(fd_MsgEditOracleScript_source_code_url, value) {
+			return
+		}
+	}
+	if len(x.Code) != 0 {
+		value := protoreflect.ValueOfBytes(x.Code)
+		if !f(fd_MsgEditOracleScript_code, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_MsgEditOracleScript_owner, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgEditOracleScript_sender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgEditOracleScript) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+		return x.OracleScriptId != uint64(0)
+	case "band.oracle.v1.MsgEditOracleScript.name":
+		return x.Name != ""
+	case "band.oracle.v1.MsgEditOracleScript.description":
+		return x.Description != ""
+	case "band.oracle.v1.MsgEditOracleScript.schema":
+		return x.Schema != ""
+	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
+		return x.SourceCodeUrl != ""
+	case "band.oracle.v1.MsgEditOracleScript.code":
+		return len(x.Code) != 0
+	case "band.oracle.v1.MsgEditOracleScript.owner":
+		return x.Owner != ""
+	case "band.oracle.v1.MsgEditOracleScript.sender":
+		return x.Sender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditOracleScript) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+		x.OracleScriptId = uint64(0)
+	case "band.oracle.v1.MsgEditOracleScript.name":
+		x.Name = ""
+	case "band.oracle.v1.MsgEditOracleScript.description":
+		x.Description = ""
+	case "band.oracle.v1.MsgEditOracleScript.schema":
+		x.Schema = ""
+	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
+		x.SourceCodeUrl = ""
+	case "band.oracle.v1.MsgEditOracleScript.code":
+		x.Code = nil
+	case "band.oracle.v1.MsgEditOracleScript.owner":
+		x.Owner = ""
+	case "band.oracle.v1.MsgEditOracleScript.sender":
+		x.Sender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgEditOracleScript) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+		value := x.OracleScriptId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgEditOracleScript.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgEditOracleScript.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgEditOracleScript.schema":
+		value := x.Schema
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
+		value := x.SourceCodeUrl
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgEditOracleScript.code":
+		value := x.Code
+		return protoreflect.ValueOfBytes(value)
+	case "band.oracle.v1.MsgEditOracleScript.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "band.oracle.v1.MsgEditOracleScript.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditOracleScript) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+		x.OracleScriptId = value.Uint()
+	case "band.oracle.v1.MsgEditOracleScript.name":
+		x.Name = value.Interface().(string)
+	case "band.oracle.v1.MsgEditOracleScript.description":
+		x.Description = value.Interface().(string)
+	case "band.oracle.v1.MsgEditOracleScript.schema":
+		x.Schema = value.Interface().(string)
+	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
+		x.SourceCodeUrl = value.Interface().(string)
+	case "band.oracle.v1.MsgEditOracleScript.code":
+		x.Code = value.Bytes()
+	case "band.oracle.v1.MsgEditOracleScript.owner":
+		x.Owner = value.Interface().(string)
+	case "band.oracle.v1.MsgEditOracleScript.sender":
+		x.Sender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditOracleScript) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgEditOracleScript.name":
+		panic(fmt.Errorf("field name of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgEditOracleScript.description":
+		panic(fmt.Errorf("field description of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgEditOracleScript.schema":
+		panic(fmt.Errorf("field schema of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
+		panic(fmt.Errorf("field source_code_url of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgEditOracleScript.code":
+		panic(fmt.Errorf("field code of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgEditOracleScript.owner":
+		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgEditOracleScript.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgEditOracleScript) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgEditOracleScript.name":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditOracleScript.description":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditOracleScript.schema":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditOracleScript.code":
+		return protoreflect.ValueOfBytes(nil)
+	case "band.oracle.v1.MsgEditOracleScript.owner":
+		return protoreflect.ValueOfString("")
+	case "band.oracle.v1.MsgEditOracleScript.sender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgEditOracleScript) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgEditOracleScript", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgEditOracleScript) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditOracleScript) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgEditOracleScript) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgEditOracleScript)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OracleScriptId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Schema)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceCodeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Code)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEditOracleScript)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SourceCodeUrl) > 0 {
+			i -= len(x.SourceCodeUrl)
+			copy(dAtA[i:], x.SourceCodeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceCodeUrl)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Schema) > 0 {
+			i -= len(x.Schema)
+			copy(dAtA[i:], x.Schema)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OracleScriptId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleScriptId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEditOracleScript)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				}
+				x.OracleScriptId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleScriptId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schema = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceCodeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
+				if x.Code == nil {
+					x.Code = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgEditOracleScriptResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgEditOracleScriptResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgEditOracleScriptResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgEditOracleScriptResponse)(nil)
+
+type fastReflection_MsgEditOracleScriptResponse MsgEditOracleScriptResponse
+
+func (x *MsgEditOracleScriptResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgEditOracleScriptResponse)(x)
+}
+
+func (x *MsgEditOracleScriptResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgEditOracleScriptResponse_messageType fastReflection_MsgEditOracleScriptResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgEditOracleScriptResponse_messageType{}
+
+type fastReflection_MsgEditOracleScriptResponse_messageType struct{}
+
+func (x fastReflection_MsgEditOracleScriptResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgEditOracleScriptResponse)(nil)
+}
+func (x fastReflection_MsgEditOracleScriptResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgEditOracleScriptResponse)
+}
+func (x fastReflection_MsgEditOracleScriptResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEditOracleScriptResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgEditOracleScriptResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEditOracleScriptResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgEditOracleScriptResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgEditOracleScriptResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgEditOracleScriptResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgEditOracleScriptResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgEditOracleScriptResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgEditOracleScriptResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgEditOracleScriptResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgEditOracleScriptResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditOracleScriptResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgEditOracleScriptResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditOracleScriptResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditOracleScriptResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgEditOracleScriptResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgEditOracleScriptResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgEditOracleScriptResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgEditOracleScriptResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEditOracleScriptResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgEditOracleScriptResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgEditOracleScriptResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgEditOracleScriptResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEditOracleScriptResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEditOracleScriptResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditOracleScriptResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateDataSourceAccessPolicy                protoreflect.MessageDescriptor
+	fd_MsgUpdateDataSourceAccessPolicy_data_source_id protoreflect.FieldDescriptor
+	fd_MsgUpdateDataSourceAccessPolicy_access_policy  protoreflect.FieldDescriptor
+	fd_MsgUpdateDataSourceAccessPolicy_sender         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_band_oracle_v1_tx_proto_init()
+	md_MsgUpdateDataSourceAccessPolicy = File_band_oracle_v1_tx_proto.Messages().ByName("MsgUpdateDataSourceAccessPolicy")
+	fd_MsgUpdateDataSourceAccessPolicy_data_source_id = md_MsgUpdateDataSourceAccessPolicy.Fields().ByName("data_source_id")
+	fd_MsgUpdateDataSourceAccessPolicy_access_policy = md_MsgUpdateDataSourceAccessPolicy.Fields().ByName("access_policy")
+	fd_MsgUpdateDataSourceAccessPolicy_sender = md_MsgUpdateDataSourceAccessPolicy.Fields().ByName("sender")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateDataSourceAccessPolicy)(nil)
+
+type fastReflection_MsgUpdateDataSourceAccessPolicy MsgUpdateDataSourceAccessPolicy
+
+func (x *MsgUpdateDataSourceAccessPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDataSourceAccessPolicy)(x)
+}
+
+func (x *MsgUpdateDataSourceAccessPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateDataSourceAccessPolicy_messageType fastReflection_MsgUpdateDataSourceAccessPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateDataSourceAccessPolicy_messageType{}
+
+type fastReflection_MsgUpdateDataSourceAccessPolicy_messageType struct{}
+
+func (x fastReflection_MsgUpdateDataSourceAccessPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDataSourceAccessPolicy)(nil)
+}
+func (x fastReflection_MsgUpdateDataSourceAccessPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDataSourceAccessPolicy)
+}
+func (x fastReflection_MsgUpdateDataSourceAccessPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDataSourceAccessPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDataSourceAccessPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateDataSourceAccessPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDataSourceAccessPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateDataSourceAccessPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataSourceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DataSourceId)
+		if !f(fd_MsgUpdateDataSourceAccessPolicy_data_source_id, value) {
+			return
+		}
+	}
+	if x.AccessPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.AccessPolicy.ProtoReflect())
+		if !f(fd_MsgUpdateDataSourceAccessPolicy_access_policy, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgUpdateDataSourceAccessPolicy_sender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.data_source_id":
+		return x.DataSourceId != uint64(0)
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.access_policy":
+		return x.AccessPolicy != nil
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.sender":
+		return x.Sender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicy"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.data_source_id":
+		x.DataSourceId = uint64(0)
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.access_policy":
+		x.AccessPolicy = nil
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.sender":
+		x.Sender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicy"))
+		}
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.data_source_id":
+		value := x.DataSourceId
+		return protoreflect.ValueOfUint64(value)
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.access_policy":
+		value := x.AccessPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5075,27 +8535,19 @@ func (x *fastReflection_MsgCreateOracleScript) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateOracleScript) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateOracleScript.name":
-		x.Name = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateOracleScript.description":
-		x.Description = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateOracleScript.schema":
-		x.Schema = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
-		x.SourceCodeUrl = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateOracleScript.code":
-		x.Code = value.Bytes()
-	case "band.oracle.v1.MsgCreateOracleScript.owner":
-		x.Owner = value.Interface().(string)
-	case "band.oracle.v1.MsgCreateOracleScript.sender":
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.data_source_id":
+		x.DataSourceId = value.Uint()
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.access_policy":
+		x.AccessPolicy = value.Message().Interface().(*AccessPolicy)
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.sender":
 		x.Sender = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5109,64 +8561,52 @@ func (x *fastReflection_MsgCreateOracleScript) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateOracleScript) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateOracleScript.name":
-		panic(fmt.Errorf("field name of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
-	case "band.oracle.v1.MsgCreateOracleScript.description":
-		panic(fmt.Errorf("field description of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
-	case "band.oracle.v1.MsgCreateOracleScript.schema":
-		panic(fmt.Errorf("field schema of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
-	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
-		panic(fmt.Errorf("field source_code_url of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
-	case "band.oracle.v1.MsgCreateOracleScript.code":
-		panic(fmt.Errorf("field code of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
-	case "band.oracle.v1.MsgCreateOracleScript.owner":
-		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
-	case "band.oracle.v1.MsgCreateOracleScript.sender":
-		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgCreateOracleScript is not mutable"))
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.access_policy":
+		if x.AccessPolicy == nil {
+			x.AccessPolicy = new(AccessPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.AccessPolicy.ProtoReflect())
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.data_source_id":
+		panic(fmt.Errorf("field data_source_id of message band.oracle.v1.MsgUpdateDataSourceAccessPolicy is not mutable"))
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgUpdateDataSourceAccessPolicy is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateOracleScript) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgCreateOracleScript.name":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateOracleScript.description":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateOracleScript.schema":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateOracleScript.source_code_url":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateOracleScript.code":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.MsgCreateOracleScript.owner":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgCreateOracleScript.sender":
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.data_source_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.access_policy":
+		m := new(AccessPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.MsgUpdateDataSourceAccessPolicy.sender":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateOracleScript) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateOracleScript", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgUpdateDataSourceAccessPolicy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5174,7 +8614,7 @@ func (x *fastReflection_MsgCreateOracleScript) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateOracleScript) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5185,7 +8625,7 @@ func (x *fastReflection_MsgCreateOracleScript) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateOracleScript) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5197,7 +8637,7 @@ func (x *fastReflection_MsgCreateOracleScript) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateOracleScript) IsValid() bool {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) IsValid() bool {
 	return x != nil
 }
 
@@ -5207,9 +8647,9 @@ func (x *fastReflection_MsgCreateOracleScript) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateOracleScript)
+		x := input.Message.Interface().(*MsgUpdateDataSourceAccessPolicy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5221,28 +8661,11 @@ func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Description)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Schema)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.SourceCodeUrl)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Code)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.DataSourceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DataSourceId))
 		}
-		l = len(x.Owner)
-		if l > 0 {
+		if x.AccessPolicy != nil {
+			l = options.Size(x.AccessPolicy)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Sender)
@@ -5259,7 +8682,7 @@ func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateOracleScript)
+		x := input.Message.Interface().(*MsgUpdateDataSourceAccessPolicy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5283,49 +8706,26 @@ func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Method
 			copy(dAtA[i:], x.Sender)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Code) > 0 {
-			i -= len(x.Code)
-			copy(dAtA[i:], x.Code)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.SourceCodeUrl) > 0 {
-			i -= len(x.SourceCodeUrl)
-			copy(dAtA[i:], x.SourceCodeUrl)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceCodeUrl)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Schema) > 0 {
-			i -= len(x.Schema)
-			copy(dAtA[i:], x.Schema)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
-			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+		if x.AccessPolicy != nil {
+			encoded, err := options.Marshal(x.AccessPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.DataSourceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataSourceId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5338,7 +8738,7 @@ func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateOracleScript)
+		x := input.Message.Interface().(*MsgUpdateDataSourceAccessPolicy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5354,129 +8754,33 @@ func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Method
 			preIndex := iNdEx
 			var wire uint64
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOracleScript: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Description = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Schema = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceCodeUrl", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				var stringLen uint64
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDataSourceAccessPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDataSourceAccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataSourceId", wireType)
+				}
+				x.DataSourceId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5486,29 +8790,16 @@ func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.DataSourceId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5518,59 +8809,29 @@ func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
-				if x.Code == nil {
-					x.Code = []byte{}
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.AccessPolicy == nil {
+					x.AccessPolicy = &AccessPolicy{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccessPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
@@ -5638,24 +8899,24 @@ func (x *fastReflection_MsgCreateOracleScript) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgCreateOracleScriptResponse protoreflect.MessageDescriptor
+	md_MsgUpdateDataSourceAccessPolicyResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgCreateOracleScriptResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgCreateOracleScriptResponse")
+	md_MsgUpdateDataSourceAccessPolicyResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgUpdateDataSourceAccessPolicyResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateOracleScriptResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateDataSourceAccessPolicyResponse)(nil)
 
-type fastReflection_MsgCreateOracleScriptResponse MsgCreateOracleScriptResponse
+type fastReflection_MsgUpdateDataSourceAccessPolicyResponse MsgUpdateDataSourceAccessPolicyResponse
 
-func (x *MsgCreateOracleScriptResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateOracleScriptResponse)(x)
+func (x *MsgUpdateDataSourceAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDataSourceAccessPolicyResponse)(x)
 }
 
-func (x *MsgCreateOracleScriptResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_tx_proto_msgTypes[9]
+func (x *MsgUpdateDataSourceAccessPolicyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5666,43 +8927,43 @@ func (x *MsgCreateOracleScriptResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateOracleScriptResponse_messageType fastReflection_MsgCreateOracleScriptResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateOracleScriptResponse_messageType{}
+var _fastReflection_MsgUpdateDataSourceAccessPolicyResponse_messageType fastReflection_MsgUpdateDataSourceAccessPolicyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateDataSourceAccessPolicyResponse_messageType{}
 
-type fastReflection_MsgCreateOracleScriptResponse_messageType struct{}
+type fastReflection_MsgUpdateDataSourceAccessPolicyResponse_messageType struct{}
 
-func (x fastReflection_MsgCreateOracleScriptResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateOracleScriptResponse)(nil)
+func (x fastReflection_MsgUpdateDataSourceAccessPolicyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateDataSourceAccessPolicyResponse)(nil)
 }
-func (x fastReflection_MsgCreateOracleScriptResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateOracleScriptResponse)
+func (x fastReflection_MsgUpdateDataSourceAccessPolicyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDataSourceAccessPolicyResponse)
 }
-func (x fastReflection_MsgCreateOracleScriptResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateOracleScriptResponse
+func (x fastReflection_MsgUpdateDataSourceAccessPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDataSourceAccessPolicyResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateOracleScriptResponse
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateDataSourceAccessPolicyResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateOracleScriptResponse_messageType
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateDataSourceAccessPolicyResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateOracleScriptResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateOracleScriptResponse)
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateDataSourceAccessPolicyResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateOracleScriptResponse)(x)
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateDataSourceAccessPolicyResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5710,7 +8971,7 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5724,13 +8985,13 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5740,13 +9001,13 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5756,13 +9017,13 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5776,13 +9037,13 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5796,36 +9057,36 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateOracleScriptResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateOracleScriptResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgCreateOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgCreateOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateOracleScriptResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgCreateOracleScriptResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5833,7 +9094,7 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateOracleScriptResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5844,7 +9105,7 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateOracleScriptResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5856,7 +9117,7 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateOracleScriptResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5866,9 +9127,9 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateOracleScriptResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateDataSourceAccessPolicyResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateOracleScriptResponse)
+		x := input.Message.Interface().(*MsgUpdateDataSourceAccessPolicyResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5890,7 +9151,7 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateOracleScriptResponse)
+		x := input.Message.Interface().(*MsgUpdateDataSourceAccessPolicyResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5920,7 +9181,7 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateOracleScriptResponse)
+		x := input.Message.Interface().(*MsgUpdateDataSourceAccessPolicyResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5952,10 +9213,10 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOracleScriptResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDataSourceAccessPolicyResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateDataSourceAccessPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5994,40 +9255,30 @@ func (x *fastReflection_MsgCreateOracleScriptResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_MsgEditOracleScript                  protoreflect.MessageDescriptor
-	fd_MsgEditOracleScript_oracle_script_id protoreflect.FieldDescriptor
-	fd_MsgEditOracleScript_name             protoreflect.FieldDescriptor
-	fd_MsgEditOracleScript_description      protoreflect.FieldDescriptor
-	fd_MsgEditOracleScript_schema           protoreflect.FieldDescriptor
-	fd_MsgEditOracleScript_source_code_url  protoreflect.FieldDescriptor
-	fd_MsgEditOracleScript_code             protoreflect.FieldDescriptor
-	fd_MsgEditOracleScript_owner            protoreflect.FieldDescriptor
-	fd_MsgEditOracleScript_sender           protoreflect.FieldDescriptor
+	md_MsgUpdateOracleScriptAccessPolicy                  protoreflect.MessageDescriptor
+	fd_MsgUpdateOracleScriptAccessPolicy_oracle_script_id protoreflect.FieldDescriptor
+	fd_MsgUpdateOracleScriptAccessPolicy_access_policy    protoreflect.FieldDescriptor
+	fd_MsgUpdateOracleScriptAccessPolicy_sender           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgEditOracleScript = File_band_oracle_v1_tx_proto.Messages().ByName("MsgEditOracleScript")
-	fd_MsgEditOracleScript_oracle_script_id = md_MsgEditOracleScript.Fields().ByName("oracle_script_id")
-	fd_MsgEditOracleScript_name = md_MsgEditOracleScript.Fields().ByName("name")
-	fd_MsgEditOracleScript_description = md_MsgEditOracleScript.Fields().ByName("description")
-	fd_MsgEditOracleScript_schema = md_MsgEditOracleScript.Fields().ByName("schema")
-	fd_MsgEditOracleScript_source_code_url = md_MsgEditOracleScript.Fields().ByName("source_code_url")
-	fd_MsgEditOracleScript_code = md_MsgEditOracleScript.Fields().ByName("code")
-	fd_MsgEditOracleScript_owner = md_MsgEditOracleScript.Fields().ByName("owner")
-	fd_MsgEditOracleScript_sender = md_MsgEditOracleScript.Fields().ByName("sender")
+	md_MsgUpdateOracleScriptAccessPolicy = File_band_oracle_v1_tx_proto.Messages().ByName("MsgUpdateOracleScriptAccessPolicy")
+	fd_MsgUpdateOracleScriptAccessPolicy_oracle_script_id = md_MsgUpdateOracleScriptAccessPolicy.Fields().ByName("oracle_script_id")
+	fd_MsgUpdateOracleScriptAccessPolicy_access_policy = md_MsgUpdateOracleScriptAccessPolicy.Fields().ByName("access_policy")
+	fd_MsgUpdateOracleScriptAccessPolicy_sender = md_MsgUpdateOracleScriptAccessPolicy.Fields().ByName("sender")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgEditOracleScript)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateOracleScriptAccessPolicy)(nil)
 
-type fastReflection_MsgEditOracleScript MsgEditOracleScript
+type fastReflection_MsgUpdateOracleScriptAccessPolicy MsgUpdateOracleScriptAccessPolicy
 
-func (x *MsgEditOracleScript) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgEditOracleScript)(x)
+func (x *MsgUpdateOracleScriptAccessPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateOracleScriptAccessPolicy)(x)
 }
 
-func (x *MsgEditOracleScript) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_tx_proto_msgTypes[10]
+func (x *MsgUpdateOracleScriptAccessPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6038,43 +9289,43 @@ func (x *MsgEditOracleScript) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgEditOracleScript_messageType fastReflection_MsgEditOracleScript_messageType
-var _ protoreflect.MessageType = fastReflection_MsgEditOracleScript_messageType{}
+var _fastReflection_MsgUpdateOracleScriptAccessPolicy_messageType fastReflection_MsgUpdateOracleScriptAccessPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateOracleScriptAccessPolicy_messageType{}
 
-type fastReflection_MsgEditOracleScript_messageType struct{}
+type fastReflection_MsgUpdateOracleScriptAccessPolicy_messageType struct{}
 
-func (x fastReflection_MsgEditOracleScript_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgEditOracleScript)(nil)
+func (x fastReflection_MsgUpdateOracleScriptAccessPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateOracleScriptAccessPolicy)(nil)
 }
-func (x fastReflection_MsgEditOracleScript_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgEditOracleScript)
+func (x fastReflection_MsgUpdateOracleScriptAccessPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateOracleScriptAccessPolicy)
 }
-func (x fastReflection_MsgEditOracleScript_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgEditOracleScript
+func (x fastReflection_MsgUpdateOracleScriptAccessPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateOracleScriptAccessPolicy
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgEditOracleScript) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgEditOracleScript
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateOracleScriptAccessPolicy
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgEditOracleScript) Type() protoreflect.MessageType {
-	return _fastReflection_MsgEditOracleScript_messageType
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateOracleScriptAccessPolicy_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgEditOracleScript) New() protoreflect.Message {
-	return new(fastReflection_MsgEditOracleScript)
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateOracleScriptAccessPolicy)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgEditOracleScript) Interface() protoreflect.ProtoMessage {
-	return (*MsgEditOracleScript)(x)
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateOracleScriptAccessPolicy)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6082,52 +9333,22 @@ func (x *fastReflection_MsgEditOracleScript) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgEditOracleScript) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.OracleScriptId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.OracleScriptId)
-		if !f(fd_MsgEditOracleScript_oracle_script_id, value) {
-			return
-		}
-	}
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgEditOracleScript_name, value) {
-			return
-		}
-	}
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_MsgEditOracleScript_description, value) {
-			return
-		}
-	}
-	if x.Schema != "" {
-		value := protoreflect.ValueOfString(x.Schema)
-		if !f(fd_MsgEditOracleScript_schema, value) {
+		if !f(fd_MsgUpdateOracleScriptAccessPolicy_oracle_script_id, value) {
 			return
 		}
 	}
-	if x.SourceCodeUrl != "" {
-		value := protoreflect.ValueOfString(x.SourceCodeUrl)
-		if !f(fd_MsgEditOracleScript_source_code_url, value) {
-			return
-		}
-	}
-	if len(x.Code) != 0 {
-		value := protoreflect.ValueOfBytes(x.Code)
-		if !f(fd_MsgEditOracleScript_code, value) {
-			return
-		}
-	}
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_MsgEditOracleScript_owner, value) {
+	if x.AccessPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.AccessPolicy.ProtoReflect())
+		if !f(fd_MsgUpdateOracleScriptAccessPolicy_access_policy, value) {
 			return
 		}
 	}
 	if x.Sender != "" {
 		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_MsgEditOracleScript_sender, value) {
+		if !f(fd_MsgUpdateOracleScriptAccessPolicy_sender, value) {
 			return
 		}
 	}
@@ -6144,29 +9365,19 @@ func (x *fastReflection_MsgEditOracleScript) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgEditOracleScript) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.oracle_script_id":
 		return x.OracleScriptId != uint64(0)
-	case "band.oracle.v1.MsgEditOracleScript.name":
-		return x.Name != ""
-	case "band.oracle.v1.MsgEditOracleScript.description":
-		return x.Description != ""
-	case "band.oracle.v1.MsgEditOracleScript.schema":
-		return x.Schema != ""
-	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
-		return x.SourceCodeUrl != ""
-	case "band.oracle.v1.MsgEditOracleScript.code":
-		return len(x.Code) != 0
-	case "band.oracle.v1.MsgEditOracleScript.owner":
-		return x.Owner != ""
-	case "band.oracle.v1.MsgEditOracleScript.sender":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.access_policy":
+		return x.AccessPolicy != nil
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.sender":
 		return x.Sender != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6176,29 +9387,19 @@ func (x *fastReflection_MsgEditOracleScript) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditOracleScript) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.oracle_script_id":
 		x.OracleScriptId = uint64(0)
-	case "band.oracle.v1.MsgEditOracleScript.name":
-		x.Name = ""
-	case "band.oracle.v1.MsgEditOracleScript.description":
-		x.Description = ""
-	case "band.oracle.v1.MsgEditOracleScript.schema":
-		x.Schema = ""
-	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
-		x.SourceCodeUrl = ""
-	case "band.oracle.v1.MsgEditOracleScript.code":
-		x.Code = nil
-	case "band.oracle.v1.MsgEditOracleScript.owner":
-		x.Owner = ""
-	case "band.oracle.v1.MsgEditOracleScript.sender":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.access_policy":
+		x.AccessPolicy = nil
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.sender":
 		x.Sender = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6208,37 +9409,22 @@ func (x *fastReflection_MsgEditOracleScript) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgEditOracleScript) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.oracle_script_id":
 		value := x.OracleScriptId
 		return protoreflect.ValueOfUint64(value)
-	case "band.oracle.v1.MsgEditOracleScript.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditOracleScript.description":
-		value := x.Description
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditOracleScript.schema":
-		value := x.Schema
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
-		value := x.SourceCodeUrl
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditOracleScript.code":
-		value := x.Code
-		return protoreflect.ValueOfBytes(value)
-	case "band.oracle.v1.MsgEditOracleScript.owner":
-		value := x.Owner
-		return protoreflect.ValueOfString(value)
-	case "band.oracle.v1.MsgEditOracleScript.sender":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.access_policy":
+		value := x.AccessPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6252,29 +9438,19 @@ func (x *fastReflection_MsgEditOracleScript) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditOracleScript) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.oracle_script_id":
 		x.OracleScriptId = value.Uint()
-	case "band.oracle.v1.MsgEditOracleScript.name":
-		x.Name = value.Interface().(string)
-	case "band.oracle.v1.MsgEditOracleScript.description":
-		x.Description = value.Interface().(string)
-	case "band.oracle.v1.MsgEditOracleScript.schema":
-		x.Schema = value.Interface().(string)
-	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
-		x.SourceCodeUrl = value.Interface().(string)
-	case "band.oracle.v1.MsgEditOracleScript.code":
-		x.Code = value.Bytes()
-	case "band.oracle.v1.MsgEditOracleScript.owner":
-		x.Owner = value.Interface().(string)
-	case "band.oracle.v1.MsgEditOracleScript.sender":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.access_policy":
+		x.AccessPolicy = value.Message().Interface().(*AccessPolicy)
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.sender":
 		x.Sender = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6288,68 +9464,52 @@ func (x *fastReflection_MsgEditOracleScript) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditOracleScript) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
-		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.MsgEditOracleScript is not mutable"))
-	case "band.oracle.v1.MsgEditOracleScript.name":
-		panic(fmt.Errorf("field name of message band.oracle.v1.MsgEditOracleScript is not mutable"))
-	case "band.oracle.v1.MsgEditOracleScript.description":
-		panic(fmt.Errorf("field description of message band.oracle.v1.MsgEditOracleScript is not mutable"))
-	case "band.oracle.v1.MsgEditOracleScript.schema":
-		panic(fmt.Errorf("field schema of message band.oracle.v1.MsgEditOracleScript is not mutable"))
-	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
-		panic(fmt.Errorf("field source_code_url of message band.oracle.v1.MsgEditOracleScript is not mutable"))
-	case "band.oracle.v1.MsgEditOracleScript.code":
-		panic(fmt.Errorf("field code of message band.oracle.v1.MsgEditOracleScript is not mutable"))
-	case "band.oracle.v1.MsgEditOracleScript.owner":
-		panic(fmt.Errorf("field owner of message band.oracle.v1.MsgEditOracleScript is not mutable"))
-	case "band.oracle.v1.MsgEditOracleScript.sender":
-		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgEditOracleScript is not mutable"))
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.access_policy":
+		if x.AccessPolicy == nil {
+			x.AccessPolicy = new(AccessPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.AccessPolicy.ProtoReflect())
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.oracle_script_id":
+		panic(fmt.Errorf("field oracle_script_id of message band.oracle.v1.MsgUpdateOracleScriptAccessPolicy is not mutable"))
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.sender":
+		panic(fmt.Errorf("field sender of message band.oracle.v1.MsgUpdateOracleScriptAccessPolicy is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgEditOracleScript) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "band.oracle.v1.MsgEditOracleScript.oracle_script_id":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.oracle_script_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "band.oracle.v1.MsgEditOracleScript.name":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditOracleScript.description":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditOracleScript.schema":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditOracleScript.source_code_url":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditOracleScript.code":
-		return protoreflect.ValueOfBytes(nil)
-	case "band.oracle.v1.MsgEditOracleScript.owner":
-		return protoreflect.ValueOfString("")
-	case "band.oracle.v1.MsgEditOracleScript.sender":
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.access_policy":
+		m := new(AccessPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.sender":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScript"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicy"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScript does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgEditOracleScript) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgEditOracleScript", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgUpdateOracleScriptAccessPolicy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6357,7 +9517,7 @@ func (x *fastReflection_MsgEditOracleScript) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgEditOracleScript) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6368,7 +9528,7 @@ func (x *fastReflection_MsgEditOracleScript) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditOracleScript) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6380,7 +9540,7 @@ func (x *fastReflection_MsgEditOracleScript) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgEditOracleScript) IsValid() bool {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) IsValid() bool {
 	return x != nil
 }
 
@@ -6390,9 +9550,9 @@ func (x *fastReflection_MsgEditOracleScript) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgEditOracleScript)
+		x := input.Message.Interface().(*MsgUpdateOracleScriptAccessPolicy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6407,28 +9567,8 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 		if x.OracleScriptId != 0 {
 			n += 1 + runtime.Sov(uint64(x.OracleScriptId))
 		}
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Description)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Schema)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.SourceCodeUrl)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Code)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Owner)
-		if l > 0 {
+		if x.AccessPolicy != nil {
+			l = options.Size(x.AccessPolicy)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Sender)
@@ -6445,7 +9585,7 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgEditOracleScript)
+		x := input.Message.Interface().(*MsgUpdateOracleScriptAccessPolicy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6469,47 +9609,19 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], x.Sender)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Code) > 0 {
-			i -= len(x.Code)
-			copy(dAtA[i:], x.Code)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.SourceCodeUrl) > 0 {
-			i -= len(x.SourceCodeUrl)
-			copy(dAtA[i:], x.SourceCodeUrl)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceCodeUrl)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Schema) > 0 {
-			i -= len(x.Schema)
-			copy(dAtA[i:], x.Schema)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Schema)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
-			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.AccessPolicy != nil {
+			encoded, err := options.Marshal(x.AccessPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -6529,7 +9641,7 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgEditOracleScript)
+		x := input.Message.Interface().(*MsgUpdateOracleScriptAccessPolicy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6548,145 +9660,30 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditOracleScript: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditOracleScript: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
-				}
-				x.OracleScriptId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.OracleScriptId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Description = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.Schema = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceCodeUrl", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateOracleScriptAccessPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateOracleScriptAccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
 				}
-				var stringLen uint64
+				x.OracleScriptId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6696,29 +9693,16 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.OracleScriptId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SourceCodeUrl = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6728,59 +9712,29 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
-				if x.Code == nil {
-					x.Code = []byte{}
-				}
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.AccessPolicy == nil {
+					x.AccessPolicy = &AccessPolicy{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccessPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
+			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
@@ -6848,24 +9802,24 @@ func (x *fastReflection_MsgEditOracleScript) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgEditOracleScriptResponse protoreflect.MessageDescriptor
+	md_MsgUpdateOracleScriptAccessPolicyResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_band_oracle_v1_tx_proto_init()
-	md_MsgEditOracleScriptResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgEditOracleScriptResponse")
+	md_MsgUpdateOracleScriptAccessPolicyResponse = File_band_oracle_v1_tx_proto.Messages().ByName("MsgUpdateOracleScriptAccessPolicyResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgEditOracleScriptResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateOracleScriptAccessPolicyResponse)(nil)
 
-type fastReflection_MsgEditOracleScriptResponse MsgEditOracleScriptResponse
+type fastReflection_MsgUpdateOracleScriptAccessPolicyResponse MsgUpdateOracleScriptAccessPolicyResponse
 
-func (x *MsgEditOracleScriptResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgEditOracleScriptResponse)(x)
+func (x *MsgUpdateOracleScriptAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateOracleScriptAccessPolicyResponse)(x)
 }
 
-func (x *MsgEditOracleScriptResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_tx_proto_msgTypes[11]
+func (x *MsgUpdateOracleScriptAccessPolicyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6876,43 +9830,43 @@ func (x *MsgEditOracleScriptResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgEditOracleScriptResponse_messageType fastReflection_MsgEditOracleScriptResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgEditOracleScriptResponse_messageType{}
+var _fastReflection_MsgUpdateOracleScriptAccessPolicyResponse_messageType fastReflection_MsgUpdateOracleScriptAccessPolicyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateOracleScriptAccessPolicyResponse_messageType{}
 
-type fastReflection_MsgEditOracleScriptResponse_messageType struct{}
+type fastReflection_MsgUpdateOracleScriptAccessPolicyResponse_messageType struct{}
 
-func (x fastReflection_MsgEditOracleScriptResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgEditOracleScriptResponse)(nil)
+func (x fastReflection_MsgUpdateOracleScriptAccessPolicyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateOracleScriptAccessPolicyResponse)(nil)
 }
-func (x fastReflection_MsgEditOracleScriptResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgEditOracleScriptResponse)
+func (x fastReflection_MsgUpdateOracleScriptAccessPolicyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateOracleScriptAccessPolicyResponse)
 }
-func (x fastReflection_MsgEditOracleScriptResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgEditOracleScriptResponse
+func (x fastReflection_MsgUpdateOracleScriptAccessPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateOracleScriptAccessPolicyResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgEditOracleScriptResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgEditOracleScriptResponse
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateOracleScriptAccessPolicyResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgEditOracleScriptResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgEditOracleScriptResponse_messageType
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateOracleScriptAccessPolicyResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgEditOracleScriptResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgEditOracleScriptResponse)
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateOracleScriptAccessPolicyResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgEditOracleScriptResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgEditOracleScriptResponse)(x)
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateOracleScriptAccessPolicyResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6920,7 +9874,7 @@ func (x *fastReflection_MsgEditOracleScriptResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgEditOracleScriptResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6934,13 +9888,13 @@ func (x *fastReflection_MsgEditOracleScriptResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgEditOracleScriptResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6950,13 +9904,13 @@ func (x *fastReflection_MsgEditOracleScriptResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditOracleScriptResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6966,13 +9920,13 @@ func (x *fastReflection_MsgEditOracleScriptResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgEditOracleScriptResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6986,13 +9940,13 @@ func (x *fastReflection_MsgEditOracleScriptResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditOracleScriptResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7006,36 +9960,36 @@ func (x *fastReflection_MsgEditOracleScriptResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditOracleScriptResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgEditOracleScriptResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgEditOracleScriptResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse"))
 		}
-		panic(fmt.Errorf("message band.oracle.v1.MsgEditOracleScriptResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgEditOracleScriptResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgEditOracleScriptResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7043,7 +9997,7 @@ func (x *fastReflection_MsgEditOracleScriptResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgEditOracleScriptResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7054,7 +10008,7 @@ func (x *fastReflection_MsgEditOracleScriptResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgEditOracleScriptResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7066,7 +10020,7 @@ func (x *fastReflection_MsgEditOracleScriptResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgEditOracleScriptResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7076,9 +10030,9 @@ func (x *fastReflection_MsgEditOracleScriptResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgEditOracleScriptResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateOracleScriptAccessPolicyResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgEditOracleScriptResponse)
+		x := input.Message.Interface().(*MsgUpdateOracleScriptAccessPolicyResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7100,7 +10054,7 @@ func (x *fastReflection_MsgEditOracleScriptResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgEditOracleScriptResponse)
+		x := input.Message.Interface().(*MsgUpdateOracleScriptAccessPolicyResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7130,7 +10084,7 @@ func (x *fastReflection_MsgEditOracleScriptResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgEditOracleScriptResponse)
+		x := input.Message.Interface().(*MsgUpdateOracleScriptAccessPolicyResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7162,10 +10116,10 @@ func (x *fastReflection_MsgEditOracleScriptResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditOracleScriptResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateOracleScriptAccessPolicyResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEditOracleScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateOracleScriptAccessPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -7223,7 +10177,7 @@ func (x *MsgActivate) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgActivate) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_tx_proto_msgTypes[12]
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7641,7 +10595,7 @@ func (x *MsgActivateResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgActivateResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_tx_proto_msgTypes[13]
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8001,7 +10955,7 @@ func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_tx_proto_msgTypes[14]
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8496,7 +11450,7 @@ func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_band_oracle_v1_tx_proto_msgTypes[15]
+	mi := &file_band_oracle_v1_tx_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8875,6 +11829,12 @@ type MsgRequestData struct {
 	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TssEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Tip is an optional amount on top of the data source fees that will be split
+	// among the validators who report before the request is resolved.
+	Tip []*v1beta1.Coin `protobuf:"bytes,11,rep,name=tip,proto3" json:"tip,omitempty"`
+	// CommitReveal is a flag indicating whether validators must commit to their
+	// reports before revealing them.
+	CommitReveal bool `protobuf:"varint,12,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
 }
 
 func (x *MsgRequestData) Reset() {
@@ -8967,6 +11927,20 @@ func (x *MsgRequestData) GetTssEncoder() Encoder {
 	return Encoder_ENCODER_UNSPECIFIED
 }
 
+func (x *MsgRequestData) GetTip() []*v1beta1.Coin {
+	if x != nil {
+		return x.Tip
+	}
+	return nil
+}
+
+func (x *MsgRequestData) GetCommitReveal() bool {
+	if x != nil {
+		return x.CommitReveal
+	}
+	return false
+}
+
 // MsgRequestDataResponse is response data for MsgRequestData message
 type MsgRequestDataResponse struct {
 	state         protoimpl.MessageState
@@ -9007,6 +11981,9 @@ type MsgReportData struct {
 	RawReports []*RawReport `protobuf:"bytes,2,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports,omitempty"`
 	// Validator is the address of the validator that owns this report.
 	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Salt is the random value used to compute the report commit hash. It is
+	// only required for requests in commit-reveal mode.
+	Salt []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (x *MsgReportData) Reset() {
@@ -9050,6 +12027,13 @@ func (x *MsgReportData) GetValidator() string {
 	return ""
 }
 
+func (x *MsgReportData) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
 // MsgReportDataResponse is response data for MsgReportData message
 type MsgReportDataResponse struct {
 	state         protoimpl.MessageState
@@ -9077,6 +12061,89 @@ func (*MsgReportDataResponse) Descriptor() ([]byte, []int) {
 	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{3}
 }
 
+// MsgCommitReport is a message for committing to a report of a data request in
+// commit-reveal mode by a validator.
+type MsgCommitReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is the identifier of the request to be committed to.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Hash is the SHA256 hash of the report computed by ReportCommitHash.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Validator is the address of the validator that owns this commit.
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (x *MsgCommitReport) Reset() {
+	*x = MsgCommitReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCommitReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCommitReport) ProtoMessage() {}
+
+// Deprecated: Use MsgCommitReport.ProtoReflect.Descriptor instead.
+func (*MsgCommitReport) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgCommitReport) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *MsgCommitReport) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *MsgCommitReport) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+// MsgCommitReportResponse is response data for MsgCommitReport message
+type MsgCommitReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCommitReportResponse) Reset() {
+	*x = MsgCommitReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCommitReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCommitReportResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCommitReportResponse.ProtoReflect.Descriptor instead.
+func (*MsgCommitReportResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
 // MsgCreateDataSource is a message for creating a new data source.
 type MsgCreateDataSource struct {
 	state         protoimpl.MessageState
@@ -9106,7 +12173,7 @@ type MsgCreateDataSource struct {
 func (x *MsgCreateDataSource) Reset() {
 	*x = MsgCreateDataSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[4]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9120,7 +12187,7 @@ func (*MsgCreateDataSource) ProtoMessage() {}
 
 // Deprecated: Use MsgCreateDataSource.ProtoReflect.Descriptor instead.
 func (*MsgCreateDataSource) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{4}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MsgCreateDataSource) GetName() string {
@@ -9182,7 +12249,7 @@ type MsgCreateDataSourceResponse struct {
 func (x *MsgCreateDataSourceResponse) Reset() {
 	*x = MsgCreateDataSourceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[5]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9196,7 +12263,7 @@ func (*MsgCreateDataSourceResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgCreateDataSourceResponse.ProtoReflect.Descriptor instead.
 func (*MsgCreateDataSourceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{5}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{7}
 }
 
 // MsgEditDataSource is a message for editing an existing data source.
@@ -9231,7 +12298,7 @@ type MsgEditDataSource struct {
 func (x *MsgEditDataSource) Reset() {
 	*x = MsgEditDataSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[6]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9245,7 +12312,7 @@ func (*MsgEditDataSource) ProtoMessage() {}
 
 // Deprecated: Use MsgEditDataSource.ProtoReflect.Descriptor instead.
 func (*MsgEditDataSource) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{6}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MsgEditDataSource) GetDataSourceId() uint64 {
@@ -9314,7 +12381,7 @@ type MsgEditDataSourceResponse struct {
 func (x *MsgEditDataSourceResponse) Reset() {
 	*x = MsgEditDataSourceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[7]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9328,7 +12395,7 @@ func (*MsgEditDataSourceResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgEditDataSourceResponse.ProtoReflect.Descriptor instead.
 func (*MsgEditDataSourceResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{7}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{9}
 }
 
 // MsgCreateOracleScript is a message for creating an oracle script.
@@ -9358,7 +12425,7 @@ type MsgCreateOracleScript struct {
 func (x *MsgCreateOracleScript) Reset() {
 	*x = MsgCreateOracleScript{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[8]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9372,7 +12439,7 @@ func (*MsgCreateOracleScript) ProtoMessage() {}
 
 // Deprecated: Use MsgCreateOracleScript.ProtoReflect.Descriptor instead.
 func (*MsgCreateOracleScript) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{8}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MsgCreateOracleScript) GetName() string {
@@ -9435,7 +12502,7 @@ type MsgCreateOracleScriptResponse struct {
 func (x *MsgCreateOracleScriptResponse) Reset() {
 	*x = MsgCreateOracleScriptResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[9]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9449,7 +12516,7 @@ func (*MsgCreateOracleScriptResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgCreateOracleScriptResponse.ProtoReflect.Descriptor instead.
 func (*MsgCreateOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{9}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{11}
 }
 
 // MsgEditOracleScript is a message for editing an existing oracle script.
@@ -9482,7 +12549,7 @@ type MsgEditOracleScript struct {
 func (x *MsgEditOracleScript) Reset() {
 	*x = MsgEditOracleScript{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[10]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9496,7 +12563,7 @@ func (*MsgEditOracleScript) ProtoMessage() {}
 
 // Deprecated: Use MsgEditOracleScript.ProtoReflect.Descriptor instead.
 func (*MsgEditOracleScript) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{10}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MsgEditOracleScript) GetOracleScriptId() uint64 {
@@ -9565,7 +12632,7 @@ type MsgEditOracleScriptResponse struct {
 func (x *MsgEditOracleScriptResponse) Reset() {
 	*x = MsgEditOracleScriptResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[11]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9579,7 +12646,177 @@ func (*MsgEditOracleScriptResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgEditOracleScriptResponse.ProtoReflect.Descriptor instead.
 func (*MsgEditOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{11}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+// MsgUpdateDataSourceAccessPolicy is a message for updating the access policy
+// of a data source.
+type MsgUpdateDataSourceAccessPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DataSourceID is the unique identifier of the data source to be updated.
+	DataSourceId uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// AccessPolicy is the new access policy of the data source.
+	AccessPolicy *AccessPolicy `protobuf:"bytes,2,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy,omitempty"`
+	// Sender is the signer of this message. Must be the current data source's
+	// owner.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *MsgUpdateDataSourceAccessPolicy) Reset() {
+	*x = MsgUpdateDataSourceAccessPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateDataSourceAccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateDataSourceAccessPolicy) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateDataSourceAccessPolicy.ProtoReflect.Descriptor instead.
+func (*MsgUpdateDataSourceAccessPolicy) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgUpdateDataSourceAccessPolicy) GetDataSourceId() uint64 {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return 0
+}
+
+func (x *MsgUpdateDataSourceAccessPolicy) GetAccessPolicy() *AccessPolicy {
+	if x != nil {
+		return x.AccessPolicy
+	}
+	return nil
+}
+
+func (x *MsgUpdateDataSourceAccessPolicy) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+// MsgUpdateDataSourceAccessPolicyResponse is response data for
+// MsgUpdateDataSourceAccessPolicy message
+type MsgUpdateDataSourceAccessPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateDataSourceAccessPolicyResponse) Reset() {
+	*x = MsgUpdateDataSourceAccessPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateDataSourceAccessPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateDataSourceAccessPolicyResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateDataSourceAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateDataSourceAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+// MsgUpdateOracleScriptAccessPolicy is a message for updating the access policy
+// of an oracle script.
+type MsgUpdateOracleScriptAccessPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OracleScriptID is the unique identifier of the oracle script to be updated.
+	OracleScriptId uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// AccessPolicy is the new access policy of the oracle script.
+	AccessPolicy *AccessPolicy `protobuf:"bytes,2,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy,omitempty"`
+	// Sender is the signer of this message. Must be the current oracle script's
+	// owner.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *MsgUpdateOracleScriptAccessPolicy) Reset() {
+	*x = MsgUpdateOracleScriptAccessPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateOracleScriptAccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateOracleScriptAccessPolicy) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateOracleScriptAccessPolicy.ProtoReflect.Descriptor instead.
+func (*MsgUpdateOracleScriptAccessPolicy) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgUpdateOracleScriptAccessPolicy) GetOracleScriptId() uint64 {
+	if x != nil {
+		return x.OracleScriptId
+	}
+	return 0
+}
+
+func (x *MsgUpdateOracleScriptAccessPolicy) GetAccessPolicy() *AccessPolicy {
+	if x != nil {
+		return x.AccessPolicy
+	}
+	return nil
+}
+
+func (x *MsgUpdateOracleScriptAccessPolicy) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+// MsgUpdateOracleScriptAccessPolicyResponse is response data for
+// MsgUpdateOracleScriptAccessPolicy message
+type MsgUpdateOracleScriptAccessPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateOracleScriptAccessPolicyResponse) Reset() {
+	*x = MsgUpdateOracleScriptAccessPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateOracleScriptAccessPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateOracleScriptAccessPolicyResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateOracleScriptAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateOracleScriptAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
 // MsgEditOracleScript is a message for activating a validator to become an
@@ -9598,7 +12835,7 @@ type MsgActivate struct {
 func (x *MsgActivate) Reset() {
 	*x = MsgActivate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[12]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9612,7 +12849,7 @@ func (*MsgActivate) ProtoMessage() {}
 
 // Deprecated: Use MsgActivate.ProtoReflect.Descriptor instead.
 func (*MsgActivate) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{12}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *MsgActivate) GetValidator() string {
@@ -9632,7 +12869,7 @@ type MsgActivateResponse struct {
 func (x *MsgActivateResponse) Reset() {
 	*x = MsgActivateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[13]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9646,7 +12883,7 @@ func (*MsgActivateResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgActivateResponse.ProtoReflect.Descriptor instead.
 func (*MsgActivateResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{13}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
 // MsgUpdateParams is the Msg/UpdateParams request type.
@@ -9668,7 +12905,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[14]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9682,7 +12919,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{14}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -9712,7 +12949,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_band_oracle_v1_tx_proto_msgTypes[15]
+		mi := &file_band_oracle_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9726,7 +12963,7 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{15}
+	return file_band_oracle_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
 var File_band_oracle_v1_tx_proto protoreflect.FileDescriptor
@@ -9744,7 +12981,7 @@ var file_band_oracle_v1_tx_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xad, 0x04, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x05, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
@@ -9776,217 +13013,307 @@ var file_band_oracle_v1_tx_proto_rawDesc = []byte{
 	0x6f, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
 	0x64, 0x65, 0x72, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x54, 0x53, 0x53, 0x45, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x3a,
-	0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf3, 0x01,
-	0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x61,
-	0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x09,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x24, 0xe8,
-	0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x03, 0x0a,
-	0x13, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65,
-	0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12,
-	0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x64, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12,
+	0x5d, 0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x74, 0x69, 0x70, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x3a, 0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x87, 0x02, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x40,
+	0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x73, 0x61, 0x6c, 0x74, 0x3a, 0x24, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde,
+	0x1f, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x09, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x2a, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7,
+	0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x8f, 0x03, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x5d, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x08,
+	0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x1d,
-	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd3, 0x03,
-	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xd3, 0x03, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20,
+	0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44,
+	0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x29, 0xe8, 0xa0, 0x1f,
+	0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x15, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xc5, 0x02, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x2d, 0xe8, 0xa0,
+	0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x19, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x03, 0x0a,
+	0x13, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24,
+	0xe2, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x49, 0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f,
+	0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9d, 0x02, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xe2, 0xde, 0x1f,
 	0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0xfa, 0xde, 0x1f,
 	0x0c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0c, 0x64,
-	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
-	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65,
-	0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x74, 0x72,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x29, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7,
-	0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2f, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xc5, 0x02, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65,
-	0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x2d, 0xe8, 0xa0, 0x1f, 0x01, 0x82,
-	0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x03, 0x0a, 0x13, 0x4d, 0x73,
-	0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f,
-	0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa,
-	0xde, 0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
-	0x44, 0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x39, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x0d, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30,
-	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x3a, 0x2b, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x45, 0x64, 0x69,
-	0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x1d, 0x0a,
-	0x1b, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x0b,
-	0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
-	0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x26, 0xe8, 0xa0,
-	0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x0f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0f,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x26, 0x82,
-	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xf7, 0x05, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x26, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x52, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0d, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x37, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x29, 0x0a, 0x27, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x02, 0x0a, 0x21, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x4e, 0x0a, 0x10, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0e,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x0e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0x52, 0x0e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x49, 0x64,
+	0x12, 0x47, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x39, 0xe8, 0xa0, 0x1f,
+	0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x25, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x2b, 0x0a, 0x29, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x3a, 0x26, 0xe8, 0xa0, 0x1f, 0x01, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x0f, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4d,
+	0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x3a, 0x26, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xed, 0x08, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x55, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x26,
+	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x1a, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x61,
+	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x2b, 0x2e,
 	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x25, 0x2e, 0x62,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0e, 0x45, 0x64,
+	0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x62,
 	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a,
+	0x29, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x12, 0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
 	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x2b, 0x2e, 0x62,
-	0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0e, 0x45, 0x64, 0x69,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x62, 0x61,
-	0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x29,
-	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
-	0x25, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64,
-	0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x1a, 0x2b,
+	0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x61, 0x6e,
+	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x1a,
+	0x2b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a,
+	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x37,
 	0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x31, 0x2e, 0x62, 0x61, 0x6e,
 	0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xb5, 0x01, 0x0a, 0x12, 0x63,
-	0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58,
-	0xaa, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x39, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x1a, 0x23, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xb5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x61, 0x6e, 0x64, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x07,
+	0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x6e, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x4f, 0x58, 0xaa, 0x02, 0x0e,
+	0x42, 0x61, 0x6e, 0x64, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0e, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1a, 0x42, 0x61, 0x6e, 0x64, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x42,
+	0x61, 0x6e, 0x64, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10001,57 +13328,73 @@ func file_band_oracle_v1_tx_proto_rawDescGZIP() []byte {
 	return file_band_oracle_v1_tx_proto_rawDescData
 }
 
-var file_band_oracle_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_band_oracle_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_band_oracle_v1_tx_proto_goTypes = []interface{}{
-	(*MsgRequestData)(nil),                // 0: band.oracle.v1.MsgRequestData
-	(*MsgRequestDataResponse)(nil),        // 1: band.oracle.v1.MsgRequestDataResponse
-	(*MsgReportData)(nil),                 // 2: band.oracle.v1.MsgReportData
-	(*MsgReportDataResponse)(nil),         // 3: band.oracle.v1.MsgReportDataResponse
-	(*MsgCreateDataSource)(nil),           // 4: band.oracle.v1.MsgCreateDataSource
-	(*MsgCreateDataSourceResponse)(nil),   // 5: band.oracle.v1.MsgCreateDataSourceResponse
-	(*MsgEditDataSource)(nil),             // 6: band.oracle.v1.MsgEditDataSource
-	(*MsgEditDataSourceResponse)(nil),     // 7: band.oracle.v1.MsgEditDataSourceResponse
-	(*MsgCreateOracleScript)(nil),         // 8: band.oracle.v1.MsgCreateOracleScript
-	(*MsgCreateOracleScriptResponse)(nil), // 9: band.oracle.v1.MsgCreateOracleScriptResponse
-	(*MsgEditOracleScript)(nil),           // 10: band.oracle.v1.MsgEditOracleScript
-	(*MsgEditOracleScriptResponse)(nil),   // 11: band.oracle.v1.MsgEditOracleScriptResponse
-	(*MsgActivate)(nil),                   // 12: band.oracle.v1.MsgActivate
-	(*MsgActivateResponse)(nil),           // 13: band.oracle.v1.MsgActivateResponse
-	(*MsgUpdateParams)(nil),               // 14: band.oracle.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),       // 15: band.oracle.v1.MsgUpdateParamsResponse
-	(*v1beta1.Coin)(nil),                  // 16: cosmos.base.v1beta1.Coin
-	(Encoder)(0),                          // 17: band.oracle.v1.Encoder
-	(*RawReport)(nil),                     // 18: band.oracle.v1.RawReport
-	(*Params)(nil),                        // 19: band.oracle.v1.Params
+	(*MsgRequestData)(nil),                            // 0: band.oracle.v1.MsgRequestData
+	(*MsgRequestDataResponse)(nil),                    // 1: band.oracle.v1.MsgRequestDataResponse
+	(*MsgReportData)(nil),                             // 2: band.oracle.v1.MsgReportData
+	(*MsgReportDataResponse)(nil),                     // 3: band.oracle.v1.MsgReportDataResponse
+	(*MsgCommitReport)(nil),                           // 4: band.oracle.v1.MsgCommitReport
+	(*MsgCommitReportResponse)(nil),                   // 5: band.oracle.v1.MsgCommitReportResponse
+	(*MsgCreateDataSource)(nil),                       // 6: band.oracle.v1.MsgCreateDataSource
+	(*MsgCreateDataSourceResponse)(nil),               // 7: band.oracle.v1.MsgCreateDataSourceResponse
+	(*MsgEditDataSource)(nil),                         // 8: band.oracle.v1.MsgEditDataSource
+	(*MsgEditDataSourceResponse)(nil),                 // 9: band.oracle.v1.MsgEditDataSourceResponse
+	(*MsgCreateOracleScript)(nil),                     // 10: band.oracle.v1.MsgCreateOracleScript
+	(*MsgCreateOracleScriptResponse)(nil),             // 11: band.oracle.v1.MsgCreateOracleScriptResponse
+	(*MsgEditOracleScript)(nil),                       // 12: band.oracle.v1.MsgEditOracleScript
+	(*MsgEditOracleScriptResponse)(nil),               // 13: band.oracle.v1.MsgEditOracleScriptResponse
+	(*MsgUpdateDataSourceAccessPolicy)(nil),           // 14: band.oracle.v1.MsgUpdateDataSourceAccessPolicy
+	(*MsgUpdateDataSourceAccessPolicyResponse)(nil),   // 15: band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse
+	(*MsgUpdateOracleScriptAccessPolicy)(nil),         // 16: band.oracle.v1.MsgUpdateOracleScriptAccessPolicy
+	(*MsgUpdateOracleScriptAccessPolicyResponse)(nil), // 17: band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse
+	(*MsgActivate)(nil),                               // 18: band.oracle.v1.MsgActivate
+	(*MsgActivateResponse)(nil),                       // 19: band.oracle.v1.MsgActivateResponse
+	(*MsgUpdateParams)(nil),                           // 20: band.oracle.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),                   // 21: band.oracle.v1.MsgUpdateParamsResponse
+	(*v1beta1.Coin)(nil),                              // 22: cosmos.base.v1beta1.Coin
+	(Encoder)(0),                                      // 23: band.oracle.v1.Encoder
+	(*RawReport)(nil),                                 // 24: band.oracle.v1.RawReport
+	(*AccessPolicy)(nil),                              // 25: band.oracle.v1.AccessPolicy
+	(*Params)(nil),                                    // 26: band.oracle.v1.Params
 }
 var file_band_oracle_v1_tx_proto_depIdxs = []int32{
-	16, // 0: band.oracle.v1.MsgRequestData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
-	17, // 1: band.oracle.v1.MsgRequestData.tss_encoder:type_name -> band.oracle.v1.Encoder
-	18, // 2: band.oracle.v1.MsgReportData.raw_reports:type_name -> band.oracle.v1.RawReport
-	16, // 3: band.oracle.v1.MsgCreateDataSource.fee:type_name -> cosmos.base.v1beta1.Coin
-	16, // 4: band.oracle.v1.MsgEditDataSource.fee:type_name -> cosmos.base.v1beta1.Coin
-	19, // 5: band.oracle.v1.MsgUpdateParams.params:type_name -> band.oracle.v1.Params
-	0,  // 6: band.oracle.v1.Msg.RequestData:input_type -> band.oracle.v1.MsgRequestData
-	2,  // 7: band.oracle.v1.Msg.ReportData:input_type -> band.oracle.v1.MsgReportData
-	4,  // 8: band.oracle.v1.Msg.CreateDataSource:input_type -> band.oracle.v1.MsgCreateDataSource
-	6,  // 9: band.oracle.v1.Msg.EditDataSource:input_type -> band.oracle.v1.MsgEditDataSource
-	8,  // 10: band.oracle.v1.Msg.CreateOracleScript:input_type -> band.oracle.v1.MsgCreateOracleScript
-	10, // 11: band.oracle.v1.Msg.EditOracleScript:input_type -> band.oracle.v1.MsgEditOracleScript
-	12, // 12: band.oracle.v1.Msg.Activate:input_type -> band.oracle.v1.MsgActivate
-	14, // 13: band.oracle.v1.Msg.UpdateParams:input_type -> band.oracle.v1.MsgUpdateParams
-	1,  // 14: band.oracle.v1.Msg.RequestData:output_type -> band.oracle.v1.MsgRequestDataResponse
-	3,  // 15: band.oracle.v1.Msg.ReportData:output_type -> band.oracle.v1.MsgReportDataResponse
-	5,  // 16: band.oracle.v1.Msg.CreateDataSource:output_type -> band.oracle.v1.MsgCreateDataSourceResponse
-	7,  // 17: band.oracle.v1.Msg.EditDataSource:output_type -> band.oracle.v1.MsgEditDataSourceResponse
-	9,  // 18: band.oracle.v1.Msg.CreateOracleScript:output_type -> band.oracle.v1.MsgCreateOracleScriptResponse
-	11, // 19: band.oracle.v1.Msg.EditOracleScript:output_type -> band.oracle.v1.MsgEditOracleScriptResponse
-	13, // 20: band.oracle.v1.Msg.Activate:output_type -> band.oracle.v1.MsgActivateResponse
-	15, // 21: band.oracle.v1.Msg.UpdateParams:output_type -> band.oracle.v1.MsgUpdateParamsResponse
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	22, // 0: band.oracle.v1.MsgRequestData.fee_limit:type_name -> cosmos.base.v1beta1.Coin
+	23, // 1: band.oracle.v1.MsgRequestData.tss_encoder:type_name -> band.oracle.v1.Encoder
+	22, // 2: band.oracle.v1.MsgRequestData.tip:type_name -> cosmos.base.v1beta1.Coin
+	24, // 3: band.oracle.v1.MsgReportData.raw_reports:type_name -> band.oracle.v1.RawReport
+	22, // 4: band.oracle.v1.MsgCreateDataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	22, // 5: band.oracle.v1.MsgEditDataSource.fee:type_name -> cosmos.base.v1beta1.Coin
+	25, // 6: band.oracle.v1.MsgUpdateDataSourceAccessPolicy.access_policy:type_name -> band.oracle.v1.AccessPolicy
+	25, // 7: band.oracle.v1.MsgUpdateOracleScriptAccessPolicy.access_policy:type_name -> band.oracle.v1.AccessPolicy
+	26, // 8: band.oracle.v1.MsgUpdateParams.params:type_name -> band.oracle.v1.Params
+	0,  // 9: band.oracle.v1.Msg.RequestData:input_type -> band.oracle.v1.MsgRequestData
+	2,  // 10: band.oracle.v1.Msg.ReportData:input_type -> band.oracle.v1.MsgReportData
+	4,  // 11: band.oracle.v1.Msg.CommitReport:input_type -> band.oracle.v1.MsgCommitReport
+	6,  // 12: band.oracle.v1.Msg.CreateDataSource:input_type -> band.oracle.v1.MsgCreateDataSource
+	8,  // 13: band.oracle.v1.Msg.EditDataSource:input_type -> band.oracle.v1.MsgEditDataSource
+	10, // 14: band.oracle.v1.Msg.CreateOracleScript:input_type -> band.oracle.v1.MsgCreateOracleScript
+	12, // 15: band.oracle.v1.Msg.EditOracleScript:input_type -> band.oracle.v1.MsgEditOracleScript
+	14, // 16: band.oracle.v1.Msg.UpdateDataSourceAccessPolicy:input_type -> band.oracle.v1.MsgUpdateDataSourceAccessPolicy
+	16, // 17: band.oracle.v1.Msg.UpdateOracleScriptAccessPolicy:input_type -> band.oracle.v1.MsgUpdateOracleScriptAccessPolicy
+	18, // 18: band.oracle.v1.Msg.Activate:input_type -> band.oracle.v1.MsgActivate
+	20, // 19: band.oracle.v1.Msg.UpdateParams:input_type -> band.oracle.v1.MsgUpdateParams
+	1,  // 20: band.oracle.v1.Msg.RequestData:output_type -> band.oracle.v1.MsgRequestDataResponse
+	3,  // 21: band.oracle.v1.Msg.ReportData:output_type -> band.oracle.v1.MsgReportDataResponse
+	5,  // 22: band.oracle.v1.Msg.CommitReport:output_type -> band.oracle.v1.MsgCommitReportResponse
+	7,  // 23: band.oracle.v1.Msg.CreateDataSource:output_type -> band.oracle.v1.MsgCreateDataSourceResponse
+	9,  // 24: band.oracle.v1.Msg.EditDataSource:output_type -> band.oracle.v1.MsgEditDataSourceResponse
+	11, // 25: band.oracle.v1.Msg.CreateOracleScript:output_type -> band.oracle.v1.MsgCreateOracleScriptResponse
+	13, // 26: band.oracle.v1.Msg.EditOracleScript:output_type -> band.oracle.v1.MsgEditOracleScriptResponse
+	15, // 27: band.oracle.v1.Msg.UpdateDataSourceAccessPolicy:output_type -> band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse
+	17, // 28: band.oracle.v1.Msg.UpdateOracleScriptAccessPolicy:output_type -> band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse
+	19, // 29: band.oracle.v1.Msg.Activate:output_type -> band.oracle.v1.MsgActivateResponse
+	21, // 30: band.oracle.v1.Msg.UpdateParams:output_type -> band.oracle.v1.MsgUpdateParamsResponse
+	20, // [20:31] is the sub-list for method output_type
+	9,  // [9:20] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_band_oracle_v1_tx_proto_init() }
@@ -10110,7 +13453,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateDataSource); i {
+			switch v := v.(*MsgCommitReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10122,7 +13465,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateDataSourceResponse); i {
+			switch v := v.(*MsgCommitReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10134,7 +13477,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgEditDataSource); i {
+			switch v := v.(*MsgCreateDataSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10146,7 +13489,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgEditDataSourceResponse); i {
+			switch v := v.(*MsgCreateDataSourceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10158,7 +13501,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateOracleScript); i {
+			switch v := v.(*MsgEditDataSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10170,7 +13513,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateOracleScriptResponse); i {
+			switch v := v.(*MsgEditDataSourceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10182,7 +13525,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgEditOracleScript); i {
+			switch v := v.(*MsgCreateOracleScript); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10194,7 +13537,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgEditOracleScriptResponse); i {
+			switch v := v.(*MsgCreateOracleScriptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10206,7 +13549,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgActivate); i {
+			switch v := v.(*MsgEditOracleScript); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10218,7 +13561,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgActivateResponse); i {
+			switch v := v.(*MsgEditOracleScriptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10230,7 +13573,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgUpdateDataSourceAccessPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10242,6 +13585,78 @@ func file_band_oracle_v1_tx_proto_init() {
 			}
 		}
 		file_band_oracle_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateDataSourceAccessPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateOracleScriptAccessPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateOracleScriptAccessPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgActivate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgActivateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_band_oracle_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -10260,7 +13675,7 @@ func file_band_oracle_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_band_oracle_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
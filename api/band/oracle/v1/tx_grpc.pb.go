@@ -19,14 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_RequestData_FullMethodName        = "/band.oracle.v1.Msg/RequestData"
-	Msg_ReportData_FullMethodName         = "/band.oracle.v1.Msg/ReportData"
-	Msg_CreateDataSource_FullMethodName   = "/band.oracle.v1.Msg/CreateDataSource"
-	Msg_EditDataSource_FullMethodName     = "/band.oracle.v1.Msg/EditDataSource"
-	Msg_CreateOracleScript_FullMethodName = "/band.oracle.v1.Msg/CreateOracleScript"
-	Msg_EditOracleScript_FullMethodName   = "/band.oracle.v1.Msg/EditOracleScript"
-	Msg_Activate_FullMethodName           = "/band.oracle.v1.Msg/Activate"
-	Msg_UpdateParams_FullMethodName       = "/band.oracle.v1.Msg/UpdateParams"
+	Msg_RequestData_FullMethodName                    = "/band.oracle.v1.Msg/RequestData"
+	Msg_ReportData_FullMethodName                     = "/band.oracle.v1.Msg/ReportData"
+	Msg_CommitReport_FullMethodName                   = "/band.oracle.v1.Msg/CommitReport"
+	Msg_CreateDataSource_FullMethodName               = "/band.oracle.v1.Msg/CreateDataSource"
+	Msg_EditDataSource_FullMethodName                 = "/band.oracle.v1.Msg/EditDataSource"
+	Msg_CreateOracleScript_FullMethodName             = "/band.oracle.v1.Msg/CreateOracleScript"
+	Msg_EditOracleScript_FullMethodName               = "/band.oracle.v1.Msg/EditOracleScript"
+	Msg_UpdateDataSourceAccessPolicy_FullMethodName   = "/band.oracle.v1.Msg/UpdateDataSourceAccessPolicy"
+	Msg_UpdateOracleScriptAccessPolicy_FullMethodName = "/band.oracle.v1.Msg/UpdateOracleScriptAccessPolicy"
+	Msg_Activate_FullMethodName                       = "/band.oracle.v1.Msg/Activate"
+	Msg_UpdateParams_FullMethodName                   = "/band.oracle.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +40,9 @@ type MsgClient interface {
 	RequestData(ctx context.Context, in *MsgRequestData, opts ...grpc.CallOption) (*MsgRequestDataResponse, error)
 	// ReportData defines a method for reporting a data to resolve the request.
 	ReportData(ctx context.Context, in *MsgReportData, opts ...grpc.CallOption) (*MsgReportDataResponse, error)
+	// CommitReport defines a method for committing to a report of a request in
+	// commit-reveal mode before revealing it with ReportData.
+	CommitReport(ctx context.Context, in *MsgCommitReport, opts ...grpc.CallOption) (*MsgCommitReportResponse, error)
 	// CreateDataSource defines a method for creating a new data source.
 	CreateDataSource(ctx context.Context, in *MsgCreateDataSource, opts ...grpc.CallOption) (*MsgCreateDataSourceResponse, error)
 	// EditDataSource defines a method for editing an existing data source.
@@ -45,6 +51,12 @@ type MsgClient interface {
 	CreateOracleScript(ctx context.Context, in *MsgCreateOracleScript, opts ...grpc.CallOption) (*MsgCreateOracleScriptResponse, error)
 	// EditOracleScript defines a method for editing an existing oracle script.
 	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
+	// UpdateDataSourceAccessPolicy defines a method for updating the access
+	// policy of an existing data source.
+	UpdateDataSourceAccessPolicy(ctx context.Context, in *MsgUpdateDataSourceAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateDataSourceAccessPolicyResponse, error)
+	// UpdateOracleScriptAccessPolicy defines a method for updating the access
+	// policy of an existing oracle script.
+	UpdateOracleScriptAccessPolicy(ctx context.Context, in *MsgUpdateOracleScriptAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateOracleScriptAccessPolicyResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
@@ -80,6 +92,15 @@ func (c *msgClient) ReportData(ctx context.Context, in *MsgReportData, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CommitReport(ctx context.Context, in *MsgCommitReport, opts ...grpc.CallOption) (*MsgCommitReportResponse, error) {
+	out := new(MsgCommitReportResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateDataSource(ctx context.Context, in *MsgCreateDataSource, opts ...grpc.CallOption) (*MsgCreateDataSourceResponse, error) {
 	out := new(MsgCreateDataSourceResponse)
 	err := c.cc.Invoke(ctx, Msg_CreateDataSource_FullMethodName, in, out, opts...)
@@ -116,6 +137,24 @@ func (c *msgClient) EditOracleScript(ctx context.Context, in *MsgEditOracleScrip
 	return out, nil
 }
 
+func (c *msgClient) UpdateDataSourceAccessPolicy(ctx context.Context, in *MsgUpdateDataSourceAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateDataSourceAccessPolicyResponse, error) {
+	out := new(MsgUpdateDataSourceAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDataSourceAccessPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateOracleScriptAccessPolicy(ctx context.Context, in *MsgUpdateOracleScriptAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateOracleScriptAccessPolicyResponse, error) {
+	out := new(MsgUpdateOracleScriptAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateOracleScriptAccessPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
 	out := new(MsgActivateResponse)
 	err := c.cc.Invoke(ctx, Msg_Activate_FullMethodName, in, out, opts...)
@@ -142,6 +181,9 @@ type MsgServer interface {
 	RequestData(context.Context, *MsgRequestData) (*MsgRequestDataResponse, error)
 	// ReportData defines a method for reporting a data to resolve the request.
 	ReportData(context.Context, *MsgReportData) (*MsgReportDataResponse, error)
+	// CommitReport defines a method for committing to a report of a request in
+	// commit-reveal mode before revealing it with ReportData.
+	CommitReport(context.Context, *MsgCommitReport) (*MsgCommitReportResponse, error)
 	// CreateDataSource defines a method for creating a new data source.
 	CreateDataSource(context.Context, *MsgCreateDataSource) (*MsgCreateDataSourceResponse, error)
 	// EditDataSource defines a method for editing an existing data source.
@@ -150,6 +192,12 @@ type MsgServer interface {
 	CreateOracleScript(context.Context, *MsgCreateOracleScript) (*MsgCreateOracleScriptResponse, error)
 	// EditOracleScript defines a method for editing an existing oracle script.
 	EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error)
+	// UpdateDataSourceAccessPolicy defines a method for updating the access
+	// policy of an existing data source.
+	UpdateDataSourceAccessPolicy(context.Context, *MsgUpdateDataSourceAccessPolicy) (*MsgUpdateDataSourceAccessPolicyResponse, error)
+	// UpdateOracleScriptAccessPolicy defines a method for updating the access
+	// policy of an existing oracle script.
+	UpdateOracleScriptAccessPolicy(context.Context, *MsgUpdateOracleScriptAccessPolicy) (*MsgUpdateOracleScriptAccessPolicyResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
@@ -170,6 +218,9 @@ func (UnimplementedMsgServer) RequestData(context.Context, *MsgRequestData) (*Ms
 func (UnimplementedMsgServer) ReportData(context.Context, *MsgReportData) (*MsgReportDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportData not implemented")
 }
+func (UnimplementedMsgServer) CommitReport(context.Context, *MsgCommitReport) (*MsgCommitReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReport not implemented")
+}
 func (UnimplementedMsgServer) CreateDataSource(context.Context, *MsgCreateDataSource) (*MsgCreateDataSourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDataSource not implemented")
 }
@@ -182,6 +233,12 @@ func (UnimplementedMsgServer) CreateOracleScript(context.Context, *MsgCreateOrac
 func (UnimplementedMsgServer) EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleScript not implemented")
 }
+func (UnimplementedMsgServer) UpdateDataSourceAccessPolicy(context.Context, *MsgUpdateDataSourceAccessPolicy) (*MsgUpdateDataSourceAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDataSourceAccessPolicy not implemented")
+}
+func (UnimplementedMsgServer) UpdateOracleScriptAccessPolicy(context.Context, *MsgUpdateOracleScriptAccessPolicy) (*MsgUpdateOracleScriptAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracleScriptAccessPolicy not implemented")
+}
 func (UnimplementedMsgServer) Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
@@ -237,6 +294,24 @@ func _Msg_ReportData_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitReport(ctx, req.(*MsgCommitReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateDataSource)
 	if err := dec(in); err != nil {
@@ -309,6 +384,42 @@ func _Msg_EditOracleScript_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDataSourceAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDataSourceAccessPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDataSourceAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDataSourceAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDataSourceAccessPolicy(ctx, req.(*MsgUpdateDataSourceAccessPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateOracleScriptAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracleScriptAccessPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracleScriptAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateOracleScriptAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracleScriptAccessPolicy(ctx, req.(*MsgUpdateOracleScriptAccessPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgActivate)
 	if err := dec(in); err != nil {
@@ -360,6 +471,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportData",
 			Handler:    _Msg_ReportData_Handler,
 		},
+		{
+			MethodName: "CommitReport",
+			Handler:    _Msg_CommitReport_Handler,
+		},
 		{
 			MethodName: "CreateDataSource",
 			Handler:    _Msg_CreateDataSource_Handler,
@@ -376,6 +491,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleScript",
 			Handler:    _Msg_EditOracleScript_Handler,
 		},
+		{
+			MethodName: "UpdateDataSourceAccessPolicy",
+			Handler:    _Msg_UpdateDataSourceAccessPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateOracleScriptAccessPolicy",
+			Handler:    _Msg_UpdateOracleScriptAccessPolicy_Handler,
+		},
 		{
 			MethodName: "Activate",
 			Handler:    _Msg_Activate_Handler,
@@ -84,6 +84,7 @@ var maccPerms = map[string][]string{
 	bandtsstypes.ModuleName:        nil,
 	restaketypes.ModuleName:        nil,
 	tunneltypes.ModuleName:         nil,
+	oracletypes.ModuleName:         nil,
 }
 
 func appModules(
@@ -28,6 +28,7 @@ const (
 	flagGasAdjustStep      = "gas-adjust-step"
 	flagRandomSecret       = "random-secret"
 	flagCheckingDEInterval = "checking-de-interval"
+	flagGasPricesRefresh   = "gas-prices-refresh-interval"
 )
 
 // runCmd returns a Cobra command to run the cylinder process.
@@ -68,6 +69,7 @@ func runCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "RPC url to BandChain node")
 	cmd.Flags().String(flagGranter, "", "granter address")
 	cmd.Flags().String(flags.FlagGasPrices, "", "gas prices for a transaction")
+	cmd.Flags().Duration(flagGasPricesRefresh, time.Minute, "The interval of refreshing the gas prices required by the chain (0 to disable)")
 	cmd.Flags().String(flagLogLevel, "info", "set the logger level")
 	cmd.Flags().Uint64(flagMaxMessages, 10, "The maximum number of messages in a transaction")
 	cmd.Flags().String(flagBroadcastTimeout, "5m", "The time that cylinder will wait for tx commit")
@@ -80,9 +82,9 @@ func runCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().Duration(flagCheckingDEInterval, 5*time.Minute, "The interval of checking DE")
 
 	flagNames := []string{
-		flags.FlagChainID, flags.FlagNode, flagGranter, flags.FlagGasPrices, flagLogLevel,
-		flagMaxMessages, flagBroadcastTimeout, flagRPCPollInterval, flagMaxTry, flagMinDE,
-		flagGasAdjustStart, flagGasAdjustStep, flagRandomSecret, flagCheckingDEInterval,
+		flags.FlagChainID, flags.FlagNode, flagGranter, flags.FlagGasPrices, flagGasPricesRefresh,
+		flagLogLevel, flagMaxMessages, flagBroadcastTimeout, flagRPCPollInterval, flagMaxTry,
+		flagMinDE, flagGasAdjustStart, flagGasAdjustStep, flagRandomSecret, flagCheckingDEInterval,
 	}
 
 	for _, flagName := range flagNames {
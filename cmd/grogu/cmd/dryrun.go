@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/chain/v3/grogu/context"
+	"github.com/bandprotocol/chain/v3/grogu/dryrun"
+)
+
+const flagPeriod = "period"
+
+func DryRunReportCmd(ctx *context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run-report [file]",
+		Short: "Summarize the accuracy of the prices recorded in dry runs against the chain prices",
+		Long: "Summarize the accuracy of the prices recorded by grogu run --dry-run against the chain " +
+			"prices, by signal and by period. The file defaults to " + defaultDryRunOutput + " in the home directory.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := filepath.Join(ctx.Home, defaultDryRunOutput)
+			if len(args) == 1 {
+				path = args[0]
+			}
+
+			period, err := cmd.Flags().GetDuration(flagPeriod)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			report, err := dryrun.Summarize(file, int64(period.Seconds()))
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "SIGNAL\tSUBMISSIONS\tUNAVAILABLE\tCOMPARED\tMEAN DEV (BPS)\tMAX DEV (BPS)\tFIRST\tLAST")
+			for _, s := range report.Signals {
+				printSummary(w, s)
+			}
+			if len(report.Periods) > 0 {
+				fmt.Fprintln(w)
+				fmt.Fprintln(w, "PERIOD\tSUBMISSIONS\tUNAVAILABLE\tCOMPARED\tMEAN DEV (BPS)\tMAX DEV (BPS)\tFIRST\tLAST")
+				for _, s := range report.Periods {
+					printSummary(w, s)
+				}
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().Duration(flagPeriod, time.Hour, "The length of the periods to summarize, 0 to disable.")
+
+	return cmd
+}
+
+func printSummary(w *tabwriter.Writer, s dryrun.Summary) {
+	fmt.Fprintf(
+		w, "%s\t%d\t%d\t%d\t%.2f\t%.2f\t%s\t%s\n",
+		s.Key, s.Submissions, s.Unavailable, s.Compared, s.MeanDeviationBps, s.MaxDeviationBps,
+		formatTimestamp(s.FirstTimestamp), formatTimestamp(s.LastTimestamp),
+	)
+}
+
+func formatTimestamp(timestamp int64) string {
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC3339)
+}
@@ -198,7 +198,7 @@ func createKeysListRunE(ctx *grogu.Context) func(cmd *cobra.Command, args []stri
 		maxBlockHeight := new(atomic.Int64)
 		maxBlockHeight.Store(0)
 
-		feedQuerier := querier.NewFeedQuerier(clientCtx, clients, maxBlockHeight)
+		feedQuerier := querier.NewFeedQuerier(clientCtx, clients, maxBlockHeight, nil)
 
 		keys, err := ctx.Keyring.List()
 		if err != nil {
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	grogu "github.com/bandprotocol/chain/v3/grogu/context"
+	"github.com/bandprotocol/chain/v3/grogu/report"
+	"github.com/bandprotocol/chain/v3/grogu/signalconfig"
+	"github.com/bandprotocol/chain/v3/grogu/signaller"
+)
+
+const (
+	flagFromHeight = "from"
+	flagToHeight   = "to"
+)
+
+func ReportCmd(ctx *grogu.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report the past performance of the validator's price submissions over a block range",
+		Long: "Scan the feeds events and the validator prices of the blocks in the range to report, by " +
+			"signal, the latency of the submissions from their assigned time, the rate of submissions " +
+			"within the interval of the previous price and the deviation from the aggregated price, " +
+			"along with the deactivations of the validator, for miss reports of either prices or oracle " +
+			"requests. The nodes need to keep the state of the range.",
+		Args: cobra.NoArgs,
+		RunE: createReportRunE(ctx),
+	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "The first block height of the range.")
+	cmd.Flags().Int64(flagToHeight, 0, "The last block height of the range, defaults to the latest height.")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+	_ = cmd.MarkFlagRequired(flagFromHeight)
+
+	return cmd
+}
+
+func createReportRunE(ctx *grogu.Context) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		fromHeight, err := cmd.Flags().GetInt64(flagFromHeight)
+		if err != nil {
+			return err
+		}
+		toHeight, err := cmd.Flags().GetInt64(flagToHeight)
+		if err != nil {
+			return err
+		}
+		output, err := cmd.Flags().GetString(flags.FlagOutput)
+		if err != nil {
+			return err
+		}
+		if output != "text" && output != "json" {
+			return fmt.Errorf("unsupported output format: %s", output)
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ctx.Config.Validator)
+		if err != nil {
+			return err
+		}
+
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+		clientCtx = clientCtx.WithCodec(ctx.BandApp.AppCodec()).
+			WithInterfaceRegistry(ctx.BandApp.InterfaceRegistry())
+
+		nodeURIs := strings.Split(viper.GetString(flagNodes), ",")
+		clients, stopClients, err := createClients(nodeURIs)
+		if err != nil {
+			return err
+		}
+		defer stopClients()
+
+		if toHeight == 0 {
+			status, err := clients[0].Status(context.Background())
+			if err != nil {
+				return err
+			}
+			toHeight = status.SyncInfo.LatestBlockHeight
+		}
+
+		// The assigned times use the current distribution of each signal, as in grogu run
+		signalsConfigPath := ctx.Config.SignalsConfig
+		if signalsConfigPath == "" {
+			signalsConfigPath = filepath.Join(ctx.Home, defaultSignalsConfig)
+		}
+		signalConfig := signalconfig.NewStore(
+			signalsConfigPath,
+			ctx.Config.DistributionStartPercentage,
+			ctx.Config.DistributionOffsetPercentage,
+		)
+		if _, err := signalConfig.Reload(); err != nil {
+			return err
+		}
+		assignedTime := func(signalID string, interval int64, timestamp int64) time.Time {
+			start, offset := signalConfig.Distribution(signalID)
+			return signaller.CalculateAssignedTime(valAddr, interval, timestamp, offset, start)
+		}
+
+		r, err := report.Generate(
+			report.NewChainQuerier(clientCtx, clients),
+			valAddr,
+			fromHeight,
+			toHeight,
+			assignedTime,
+		)
+		if err != nil {
+			return err
+		}
+
+		if output == "json" {
+			bz, err := json.MarshalIndent(r, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		}
+
+		return printReport(cmd, r)
+	}
+}
+
+func printReport(cmd *cobra.Command, r report.Report) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Validator %s from height %d to %d\n", r.Validator, r.FromHeight, r.ToHeight)
+	fmt.Fprintf(w, "Deactivations: %d %v\n\n", len(r.DeactivationHeights), r.DeactivationHeights)
+
+	fmt.Fprintln(w, "SIGNAL\tSUBMISSIONS\tTIMED\tON TIME\tON TIME RATE\tMEAN LAT (S)\tP50 LAT (S)\tP90 LAT (S)\t"+
+		"MAX LAT (S)\tCOMPARED\tMEAN DEV (BPS)\tP90 DEV (BPS)\tMAX DEV (BPS)")
+	for _, s := range r.Signals {
+		fmt.Fprintf(
+			w, "%s\t%d\t%d\t%d\t%.2f%%\t%.1f\t%.0f\t%.0f\t%.0f\t%d\t%.2f\t%.2f\t%.2f\n",
+			s.SignalID, s.Submissions, s.Timed, s.OnTime, s.OnTimeRate*100,
+			s.Latency.Mean, s.Latency.P50, s.Latency.P90, s.Latency.Max,
+			s.Compared, s.Deviation.Mean, s.Deviation.P90, s.Deviation.Max,
+		)
+	}
+
+	fmt.Fprintln(w)
+	header := []string{"SIGNAL"}
+	for _, bound := range report.DeviationBucketBps {
+		header = append(header, fmt.Sprintf("<= %g BPS", bound))
+	}
+	header = append(header, fmt.Sprintf("> %g BPS", report.DeviationBucketBps[len(report.DeviationBucketBps)-1]))
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, s := range r.Signals {
+		row := []string{s.SignalID}
+		for _, count := range s.DeviationBuckets {
+			row = append(row, fmt.Sprintf("%d", count))
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	return w.Flush()
+}
@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"math"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -12,6 +14,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -21,10 +25,15 @@ import (
 	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
 
 	"github.com/bandprotocol/chain/v3/grogu/context"
+	"github.com/bandprotocol/chain/v3/grogu/dryrun"
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
+	"github.com/bandprotocol/chain/v3/grogu/provider"
 	"github.com/bandprotocol/chain/v3/grogu/querier"
+	"github.com/bandprotocol/chain/v3/grogu/signalconfig"
 	"github.com/bandprotocol/chain/v3/grogu/signaller"
 	"github.com/bandprotocol/chain/v3/grogu/submitter"
 	"github.com/bandprotocol/chain/v3/grogu/updater"
+	"github.com/bandprotocol/chain/v3/pkg/gasprice"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 )
 
@@ -34,14 +43,48 @@ const (
 	flagBroadcastTimeout     = "broadcast-timeout"
 	flagRPCPollInterval      = "rpc-poll-interval"
 	flagMaxTry               = "max-try"
+	flagNodeMaxHeightLag     = "node-max-height-lag"
+	flagNodeMaxErrorRate     = "node-max-error-rate"
+	flagNodeErrorWindow      = "node-error-window"
+	flagNodeEjectCooldown    = "node-eject-cooldown"
 	flagBothan               = "bothan"
 	flagBothanTimeout        = "bothan-timeout"
 	flagDistrStartPct        = "distribution-start-pct"
 	flagDistrOffsetPct       = "distribution-offset-pct"
 	flagLogLevel             = "log-level"
 	flagUpdaterQueryInterval = "updater-query-interval"
+	flagGasPricesRefresh     = "gas-prices-refresh-interval"
+	flagPriceProviders       = "price-providers"
+	flagPriceMaxDeviationBps = "price-max-deviation-bps"
+	flagPriceMinProviders    = "price-min-providers"
+	flagMetricsListenAddr    = "metrics-listen-addr"
+	flagHealthMargin         = "health-miss-report-margin"
+	flagSignallerResync      = "signaller-resync-interval"
+	flagGuardMaxJumpBps      = "price-guard-max-jump-bps"
+	flagGuardMaxPrice        = "price-guard-max-price"
+	flagGuardStaleDuration   = "price-guard-stale-duration"
+	flagGuardStaleSignals    = "price-guard-stale-signals"
+	flagGuardAction          = "price-guard-action"
+	flagDryRun               = "dry-run"
+	flagDryRunOutput         = "dry-run-output"
+	flagSignalsConfig        = "signals-config"
+	flagSignalsConfigReload  = "signals-config-reload-interval"
+	flagBatchWindow          = "submitter-batch-window"
+	flagBatchMaxGas          = "submitter-batch-max-gas"
+	flagIPFSGateway          = "registry-ipfs-gateway"
+	flagIPFSTimeout          = "registry-ipfs-timeout"
+	flagRollout              = "registry-rollout"
+	flagRolloutWarmup        = "registry-rollout-warmup"
+	flagRolloutObservation   = "registry-rollout-observation"
+	flagRolloutMaxDrop       = "registry-rollout-max-success-drop"
 )
 
+// defaultDryRunOutput is the file in the home directory where the prices are recorded in dry runs.
+const defaultDryRunOutput = "dry-run.jsonl"
+
+// defaultSignalsConfig is the file in the home directory where the per-signal configuration is read.
+const defaultSignalsConfig = "signals.yaml"
+
 func RunCmd(ctx *context.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "run",
@@ -55,29 +98,170 @@ func RunCmd(ctx *context.Context) *cobra.Command {
 	cmd.Flags().String(flagNodes, "tcp://localhost:26657", "The RPC URLs to connect to.")
 	cmd.Flags().String(flags.FlagChainID, "", "The chain ID of the connected chain.")
 	cmd.Flags().String(flags.FlagGasPrices, "0uband", "The gas prices for transactions.")
+	cmd.Flags().String(flagGasPricesRefresh, "1m", "The interval for refreshing the gas prices required by the chain (0 to disable).")
 	cmd.Flags().String(flagBroadcastTimeout, "1m", "The timeout duration for transaction commits.")
 	cmd.Flags().String(flagRPCPollInterval, "1s", "The duration to wait between RPC polls.")
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of attempts to submit a transaction.")
+	cmd.Flags().Int64(
+		flagNodeMaxHeightLag,
+		5,
+		"The number of blocks a node can lag behind the highest block height seen before it is ejected.",
+	)
+	cmd.Flags().Float64(
+		flagNodeMaxErrorRate,
+		0.5,
+		"The maximum rate of failed requests to a node, between 0 and 1, before it is ejected.",
+	)
+	cmd.Flags().Int(flagNodeErrorWindow, 10, "The number of latest requests to a node its error rate is computed over.")
+	cmd.Flags().String(flagNodeEjectCooldown, "1m", "The duration an unhealthy node is ejected for.")
+	cmd.Flags().String(
+		flagBatchWindow,
+		"0s",
+		"The window for coalescing price submissions into one transaction (0 to disable batching).",
+	)
+	cmd.Flags().Uint64(
+		flagBatchMaxGas,
+		0,
+		"The maximum gas of a batch transaction, above which the batch is split (0 to disable).",
+	)
 	cmd.Flags().Uint64(flagDistrStartPct, 50, "The starting percentage for the distribution offset range.")
 	cmd.Flags().Uint64(flagDistrOffsetPct, 30, "The offset percentage range from the starting distribution.")
 	cmd.Flags().String(flagBothan, "", "The Bothan URL to connect to.")
 	cmd.Flags().String(flagBothanTimeout, "10s", "The timeout duration for Bothan requests.")
+	cmd.Flags().String(
+		flagPriceProviders,
+		"",
+		"Comma-separated additional price providers: Bothan gRPC URLs or HTTP JSON endpoints starting with http:// or https://.",
+	)
+	cmd.Flags().Uint64(flagPriceMaxDeviationBps, 100, "The maximum deviation of a provider's price from the median in basis points.")
+	cmd.Flags().Int(flagPriceMinProviders, 1, "The minimum number of agreeing providers for a price to be available.")
+	cmd.Flags().Uint64(
+		flagGuardMaxJumpBps,
+		0,
+		"The maximum deviation of a price from the on-chain price in basis points (0 to disable).",
+	)
+	cmd.Flags().Uint64(flagGuardMaxPrice, math.MaxInt64, "The maximum price that can be submitted (0 to disable).")
+	cmd.Flags().String(
+		flagGuardStaleDuration,
+		"0s",
+		"The duration after which an unchanged price of a signal in --price-guard-stale-signals is stale (0 to disable).",
+	)
+	cmd.Flags().String(
+		flagGuardStaleSignals,
+		"",
+		"Comma-separated signal IDs whose prices are checked for staleness, excluding flat prices such as stablecoins.",
+	)
+	cmd.Flags().String(
+		flagGuardAction,
+		string(signaller.GuardActionUnavailable),
+		"The action on prices violating the price guard: unavailable or hold.",
+	)
+	cmd.Flags().String(
+		flagMetricsListenAddr,
+		"",
+		"The address to listen on for prometheus metrics and the health endpoint, disabled if empty.",
+	)
+	cmd.Flags().String(
+		flagHealthMargin,
+		"5s",
+		"The health endpoint fails when a signal is not reported within this margin of its miss report deadline.",
+	)
 	cmd.Flags().String(flagLogLevel, "info", "The application's log level.")
 	cmd.Flags().String(flagUpdaterQueryInterval, "1m", "The interval for updater querying chain.")
+	cmd.Flags().String(
+		flagIPFSGateway,
+		"https://ipfs.io",
+		"The IPFS gateway to fetch the registry from, to verify it against its hash before updating Bothan. "+
+			"Empty disables the verification.",
+	)
+	cmd.Flags().String(flagIPFSTimeout, "30s", "The timeout duration for IPFS gateway requests.")
+	cmd.Flags().Bool(
+		flagRollout,
+		false,
+		"Stage new registries, rolling them back if the price fetch success ratio drops (until grogu restarts).",
+	)
+	cmd.Flags().String(
+		flagRolloutWarmup,
+		"1m",
+		"The duration after switching to a staged registry before its dry price fetch, on the next updater query.",
+	)
+	cmd.Flags().String(
+		flagRolloutObservation,
+		"10m",
+		"The duration after switching during which a staged registry is rolled back on a price fetch success drop.",
+	)
+	cmd.Flags().Float64(
+		flagRolloutMaxDrop,
+		0.1,
+		"The maximum drop of the price fetch success ratio, between 0 and 1, before a staged registry is rolled back.",
+	)
+	cmd.Flags().String(
+		flagSignallerResync,
+		"1m",
+		"The interval for the signaller to refresh all chain states regardless of new block events.",
+	)
+	cmd.Flags().Bool(
+		flagDryRun,
+		false,
+		"Run the signaller as if the validator is required to feed prices, recording the prices instead of submitting them.",
+	)
+	cmd.Flags().String(
+		flagDryRunOutput,
+		"",
+		"The file to record the prices to in dry runs, defaults to "+defaultDryRunOutput+" in the home directory.",
+	)
+	cmd.Flags().String(
+		flagSignalsConfig,
+		"",
+		"The per-signal configuration file, defaults to "+defaultSignalsConfig+" in the home directory.",
+	)
+	cmd.Flags().String(
+		flagSignalsConfigReload,
+		"10s",
+		"The interval for reloading the per-signal configuration file on changes (0 to disable).",
+	)
 
 	_ = viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
 	_ = viper.BindPFlag(flagNodes, cmd.Flags().Lookup(flagNodes))
 	_ = viper.BindPFlag(flags.FlagChainID, cmd.Flags().Lookup(flags.FlagChainID))
 	_ = viper.BindPFlag(flags.FlagGasPrices, cmd.Flags().Lookup(flags.FlagGasPrices))
+	_ = viper.BindPFlag(flagGasPricesRefresh, cmd.Flags().Lookup(flagGasPricesRefresh))
 	_ = viper.BindPFlag(flagBroadcastTimeout, cmd.Flags().Lookup(flagBroadcastTimeout))
 	_ = viper.BindPFlag(flagRPCPollInterval, cmd.Flags().Lookup(flagRPCPollInterval))
 	_ = viper.BindPFlag(flagMaxTry, cmd.Flags().Lookup(flagMaxTry))
+	_ = viper.BindPFlag(flagNodeMaxHeightLag, cmd.Flags().Lookup(flagNodeMaxHeightLag))
+	_ = viper.BindPFlag(flagNodeMaxErrorRate, cmd.Flags().Lookup(flagNodeMaxErrorRate))
+	_ = viper.BindPFlag(flagNodeErrorWindow, cmd.Flags().Lookup(flagNodeErrorWindow))
+	_ = viper.BindPFlag(flagNodeEjectCooldown, cmd.Flags().Lookup(flagNodeEjectCooldown))
+	_ = viper.BindPFlag(flagBatchWindow, cmd.Flags().Lookup(flagBatchWindow))
+	_ = viper.BindPFlag(flagBatchMaxGas, cmd.Flags().Lookup(flagBatchMaxGas))
 	_ = viper.BindPFlag(flagDistrStartPct, cmd.Flags().Lookup(flagDistrStartPct))
 	_ = viper.BindPFlag(flagDistrOffsetPct, cmd.Flags().Lookup(flagDistrOffsetPct))
 	_ = viper.BindPFlag(flagBothan, cmd.Flags().Lookup(flagBothan))
 	_ = viper.BindPFlag(flagBothanTimeout, cmd.Flags().Lookup(flagBothanTimeout))
+	_ = viper.BindPFlag(flagPriceProviders, cmd.Flags().Lookup(flagPriceProviders))
+	_ = viper.BindPFlag(flagPriceMaxDeviationBps, cmd.Flags().Lookup(flagPriceMaxDeviationBps))
+	_ = viper.BindPFlag(flagPriceMinProviders, cmd.Flags().Lookup(flagPriceMinProviders))
+	_ = viper.BindPFlag(flagGuardMaxJumpBps, cmd.Flags().Lookup(flagGuardMaxJumpBps))
+	_ = viper.BindPFlag(flagGuardMaxPrice, cmd.Flags().Lookup(flagGuardMaxPrice))
+	_ = viper.BindPFlag(flagGuardStaleDuration, cmd.Flags().Lookup(flagGuardStaleDuration))
+	_ = viper.BindPFlag(flagGuardStaleSignals, cmd.Flags().Lookup(flagGuardStaleSignals))
+	_ = viper.BindPFlag(flagGuardAction, cmd.Flags().Lookup(flagGuardAction))
+	_ = viper.BindPFlag(flagMetricsListenAddr, cmd.Flags().Lookup(flagMetricsListenAddr))
+	_ = viper.BindPFlag(flagHealthMargin, cmd.Flags().Lookup(flagHealthMargin))
 	_ = viper.BindPFlag(flagLogLevel, cmd.Flags().Lookup(flagLogLevel))
 	_ = viper.BindPFlag(flagUpdaterQueryInterval, cmd.Flags().Lookup(flagUpdaterQueryInterval))
+	_ = viper.BindPFlag(flagIPFSGateway, cmd.Flags().Lookup(flagIPFSGateway))
+	_ = viper.BindPFlag(flagIPFSTimeout, cmd.Flags().Lookup(flagIPFSTimeout))
+	_ = viper.BindPFlag(flagRollout, cmd.Flags().Lookup(flagRollout))
+	_ = viper.BindPFlag(flagRolloutWarmup, cmd.Flags().Lookup(flagRolloutWarmup))
+	_ = viper.BindPFlag(flagRolloutObservation, cmd.Flags().Lookup(flagRolloutObservation))
+	_ = viper.BindPFlag(flagRolloutMaxDrop, cmd.Flags().Lookup(flagRolloutMaxDrop))
+	_ = viper.BindPFlag(flagSignallerResync, cmd.Flags().Lookup(flagSignallerResync))
+	_ = viper.BindPFlag(flagDryRun, cmd.Flags().Lookup(flagDryRun))
+	_ = viper.BindPFlag(flagDryRunOutput, cmd.Flags().Lookup(flagDryRunOutput))
+	_ = viper.BindPFlag(flagSignalsConfig, cmd.Flags().Lookup(flagSignalsConfig))
+	_ = viper.BindPFlag(flagSignalsConfigReload, cmd.Flags().Lookup(flagSignalsConfigReload))
 
 	return cmd
 }
@@ -110,13 +294,33 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 		}
 		defer stopClients()
 
+		// Set up the pool of node health
+		nodeEjectCooldown, err := time.ParseDuration(ctx.Config.NodeEjectCooldown)
+		if err != nil {
+			return err
+		}
+		nodePoolConfig := querier.NodePoolConfig{
+			MaxHeightLag: ctx.Config.NodeMaxHeightLag,
+			MaxErrorRate: ctx.Config.NodeMaxErrorRate,
+			ErrorWindow:  ctx.Config.NodeErrorWindow,
+			Cooldown:     nodeEjectCooldown,
+		}
+		if err := nodePoolConfig.Validate(); err != nil {
+			return err
+		}
+		nodeNames := make([]string, len(clients))
+		for i, c := range clients {
+			nodeNames[i] = c.Remote()
+		}
+		nodePool := querier.NewNodePool(nodeNames, nodePoolConfig, l)
+
 		// Set up Queriers
 		maxBlockHeight := new(atomic.Int64)
 		maxBlockHeight.Store(0)
 
-		authQuerier := querier.NewAuthQuerier(clientCtx, clients, maxBlockHeight)
-		feedQuerier := querier.NewFeedQuerier(clientCtx, clients, maxBlockHeight)
-		txQuerier := querier.NewTxQuerier(clientCtx, clients)
+		authQuerier := querier.NewAuthQuerier(clientCtx, clients, maxBlockHeight, nodePool)
+		feedQuerier := querier.NewFeedQuerier(clientCtx, clients, maxBlockHeight, nodePool)
+		txQuerier := querier.NewTxQuerier(clientCtx, clients, nodePool)
 
 		// Setup Bothan service
 		timeout, err := time.ParseDuration(ctx.Config.BothanTimeout)
@@ -128,6 +332,45 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 			return err
 		}
 
+		// Setup price providers, with Bothan as the first one
+		providers, err := createPriceProviders(ctx.Config.PriceProviders, timeout)
+		if err != nil {
+			return err
+		}
+		providers = append([]provider.Provider{provider.NewBothanProvider(ctx.Config.Bothan, bothanService)}, providers...)
+		priceAggregator, err := provider.NewAggregator(
+			providers,
+			l,
+			ctx.Config.PriceMaxDeviationBps,
+			ctx.Config.PriceMinProviders,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Set up per-signal config, which is reloaded on changes
+		signalsConfigPath := ctx.Config.SignalsConfig
+		if signalsConfigPath == "" {
+			signalsConfigPath = filepath.Join(ctx.Home, defaultSignalsConfig)
+		}
+		signalsConfigReloadInterval, err := time.ParseDuration(ctx.Config.SignalsConfigReloadInterval)
+		if err != nil {
+			return err
+		}
+		signalConfig := signalconfig.NewStore(
+			signalsConfigPath,
+			ctx.Config.DistributionStartPercentage,
+			ctx.Config.DistributionOffsetPercentage,
+		)
+		signalConfig.Subscribe(func(st *signalconfig.Store) {
+			if err := priceAggregator.SetPins(st.Pins()); err != nil {
+				l.Error("[SignalConfig] failed to pin signals to price providers: %v", err)
+			}
+		})
+		if err := signalConfig.Start(signalsConfigReloadInterval, l); err != nil {
+			return err
+		}
+
 		// Create submit channel
 		submitSignalPriceCh := make(chan submitter.SignalPriceSubmission, 300)
 
@@ -149,48 +392,164 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 			return err
 		}
 
+		// Parse submitter batch window
+		batchWindow, err := time.ParseDuration(ctx.Config.SubmitterBatchWindow)
+		if err != nil {
+			return err
+		}
+
 		// Parse Updater query interval
 		updaterQueryInterval, err := time.ParseDuration(ctx.Config.UpdaterQueryInterval)
 		if err != nil {
 			return err
 		}
 
-		// Initialize pending signal IDs map
+		// Set up registry verification and staged rollout
+		ipfsTimeout, err := time.ParseDuration(ctx.Config.RegistryIPFSTimeout)
+		if err != nil {
+			return err
+		}
+		var registryVerifier *updater.RegistryVerifier
+		if ctx.Config.RegistryIPFSGateway != "" {
+			registryVerifier = updater.NewRegistryVerifier(ctx.Config.RegistryIPFSGateway, ipfsTimeout)
+		} else {
+			l.Warn("Registry verification is disabled, Bothan is updated to registries without verifying them")
+		}
+		rolloutWarmup, err := time.ParseDuration(ctx.Config.RegistryRolloutWarmup)
+		if err != nil {
+			return err
+		}
+		rolloutObservation, err := time.ParseDuration(ctx.Config.RegistryRolloutObservation)
+		if err != nil {
+			return err
+		}
+		rolloutConfig := updater.RolloutConfig{
+			Enabled:        ctx.Config.RegistryRollout,
+			Warmup:         rolloutWarmup,
+			Observation:    rolloutObservation,
+			MaxSuccessDrop: ctx.Config.RegistryRolloutMaxSuccessDrop,
+		}
+		if err := rolloutConfig.Validate(); err != nil {
+			return err
+		}
+
+		// Set up gas price provider
+		gasPricesRefreshInterval, err := time.ParseDuration(ctx.Config.GasPricesRefreshInterval)
+		if err != nil {
+			return err
+		}
+		gasPriceQueriers := make([]gasprice.Querier, len(clients))
+		for i, c := range clients {
+			gasPriceQueriers[i] = gasprice.NewGRPCQuerier(clientCtx.WithClient(c))
+		}
+		gasPriceProvider, err := gasprice.NewProvider(ctx.Config.GasPrices, gasPriceQueriers...)
+		if err != nil {
+			return err
+		}
+		gasPriceProvider.Start(gasPricesRefreshInterval, l)
+
+		// Set up price guard
+		guardStaleDuration, err := time.ParseDuration(ctx.Config.PriceGuardStaleDuration)
+		if err != nil {
+			return err
+		}
+		var guardStaleSignalIDs []string
+		if ctx.Config.PriceGuardStaleSignals != "" {
+			guardStaleSignalIDs = strings.Split(ctx.Config.PriceGuardStaleSignals, ",")
+		}
+		guardConfig := signaller.GuardConfig{
+			MaxJumpBps:     ctx.Config.PriceGuardMaxJumpBps,
+			MaxPrice:       ctx.Config.PriceGuardMaxPrice,
+			StaleDuration:  guardStaleDuration,
+			StaleSignalIDs: guardStaleSignalIDs,
+			Action:         signaller.GuardAction(ctx.Config.PriceGuardAction),
+		}
+		if err := guardConfig.Validate(); err != nil {
+			return err
+		}
+		priceGuard := signaller.NewGuard(guardConfig)
+
+		// Parse health miss report margin
+		healthMargin, err := time.ParseDuration(ctx.Config.HealthMissReportMargin)
+		if err != nil {
+			return err
+		}
+
+		// Initialize pending signal IDs map and metrics
 		pendingSignalIDs := sync.Map{}
+		groguMetrics := metrics.New()
+
+		// Parse signaller resync interval
+		signallerResyncInterval, err := time.ParseDuration(ctx.Config.SignallerResyncInterval)
+		if err != nil {
+			return err
+		}
+
+		// Setup Submitter, or a recorder of the would-be submissions in dry runs
+		signallerFeedQuerier := signaller.FeedQuerier(feedQuerier)
+		var startSubmitter func()
+		if ctx.Config.DryRun {
+			outputPath := ctx.Config.DryRunOutput
+			if outputPath == "" {
+				outputPath = filepath.Join(ctx.Home, defaultDryRunOutput)
+			}
+			output, err := os.OpenFile(outputPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+			if err != nil {
+				return err
+			}
+			defer output.Close()
+
+			l.Info("Running in dry run mode, recording prices to %s instead of submitting them", outputPath)
+			recorder := dryrun.NewRecorder(feedQuerier, l, submitSignalPriceCh, valAddr, &pendingSignalIDs, output)
+			signallerFeedQuerier = dryrun.NewFeedQuerier(feedQuerier, recorder)
+			startSubmitter = recorder.Start
+		} else {
+			submitterService, err := submitter.New(
+				clientCtx,
+				clients,
+				nodePool,
+				bothanService,
+				l,
+				submitSignalPriceCh,
+				authQuerier,
+				txQuerier,
+				feedQuerier,
+				valAddr,
+				&pendingSignalIDs,
+				broadcastTimeout,
+				ctx.Config.MaxTry,
+				rpcPollInterval,
+				gasPriceProvider,
+				groguMetrics,
+				batchWindow,
+				ctx.Config.SubmitterBatchMaxGas,
+			)
+			if err != nil {
+				return err
+			}
+			startSubmitter = submitterService.Start
+		}
 
 		// Setup Signaller
+		eventClients := make([]rpcclient.EventsClient, len(clients))
+		for i, c := range clients {
+			eventClients[i] = c
+		}
 		signallerService := signaller.New(
-			feedQuerier,
-			bothanService,
+			signallerFeedQuerier,
+			priceAggregator,
+			eventClients,
 			time.Second,
+			signallerResyncInterval,
 			submitSignalPriceCh,
 			l,
 			valAddr,
 			&pendingSignalIDs,
-			ctx.Config.DistributionStartPercentage,
-			ctx.Config.DistributionOffsetPercentage,
+			priceGuard,
+			groguMetrics,
+			signalConfig,
 		)
 
-		// Setup Submitter
-		submitterService, err := submitter.New(
-			clientCtx,
-			clients,
-			bothanService,
-			l,
-			submitSignalPriceCh,
-			authQuerier,
-			txQuerier,
-			valAddr,
-			&pendingSignalIDs,
-			broadcastTimeout,
-			ctx.Config.MaxTry,
-			rpcPollInterval,
-			ctx.Config.GasPrices,
-		)
-		if err != nil {
-			return err
-		}
-
 		// Setup Updater
 		maxCurrentFeedEventHeight := new(atomic.Int64)
 		maxCurrentFeedEventHeight.Store(0)
@@ -204,16 +563,34 @@ func createRunE(ctx *context.Context) func(cmd *cobra.Command, args []string) er
 			clients,
 			l,
 			updaterQueryInterval,
+			registryVerifier,
+			rolloutConfig,
 		)
 
 		// Listen for termination signals for graceful shutdown
 		sigChan := make(chan os.Signal, 1)
 		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
-		// Start all services
-		go updaterService.Start(sigChan)
+		// Start metrics listener
+		if ctx.Config.MetricsListenAddr != "" {
+			l.Info("Starting Prometheus listener")
+			go metricsListen(
+				ctx.Config.MetricsListenAddr,
+				metrics.NewHealthHandler(groguMetrics, healthMargin),
+				metrics.NewCollector(groguMetrics, &pendingSignalIDs),
+				provider.NewCollector(priceAggregator),
+				signaller.NewCollector(priceGuard),
+				updater.NewCollector(updaterService),
+				querier.NewCollector(nodePool),
+			)
+		}
+
+		// Start all services, leaving the Bothan registry untouched in dry runs
+		if !ctx.Config.DryRun {
+			go updaterService.Start(sigChan)
+		}
 		go signallerService.Start()
-		go submitterService.Start()
+		go startSubmitter()
 
 		l.Info("Grogu has started")
 
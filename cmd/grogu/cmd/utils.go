@@ -2,9 +2,19 @@ package cmd
 
 import (
 	"fmt"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/client/http"
+
+	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
+
+	"github.com/bandprotocol/chain/v3/grogu/provider"
 )
 
 func createClients(nodeURIs []string) ([]rpcclient.RemoteClient, func(), error) {
@@ -35,3 +45,48 @@ func createClients(nodeURIs []string) ([]rpcclient.RemoteClient, func(), error)
 
 	return clients, stopClients, nil
 }
+
+// createPriceProviders creates the price providers from the comma-separated list of URLs, where
+// URLs starting with http:// or https:// are HTTP JSON endpoints and the others are Bothan gRPC URLs.
+func createPriceProviders(urls string, timeout time.Duration) ([]provider.Provider, error) {
+	providers := make([]provider.Provider, 0)
+	for _, url := range strings.Split(urls, ",") {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+
+		if strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") {
+			httpProvider, err := provider.NewHTTPProvider(url, timeout)
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, httpProvider)
+			continue
+		}
+
+		bothanClient, err := bothanclient.NewGrpcClient(url, timeout)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider.NewBothanProvider(url, bothanClient))
+	}
+
+	return providers, nil
+}
+
+// metricsListen serves the prometheus metrics of the given collectors and the health endpoint on the given address.
+func metricsListen(listenAddr string, health nethttp.Handler, collectors ...prometheus.Collector) {
+	prometheus.MustRegister(collectors...)
+	nethttp.Handle("/metrics", promhttp.Handler())
+	nethttp.Handle("/healthz", health)
+
+	server := &nethttp.Server{
+		Addr:              listenAddr,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	if err := server.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
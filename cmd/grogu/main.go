@@ -46,6 +46,8 @@ func createRootCmd(ctx *context.Context) *cobra.Command {
 		cmd.ConfigCmd(),
 		cmd.KeysCmd(ctx),
 		cmd.RunCmd(ctx),
+		cmd.DryRunReportCmd(ctx),
+		cmd.ReportCmd(ctx),
 		version.NewVersionCommand(),
 	)
 
@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	cylinderctx "github.com/bandprotocol/chain/v3/cylinder/context"
+	"github.com/bandprotocol/chain/v3/pkg/gasprice"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/pkg/tss"
 	bandtsstypes "github.com/bandprotocol/chain/v3/x/bandtss/types"
@@ -28,9 +29,10 @@ import (
 )
 
 type Client struct {
-	client    rpcclient.Client // RPC client for communication with the node.
-	context   client.Context   // Context that holds the client's configuration and context.
-	txFactory tx.Factory       // Factory for creating and handling transactions.
+	client    rpcclient.Client   // RPC client for communication with the node.
+	context   client.Context     // Context that holds the client's configuration and context.
+	txFactory tx.Factory         // Factory for creating and handling transactions.
+	gasPrices *gasprice.Provider // Provider of the gas prices of transactions.
 
 	maxTry       uint64        // Maximum number of tries to submit a transaction.
 	timeout      time.Duration // Timeout duration for waiting for transaction commits.
@@ -72,14 +74,20 @@ func New(cylinderCtx *cylinderctx.Context) (*Client, error) {
 		WithChainID(ctx.ChainID).
 		WithKeybase(ctx.Keyring).
 		WithAccountRetriever(ctx.AccountRetriever).
-		WithGasPrices(cfg.GasPrices).
 		WithSimulateAndExecute(true)
 
+	// Create a gas price provider that raises the configured gas prices to the requirement of the chain
+	gasPrices, err := gasprice.NewProvider(cfg.GasPrices, gasprice.NewGRPCQuerier(ctx))
+	if err != nil {
+		return nil, err
+	}
+
 	// Create and return the Client instance with the initialized fields
 	return &Client{
 		client:         c,
 		context:        ctx,
 		txFactory:      txf,
+		gasPrices:      gasPrices,
 		timeout:        cfg.BroadcastTimeout,
 		pollInterval:   cfg.RPCPollInterval,
 		maxTry:         cfg.MaxTry,
@@ -88,6 +96,11 @@ func New(cylinderCtx *cylinderctx.Context) (*Client, error) {
 	}, nil
 }
 
+// StartGasPricesRefresh refreshes the gas prices of the client every interval in the background.
+func (c *Client) StartGasPricesRefresh(interval time.Duration, logger *logger.Logger) {
+	c.gasPrices.Start(interval, logger)
+}
+
 // Subscribe subscribes to an event query with the provided subscriber and query string.
 // It returns a channel of ResultEvent to receive the subscribed events and an error if any.
 func (c *Client) Subscribe(subscriber, query string, outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
@@ -283,6 +296,7 @@ func (c *Client) Broadcast(key *keyring.Record, msgs []sdk.Msg, gasAdjust float6
 	txf := c.txFactory.WithAccountNumber(acc.GetAccountNumber()).
 		WithSequence(acc.GetSequence()).
 		WithGasAdjustment(gasAdjust).
+		WithGasPrices(c.gasPrices.GasPrices()).
 		WithFromName(key.Name)
 
 	execMsg := authz.NewMsgExec(address, msgs)
@@ -51,6 +51,8 @@ func New(ctx *context.Context) (*Sender, error) {
 func (s *Sender) Start() {
 	s.logger.Info("start")
 
+	s.client.StartGasPricesRefresh(s.context.Config.GasPricesRefreshInterval, s.logger)
+
 	for key := range s.freeKeys {
 		msgs := s.collectMsgs()
 		go s.sendMsgs(key, msgs)
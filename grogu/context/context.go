@@ -27,9 +27,30 @@ type Config struct {
 	// MaxTry is the maximum number of attempts to submit a transaction.
 	MaxTry uint64 `mapstructure:"max-try"`
 
+	// NodeMaxHeightLag is the number of blocks a node can lag behind before it is ejected.
+	NodeMaxHeightLag int64 `mapstructure:"node-max-height-lag"`
+
+	// NodeMaxErrorRate is the maximum rate of failed requests to a node before it is ejected.
+	NodeMaxErrorRate float64 `mapstructure:"node-max-error-rate"`
+
+	// NodeErrorWindow is the number of latest requests to a node its error rate is computed over.
+	NodeErrorWindow int `mapstructure:"node-error-window"`
+
+	// NodeEjectCooldown is the duration an unhealthy node is ejected for.
+	NodeEjectCooldown string `mapstructure:"node-eject-cooldown"`
+
+	// SubmitterBatchWindow is the window for coalescing price submissions into one transaction.
+	SubmitterBatchWindow string `mapstructure:"submitter-batch-window"`
+
+	// SubmitterBatchMaxGas is the maximum gas of a batch transaction, above which the batch is split.
+	SubmitterBatchMaxGas uint64 `mapstructure:"submitter-batch-max-gas"`
+
 	// GasPrices is the gas price set for each transaction.
 	GasPrices string `mapstructure:"gas-prices"`
 
+	// GasPricesRefreshInterval is the interval for refreshing the gas prices required by the chain.
+	GasPricesRefreshInterval string `mapstructure:"gas-prices-refresh-interval"`
+
 	// DistributionStartPercentage defines the initial percentage for price distribution.
 	DistributionStartPercentage uint64 `mapstructure:"distribution-start-pct"`
 
@@ -42,11 +63,76 @@ type Config struct {
 	// BothanTimeout is the timeout duration for Bothan requests.
 	BothanTimeout string `mapstructure:"bothan-timeout"`
 
+	// PriceProviders are the additional price providers queried along with Bothan.
+	PriceProviders string `mapstructure:"price-providers"`
+
+	// PriceMaxDeviationBps is the maximum deviation of a provider's price from the median in basis points.
+	PriceMaxDeviationBps uint64 `mapstructure:"price-max-deviation-bps"`
+
+	// PriceMinProviders is the minimum number of agreeing providers for a price to be available.
+	PriceMinProviders int `mapstructure:"price-min-providers"`
+
+	// PriceGuardMaxJumpBps is the maximum deviation of a price from the on-chain price in basis points.
+	PriceGuardMaxJumpBps uint64 `mapstructure:"price-guard-max-jump-bps"`
+
+	// PriceGuardMaxPrice is the maximum price that can be submitted.
+	PriceGuardMaxPrice uint64 `mapstructure:"price-guard-max-price"`
+
+	// PriceGuardStaleDuration is the duration after which a price of a stale-checked signal that has not
+	// changed is considered stale.
+	PriceGuardStaleDuration string `mapstructure:"price-guard-stale-duration"`
+
+	// PriceGuardStaleSignals is the comma-separated signal IDs whose prices are checked for staleness.
+	PriceGuardStaleSignals string `mapstructure:"price-guard-stale-signals"`
+
+	// PriceGuardAction is the action on prices violating the price guard.
+	PriceGuardAction string `mapstructure:"price-guard-action"`
+
+	// MetricsListenAddr is the address to listen on for prometheus metrics.
+	MetricsListenAddr string `mapstructure:"metrics-listen-addr"`
+
+	// HealthMissReportMargin is the margin of the miss report deadlines within which the health endpoint fails.
+	HealthMissReportMargin string `mapstructure:"health-miss-report-margin"`
+
 	// LogLevel is the level of logging for the logger.
 	LogLevel string `mapstructure:"log-level"`
 
 	// UpdaterQueryInterval is the interval for updater querying chain.
 	UpdaterQueryInterval string `mapstructure:"updater-query-interval"`
+
+	// RegistryIPFSGateway is the IPFS gateway to fetch the registry from for verification, where
+	// empty disables the verification.
+	RegistryIPFSGateway string `mapstructure:"registry-ipfs-gateway"`
+
+	// RegistryIPFSTimeout is the timeout duration for IPFS gateway requests.
+	RegistryIPFSTimeout string `mapstructure:"registry-ipfs-timeout"`
+
+	// RegistryRollout is whether new registries are staged and rolled back on price fetch success drops.
+	RegistryRollout bool `mapstructure:"registry-rollout"`
+
+	// RegistryRolloutWarmup is the duration after switching to a staged registry before its dry price fetch.
+	RegistryRolloutWarmup string `mapstructure:"registry-rollout-warmup"`
+
+	// RegistryRolloutObservation is the duration during which a staged registry can be rolled back.
+	RegistryRolloutObservation string `mapstructure:"registry-rollout-observation"`
+
+	// RegistryRolloutMaxSuccessDrop is the maximum drop of the price fetch success ratio of a staged registry.
+	RegistryRolloutMaxSuccessDrop float64 `mapstructure:"registry-rollout-max-success-drop"`
+
+	// DryRun is whether to record the prices instead of submitting them.
+	DryRun bool `mapstructure:"dry-run"`
+
+	// DryRunOutput is the file to record the prices to in dry runs.
+	DryRunOutput string `mapstructure:"dry-run-output"`
+
+	// SignallerResyncInterval is the interval for the signaller to refresh all chain states regardless of events.
+	SignallerResyncInterval string `mapstructure:"signaller-resync-interval"`
+
+	// SignalsConfig is the per-signal configuration file.
+	SignalsConfig string `mapstructure:"signals-config"`
+
+	// SignalsConfigReloadInterval is the interval for reloading the per-signal configuration file on changes.
+	SignalsConfigReloadInterval string `mapstructure:"signals-config-reload-interval"`
 }
 
 // Context holds the runtime context for the application.
@@ -0,0 +1,36 @@
+package dryrun
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/grogu/signaller"
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+var _ signaller.FeedQuerier = &FeedQuerier{}
+
+// FeedQuerier is a signaller.FeedQuerier for dry runs. The validator is always treated as required
+// to feed prices, and its prices are the ones recorded by the Recorder instead of the ones on chain,
+// so that the signaller behaves as if the recorded submissions were committed.
+type FeedQuerier struct {
+	signaller.FeedQuerier
+	recorder *Recorder
+}
+
+// NewFeedQuerier creates a new FeedQuerier on top of the given querier.
+func NewFeedQuerier(feedQuerier signaller.FeedQuerier, recorder *Recorder) *FeedQuerier {
+	return &FeedQuerier{
+		FeedQuerier: feedQuerier,
+		recorder:    recorder,
+	}
+}
+
+// QueryValidValidator implements signaller.FeedQuerier.
+func (q *FeedQuerier) QueryValidValidator(valAddress sdk.ValAddress) (*feeds.QueryValidValidatorResponse, error) {
+	return &feeds.QueryValidValidatorResponse{Valid: true}, nil
+}
+
+// QueryValidatorPrices implements signaller.FeedQuerier.
+func (q *FeedQuerier) QueryValidatorPrices(valAddress sdk.ValAddress) (*feeds.QueryValidatorPricesResponse, error) {
+	return &feeds.QueryValidatorPricesResponse{ValidatorPrices: q.recorder.ValidatorPrices()}, nil
+}
@@ -0,0 +1,175 @@
+package dryrun
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/grogu/submitter"
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// PriceQuerier queries the current on-chain prices.
+type PriceQuerier interface {
+	QueryPrices(signalIDs []string) (*feeds.QueryPricesResponse, error)
+}
+
+// PriceRecord is a price that would have been submitted, compared with the on-chain price.
+type PriceRecord struct {
+	SignalID    string `json:"signal_id"`
+	Status      string `json:"status"`
+	Price       uint64 `json:"price"`
+	ChainStatus string `json:"chain_status"`
+	ChainPrice  uint64 `json:"chain_price"`
+	// DeviationBps is the deviation from the on-chain price in basis points, only set if both
+	// prices are available.
+	DeviationBps *float64 `json:"deviation_bps,omitempty"`
+}
+
+// Record is a MsgSubmitSignalPrices that would have been broadcast.
+type Record struct {
+	Validator string        `json:"validator"`
+	Timestamp int64         `json:"timestamp"`
+	UUID      string        `json:"uuid"`
+	Prices    []PriceRecord `json:"prices"`
+}
+
+// Recorder takes the place of the submitter in dry runs. Instead of broadcasting the prices, it
+// records them along with the on-chain prices as JSON lines to the output.
+type Recorder struct {
+	priceQuerier        PriceQuerier
+	logger              *logger.Logger
+	submitSignalPriceCh <-chan submitter.SignalPriceSubmission
+	valAddress          sdk.ValAddress
+	pendingSignalIDs    *sync.Map
+	out                 io.Writer
+
+	mu              sync.Mutex
+	validatorPrices map[string]feeds.ValidatorPrice
+}
+
+// NewRecorder creates a new Recorder writing to the given output.
+func NewRecorder(
+	priceQuerier PriceQuerier,
+	logger *logger.Logger,
+	submitSignalPriceCh <-chan submitter.SignalPriceSubmission,
+	valAddress sdk.ValAddress,
+	pendingSignalIDs *sync.Map,
+	out io.Writer,
+) *Recorder {
+	return &Recorder{
+		priceQuerier:        priceQuerier,
+		logger:              logger,
+		submitSignalPriceCh: submitSignalPriceCh,
+		valAddress:          valAddress,
+		pendingSignalIDs:    pendingSignalIDs,
+		out:                 out,
+		validatorPrices:     make(map[string]feeds.ValidatorPrice),
+	}
+}
+
+func (r *Recorder) Start() {
+	for priceSubmission := range r.submitSignalPriceCh {
+		r.record(priceSubmission, time.Now())
+	}
+}
+
+// ValidatorPrices returns the last recorded price of each signal.
+func (r *Recorder) ValidatorPrices() []feeds.ValidatorPrice {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prices := make([]feeds.ValidatorPrice, 0, len(r.validatorPrices))
+	for _, price := range r.validatorPrices {
+		prices = append(prices, price)
+	}
+	return prices
+}
+
+func (r *Recorder) record(priceSubmission submitter.SignalPriceSubmission, now time.Time) {
+	signalPrices := priceSubmission.SignalPrices
+	defer r.removePending(signalPrices)
+
+	signalIDs := make([]string, 0, len(signalPrices))
+	for _, p := range signalPrices {
+		signalIDs = append(signalIDs, p.SignalID)
+	}
+
+	chainPrices := make(map[string]feeds.Price)
+	resp, err := r.priceQuerier.QueryPrices(signalIDs)
+	if err != nil {
+		r.logger.Error("[DryRun] failed to query on-chain prices: %v", err)
+	} else {
+		for _, price := range resp.Prices {
+			chainPrices[price.SignalID] = price
+		}
+	}
+
+	record := Record{
+		Validator: r.valAddress.String(),
+		Timestamp: now.Unix(),
+		UUID:      priceSubmission.UUID,
+		Prices:    make([]PriceRecord, 0, len(signalPrices)),
+	}
+	for _, p := range signalPrices {
+		chainPrice := chainPrices[p.SignalID]
+		priceRecord := PriceRecord{
+			SignalID:    p.SignalID,
+			Status:      p.Status.String(),
+			Price:       p.Price,
+			ChainStatus: chainPrice.Status.String(),
+			ChainPrice:  chainPrice.Price,
+		}
+		if p.Status == feeds.SIGNAL_PRICE_STATUS_AVAILABLE &&
+			chainPrice.Status == feeds.PRICE_STATUS_AVAILABLE && chainPrice.Price != 0 {
+			deviation := math.Abs(float64(p.Price)-float64(chainPrice.Price)) * 10000 / float64(chainPrice.Price)
+			priceRecord.DeviationBps = &deviation
+		}
+		record.Prices = append(record.Prices, priceRecord)
+
+		r.logger.Debug(
+			"[DryRun] %s: %s %d, chain: %s %d",
+			p.SignalID, priceRecord.Status, p.Price, priceRecord.ChainStatus, chainPrice.Price,
+		)
+	}
+
+	r.setValidatorPrices(signalPrices, now)
+
+	bz, err := json.Marshal(record)
+	if err != nil {
+		r.logger.Error("[DryRun] failed to encode record: %v", err)
+		return
+	}
+	if _, err := r.out.Write(append(bz, '\n')); err != nil {
+		r.logger.Error("[DryRun] failed to write record: %v", err)
+		return
+	}
+
+	r.logger.Info("[DryRun] recorded %d prices that would have been submitted", len(signalPrices))
+}
+
+// setValidatorPrices keeps the prices as if they were committed on chain.
+func (r *Recorder) setValidatorPrices(signalPrices []feeds.SignalPrice, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range signalPrices {
+		r.validatorPrices[p.SignalID] = feeds.ValidatorPrice{
+			SignalPriceStatus: p.Status,
+			SignalID:          p.SignalID,
+			Price:             p.Price,
+			Timestamp:         now.Unix(),
+		}
+	}
+}
+
+func (r *Recorder) removePending(prices []feeds.SignalPrice) {
+	for _, p := range prices {
+		r.pendingSignalIDs.Delete(p.SignalID)
+	}
+}
@@ -0,0 +1,102 @@
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/grogu/submitter"
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+type mockPriceQuerier struct {
+	prices []feeds.Price
+	err    error
+}
+
+func (q *mockPriceQuerier) QueryPrices(signalIDs []string) (*feeds.QueryPricesResponse, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &feeds.QueryPricesResponse{Prices: q.prices}, nil
+}
+
+func newTestRecorder(q PriceQuerier, pendingSignalIDs *sync.Map, out *bytes.Buffer) *Recorder {
+	allowLevel, _ := log.ParseLogLevel("error")
+	return NewRecorder(q, logger.NewLogger(allowLevel), nil, sdk.ValAddress("1000000001"), pendingSignalIDs, out)
+}
+
+func TestRecorderRecord(t *testing.T) {
+	q := &mockPriceQuerier{prices: []feeds.Price{
+		{Status: feeds.PRICE_STATUS_AVAILABLE, SignalID: "BTC", Price: 1000},
+		{Status: feeds.PRICE_STATUS_NOT_READY, SignalID: "ETH"},
+	}}
+	pendingSignalIDs := sync.Map{}
+	pendingSignalIDs.Store("BTC", struct{}{})
+	pendingSignalIDs.Store("ETH", struct{}{})
+	var out bytes.Buffer
+	r := newTestRecorder(q, &pendingSignalIDs, &out)
+
+	now := time.Unix(100, 0)
+	r.record(submitter.SignalPriceSubmission{
+		SignalPrices: []feeds.SignalPrice{
+			{Status: feeds.SIGNAL_PRICE_STATUS_AVAILABLE, SignalID: "BTC", Price: 1010},
+			{Status: feeds.SIGNAL_PRICE_STATUS_AVAILABLE, SignalID: "ETH", Price: 50},
+		},
+		UUID: "uuid",
+	}, now)
+
+	var record Record
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+	require.Equal(t, sdk.ValAddress("1000000001").String(), record.Validator)
+	require.Equal(t, int64(100), record.Timestamp)
+	require.Equal(t, "uuid", record.UUID)
+	require.Len(t, record.Prices, 2)
+	require.Equal(t, uint64(1000), record.Prices[0].ChainPrice)
+	require.InDelta(t, 100, *record.Prices[0].DeviationBps, 1e-9)
+	require.Equal(t, feeds.PRICE_STATUS_NOT_READY.String(), record.Prices[1].ChainStatus)
+	require.Nil(t, record.Prices[1].DeviationBps)
+
+	// The prices are no longer pending and are kept as the validator prices.
+	_, ok := pendingSignalIDs.Load("BTC")
+	require.False(t, ok)
+	require.ElementsMatch(t, []feeds.ValidatorPrice{
+		{SignalPriceStatus: feeds.SIGNAL_PRICE_STATUS_AVAILABLE, SignalID: "BTC", Price: 1010, Timestamp: 100},
+		{SignalPriceStatus: feeds.SIGNAL_PRICE_STATUS_AVAILABLE, SignalID: "ETH", Price: 50, Timestamp: 100},
+	}, r.ValidatorPrices())
+
+	querier := NewFeedQuerier(nil, r)
+	valid, err := querier.QueryValidValidator(nil)
+	require.NoError(t, err)
+	require.True(t, valid.Valid)
+	valPrices, err := querier.QueryValidatorPrices(nil)
+	require.NoError(t, err)
+	require.Len(t, valPrices.ValidatorPrices, 2)
+}
+
+func TestRecorderRecordWithoutChainPrices(t *testing.T) {
+	var out bytes.Buffer
+	r := newTestRecorder(&mockPriceQuerier{err: errors.New("connection refused")}, &sync.Map{}, &out)
+
+	r.record(submitter.SignalPriceSubmission{
+		SignalPrices: []feeds.SignalPrice{
+			{Status: feeds.SIGNAL_PRICE_STATUS_UNAVAILABLE, SignalID: "BTC"},
+		},
+	}, time.Unix(100, 0))
+
+	var record Record
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+	require.Len(t, record.Prices, 1)
+	require.Equal(t, feeds.SIGNAL_PRICE_STATUS_UNAVAILABLE.String(), record.Prices[0].Status)
+	require.Nil(t, record.Prices[0].DeviationBps)
+}
@@ -0,0 +1,120 @@
+package dryrun
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// maxRecordSize is the maximum size of a record line in the dry run output.
+const maxRecordSize = 16 * 1024 * 1024
+
+// Summary is the accuracy of the recorded prices, either of a signal or of a period, keyed by the
+// signal ID or the start time of the period respectively.
+type Summary struct {
+	Key string
+	// Submissions is the number of recorded prices.
+	Submissions int
+	// Unavailable is the number of recorded prices that were not available.
+	Unavailable int
+	// Compared is the number of recorded prices compared with an available on-chain price.
+	Compared         int
+	MeanDeviationBps float64
+	MaxDeviationBps  float64
+	FirstTimestamp   int64
+	LastTimestamp    int64
+}
+
+func (s *Summary) add(timestamp int64, price PriceRecord) {
+	if s.Submissions == 0 || timestamp < s.FirstTimestamp {
+		s.FirstTimestamp = timestamp
+	}
+	if timestamp > s.LastTimestamp {
+		s.LastTimestamp = timestamp
+	}
+	s.Submissions++
+
+	if price.Status != feeds.SIGNAL_PRICE_STATUS_AVAILABLE.String() {
+		s.Unavailable++
+	}
+	if price.DeviationBps == nil {
+		return
+	}
+
+	deviation := *price.DeviationBps
+	s.MeanDeviationBps = (s.MeanDeviationBps*float64(s.Compared) + deviation) / float64(s.Compared+1)
+	s.Compared++
+	if deviation > s.MaxDeviationBps {
+		s.MaxDeviationBps = deviation
+	}
+}
+
+// Report is the summary of a dry run output, by signal and by period.
+type Report struct {
+	Signals []Summary
+	Periods []Summary
+}
+
+// Summarize reads the records of a dry run output and summarizes the accuracy of the prices by
+// signal, and by period of the given length in seconds if it is positive.
+func Summarize(r io.Reader, period int64) (Report, error) {
+	signals := make(map[string]*Summary)
+	periods := make(map[int64]*Summary)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return Report{}, fmt.Errorf("invalid record at line %d: %w", line, err)
+		}
+
+		for _, price := range record.Prices {
+			if _, ok := signals[price.SignalID]; !ok {
+				signals[price.SignalID] = &Summary{Key: price.SignalID}
+			}
+			signals[price.SignalID].add(record.Timestamp, price)
+
+			if period <= 0 {
+				continue
+			}
+			start := record.Timestamp - record.Timestamp%period
+			if _, ok := periods[start]; !ok {
+				periods[start] = &Summary{Key: time.Unix(start, 0).UTC().Format(time.RFC3339)}
+			}
+			periods[start].add(record.Timestamp, price)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Report{}, err
+	}
+
+	report := Report{
+		Signals: make([]Summary, 0, len(signals)),
+		Periods: make([]Summary, 0, len(periods)),
+	}
+	for _, s := range signals {
+		report.Signals = append(report.Signals, *s)
+	}
+	sort.Slice(report.Signals, func(i, j int) bool { return report.Signals[i].Key < report.Signals[j].Key })
+
+	starts := make([]int64, 0, len(periods))
+	for start := range periods {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+	for _, start := range starts {
+		report.Periods = append(report.Periods, *periods[start])
+	}
+
+	return report, nil
+}
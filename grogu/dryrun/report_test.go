@@ -0,0 +1,52 @@
+package dryrun
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testOutput = `{"timestamp": 3600, "prices": [{"signal_id": "BTC", "status": "SIGNAL_PRICE_STATUS_AVAILABLE", "price": 1010, "deviation_bps": 100}, {"signal_id": "ETH", "status": "SIGNAL_PRICE_STATUS_UNAVAILABLE"}]}
+
+{"timestamp": 3700, "prices": [{"signal_id": "BTC", "status": "SIGNAL_PRICE_STATUS_AVAILABLE", "price": 1030, "deviation_bps": 300}]}
+{"timestamp": 7200, "prices": [{"signal_id": "BTC", "status": "SIGNAL_PRICE_STATUS_AVAILABLE", "price": 1000}]}
+`
+
+func TestSummarize(t *testing.T) {
+	report, err := Summarize(strings.NewReader(testOutput), 3600)
+	require.NoError(t, err)
+
+	require.Equal(t, []Summary{
+		{
+			Key:              "BTC",
+			Submissions:      3,
+			Compared:         2,
+			MeanDeviationBps: 200,
+			MaxDeviationBps:  300,
+			FirstTimestamp:   3600,
+			LastTimestamp:    7200,
+		},
+		{
+			Key:            "ETH",
+			Submissions:    1,
+			Unavailable:    1,
+			FirstTimestamp: 3600,
+			LastTimestamp:  3600,
+		},
+	}, report.Signals)
+
+	require.Len(t, report.Periods, 2)
+	require.Equal(t, "1970-01-01T01:00:00Z", report.Periods[0].Key)
+	require.Equal(t, 3, report.Periods[0].Submissions)
+	require.Equal(t, "1970-01-01T02:00:00Z", report.Periods[1].Key)
+	require.Equal(t, 1, report.Periods[1].Submissions)
+
+	report, err = Summarize(strings.NewReader(""), 0)
+	require.NoError(t, err)
+	require.Empty(t, report.Signals)
+	require.Empty(t, report.Periods)
+
+	_, err = Summarize(strings.NewReader("not json\n"), 0)
+	require.Error(t, err)
+}
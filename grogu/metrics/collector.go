@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type groguCollector struct {
+	metrics                 *Metrics
+	pendingSignalIDs        *sync.Map
+	signalsPendingGaugeDesc *prometheus.Desc
+	submittedTxCountDesc    *prometheus.Desc
+	submittedPriceCountDesc *prometheus.Desc
+	broadcastErrorCountDesc *prometheus.Desc
+	txFailureCountDesc      *prometheus.Desc
+	lastSubmitGaugeDesc     *prometheus.Desc
+	priceDistanceGaugeDesc  *prometheus.Desc
+}
+
+// NewCollector creates a prometheus collector of the grogu metrics. The latency and errors of the
+// price providers, including Bothan, are collected by the collector of the provider package.
+func NewCollector(m *Metrics, pendingSignalIDs *sync.Map) prometheus.Collector {
+	return &groguCollector{
+		metrics:          m,
+		pendingSignalIDs: pendingSignalIDs,
+		signalsPendingGaugeDesc: prometheus.NewDesc(
+			"grogu_signals_pending_count",
+			"Number of signals currently pending for submission",
+			nil, nil),
+		submittedTxCountDesc: prometheus.NewDesc(
+			"grogu_submissions_total",
+			"Number of successful price submission transactions since last grogu restart",
+			nil, nil),
+		submittedPriceCountDesc: prometheus.NewDesc(
+			"grogu_submitted_prices_total",
+			"Number of signal prices successfully submitted since last grogu restart",
+			nil, nil),
+		broadcastErrorCountDesc: prometheus.NewDesc(
+			"grogu_tx_broadcast_error_total",
+			"Number of transactions that could not be broadcast or confirmed since last grogu restart",
+			nil, nil),
+		txFailureCountDesc: prometheus.NewDesc(
+			"grogu_tx_failure_total",
+			"Number of transactions failed with a code since last grogu restart",
+			[]string{"codespace", "code"}, nil),
+		lastSubmitGaugeDesc: prometheus.NewDesc(
+			"grogu_signal_last_submit_seconds",
+			"Time since the last successful submission of the signal",
+			[]string{"signal_id"}, nil),
+		priceDistanceGaugeDesc: prometheus.NewDesc(
+			"grogu_signal_price_distance_bps",
+			"Distance between the last submitted price and the on-chain price of the signal in basis points",
+			[]string{"signal_id"}, nil),
+	}
+}
+
+func (collector groguCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.signalsPendingGaugeDesc
+	ch <- collector.submittedTxCountDesc
+	ch <- collector.submittedPriceCountDesc
+	ch <- collector.broadcastErrorCountDesc
+	ch <- collector.txFailureCountDesc
+	ch <- collector.lastSubmitGaugeDesc
+	ch <- collector.priceDistanceGaugeDesc
+}
+
+func (collector groguCollector) Collect(ch chan<- prometheus.Metric) {
+	pending := 0
+	collector.pendingSignalIDs.Range(func(_, _ any) bool {
+		pending++
+		return true
+	})
+	ch <- prometheus.MustNewConstMetric(collector.signalsPendingGaugeDesc, prometheus.GaugeValue,
+		float64(pending))
+
+	snapshot := collector.metrics.Snapshot()
+	ch <- prometheus.MustNewConstMetric(collector.submittedTxCountDesc, prometheus.CounterValue,
+		float64(snapshot.SubmittedTxs))
+	ch <- prometheus.MustNewConstMetric(collector.submittedPriceCountDesc, prometheus.CounterValue,
+		float64(snapshot.SubmittedPrices))
+	ch <- prometheus.MustNewConstMetric(collector.broadcastErrorCountDesc, prometheus.CounterValue,
+		float64(snapshot.BroadcastErrors))
+	for _, failure := range snapshot.TxFailures {
+		ch <- prometheus.MustNewConstMetric(collector.txFailureCountDesc, prometheus.CounterValue,
+			float64(failure.Count), failure.Codespace, strconv.FormatUint(uint64(failure.Code), 10))
+	}
+	for signalID, t := range snapshot.LastSubmits {
+		ch <- prometheus.MustNewConstMetric(collector.lastSubmitGaugeDesc, prometheus.GaugeValue,
+			time.Since(t).Seconds(), signalID)
+	}
+	for signalID, distance := range snapshot.PriceDistances {
+		ch <- prometheus.MustNewConstMetric(collector.priceDistanceGaugeDesc, prometheus.GaugeValue,
+			distance, signalID)
+	}
+}
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type healthResponse struct {
+	Healthy         bool     `json:"healthy"`
+	AtRiskSignalIDs []string `json:"at_risk_signal_ids"`
+}
+
+// NewHealthHandler creates an HTTP handler that fails with 503 when any signal is not reported
+// within the margin of its deadline, putting the validator at risk of a miss report.
+func NewHealthHandler(m *Metrics, margin time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atRisk := m.AtRisk(time.Now(), margin)
+		res := healthResponse{
+			Healthy:         len(atRisk) == 0,
+			AtRiskSignalIDs: atRisk,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !res.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
@@ -0,0 +1,151 @@
+package metrics
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TxFailure is the number of failed transactions with a code.
+type TxFailure struct {
+	Codespace string
+	Code      uint32
+	Count     uint64
+}
+
+type txFailureKey struct {
+	codespace string
+	code      uint32
+}
+
+// Metrics tracks the state of grogu that is exposed through prometheus and the health endpoint.
+type Metrics struct {
+	mu sync.Mutex
+
+	submittedTxs    uint64
+	submittedPrices uint64
+	broadcastErrors uint64
+	txFailures      map[txFailureKey]uint64
+
+	// lastSubmits is the time of the last successful submission of each signal.
+	lastSubmits map[string]time.Time
+	// priceDistances is the distance in basis points between the last submitted price and the
+	// on-chain price of each signal.
+	priceDistances map[string]float64
+	// reportDeadlines is the time before which each signal must be reported to avoid a miss report.
+	reportDeadlines map[string]time.Time
+}
+
+// New creates a new Metrics.
+func New() *Metrics {
+	return &Metrics{
+		txFailures:      make(map[txFailureKey]uint64),
+		lastSubmits:     make(map[string]time.Time),
+		priceDistances:  make(map[string]float64),
+		reportDeadlines: make(map[string]time.Time),
+	}
+}
+
+// RecordSubmission records a successful submission of the prices of the given signal IDs.
+func (m *Metrics) RecordSubmission(signalIDs []string, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.submittedTxs++
+	m.submittedPrices += uint64(len(signalIDs))
+	for _, signalID := range signalIDs {
+		m.lastSubmits[signalID] = now
+	}
+}
+
+// RecordTxFailure records a transaction that failed with the given code.
+func (m *Metrics) RecordTxFailure(codespace string, code uint32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.txFailures[txFailureKey{codespace, code}]++
+}
+
+// RecordBroadcastError records a transaction that could not be broadcast or confirmed.
+func (m *Metrics) RecordBroadcastError() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.broadcastErrors++
+}
+
+// RecordPriceDistance records the distance between the submitted price and the on-chain price of a signal.
+func (m *Metrics) RecordPriceDistance(signalID string, submitted uint64, onChain uint64) {
+	if onChain == 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.priceDistances[signalID] = math.Abs(float64(submitted)-float64(onChain)) * 10000 / float64(onChain)
+}
+
+// SetReportDeadlines replaces the report deadlines of the signals the validator is required to report.
+func (m *Metrics) SetReportDeadlines(deadlines map[string]time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.reportDeadlines = make(map[string]time.Time, len(deadlines))
+	for signalID, deadline := range deadlines {
+		m.reportDeadlines[signalID] = deadline
+	}
+}
+
+// AtRisk returns the sorted signal IDs whose report deadline is within the margin of the given time.
+func (m *Metrics) AtRisk(now time.Time, margin time.Duration) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	signalIDs := make([]string, 0)
+	for signalID, deadline := range m.reportDeadlines {
+		if now.Add(margin).After(deadline) {
+			signalIDs = append(signalIDs, signalID)
+		}
+	}
+	sort.Strings(signalIDs)
+
+	return signalIDs
+}
+
+// Snapshot is a copy of the state of Metrics.
+type Snapshot struct {
+	SubmittedTxs    uint64
+	SubmittedPrices uint64
+	BroadcastErrors uint64
+	TxFailures      []TxFailure
+	LastSubmits     map[string]time.Time
+	PriceDistances  map[string]float64
+}
+
+// Snapshot returns a copy of the current state.
+func (m *Metrics) Snapshot() Snapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := Snapshot{
+		SubmittedTxs:    m.submittedTxs,
+		SubmittedPrices: m.submittedPrices,
+		BroadcastErrors: m.broadcastErrors,
+		TxFailures:      make([]TxFailure, 0, len(m.txFailures)),
+		LastSubmits:     make(map[string]time.Time, len(m.lastSubmits)),
+		PriceDistances:  make(map[string]float64, len(m.priceDistances)),
+	}
+	for key, count := range m.txFailures {
+		s.TxFailures = append(s.TxFailures, TxFailure{Codespace: key.codespace, Code: key.code, Count: count})
+	}
+	for signalID, t := range m.lastSubmits {
+		s.LastSubmits[signalID] = t
+	}
+	for signalID, d := range m.priceDistances {
+		s.PriceDistances[signalID] = d
+	}
+
+	return s
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsRecord(t *testing.T) {
+	m := New()
+	now := time.Unix(100, 0)
+
+	m.RecordSubmission([]string{"BTC", "ETH"}, now)
+	m.RecordSubmission([]string{"BTC"}, now.Add(time.Minute))
+	m.RecordTxFailure("sdk", 11)
+	m.RecordTxFailure("sdk", 11)
+	m.RecordTxFailure("feeds", 5)
+	m.RecordBroadcastError()
+	m.RecordPriceDistance("BTC", 1010, 1000)
+	m.RecordPriceDistance("ETH", 1000, 0)
+
+	s := m.Snapshot()
+	require.Equal(t, uint64(2), s.SubmittedTxs)
+	require.Equal(t, uint64(3), s.SubmittedPrices)
+	require.Equal(t, uint64(1), s.BroadcastErrors)
+	require.ElementsMatch(t, []TxFailure{
+		{Codespace: "sdk", Code: 11, Count: 2},
+		{Codespace: "feeds", Code: 5, Count: 1},
+	}, s.TxFailures)
+	require.Equal(t, map[string]time.Time{"BTC": now.Add(time.Minute), "ETH": now}, s.LastSubmits)
+	// The distance to a zero on-chain price is undefined and not recorded.
+	require.Equal(t, map[string]float64{"BTC": 100}, s.PriceDistances)
+}
+
+func TestMetricsAtRisk(t *testing.T) {
+	m := New()
+	now := time.Unix(100, 0)
+	require.Empty(t, m.AtRisk(now, 5*time.Second))
+
+	m.SetReportDeadlines(map[string]time.Time{
+		"BTC":  now.Add(time.Minute),
+		"ETH":  now.Add(3 * time.Second),
+		"BAND": now.Add(-time.Second),
+	})
+	require.Equal(t, []string{"BAND", "ETH"}, m.AtRisk(now, 5*time.Second))
+	require.Equal(t, []string{"BAND"}, m.AtRisk(now, 0))
+
+	m.SetReportDeadlines(nil)
+	require.Empty(t, m.AtRisk(now, 5*time.Second))
+}
+
+func TestHealthHandler(t *testing.T) {
+	m := New()
+	handler := NewHealthHandler(m, 5*time.Second)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	m.SetReportDeadlines(map[string]time.Time{"BTC": time.Now()})
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var res healthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.False(t, res.Healthy)
+	require.Equal(t, []string{"BTC"}, res.AtRiskSignalIDs)
+}
+
+func TestCollector(t *testing.T) {
+	m := New()
+	pendingSignalIDs := sync.Map{}
+	pendingSignalIDs.Store("BTC", struct{}{})
+	m.RecordSubmission([]string{"BTC"}, time.Now())
+	m.RecordTxFailure("sdk", 11)
+	m.RecordPriceDistance("BTC", 1010, 1000)
+
+	c := NewCollector(m, &pendingSignalIDs)
+	// pending, submissions, submitted prices, broadcast errors, 1 tx failure, 1 last submit, 1 distance
+	require.Equal(t, 7, testutil.CollectAndCount(c))
+	require.Equal(t, 1, testutil.CollectAndCount(c, "grogu_signals_pending_count"))
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+)
+
+// Stats is a snapshot of the health of a price provider.
+type Stats struct {
+	Name       string
+	Healthy    bool   // Whether the last query to the provider succeeded
+	Successes  uint64 // Number of successful queries
+	Failures   uint64 // Number of failed queries
+	Rejections uint64 // Number of prices rejected for disagreeing with the other providers
+	Latency    time.Duration
+}
+
+// Aggregator queries several price providers concurrently and combines their prices. The price of
+// a signal is the median of the available prices, where the prices deviating from the median of
+// all providers by more than the maximum deviation are rejected.
+type Aggregator struct {
+	providers       []Provider
+	logger          *logger.Logger
+	maxDeviationBps uint64 // Maximum deviation from the median in basis points
+	minProviders    int    // Minimum number of agreeing providers for a price to be available
+
+	mu    sync.Mutex
+	stats []Stats
+	// pins maps the pinned signal IDs to the index of the provider they are pinned to.
+	pins map[string]int
+}
+
+// NewAggregator creates a new Aggregator of the given providers. The UUID of the responses, which
+// is used for monitoring, is taken from the first provider in order that responds.
+func NewAggregator(
+	providers []Provider,
+	logger *logger.Logger,
+	maxDeviationBps uint64,
+	minProviders int,
+) (*Aggregator, error) {
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("price providers cannot be empty")
+	}
+	if minProviders < 1 || minProviders > len(providers) {
+		return nil, fmt.Errorf("minimum number of price providers must be between 1 and %d", len(providers))
+	}
+
+	stats := make([]Stats, len(providers))
+	for i, p := range providers {
+		stats[i] = Stats{Name: p.Name()}
+	}
+
+	return &Aggregator{
+		providers:       providers,
+		logger:          logger,
+		maxDeviationBps: maxDeviationBps,
+		minProviders:    minProviders,
+		stats:           stats,
+		pins:            make(map[string]int),
+	}, nil
+}
+
+// SetPins pins the prices of signals to the providers with the given names, such that only the
+// price of that provider is used. The pins to unknown providers are ignored with an error.
+func (a *Aggregator) SetPins(pins map[string]string) error {
+	indexes := make(map[string]int, len(a.providers))
+	for i, p := range a.providers {
+		indexes[p.Name()] = i
+	}
+
+	var errs []error
+	newPins := make(map[string]int, len(pins))
+	for signalID, name := range pins {
+		i, ok := indexes[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("signal %s is pinned to unknown price provider %s", signalID, name))
+			continue
+		}
+		newPins[signalID] = i
+	}
+
+	a.mu.Lock()
+	a.pins = newPins
+	a.mu.Unlock()
+
+	return errors.Join(errs...)
+}
+
+// GetPrices queries the prices of the given signal IDs from all providers and aggregates them. It
+// only fails if none of the providers responds.
+func (a *Aggregator) GetPrices(signalIDs []string) (*bothan.GetPricesResponse, error) {
+	responses := make([]*bothan.GetPricesResponse, len(a.providers))
+	errs := make([]error, len(a.providers))
+
+	var wg sync.WaitGroup
+	for i, p := range a.providers {
+		wg.Add(1)
+		go func(i int, p Provider) {
+			defer wg.Done()
+
+			start := time.Now()
+			responses[i], errs[i] = p.GetPrices(signalIDs)
+			a.recordQuery(i, errs[i], time.Since(start))
+			if errs[i] != nil {
+				a.logger.Error("[Aggregator] failed to query prices from %s: %v", p.Name(), errs[i])
+			}
+		}(i, p)
+	}
+	wg.Wait()
+
+	uuid := ""
+	responded := false
+	// pricesBySignal maps each signal ID to its price from each provider, nil if missing.
+	pricesBySignal := make(map[string][]*bothan.Price, len(signalIDs))
+	for i, res := range responses {
+		if errs[i] != nil || res == nil {
+			continue
+		}
+		if !responded {
+			uuid = res.Uuid
+			responded = true
+		}
+		for _, price := range res.Prices {
+			if _, ok := pricesBySignal[price.SignalId]; !ok {
+				pricesBySignal[price.SignalId] = make([]*bothan.Price, len(a.providers))
+			}
+			pricesBySignal[price.SignalId][i] = price
+		}
+	}
+	if !responded {
+		return nil, fmt.Errorf("all price providers failed: %w", errors.Join(errs...))
+	}
+
+	prices := make([]*bothan.Price, 0, len(signalIDs))
+	for _, signalID := range signalIDs {
+		providerPrices, ok := pricesBySignal[signalID]
+		if !ok {
+			continue
+		}
+		prices = append(prices, a.aggregate(signalID, providerPrices))
+	}
+
+	return &bothan.GetPricesResponse{Prices: prices, Uuid: uuid}, nil
+}
+
+// aggregate combines the prices of a signal from the providers, indexed by provider.
+func (a *Aggregator) aggregate(signalID string, providerPrices []*bothan.Price) *bothan.Price {
+	if i, ok := a.pinnedProvider(signalID); ok {
+		if providerPrices[i] == nil {
+			return &bothan.Price{SignalId: signalID, Status: bothan.Status_STATUS_UNAVAILABLE}
+		}
+		return providerPrices[i]
+	}
+
+	var available []uint64
+	var availableIdxs []int
+	status := bothan.Status_STATUS_UNSPECIFIED
+	for i, price := range providerPrices {
+		if price == nil {
+			continue
+		}
+		if price.Status == bothan.Status_STATUS_AVAILABLE {
+			available = append(available, price.Price)
+			availableIdxs = append(availableIdxs, i)
+		}
+		// Prefer unavailable over unsupported, as some provider supports the signal.
+		if price.Status > status {
+			status = price.Status
+		}
+	}
+	if len(available) == 0 {
+		return &bothan.Price{SignalId: signalID, Status: status}
+	}
+
+	m := median(available)
+	accepted := make([]uint64, 0, len(available))
+	for j, price := range available {
+		if deviationBps(m, price) > a.maxDeviationBps {
+			name := a.recordRejection(availableIdxs[j])
+			a.logger.Info("[Aggregator] rejected price %d of %s from %s, median: %d", price, signalID, name, m)
+			continue
+		}
+		accepted = append(accepted, price)
+	}
+	if len(accepted) < a.minProviders {
+		a.logger.Info(
+			"[Aggregator] not enough agreeing providers for %s: %d of %d required",
+			signalID, len(accepted), a.minProviders,
+		)
+		return &bothan.Price{SignalId: signalID, Status: bothan.Status_STATUS_UNAVAILABLE}
+	}
+
+	return &bothan.Price{SignalId: signalID, Price: median(accepted), Status: bothan.Status_STATUS_AVAILABLE}
+}
+
+func (a *Aggregator) pinnedProvider(signalID string) (int, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	i, ok := a.pins[signalID]
+	return i, ok
+}
+
+// Stats returns the health snapshots of all providers.
+func (a *Aggregator) Stats() []Stats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return append([]Stats{}, a.stats...)
+}
+
+func (a *Aggregator) recordQuery(i int, err error, latency time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.stats[i].Healthy = err == nil
+	a.stats[i].Latency = latency
+	if err == nil {
+		a.stats[i].Successes++
+	} else {
+		a.stats[i].Failures++
+	}
+}
+
+func (a *Aggregator) recordRejection(i int) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.stats[i].Rejections++
+	return a.stats[i].Name
+}
+
+// median returns the median of the given prices, which must not be empty.
+func median(prices []uint64) uint64 {
+	sorted := append([]uint64{}, prices...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	lo, hi := sorted[mid-1], sorted[mid]
+	return lo + (hi-lo)/2
+}
+
+// deviationBps returns the deviation of the price from the reference price in basis points.
+func deviationBps(reference uint64, price uint64) uint64 {
+	if reference == 0 {
+		if price == 0 {
+			return 0
+		}
+		return math.MaxUint64
+	}
+
+	diff := math.Abs(float64(price) - float64(reference))
+	return uint64((diff * 10000) / float64(reference))
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+)
+
+type mockProvider struct {
+	name   string
+	prices []*bothan.Price
+	uuid   string
+	err    error
+}
+
+func (p *mockProvider) Name() string {
+	return p.name
+}
+
+func (p *mockProvider) GetPrices(signalIDs []string) (*bothan.GetPricesResponse, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &bothan.GetPricesResponse{Prices: p.prices, Uuid: p.uuid}, nil
+}
+
+func available(signalID string, price uint64) *bothan.Price {
+	return &bothan.Price{SignalId: signalID, Price: price, Status: bothan.Status_STATUS_AVAILABLE}
+}
+
+func newTestAggregator(t *testing.T, providers []Provider, maxDeviationBps uint64, minProviders int) *Aggregator {
+	allowLevel, _ := log.ParseLogLevel("error")
+	a, err := NewAggregator(providers, logger.NewLogger(allowLevel), maxDeviationBps, minProviders)
+	require.NoError(t, err)
+	return a
+}
+
+func TestMedian(t *testing.T) {
+	assert.Equal(t, uint64(5), median([]uint64{5}))
+	assert.Equal(t, uint64(2), median([]uint64{3, 1, 2}))
+	assert.Equal(t, uint64(15), median([]uint64{20, 10}))
+	assert.Equal(t, uint64(25), median([]uint64{40, 10, 20, 30}))
+}
+
+func TestDeviationBps(t *testing.T) {
+	assert.Equal(t, uint64(0), deviationBps(1000, 1000))
+	assert.Equal(t, uint64(100), deviationBps(1000, 1010))
+	assert.Equal(t, uint64(100), deviationBps(1000, 990))
+	assert.Equal(t, uint64(0), deviationBps(0, 0))
+	assert.Greater(t, deviationBps(0, 1), uint64(10000))
+}
+
+func TestAggregatorMedianAndRejection(t *testing.T) {
+	providers := []Provider{
+		&mockProvider{name: "a", prices: []*bothan.Price{available("BTC", 1000)}, uuid: "uuid-a"},
+		&mockProvider{name: "b", prices: []*bothan.Price{available("BTC", 1004)}, uuid: "uuid-b"},
+		&mockProvider{name: "c", prices: []*bothan.Price{available("BTC", 2000)}},
+	}
+	a := newTestAggregator(t, providers, 100, 2)
+
+	res, err := a.GetPrices([]string{"BTC", "ETH"})
+	require.NoError(t, err)
+	require.Equal(t, "uuid-a", res.Uuid)
+	// The price of c deviates from the median and is rejected, and ETH is not provided at all.
+	require.Len(t, res.Prices, 1)
+	require.Equal(t, bothan.Status_STATUS_AVAILABLE, res.Prices[0].Status)
+	require.Equal(t, uint64(1002), res.Prices[0].Price)
+
+	stats := a.Stats()
+	require.Equal(t, uint64(0), stats[0].Rejections)
+	require.Equal(t, uint64(0), stats[1].Rejections)
+	require.Equal(t, uint64(1), stats[2].Rejections)
+}
+
+func TestAggregatorNotEnoughAgreeingProviders(t *testing.T) {
+	providers := []Provider{
+		&mockProvider{name: "a", prices: []*bothan.Price{available("BTC", 1000)}},
+		&mockProvider{name: "b", prices: []*bothan.Price{available("BTC", 2000)}},
+	}
+	a := newTestAggregator(t, providers, 100, 1)
+
+	res, err := a.GetPrices([]string{"BTC"})
+	require.NoError(t, err)
+	require.Len(t, res.Prices, 1)
+	require.Equal(t, bothan.Status_STATUS_UNAVAILABLE, res.Prices[0].Status)
+}
+
+func TestAggregatorFailover(t *testing.T) {
+	providers := []Provider{
+		&mockProvider{name: "a", err: errors.New("connection refused")},
+		&mockProvider{name: "b", prices: []*bothan.Price{available("BTC", 1000)}, uuid: "uuid-b"},
+	}
+	a := newTestAggregator(t, providers, 100, 1)
+
+	res, err := a.GetPrices([]string{"BTC"})
+	require.NoError(t, err)
+	require.Equal(t, "uuid-b", res.Uuid)
+	require.Equal(t, uint64(1000), res.Prices[0].Price)
+
+	stats := a.Stats()
+	require.False(t, stats[0].Healthy)
+	require.Equal(t, uint64(1), stats[0].Failures)
+	require.True(t, stats[1].Healthy)
+	require.Equal(t, uint64(1), stats[1].Successes)
+
+	// The query fails only if all providers fail.
+	providers[1].(*mockProvider).err = errors.New("timeout")
+	_, err = a.GetPrices([]string{"BTC"})
+	require.Error(t, err)
+}
+
+func TestAggregatorStatus(t *testing.T) {
+	providers := []Provider{
+		&mockProvider{name: "a", prices: []*bothan.Price{
+			{SignalId: "BTC", Status: bothan.Status_STATUS_UNSUPPORTED},
+			{SignalId: "ETH", Status: bothan.Status_STATUS_UNSUPPORTED},
+		}},
+		&mockProvider{name: "b", prices: []*bothan.Price{
+			{SignalId: "BTC", Status: bothan.Status_STATUS_UNAVAILABLE},
+			{SignalId: "ETH", Status: bothan.Status_STATUS_UNSUPPORTED},
+		}},
+	}
+	a := newTestAggregator(t, providers, 100, 1)
+
+	res, err := a.GetPrices([]string{"BTC", "ETH"})
+	require.NoError(t, err)
+	require.Equal(t, bothan.Status_STATUS_UNAVAILABLE, res.Prices[0].Status)
+	require.Equal(t, bothan.Status_STATUS_UNSUPPORTED, res.Prices[1].Status)
+}
+
+func TestNewAggregatorInvalid(t *testing.T) {
+	allowLevel, _ := log.ParseLogLevel("error")
+	l := logger.NewLogger(allowLevel)
+
+	_, err := NewAggregator(nil, l, 100, 1)
+	require.Error(t, err)
+
+	_, err = NewAggregator([]Provider{&mockProvider{name: "a"}}, l, 100, 2)
+	require.Error(t, err)
+}
+
+func TestAggregatorPins(t *testing.T) {
+	providers := []Provider{
+		&mockProvider{name: "a", prices: []*bothan.Price{available("BTC", 1000), available("ETH", 10)}},
+		&mockProvider{name: "b", prices: []*bothan.Price{available("BTC", 1004), available("ETH", 11)}},
+		&mockProvider{name: "c", prices: []*bothan.Price{available("BTC", 2000)}},
+	}
+	a := newTestAggregator(t, providers, 100, 2)
+
+	err := a.SetPins(map[string]string{"BTC": "c", "ETH": "c", "BAND": "d"})
+	require.Error(t, err)
+
+	res, err := a.GetPrices([]string{"BTC", "ETH"})
+	require.NoError(t, err)
+	require.Len(t, res.Prices, 2)
+	// The pinned price is used even if it deviates from the other providers.
+	require.Equal(t, uint64(2000), res.Prices[0].Price)
+	require.Equal(t, bothan.Status_STATUS_AVAILABLE, res.Prices[0].Status)
+	// The price is unavailable if the pinned provider does not provide it.
+	require.Equal(t, bothan.Status_STATUS_UNAVAILABLE, res.Prices[1].Status)
+
+	require.NoError(t, a.SetPins(nil))
+	res, err = a.GetPrices([]string{"BTC"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1002), res.Prices[0].Price)
+}
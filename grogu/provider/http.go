@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+)
+
+// maxHTTPResponseSize is the maximum size of a response body read from an HTTP provider.
+const maxHTTPResponseSize = 10 * 1024 * 1024
+
+var _ Provider = &HTTPProvider{}
+
+// HTTPProvider is a Provider backed by a generic HTTP endpoint. The prices are requested with
+// GET <url>?signal_ids=<comma-separated signal IDs>, and the endpoint responds with a JSON object
+// in the form of {"prices": [{"signal_id": "CS:BTC-USD", "price": 1000, "status": "available"}]},
+// where the status is one of "available", "unavailable" or "unsupported".
+type HTTPProvider struct {
+	url    string
+	client *http.Client
+}
+
+type httpPrice struct {
+	SignalID string `json:"signal_id"`
+	Price    uint64 `json:"price"`
+	Status   string `json:"status"`
+}
+
+type httpPricesResponse struct {
+	Prices []httpPrice `json:"prices"`
+}
+
+// NewHTTPProvider creates a new HTTPProvider for the given URL and request timeout.
+func NewHTTPProvider(rawURL string, timeout time.Duration) (*HTTPProvider, error) {
+	if _, err := url.ParseRequestURI(rawURL); err != nil {
+		return nil, fmt.Errorf("invalid price provider URL %s: %w", rawURL, err)
+	}
+
+	return &HTTPProvider{
+		url:    rawURL,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Name implements Provider.
+func (p *HTTPProvider) Name() string {
+	u, err := url.Parse(p.url)
+	if err != nil {
+		return p.url
+	}
+	// Do not expose the query and credentials of the endpoint in logs and metrics.
+	u.RawQuery = ""
+	return u.Redacted()
+}
+
+// GetPrices implements Provider.
+func (p *HTTPProvider) GetPrices(signalIDs []string) (*bothan.GetPricesResponse, error) {
+	u, err := url.Parse(p.url)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	query.Set("signal_ids", strings.Join(signalIDs, ","))
+	u.RawQuery = query.Encode()
+
+	resp, err := p.client.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code from %s: %d", p.Name(), resp.StatusCode)
+	}
+
+	var body httpPricesResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxHTTPResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode prices from %s: %w", p.Name(), err)
+	}
+
+	prices := make([]*bothan.Price, 0, len(body.Prices))
+	for _, price := range body.Prices {
+		status, err := parseStatus(price.Status)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, &bothan.Price{SignalId: price.SignalID, Price: price.Price, Status: status})
+	}
+
+	return &bothan.GetPricesResponse{Prices: prices}, nil
+}
+
+func parseStatus(status string) (bothan.Status, error) {
+	switch strings.ToLower(status) {
+	case "available":
+		return bothan.Status_STATUS_AVAILABLE, nil
+	case "unavailable":
+		return bothan.Status_STATUS_UNAVAILABLE, nil
+	case "unsupported":
+		return bothan.Status_STATUS_UNSUPPORTED, nil
+	default:
+		return bothan.Status_STATUS_UNSPECIFIED, fmt.Errorf("unknown price status: %s", status)
+	}
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+)
+
+func TestHTTPProviderGetPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "BTC,ETH", r.URL.Query().Get("signal_ids"))
+		require.Equal(t, "secret", r.URL.Query().Get("key"))
+		_, _ = w.Write([]byte(`{"prices": [
+			{"signal_id": "BTC", "price": 1000, "status": "available"},
+			{"signal_id": "ETH", "status": "unavailable"}
+		]}`))
+	}))
+	defer server.Close()
+
+	p, err := NewHTTPProvider(server.URL+"/prices?key=secret", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/prices", p.Name())
+
+	res, err := p.GetPrices([]string{"BTC", "ETH"})
+	require.NoError(t, err)
+	require.Len(t, res.Prices, 2)
+	require.Equal(t, "BTC", res.Prices[0].SignalId)
+	require.Equal(t, uint64(1000), res.Prices[0].Price)
+	require.Equal(t, bothan.Status_STATUS_AVAILABLE, res.Prices[0].Status)
+	require.Equal(t, bothan.Status_STATUS_UNAVAILABLE, res.Prices[1].Status)
+}
+
+func TestHTTPProviderErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/down":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/invalid":
+			_, _ = w.Write([]byte(`{"prices": [{"signal_id": "BTC", "status": "stale"}]}`))
+		default:
+			_, _ = w.Write([]byte(`not json`))
+		}
+	}))
+	defer server.Close()
+
+	for _, path := range []string{"/down", "/invalid", "/garbage"} {
+		p, err := NewHTTPProvider(server.URL+path, time.Second)
+		require.NoError(t, err)
+		_, err = p.GetPrices([]string{"BTC"})
+		require.Error(t, err, path)
+	}
+
+	_, err := NewHTTPProvider("not a url", time.Second)
+	require.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type aggregatorCollector struct {
+	aggregator         *Aggregator
+	healthyGaugeDesc   *prometheus.Desc
+	successCountDesc   *prometheus.Desc
+	failureCountDesc   *prometheus.Desc
+	rejectionCountDesc *prometheus.Desc
+	latencyGaugeDesc   *prometheus.Desc
+}
+
+// NewCollector creates a prometheus collector of the health of the price providers.
+func NewCollector(a *Aggregator) prometheus.Collector {
+	return &aggregatorCollector{
+		aggregator: a,
+		healthyGaugeDesc: prometheus.NewDesc(
+			"grogu_price_provider_healthy",
+			"Whether the last query to the price provider succeeded (1) or not (0)",
+			[]string{"provider"}, nil),
+		successCountDesc: prometheus.NewDesc(
+			"grogu_price_provider_success_total",
+			"Number of successful queries to the price provider since last grogu restart",
+			[]string{"provider"}, nil),
+		failureCountDesc: prometheus.NewDesc(
+			"grogu_price_provider_failure_total",
+			"Number of failed queries to the price provider since last grogu restart",
+			[]string{"provider"}, nil),
+		rejectionCountDesc: prometheus.NewDesc(
+			"grogu_price_provider_rejection_total",
+			"Number of prices of the price provider rejected for deviating from the median since last grogu restart",
+			[]string{"provider"}, nil),
+		latencyGaugeDesc: prometheus.NewDesc(
+			"grogu_price_provider_latency_seconds",
+			"Latency of the last query to the price provider",
+			[]string{"provider"}, nil),
+	}
+}
+
+func (collector aggregatorCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.healthyGaugeDesc
+	ch <- collector.successCountDesc
+	ch <- collector.failureCountDesc
+	ch <- collector.rejectionCountDesc
+	ch <- collector.latencyGaugeDesc
+}
+
+func (collector aggregatorCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range collector.aggregator.Stats() {
+		healthy := 0.0
+		if stats.Healthy {
+			healthy = 1
+		}
+		ch <- prometheus.MustNewConstMetric(collector.healthyGaugeDesc, prometheus.GaugeValue,
+			healthy, stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.successCountDesc, prometheus.CounterValue,
+			float64(stats.Successes), stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.failureCountDesc, prometheus.CounterValue,
+			float64(stats.Failures), stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.rejectionCountDesc, prometheus.CounterValue,
+			float64(stats.Rejections), stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.latencyGaugeDesc, prometheus.GaugeValue,
+			stats.Latency.Seconds(), stats.Name)
+	}
+}
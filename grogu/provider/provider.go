@@ -0,0 +1,40 @@
+package provider
+
+import (
+	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+)
+
+// Provider is a source of signal prices.
+type Provider interface {
+	// Name returns the name of the provider used in logs and metrics.
+	Name() string
+	// GetPrices returns the prices of the given signal IDs.
+	GetPrices(signalIDs []string) (*bothan.GetPricesResponse, error)
+}
+
+var _ Provider = &BothanProvider{}
+
+// BothanProvider is a Provider backed by a Bothan instance.
+type BothanProvider struct {
+	name   string
+	client bothanclient.Client
+}
+
+// NewBothanProvider creates a new BothanProvider with the given name and Bothan client.
+func NewBothanProvider(name string, client bothanclient.Client) *BothanProvider {
+	return &BothanProvider{
+		name:   name,
+		client: client,
+	}
+}
+
+// Name implements Provider.
+func (p *BothanProvider) Name() string {
+	return p.name
+}
+
+// GetPrices implements Provider.
+func (p *BothanProvider) GetPrices(signalIDs []string) (*bothan.GetPricesResponse, error) {
+	return p.client.GetPrices(signalIDs)
+}
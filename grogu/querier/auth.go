@@ -13,12 +13,14 @@ import (
 type AuthQuerier struct {
 	queryClients   []auth.QueryClient
 	maxBlockHeight *atomic.Int64
+	nodes          *NodePool
 }
 
 func NewAuthQuerier(
 	clientCtx client.Context,
 	clients []rpcclient.RemoteClient,
 	maxBlockHeight *atomic.Int64,
+	nodes *NodePool,
 ) *AuthQuerier {
 	queryClients := make([]auth.QueryClient, 0, len(clients))
 	for _, cl := range clients {
@@ -28,6 +30,7 @@ func NewAuthQuerier(
 	return &AuthQuerier{
 		queryClients,
 		maxBlockHeight,
+		nodes,
 	}
 }
 
@@ -38,5 +41,5 @@ func (q *AuthQuerier) QueryAccount(address sdk.Address) (*auth.QueryAccountRespo
 	}
 
 	in := auth.QueryAccountRequest{Address: address.String()}
-	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
+	return getMaxBlockHeightResponse(q.nodes, fs, &in, q.maxBlockHeight)
 }
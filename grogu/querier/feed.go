@@ -14,19 +14,21 @@ import (
 type FeedQuerier struct {
 	queryClients   []feeds.QueryClient
 	maxBlockHeight *atomic.Int64
+	nodes          *NodePool
 }
 
 func NewFeedQuerier(
 	clientCtx client.Context,
 	clients []rpcclient.RemoteClient,
 	maxBlockHeight *atomic.Int64,
+	nodes *NodePool,
 ) *FeedQuerier {
 	queryClients := make([]feeds.QueryClient, 0, len(clients))
 	for _, cl := range clients {
 		queryClients = append(queryClients, feeds.NewQueryClient(clientCtx.WithClient(cl)))
 	}
 
-	return &FeedQuerier{queryClients, maxBlockHeight}
+	return &FeedQuerier{queryClients, maxBlockHeight, nodes}
 }
 
 func (q *FeedQuerier) QueryValidValidator(valAddress sdk.ValAddress) (*feeds.QueryValidValidatorResponse, error) {
@@ -43,7 +45,7 @@ func (q *FeedQuerier) QueryValidValidator(valAddress sdk.ValAddress) (*feeds.Que
 		Validator: valAddress.String(),
 	}
 
-	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
+	return getMaxBlockHeightResponse(q.nodes, fs, &in, q.maxBlockHeight)
 }
 
 func (q *FeedQuerier) QueryIsFeeder(
@@ -59,7 +61,7 @@ func (q *FeedQuerier) QueryIsFeeder(
 		Feeder:    feeder.String(),
 		Validator: validator.String(),
 	}
-	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
+	return getMaxBlockHeightResponse(q.nodes, fs, &in, q.maxBlockHeight)
 }
 
 func (q *FeedQuerier) QueryValidatorPrices(valAddress sdk.ValAddress) (*feeds.QueryValidatorPricesResponse, error) {
@@ -75,7 +77,7 @@ func (q *FeedQuerier) QueryValidatorPrices(valAddress sdk.ValAddress) (*feeds.Qu
 	in := feeds.QueryValidatorPricesRequest{
 		Validator: valAddress.String(),
 	}
-	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
+	return getMaxBlockHeightResponse(q.nodes, fs, &in, q.maxBlockHeight)
 }
 
 func (q *FeedQuerier) QueryParams() (*feeds.QueryParamsResponse, error) {
@@ -85,7 +87,7 @@ func (q *FeedQuerier) QueryParams() (*feeds.QueryParamsResponse, error) {
 	}
 
 	in := feeds.QueryParamsRequest{}
-	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
+	return getMaxBlockHeightResponse(q.nodes, fs, &in, q.maxBlockHeight)
 }
 
 func (q *FeedQuerier) QueryCurrentFeeds() (*feeds.QueryCurrentFeedsResponse, error) {
@@ -99,7 +101,7 @@ func (q *FeedQuerier) QueryCurrentFeeds() (*feeds.QueryCurrentFeedsResponse, err
 	}
 
 	in := feeds.QueryCurrentFeedsRequest{}
-	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
+	return getMaxBlockHeightResponse(q.nodes, fs, &in, q.maxBlockHeight)
 }
 
 func (q *FeedQuerier) QueryReferenceSourceConfig() (*feeds.QueryReferenceSourceConfigResponse, error) {
@@ -113,5 +115,17 @@ func (q *FeedQuerier) QueryReferenceSourceConfig() (*feeds.QueryReferenceSourceC
 	}
 
 	in := feeds.QueryReferenceSourceConfigRequest{}
-	return getMaxBlockHeightResponse(fs, &in, q.maxBlockHeight)
+	return getMaxBlockHeightResponse(q.nodes, fs, &in, q.maxBlockHeight)
+}
+
+func (q *FeedQuerier) QueryPrices(signalIDs []string) (*feeds.QueryPricesResponse, error) {
+	fs := make([]QueryFunction[feeds.QueryPricesRequest, feeds.QueryPricesResponse], 0, len(q.queryClients))
+	for _, queryClient := range q.queryClients {
+		fs = append(fs, queryClient.Prices)
+	}
+
+	in := feeds.QueryPricesRequest{
+		SignalIds: signalIDs,
+	}
+	return getMaxBlockHeightResponse(q.nodes, fs, &in, q.maxBlockHeight)
 }
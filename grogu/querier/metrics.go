@@ -0,0 +1,70 @@
+package querier
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type nodePoolCollector struct {
+	nodes              *NodePool
+	ejectedGaugeDesc   *prometheus.Desc
+	heightLagGaugeDesc *prometheus.Desc
+	requestCountDesc   *prometheus.Desc
+	errorCountDesc     *prometheus.Desc
+	ejectionCountDesc  *prometheus.Desc
+}
+
+// NewCollector creates a prometheus collector of the health of the nodes.
+func NewCollector(p *NodePool) prometheus.Collector {
+	return &nodePoolCollector{
+		nodes: p,
+		ejectedGaugeDesc: prometheus.NewDesc(
+			"grogu_node_ejected",
+			"Whether the node is ejected (1) or not (0)",
+			[]string{"node"}, nil),
+		heightLagGaugeDesc: prometheus.NewDesc(
+			"grogu_node_height_lag",
+			"Number of blocks the last response of the node lags behind the highest block height seen",
+			[]string{"node"}, nil),
+		requestCountDesc: prometheus.NewDesc(
+			"grogu_node_request_total",
+			"Number of requests to the node since last grogu restart",
+			[]string{"node"}, nil),
+		errorCountDesc: prometheus.NewDesc(
+			"grogu_node_error_total",
+			"Number of failed requests to the node since last grogu restart",
+			[]string{"node"}, nil),
+		ejectionCountDesc: prometheus.NewDesc(
+			"grogu_node_ejection_total",
+			"Number of ejections of the node since last grogu restart",
+			[]string{"node", "reason"}, nil),
+	}
+}
+
+func (collector nodePoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.ejectedGaugeDesc
+	ch <- collector.heightLagGaugeDesc
+	ch <- collector.requestCountDesc
+	ch <- collector.errorCountDesc
+	ch <- collector.ejectionCountDesc
+}
+
+func (collector nodePoolCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range collector.nodes.Stats() {
+		ejected := 0.0
+		if stats.Ejected {
+			ejected = 1
+		}
+		ch <- prometheus.MustNewConstMetric(collector.ejectedGaugeDesc, prometheus.GaugeValue,
+			ejected, stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.heightLagGaugeDesc, prometheus.GaugeValue,
+			float64(stats.HeightLag), stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.requestCountDesc, prometheus.CounterValue,
+			float64(stats.Requests), stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.errorCountDesc, prometheus.CounterValue,
+			float64(stats.Errors), stats.Name)
+		for reason, count := range stats.Ejections {
+			ch <- prometheus.MustNewConstMetric(collector.ejectionCountDesc, prometheus.CounterValue,
+				float64(count), stats.Name, string(reason))
+		}
+	}
+}
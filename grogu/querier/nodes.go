@@ -0,0 +1,252 @@
+package querier
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+)
+
+// EjectionReason is the reason a node is ejected from the node pool.
+type EjectionReason string
+
+const (
+	// EjectionLag is a node lagging behind the highest block height seen.
+	EjectionLag EjectionReason = "lag"
+	// EjectionErrors is a node with an error rate above the maximum.
+	EjectionErrors EjectionReason = "errors"
+	// EjectionDisagreement is a node whose response disagrees with the majority at the same height.
+	EjectionDisagreement EjectionReason = "disagreement"
+)
+
+// NodePoolConfig is the configuration of the ejection of unhealthy nodes.
+type NodePoolConfig struct {
+	// MaxHeightLag is the number of blocks a node can lag behind the highest block height seen.
+	MaxHeightLag int64
+	// MaxErrorRate is the maximum rate of failed requests, between 0 and 1, in the error window.
+	MaxErrorRate float64
+	// ErrorWindow is the number of latest requests of a node the error rate is computed over.
+	ErrorWindow int
+	// Cooldown is the duration a node is ejected for.
+	Cooldown time.Duration
+}
+
+// Validate checks the node pool configuration.
+func (c NodePoolConfig) Validate() error {
+	if c.MaxHeightLag < 0 {
+		return fmt.Errorf("max height lag cannot be negative")
+	}
+	if c.MaxErrorRate < 0 || c.MaxErrorRate > 1 {
+		return fmt.Errorf("max error rate must be between 0 and 1")
+	}
+	if c.ErrorWindow <= 0 {
+		return fmt.Errorf("error window must be positive")
+	}
+	if c.Cooldown < 0 {
+		return fmt.Errorf("cooldown cannot be negative")
+	}
+
+	return nil
+}
+
+// NodeStats is the health of a node in the node pool.
+type NodeStats struct {
+	Name      string
+	Ejected   bool
+	HeightLag int64
+	Requests  uint64
+	Errors    uint64
+	Ejections map[EjectionReason]uint64
+}
+
+type node struct {
+	name         string
+	height       int64
+	results      []bool
+	ejectedUntil time.Time
+	requests     uint64
+	errors       uint64
+	ejections    map[EjectionReason]uint64
+}
+
+// NodePool tracks the health of the nodes queried and broadcast to. Lagging, failing and
+// disagreeing nodes are ejected for a cooldown, unless all of them are.
+type NodePool struct {
+	config NodePoolConfig
+	logger *logger.Logger
+	now    func() time.Time
+
+	mu        sync.Mutex
+	nodes     []*node
+	maxHeight int64
+}
+
+// NewNodePool creates a new NodePool of the nodes with the given names, by index.
+func NewNodePool(names []string, config NodePoolConfig, logger *logger.Logger) *NodePool {
+	nodes := make([]*node, 0, len(names))
+	for _, name := range names {
+		nodes = append(nodes, &node{name: name, ejections: make(map[EjectionReason]uint64)})
+	}
+
+	return &NodePool{
+		config: config,
+		logger: logger,
+		now:    time.Now,
+		nodes:  nodes,
+	}
+}
+
+// availableNodes returns the indexes of the available nodes of the pool, or of all n nodes if the
+// pool is nil.
+func availableNodes(p *NodePool, n int) []int {
+	if p == nil {
+		indexes := make([]int, 0, n)
+		for i := 0; i < n; i++ {
+			indexes = append(indexes, i)
+		}
+		return indexes
+	}
+
+	return p.Available()
+}
+
+// Available returns the indexes of the nodes that are not ejected, or of all nodes if all of them
+// are ejected.
+func (p *NodePool) Available() []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	all := make([]int, 0, len(p.nodes))
+	available := make([]int, 0, len(p.nodes))
+	for i, n := range p.nodes {
+		all = append(all, i)
+		if !now.Before(n.ejectedUntil) {
+			available = append(available, i)
+		}
+	}
+
+	if len(available) == 0 {
+		return all
+	}
+	return available
+}
+
+// RecordResult records the result of a request to the node, ejecting it if its error rate in the
+// error window exceeds the maximum.
+func (p *NodePool) RecordResult(i int, err error) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := p.nodes[i]
+	n.requests++
+	if err != nil {
+		n.errors++
+	}
+
+	n.results = append(n.results, err != nil)
+	if len(n.results) > p.config.ErrorWindow {
+		n.results = n.results[1:]
+	}
+	if len(n.results) < p.config.ErrorWindow {
+		return
+	}
+
+	failures := 0
+	for _, failed := range n.results {
+		if failed {
+			failures++
+		}
+	}
+	if float64(failures)/float64(len(n.results)) > p.config.MaxErrorRate {
+		p.eject(i, EjectionErrors, fmt.Sprintf("%d of the latest %d requests failed", failures, len(n.results)))
+	}
+}
+
+// RecordHeights records the block heights of the nodes' responses, by node index, ejecting the
+// nodes lagging behind the highest block height seen.
+func (p *NodePool) RecordHeights(heights map[int]int64) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, height := range heights {
+		p.nodes[i].height = height
+		if height > p.maxHeight {
+			p.maxHeight = height
+		}
+	}
+
+	for i, height := range heights {
+		if lag := p.maxHeight - height; lag > p.config.MaxHeightLag {
+			p.eject(i, EjectionLag, fmt.Sprintf("%d blocks behind", lag))
+		}
+	}
+}
+
+// Eject ejects the node for the cooldown.
+func (p *NodePool) Eject(i int, reason EjectionReason, detail string) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.eject(i, reason, detail)
+}
+
+func (p *NodePool) eject(i int, reason EjectionReason, detail string) {
+	n := p.nodes[i]
+	n.results = nil
+
+	// a node already ejected is only queried when all nodes are ejected, so it is not counted again
+	now := p.now()
+	if now.Before(n.ejectedUntil) {
+		n.ejectedUntil = now.Add(p.config.Cooldown)
+		return
+	}
+	n.ejectedUntil = now.Add(p.config.Cooldown)
+	n.ejections[reason]++
+
+	p.logger.Warn("[NodePool] ejected node %s for %v due to %s: %s", n.name, p.config.Cooldown, reason, detail)
+}
+
+// Stats returns the health of each node.
+func (p *NodePool) Stats() []NodeStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	stats := make([]NodeStats, 0, len(p.nodes))
+	for _, n := range p.nodes {
+		ejections := make(map[EjectionReason]uint64, len(n.ejections))
+		for reason, count := range n.ejections {
+			ejections[reason] = count
+		}
+
+		var lag int64
+		if n.height > 0 {
+			lag = p.maxHeight - n.height
+		}
+
+		stats = append(stats, NodeStats{
+			Name:      n.name,
+			Ejected:   now.Before(n.ejectedUntil),
+			HeightLag: lag,
+			Requests:  n.requests,
+			Errors:    n.errors,
+			Ejections: ejections,
+		})
+	}
+
+	return stats
+}
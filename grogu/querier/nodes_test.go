@@ -0,0 +1,137 @@
+package querier
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types/grpc"
+
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+)
+
+func newTestNodePool(n int, config NodePoolConfig) (*NodePool, *time.Time) {
+	names := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		names = append(names, fmt.Sprintf("node%d", i))
+	}
+
+	allowLevel, _ := log.ParseLogLevel("info")
+	pool := NewNodePool(names, config, logger.NewLogger(allowLevel))
+	now := time.Unix(1000, 0)
+	pool.now = func() time.Time { return now }
+
+	return pool, &now
+}
+
+func generateMockFuncWithResponse(blockHeight string, value string) QueryFunction[MockRequest, MockResponse] {
+	return func(ctx context.Context, in *MockRequest, opts ...grpc.CallOption) (*MockResponse, error) {
+		for _, opt := range opts {
+			if hOpt, ok := opt.(grpc.HeaderCallOption); ok {
+				*hOpt.HeaderAddr = metadata.Pairs(sdk.GRPCBlockHeightHeader, blockHeight)
+			}
+		}
+		return &MockResponse{Value: value}, nil
+	}
+}
+
+func TestNodePoolConfigValidate(t *testing.T) {
+	config := NodePoolConfig{MaxHeightLag: 5, MaxErrorRate: 0.5, ErrorWindow: 10, Cooldown: time.Minute}
+	require.NoError(t, config.Validate())
+
+	invalid := config
+	invalid.MaxErrorRate = 1.5
+	require.Error(t, invalid.Validate())
+
+	invalid = config
+	invalid.ErrorWindow = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = config
+	invalid.MaxHeightLag = -1
+	require.Error(t, invalid.Validate())
+}
+
+func TestNodePoolErrorRate(t *testing.T) {
+	pool, now := newTestNodePool(2, NodePoolConfig{MaxErrorRate: 0.5, ErrorWindow: 4, Cooldown: time.Minute})
+
+	// the error rate is only checked once the error window is full
+	pool.RecordResult(0, fmt.Errorf("failed"))
+	pool.RecordResult(0, fmt.Errorf("failed"))
+	pool.RecordResult(0, fmt.Errorf("failed"))
+	require.Equal(t, []int{0, 1}, pool.Available())
+
+	pool.RecordResult(0, nil)
+	require.Equal(t, []int{1}, pool.Available())
+
+	stats := pool.Stats()
+	require.True(t, stats[0].Ejected)
+	require.Equal(t, uint64(4), stats[0].Requests)
+	require.Equal(t, uint64(3), stats[0].Errors)
+	require.Equal(t, map[EjectionReason]uint64{EjectionErrors: 1}, stats[0].Ejections)
+
+	// the node is available again after the cooldown
+	*now = now.Add(time.Minute)
+	require.Equal(t, []int{0, 1}, pool.Available())
+}
+
+func TestNodePoolHeightLag(t *testing.T) {
+	pool, _ := newTestNodePool(3, NodePoolConfig{MaxHeightLag: 2, MaxErrorRate: 1, ErrorWindow: 1, Cooldown: time.Minute})
+
+	pool.RecordHeights(map[int]int64{0: 100, 1: 98, 2: 97})
+	require.Equal(t, []int{0, 1}, pool.Available())
+
+	stats := pool.Stats()
+	require.Equal(t, int64(3), stats[2].HeightLag)
+	require.Equal(t, map[EjectionReason]uint64{EjectionLag: 1}, stats[2].Ejections)
+}
+
+func TestNodePoolAllEjected(t *testing.T) {
+	pool, _ := newTestNodePool(2, NodePoolConfig{MaxErrorRate: 0, ErrorWindow: 1, Cooldown: time.Minute})
+
+	pool.RecordResult(0, fmt.Errorf("failed"))
+	pool.RecordResult(1, fmt.Errorf("failed"))
+	require.Equal(t, []int{0, 1}, pool.Available())
+
+	// a node failing again while ejected is not counted as another ejection
+	pool.RecordResult(0, fmt.Errorf("failed"))
+	require.Equal(t, map[EjectionReason]uint64{EjectionErrors: 1}, pool.Stats()[0].Ejections)
+}
+
+func TestGetMaxBlockHeightResponse_Disagreement(t *testing.T) {
+	pool, _ := newTestNodePool(4, NodePoolConfig{MaxHeightLag: 5, MaxErrorRate: 1, ErrorWindow: 1, Cooldown: time.Minute})
+	fs := []QueryFunction[MockRequest, MockResponse]{
+		generateMockFuncWithResponse("15", "majority"),
+		generateMockFuncWithResponse("15", "minority"),
+		generateMockFuncWithResponse("15", "majority"),
+		generateMockFuncWithResponse("14", "previous"),
+	}
+
+	maxBlockHeight := new(atomic.Int64)
+	resp, err := getMaxBlockHeightResponse(pool, fs, &MockRequest{}, maxBlockHeight)
+	require.NoError(t, err)
+	require.Equal(t, "majority", resp.Value)
+	require.Equal(t, int64(15), maxBlockHeight.Load())
+	require.Equal(t, []int{0, 2, 3}, pool.Available())
+	require.Equal(t, map[EjectionReason]uint64{EjectionDisagreement: 1}, pool.Stats()[1].Ejections)
+}
+
+func TestGetMaxBlockHeightResponse_DisagreementTie(t *testing.T) {
+	pool, _ := newTestNodePool(2, NodePoolConfig{MaxHeightLag: 5, MaxErrorRate: 1, ErrorWindow: 1, Cooldown: time.Minute})
+	fs := []QueryFunction[MockRequest, MockResponse]{
+		generateMockFuncWithResponse("15", "first"),
+		generateMockFuncWithResponse("15", "second"),
+	}
+
+	_, err := getMaxBlockHeightResponse(pool, fs, &MockRequest{}, new(atomic.Int64))
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1}, pool.Available())
+}
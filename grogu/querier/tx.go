@@ -10,21 +10,25 @@ import (
 
 type TxQuerier struct {
 	clientCtxs []client.Context
+	nodes      *NodePool
 }
 
-func NewTxQuerier(clientCtx client.Context, clients []rpcclient.RemoteClient) *TxQuerier {
+func NewTxQuerier(clientCtx client.Context, clients []rpcclient.RemoteClient, nodes *NodePool) *TxQuerier {
 	clientCtxs := make([]client.Context, 0, len(clients))
 	for _, cl := range clients {
 		clientCtxs = append(clientCtxs, clientCtx.WithClient(cl))
 	}
-	return &TxQuerier{clientCtxs}
+	return &TxQuerier{clientCtxs, nodes}
 }
 
+// QueryTx queries the transaction from the available nodes. The errors are not recorded to the node
+// pool, as a transaction not found yet is expected while waiting for it to be committed.
 func (q *TxQuerier) QueryTx(hash string) (*types.TxResponse, error) {
-	resultCh := make(chan *types.TxResponse, len(q.clientCtxs))
-	failureCh := make(chan error, len(q.clientCtxs))
+	indexes := availableNodes(q.nodes, len(q.clientCtxs))
+	resultCh := make(chan *types.TxResponse, len(indexes))
+	failureCh := make(chan error, len(indexes))
 
-	for _, ctx := range q.clientCtxs {
+	for _, i := range indexes {
 		go func(ctx client.Context) {
 			resp, err := tx.QueryTx(ctx, hash)
 			if err != nil {
@@ -33,11 +37,11 @@ func (q *TxQuerier) QueryTx(hash string) (*types.TxResponse, error) {
 			}
 
 			resultCh <- resp
-		}(ctx)
+		}(q.clientCtxs[i])
 	}
 
 	var err error
-	for range q.clientCtxs {
+	for range indexes {
 		select {
 		case res := <-resultCh:
 			return res, nil
@@ -3,6 +3,7 @@ package querier
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"sync/atomic"
 
@@ -17,6 +18,7 @@ type QueryFunction[I, O any] func(ctx context.Context, in *I, opts ...grpc.CallO
 type responseWithBlockHeight[T any] struct {
 	response    *T
 	blockHeight int64
+	node        int
 }
 
 func getResponseWithBlockHeight[I, O any](
@@ -42,56 +44,56 @@ func getResponseWithBlockHeight[I, O any](
 		return nil, fmt.Errorf("failed to parse block height with error: %v", err)
 	}
 
-	return &responseWithBlockHeight[O]{resp, blockHeight}, nil
-}
-
-func getResponseWithBlockHeightToChannel[I, O any](
-	resultCh chan *responseWithBlockHeight[O],
-	errorCh chan error,
-	f QueryFunction[I, O],
-	in *I,
-	opts ...grpc.CallOption,
-) {
-	resp, err := getResponseWithBlockHeight(f, in, opts...)
-	if err != nil {
-		errorCh <- err
-		return
-	}
-
-	resultCh <- resp
+	return &responseWithBlockHeight[O]{response: resp, blockHeight: blockHeight}, nil
 }
 
+// getMaxBlockHeightResponse queries the available nodes of the pool, or all nodes if it is nil, and
+// returns the response at the highest block height. Among different responses at that height, the
+// one of the majority is returned and the disagreeing nodes are ejected.
 func getMaxBlockHeightResponse[I, O any](
+	nodes *NodePool,
 	fs []QueryFunction[I, O],
 	in *I,
 	maxBlockHeight *atomic.Int64,
 	opts ...grpc.CallOption,
 ) (*O, error) {
-	resultCh := make(chan *responseWithBlockHeight[O], len(fs))
-	errorCh := make(chan error, len(fs))
+	indexes := availableNodes(nodes, len(fs))
+	resultCh := make(chan *responseWithBlockHeight[O], len(indexes))
+	errorCh := make(chan error, len(indexes))
+
+	for _, i := range indexes {
+		go func(i int) {
+			resp, err := getResponseWithBlockHeight(fs[i], in, opts...)
+			nodes.RecordResult(i, err)
+			if err != nil {
+				errorCh <- err
+				return
+			}
 
-	for _, f := range fs {
-		go getResponseWithBlockHeightToChannel(resultCh, errorCh, f, in, opts...)
+			resp.node = i
+			resultCh <- resp
+		}(i)
 	}
 
-	var resp *O
-	var localMaxBlockHeight int64
+	var results []*responseWithBlockHeight[O]
 	var err error
-	for range fs {
+	for range indexes {
 		select {
 		case r := <-resultCh:
-			if r.blockHeight <= localMaxBlockHeight {
-				continue
-			}
-
-			resp = r.response
-			localMaxBlockHeight = r.blockHeight
+			results = append(results, r)
 		case err = <-errorCh:
 			continue
 		}
 	}
 
-	if resp != nil {
+	if len(results) > 0 {
+		heights := make(map[int]int64, len(results))
+		for _, r := range results {
+			heights[r.node] = r.blockHeight
+		}
+		nodes.RecordHeights(heights)
+
+		resp, localMaxBlockHeight := getMajorityResponse(nodes, results)
 		if localMaxBlockHeight < maxBlockHeight.Load() {
 			return nil, fmt.Errorf("block height is lower than latest max block height")
 		}
@@ -106,3 +108,65 @@ func getMaxBlockHeightResponse[I, O any](
 
 	return nil, err
 }
+
+// getMajorityResponse returns the response of the most nodes at the highest block height of the
+// results, which are in order of arrival, ejecting the nodes disagreeing with it. On a tie, the
+// earliest response is returned and no node is ejected.
+func getMajorityResponse[O any](nodes *NodePool, results []*responseWithBlockHeight[O]) (*O, int64) {
+	var maxHeight int64
+	for _, r := range results {
+		if r.blockHeight > maxHeight {
+			maxHeight = r.blockHeight
+		}
+	}
+
+	type group struct {
+		response *O
+		nodes    []int
+	}
+	var groups []*group
+	for _, r := range results {
+		if r.blockHeight != maxHeight {
+			continue
+		}
+
+		found := false
+		for _, g := range groups {
+			if reflect.DeepEqual(g.response, r.response) {
+				g.nodes = append(g.nodes, r.node)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, &group{response: r.response, nodes: []int{r.node}})
+		}
+	}
+
+	majority, tie := groups[0], false
+	for _, g := range groups[1:] {
+		switch {
+		case len(g.nodes) > len(majority.nodes):
+			majority, tie = g, false
+		case len(g.nodes) == len(majority.nodes):
+			tie = true
+		}
+	}
+
+	if !tie {
+		for _, g := range groups {
+			if g == majority {
+				continue
+			}
+			for _, i := range g.nodes {
+				nodes.Eject(
+					i,
+					EjectionDisagreement,
+					fmt.Sprintf("response differs from %d other nodes at height %d", len(majority.nodes), maxHeight),
+				)
+			}
+		}
+	}
+
+	return majority.response, maxHeight
+}
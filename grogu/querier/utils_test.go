@@ -81,7 +81,7 @@ func TestGetMaxBlockHeightResponse(t *testing.T) {
 	maxBlockHeight.Store(10)
 	opts := []grpc.CallOption{}
 
-	resp, err := getMaxBlockHeightResponse(fs, in, maxBlockHeight, opts...)
+	resp, err := getMaxBlockHeightResponse(nil, fs, in, maxBlockHeight, opts...)
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	require.Equal(t, int64(15), maxBlockHeight.Load())
@@ -98,7 +98,7 @@ func TestGetMaxBlockHeightResponse_LowerThanCurrentMax(t *testing.T) {
 	maxBlockHeight.Store(20)
 	opts := []grpc.CallOption{}
 
-	resp, err := getMaxBlockHeightResponse(fs, in, maxBlockHeight, opts...)
+	resp, err := getMaxBlockHeightResponse(nil, fs, in, maxBlockHeight, opts...)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "block height is lower than latest max block height")
 	require.Nil(t, resp)
@@ -119,6 +119,6 @@ func TestGetMaxBlockHeightResponse_AllFail(t *testing.T) {
 	maxBlockHeight.Store(10)
 	opts := []grpc.CallOption{}
 
-	_, err := getMaxBlockHeightResponse(fs, in, maxBlockHeight, opts...)
+	_, err := getMaxBlockHeightResponse(nil, fs, in, maxBlockHeight, opts...)
 	require.Error(t, err)
 }
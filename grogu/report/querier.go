@@ -0,0 +1,80 @@
+package report
+
+import (
+	"context"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// HistoryQuerier queries the block results and the state of the chain at past heights.
+type HistoryQuerier interface {
+	QueryBlockResults(height int64) (*coretypes.ResultBlockResults, error)
+	QueryValidatorPrices(height int64, validator sdk.ValAddress) ([]feeds.ValidatorPrice, error)
+	QueryCurrentFeeds(height int64) ([]feeds.FeedWithDeviation, error)
+}
+
+// ChainQuerier is a HistoryQuerier of the nodes in order, returning the response of the first
+// node that succeeds. The nodes need to keep the state of the heights queried.
+type ChainQuerier struct {
+	clientCtx client.Context
+	clients   []rpcclient.RemoteClient
+}
+
+var _ HistoryQuerier = &ChainQuerier{}
+
+// NewChainQuerier creates a new ChainQuerier.
+func NewChainQuerier(clientCtx client.Context, clients []rpcclient.RemoteClient) *ChainQuerier {
+	return &ChainQuerier{clientCtx: clientCtx, clients: clients}
+}
+
+// QueryBlockResults queries the results of the block at the height.
+func (q *ChainQuerier) QueryBlockResults(height int64) (*coretypes.ResultBlockResults, error) {
+	return firstSuccess(q.clients, func(cl rpcclient.RemoteClient) (*coretypes.ResultBlockResults, error) {
+		return cl.BlockResults(context.Background(), &height)
+	})
+}
+
+// QueryValidatorPrices queries the prices of the validator at the height.
+func (q *ChainQuerier) QueryValidatorPrices(height int64, validator sdk.ValAddress) ([]feeds.ValidatorPrice, error) {
+	return firstSuccess(q.clients, func(cl rpcclient.RemoteClient) ([]feeds.ValidatorPrice, error) {
+		queryClient := feeds.NewQueryClient(q.clientCtx.WithClient(cl).WithHeight(height))
+		resp, err := queryClient.ValidatorPrices(
+			context.Background(),
+			&feeds.QueryValidatorPricesRequest{Validator: validator.String()},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return resp.ValidatorPrices, nil
+	})
+}
+
+// QueryCurrentFeeds queries the current feeds at the height.
+func (q *ChainQuerier) QueryCurrentFeeds(height int64) ([]feeds.FeedWithDeviation, error) {
+	return firstSuccess(q.clients, func(cl rpcclient.RemoteClient) ([]feeds.FeedWithDeviation, error) {
+		queryClient := feeds.NewQueryClient(q.clientCtx.WithClient(cl).WithHeight(height))
+		resp, err := queryClient.CurrentFeeds(context.Background(), &feeds.QueryCurrentFeedsRequest{})
+		if err != nil {
+			return nil, err
+		}
+		return resp.CurrentFeeds.Feeds, nil
+	})
+}
+
+func firstSuccess[T any](clients []rpcclient.RemoteClient, f func(rpcclient.RemoteClient) (T, error)) (T, error) {
+	var resp T
+	var err error
+	for _, cl := range clients {
+		resp, err = f(cl)
+		if err == nil {
+			return resp, nil
+		}
+	}
+	return resp, err
+}
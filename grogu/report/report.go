@@ -0,0 +1,268 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracle "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// DeviationBucketBps are the upper bounds in basis points of the buckets of the deviation
+// distribution, the last bucket being unbounded.
+var DeviationBucketBps = []float64{10, 50, 100, 500}
+
+// AssignedTimeFunc returns the assigned time of the validator to send the price of the signal,
+// given the interval of its feed and the timestamp of its previous price.
+type AssignedTimeFunc func(signalID string, interval int64, timestamp int64) time.Time
+
+// SignalReport is the performance of the validator on a signal.
+type SignalReport struct {
+	SignalID string `json:"signal_id"`
+	// Submissions is the number of prices submitted.
+	Submissions int `json:"submissions"`
+	// Timed is the number of submissions following a previous price, which are timed against it.
+	Timed int `json:"timed"`
+	// OnTime is the number of timed submissions within the interval of the previous price.
+	OnTime     int     `json:"on_time"`
+	OnTimeRate float64 `json:"on_time_rate"`
+	// Latency is the distribution of the delays in seconds of the timed submissions from their
+	// assigned time, negative when submitted early on a deviation.
+	Latency Distribution `json:"latency_seconds"`
+	// Compared is the number of available prices compared with an available aggregated price.
+	Compared int `json:"compared"`
+	// Deviation is the distribution of the deviations from the aggregated price in basis points.
+	Deviation Distribution `json:"deviation_bps"`
+	// DeviationBuckets is the number of compared prices by bucket of DeviationBucketBps.
+	DeviationBuckets []int `json:"deviation_buckets"`
+
+	latencies  []float64
+	deviations []float64
+}
+
+// Distribution is the summary of a set of values.
+type Distribution struct {
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	Max  float64 `json:"max"`
+}
+
+// Report is the performance of the validator over a block range.
+type Report struct {
+	Validator  string `json:"validator"`
+	FromHeight int64  `json:"from_height"`
+	ToHeight   int64  `json:"to_height"`
+	// DeactivationHeights are the heights at which the validator was deactivated. The deactivations
+	// for a miss report of the feeds module cannot be told apart from the ones for a miss report of
+	// an oracle request, as both emit the same event.
+	DeactivationHeights []int64        `json:"deactivation_heights"`
+	Signals             []SignalReport `json:"signals"`
+}
+
+// Generate scans the blocks of the range and reports the performance of the validator.
+func Generate(
+	querier HistoryQuerier,
+	validator sdk.ValAddress,
+	fromHeight int64,
+	toHeight int64,
+	assignedTime AssignedTimeFunc,
+) (Report, error) {
+	if fromHeight <= 1 || toHeight < fromHeight {
+		return Report{}, fmt.Errorf("invalid block range %d to %d", fromHeight, toHeight)
+	}
+
+	report := Report{
+		Validator:           validator.String(),
+		FromHeight:          fromHeight,
+		ToHeight:            toHeight,
+		DeactivationHeights: []int64{},
+	}
+	signals := make(map[string]*SignalReport)
+
+	for height := fromHeight; height <= toHeight; height++ {
+		results, err := querier.QueryBlockResults(height)
+		if err != nil {
+			return Report{}, fmt.Errorf("failed to query block results at height %d: %w", height, err)
+		}
+
+		var submissions []feeds.ValidatorPrice
+		for _, tx := range results.TxsResults {
+			if tx.Code != 0 {
+				continue
+			}
+			submissions = append(submissions, parseSubmissions(tx.Events, validator, height)...)
+		}
+
+		prices := make(map[string]feeds.Price)
+		for _, event := range results.FinalizeBlockEvents {
+			switch event.Type {
+			case feeds.EventTypeUpdatePrice:
+				if price, ok := parsePrice(event); ok {
+					prices[price.SignalID] = price
+				}
+			case oracle.EventTypeDeactivate:
+				if attribute(event, oracle.AttributeKeyValidator) == validator.String() {
+					report.DeactivationHeights = append(report.DeactivationHeights, height)
+				}
+			}
+		}
+
+		if len(submissions) == 0 {
+			continue
+		}
+
+		// the state before the submissions is the one committed at the previous height
+		previous, err := querier.QueryValidatorPrices(height-1, validator)
+		if err != nil {
+			return Report{}, fmt.Errorf("failed to query validator prices at height %d: %w", height-1, err)
+		}
+		currentFeeds, err := querier.QueryCurrentFeeds(height - 1)
+		if err != nil {
+			return Report{}, fmt.Errorf("failed to query current feeds at height %d: %w", height-1, err)
+		}
+		previousPrices := make(map[string]feeds.ValidatorPrice, len(previous))
+		for _, p := range previous {
+			previousPrices[p.SignalID] = p
+		}
+		intervals := make(map[string]int64, len(currentFeeds))
+		for _, feed := range currentFeeds {
+			intervals[feed.SignalID] = feed.Interval
+		}
+
+		for _, sub := range submissions {
+			s, ok := signals[sub.SignalID]
+			if !ok {
+				s = &SignalReport{SignalID: sub.SignalID, DeviationBuckets: make([]int, len(DeviationBucketBps)+1)}
+				signals[sub.SignalID] = s
+			}
+			s.Submissions++
+
+			prev, interval := previousPrices[sub.SignalID], intervals[sub.SignalID]
+			if prev.SignalPriceStatus != feeds.SIGNAL_PRICE_STATUS_UNSPECIFIED && interval > 0 {
+				s.Timed++
+				if sub.Timestamp <= prev.Timestamp+interval {
+					s.OnTime++
+				}
+				assigned := assignedTime(sub.SignalID, interval, prev.Timestamp)
+				s.latencies = append(s.latencies, float64(sub.Timestamp-assigned.Unix()))
+			}
+
+			price, ok := prices[sub.SignalID]
+			if sub.SignalPriceStatus == feeds.SIGNAL_PRICE_STATUS_AVAILABLE && ok &&
+				price.Status == feeds.PRICE_STATUS_AVAILABLE && price.Price > 0 {
+				deviation := math.Abs(float64(sub.Price)-float64(price.Price)) * 10000 / float64(price.Price)
+				s.Compared++
+				s.DeviationBuckets[sort.SearchFloat64s(DeviationBucketBps, deviation)]++
+				s.deviations = append(s.deviations, deviation)
+			}
+		}
+	}
+
+	report.Signals = make([]SignalReport, 0, len(signals))
+	for _, s := range signals {
+		if s.Timed > 0 {
+			s.OnTimeRate = float64(s.OnTime) / float64(s.Timed)
+		}
+		s.Latency = summarize(s.latencies)
+		s.Deviation = summarize(s.deviations)
+		report.Signals = append(report.Signals, *s)
+	}
+	sort.Slice(report.Signals, func(i, j int) bool { return report.Signals[i].SignalID < report.Signals[j].SignalID })
+
+	return report, nil
+}
+
+// parseSubmissions returns the prices submitted by the validator in the events of a transaction.
+func parseSubmissions(events []abci.Event, validator sdk.ValAddress, height int64) []feeds.ValidatorPrice {
+	var submissions []feeds.ValidatorPrice
+	for _, event := range events {
+		if event.Type != feeds.EventTypeSubmitSignalPrice ||
+			attribute(event, feeds.AttributeKeyValidator) != validator.String() {
+			continue
+		}
+
+		status, ok := feeds.SignalPriceStatus_value[attribute(event, feeds.AttributeKeySignalPriceStatus)]
+		if !ok {
+			continue
+		}
+		price, err := strconv.ParseUint(attribute(event, feeds.AttributeKeyPrice), 10, 64)
+		if err != nil {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(attribute(event, feeds.AttributeKeyTimestamp), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		submissions = append(submissions, feeds.ValidatorPrice{
+			SignalPriceStatus: feeds.SignalPriceStatus(status),
+			SignalID:          attribute(event, feeds.AttributeKeySignalID),
+			Price:             price,
+			Timestamp:         timestamp,
+			BlockHeight:       height,
+		})
+	}
+
+	return submissions
+}
+
+// parsePrice returns the aggregated price of an update price event.
+func parsePrice(event abci.Event) (feeds.Price, bool) {
+	status, ok := feeds.PriceStatus_value[attribute(event, feeds.AttributeKeyPriceStatus)]
+	if !ok {
+		return feeds.Price{}, false
+	}
+	price, err := strconv.ParseUint(attribute(event, feeds.AttributeKeyPrice), 10, 64)
+	if err != nil {
+		return feeds.Price{}, false
+	}
+
+	return feeds.Price{
+		Status:   feeds.PriceStatus(status),
+		SignalID: attribute(event, feeds.AttributeKeySignalID),
+		Price:    price,
+	}, true
+}
+
+func attribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// summarize returns the distribution of the values, using the nearest rank for the percentiles.
+func summarize(values []float64) Distribution {
+	if len(values) == 0 {
+		return Distribution{}
+	}
+
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p * float64(len(sorted))))
+		return sorted[max(rank, 1)-1]
+	}
+
+	return Distribution{
+		Mean: sum / float64(len(sorted)),
+		P50:  percentile(0.5),
+		P90:  percentile(0.9),
+		Max:  sorted[len(sorted)-1],
+	}
+}
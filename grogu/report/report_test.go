@@ -0,0 +1,145 @@
+package report
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+	oracle "github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var testValidator = sdk.ValAddress("1000000001")
+
+type mockHistoryQuerier struct {
+	results         map[int64]*coretypes.ResultBlockResults
+	validatorPrices map[int64][]feeds.ValidatorPrice
+	feeds           []feeds.FeedWithDeviation
+}
+
+func (q *mockHistoryQuerier) QueryBlockResults(height int64) (*coretypes.ResultBlockResults, error) {
+	if r, ok := q.results[height]; ok {
+		return r, nil
+	}
+	return &coretypes.ResultBlockResults{Height: height}, nil
+}
+
+func (q *mockHistoryQuerier) QueryValidatorPrices(height int64, _ sdk.ValAddress) ([]feeds.ValidatorPrice, error) {
+	prices, ok := q.validatorPrices[height]
+	if !ok {
+		return nil, fmt.Errorf("no state at height %d", height)
+	}
+	return prices, nil
+}
+
+func (q *mockHistoryQuerier) QueryCurrentFeeds(int64) ([]feeds.FeedWithDeviation, error) {
+	return q.feeds, nil
+}
+
+func newEvent(eventType string, attrs ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return event
+}
+
+func submitEvent(validator sdk.ValAddress, signalID string, price uint64, timestamp int64) abci.Event {
+	return newEvent(
+		feeds.EventTypeSubmitSignalPrice,
+		feeds.AttributeKeySignalPriceStatus, feeds.SIGNAL_PRICE_STATUS_AVAILABLE.String(),
+		feeds.AttributeKeyValidator, validator.String(),
+		feeds.AttributeKeySignalID, signalID,
+		feeds.AttributeKeyPrice, fmt.Sprintf("%d", price),
+		feeds.AttributeKeyTimestamp, fmt.Sprintf("%d", timestamp),
+	)
+}
+
+func updatePriceEvent(signalID string, price uint64) abci.Event {
+	return newEvent(
+		feeds.EventTypeUpdatePrice,
+		feeds.AttributeKeySignalID, signalID,
+		feeds.AttributeKeyPriceStatus, feeds.PRICE_STATUS_AVAILABLE.String(),
+		feeds.AttributeKeyPrice, fmt.Sprintf("%d", price),
+	)
+}
+
+func TestGenerate(t *testing.T) {
+	q := &mockHistoryQuerier{
+		results: map[int64]*coretypes.ResultBlockResults{
+			// on time, 20 seconds after the assigned time, and 100 bps off
+			10: {
+				TxsResults: []*abci.ExecTxResult{
+					{Events: []abci.Event{
+						submitEvent(testValidator, "BTC", 1010, 1080),
+						submitEvent(sdk.ValAddress("other"), "ETH", 1000, 1080),
+					}},
+					{Code: 1, Events: []abci.Event{submitEvent(testValidator, "ETH", 1000, 1080)}},
+				},
+				FinalizeBlockEvents: []abci.Event{updatePriceEvent("BTC", 1000)},
+			},
+			// late and exactly the aggregated price
+			20: {
+				TxsResults: []*abci.ExecTxResult{
+					{Events: []abci.Event{submitEvent(testValidator, "BTC", 1000, 1200)}},
+				},
+				FinalizeBlockEvents: []abci.Event{
+					updatePriceEvent("BTC", 1000),
+					newEvent(oracle.EventTypeDeactivate, oracle.AttributeKeyValidator, testValidator.String()),
+				},
+			},
+			// the first price of a signal is not timed, and is not compared without an aggregated price
+			30: {
+				TxsResults: []*abci.ExecTxResult{
+					{Events: []abci.Event{submitEvent(testValidator, "ETH", 2000, 1300)}},
+				},
+			},
+		},
+		validatorPrices: map[int64][]feeds.ValidatorPrice{
+			9:  {{SignalPriceStatus: feeds.SIGNAL_PRICE_STATUS_AVAILABLE, SignalID: "BTC", Timestamp: 1000}},
+			19: {{SignalPriceStatus: feeds.SIGNAL_PRICE_STATUS_AVAILABLE, SignalID: "BTC", Timestamp: 1080}},
+			29: {{SignalPriceStatus: feeds.SIGNAL_PRICE_STATUS_AVAILABLE, SignalID: "BTC", Timestamp: 1200}},
+		},
+		feeds: []feeds.FeedWithDeviation{{SignalID: "BTC", Interval: 100}, {SignalID: "ETH", Interval: 100}},
+	}
+	assignedTime := func(_ string, interval int64, timestamp int64) time.Time {
+		return time.Unix(timestamp+interval*60/100, 0)
+	}
+
+	r, err := Generate(q, testValidator, 2, 40, assignedTime)
+	require.NoError(t, err)
+	require.Equal(t, []int64{20}, r.DeactivationHeights)
+	require.Len(t, r.Signals, 2)
+
+	btc := r.Signals[0]
+	require.Equal(t, "BTC", btc.SignalID)
+	require.Equal(t, 2, btc.Submissions)
+	require.Equal(t, 2, btc.Timed)
+	require.Equal(t, 1, btc.OnTime)
+	require.Equal(t, 0.5, btc.OnTimeRate)
+	require.Equal(t, Distribution{Mean: 40, P50: 20, P90: 60, Max: 60}, btc.Latency)
+	require.Equal(t, 2, btc.Compared)
+	require.Equal(t, Distribution{Mean: 50, P50: 0, P90: 100, Max: 100}, btc.Deviation)
+	require.Equal(t, []int{1, 0, 1, 0, 0}, btc.DeviationBuckets)
+
+	eth := r.Signals[1]
+	require.Equal(t, "ETH", eth.SignalID)
+	require.Equal(t, 1, eth.Submissions)
+	require.Equal(t, 0, eth.Timed)
+	require.Equal(t, 0, eth.Compared)
+	require.Equal(t, Distribution{}, eth.Latency)
+
+	_, err = Generate(q, testValidator, 1, 40, assignedTime)
+	require.Error(t, err)
+
+	q.validatorPrices = nil
+	_, err = Generate(q, testValidator, 2, 40, assignedTime)
+	require.ErrorContains(t, err, "failed to query validator prices at height 9")
+}
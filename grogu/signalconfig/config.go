@@ -0,0 +1,95 @@
+package signalconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/viper"
+)
+
+// SignalOverride overrides the behavior of grogu for a signal.
+type SignalOverride struct {
+	// SignalID is the signal ID to override.
+	SignalID string `mapstructure:"signal_id"`
+	// Unsupported forces the signal to be submitted as unsupported.
+	Unsupported bool `mapstructure:"unsupported"`
+	// Provider pins the price of the signal to the price provider with this name.
+	Provider string `mapstructure:"provider"`
+	// DistributionStartPercentage overrides the initial percentage for price distribution.
+	DistributionStartPercentage *uint64 `mapstructure:"distribution_start_pct"`
+	// DistributionOffsetPercentage overrides the range of the percentage for price distribution.
+	DistributionOffsetPercentage *uint64 `mapstructure:"distribution_offset_pct"`
+}
+
+// Config is the local signal configuration of grogu, for example:
+//
+//	allowlist: ["CS:BTC-USD", "CS:ETH-USD"]
+//	denylist: ["CS:ETH-USD"]
+//	signals:
+//	  - signal_id: CS:BTC-USD
+//	    provider: https://prices.example.com/v1/prices
+//	    distribution_start_pct: 10
+//	    distribution_offset_pct: 20
+//
+// The signals not in the allowlist, if it is not empty, and the signals in the denylist are
+// submitted as unsupported.
+type Config struct {
+	Allowlist []string         `mapstructure:"allowlist"`
+	Denylist  []string         `mapstructure:"denylist"`
+	Signals   []SignalOverride `mapstructure:"signals"`
+}
+
+// Validate checks the signal configuration, where the distribution percentages that are not
+// overridden are the given defaults.
+func (c Config) Validate(defaultStart uint64, defaultOffset uint64) error {
+	seen := make(map[string]bool, len(c.Signals))
+	for _, s := range c.Signals {
+		if s.SignalID == "" {
+			return fmt.Errorf("signal ID of an override cannot be empty")
+		}
+		if seen[s.SignalID] {
+			return fmt.Errorf("duplicate override of signal %s", s.SignalID)
+		}
+		seen[s.SignalID] = true
+
+		start, offset := defaultStart, defaultOffset
+		if s.DistributionStartPercentage != nil {
+			start = *s.DistributionStartPercentage
+		}
+		if s.DistributionOffsetPercentage != nil {
+			offset = *s.DistributionOffsetPercentage
+		}
+		if offset == 0 {
+			return fmt.Errorf("distribution offset percentage of signal %s must be positive", s.SignalID)
+		}
+		if start+offset > 100 {
+			return fmt.Errorf("distribution percentages of signal %s cannot exceed 100", s.SignalID)
+		}
+	}
+
+	return nil
+}
+
+// Load reads the signal configuration from a YAML, JSON or TOML file and validates it against the
+// default distribution percentages. A missing file is an empty configuration.
+func Load(path string, defaultStart uint64, defaultOffset uint64) (Config, error) {
+	var config Config
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return config, err
+	}
+	if err := v.Unmarshal(&config); err != nil {
+		return config, err
+	}
+	if err := config.Validate(defaultStart, defaultOffset); err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
@@ -0,0 +1,168 @@
+package signalconfig
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+)
+
+// Store holds the signal configuration loaded from a file, which is reloaded when the file changes.
+type Store struct {
+	path string
+	// defaultStart and defaultOffset are the distribution percentages of the signals that are not
+	// overridden.
+	defaultStart  uint64
+	defaultOffset uint64
+
+	mu          sync.RWMutex
+	modTime     time.Time
+	size        int64
+	allowlist   map[string]bool
+	denylist    map[string]bool
+	overrides   map[string]SignalOverride
+	subscribers []func(*Store)
+}
+
+// NewStore creates a new Store of the signal configuration file at the given path with the default
+// distribution percentages. An empty path is an empty configuration that is never reloaded.
+func NewStore(path string, defaultStart uint64, defaultOffset uint64) *Store {
+	s := &Store{path: path, defaultStart: defaultStart, defaultOffset: defaultOffset}
+	s.set(Config{})
+	return s
+}
+
+// Subscribe registers a function called with the store whenever the configuration is reloaded.
+func (s *Store) Subscribe(f func(*Store)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers = append(s.subscribers, f)
+}
+
+// Reload loads the configuration again if the file has changed since the last load. It returns
+// whether the configuration is reloaded, and keeps the current configuration on errors.
+func (s *Store) Reload() (bool, error) {
+	if s.path == "" {
+		return false, nil
+	}
+
+	var modTime time.Time
+	var size int64
+	info, err := os.Stat(s.path)
+	switch {
+	case err == nil:
+		modTime, size = info.ModTime(), info.Size()
+	case !errors.Is(err, os.ErrNotExist):
+		return false, err
+	}
+
+	s.mu.RLock()
+	unchanged := modTime.Equal(s.modTime) && size == s.size
+	s.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	config, err := Load(s.path, s.defaultStart, s.defaultOffset)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	s.modTime, s.size = modTime, size
+	s.set(config)
+	subscribers := append([]func(*Store){}, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, f := range subscribers {
+		f(s)
+	}
+
+	return true, nil
+}
+
+// Start loads the configuration and reloads it on changes every interval.
+func (s *Store) Start(interval time.Duration, l *logger.Logger) error {
+	if _, err := s.Reload(); err != nil {
+		return err
+	}
+	if s.path == "" || interval <= 0 {
+		return nil
+	}
+
+	go func() {
+		for range time.Tick(interval) {
+			reloaded, err := s.Reload()
+			if err != nil {
+				l.Error("[SignalConfig] failed to reload signal config from %s: %v", s.path, err)
+				continue
+			}
+			if reloaded {
+				l.Info("[SignalConfig] reloaded signal config from %s", s.path)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// set replaces the configuration, the lock must be held by the caller except on creation.
+func (s *Store) set(config Config) {
+	s.allowlist = make(map[string]bool, len(config.Allowlist))
+	for _, signalID := range config.Allowlist {
+		s.allowlist[signalID] = true
+	}
+	s.denylist = make(map[string]bool, len(config.Denylist))
+	for _, signalID := range config.Denylist {
+		s.denylist[signalID] = true
+	}
+	s.overrides = make(map[string]SignalOverride, len(config.Signals))
+	for _, override := range config.Signals {
+		s.overrides[override.SignalID] = override
+	}
+}
+
+// IsUnsupported returns whether the signal is forced to be submitted as unsupported.
+func (s *Store) IsUnsupported(signalID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.allowlist) > 0 && !s.allowlist[signalID] {
+		return true
+	}
+	return s.denylist[signalID] || s.overrides[signalID].Unsupported
+}
+
+// Distribution returns the distribution percentages of the signal, which are the defaults of the
+// store unless overridden.
+func (s *Store) Distribution(signalID string) (uint64, uint64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	start, offset := s.defaultStart, s.defaultOffset
+	override := s.overrides[signalID]
+	if override.DistributionStartPercentage != nil {
+		start = *override.DistributionStartPercentage
+	}
+	if override.DistributionOffsetPercentage != nil {
+		offset = *override.DistributionOffsetPercentage
+	}
+	return start, offset
+}
+
+// Pins returns the price provider each pinned signal is pinned to.
+func (s *Store) Pins() map[string]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pins := make(map[string]string)
+	for signalID, override := range s.overrides {
+		if override.Provider != "" {
+			pins[signalID] = override.Provider
+		}
+	}
+	return pins
+}
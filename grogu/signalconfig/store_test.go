@@ -0,0 +1,198 @@
+package signalconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, path string, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	config, err := Load(filepath.Join(dir, "missing.yaml"), 50, 30)
+	require.NoError(t, err)
+	require.Equal(t, Config{}, config)
+
+	path := filepath.Join(dir, "signals.yaml")
+	writeConfig(t, path, `
+allowlist: ["CS:BTC-USD", "CS:ETH-USD"]
+denylist: ["CS:ETH-USD"]
+signals:
+  - signal_id: CS:BTC-USD
+    provider: http://localhost:8080
+    distribution_start_pct: 10
+    distribution_offset_pct: 20
+`, time.Now())
+
+	config, err = Load(path, 50, 30)
+	require.NoError(t, err)
+	start, offset := uint64(10), uint64(20)
+	require.Equal(t, Config{
+		Allowlist: []string{"CS:BTC-USD", "CS:ETH-USD"},
+		Denylist:  []string{"CS:ETH-USD"},
+		Signals: []SignalOverride{
+			{
+				SignalID:                     "CS:BTC-USD",
+				Provider:                     "http://localhost:8080",
+				DistributionStartPercentage:  &start,
+				DistributionOffsetPercentage: &offset,
+			},
+		},
+	}, config)
+}
+
+func TestConfigValidate(t *testing.T) {
+	pct := func(v uint64) *uint64 { return &v }
+
+	testCases := []struct {
+		name   string
+		config Config
+		err    string
+	}{
+		{
+			name:   "empty",
+			config: Config{},
+		},
+		{
+			name: "valid overrides",
+			config: Config{Signals: []SignalOverride{
+				{SignalID: "CS:BTC-USD", DistributionStartPercentage: pct(70), DistributionOffsetPercentage: pct(30)},
+				{SignalID: "CS:ETH-USD", Unsupported: true},
+			}},
+		},
+		{
+			name:   "empty signal ID",
+			config: Config{Signals: []SignalOverride{{Unsupported: true}}},
+			err:    "cannot be empty",
+		},
+		{
+			name:   "duplicate signal ID",
+			config: Config{Signals: []SignalOverride{{SignalID: "CS:BTC-USD"}, {SignalID: "CS:BTC-USD"}}},
+			err:    "duplicate override",
+		},
+		{
+			name:   "zero offset",
+			config: Config{Signals: []SignalOverride{{SignalID: "CS:BTC-USD", DistributionOffsetPercentage: pct(0)}}},
+			err:    "must be positive",
+		},
+		{
+			name: "exceeding percentages",
+			config: Config{Signals: []SignalOverride{
+				{SignalID: "CS:BTC-USD", DistributionStartPercentage: pct(90), DistributionOffsetPercentage: pct(20)},
+			}},
+			err: "cannot exceed 100",
+		},
+		{
+			name: "start exceeding with default offset",
+			config: Config{Signals: []SignalOverride{
+				{SignalID: "CS:BTC-USD", DistributionStartPercentage: pct(90)},
+			}},
+			err: "cannot exceed 100",
+		},
+		{
+			name: "offset exceeding with default start",
+			config: Config{Signals: []SignalOverride{
+				{SignalID: "CS:BTC-USD", DistributionOffsetPercentage: pct(60)},
+			}},
+			err: "cannot exceed 100",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate(50, 30)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestStoreReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signals.yaml")
+	store := NewStore(path, 50, 30)
+
+	var reloads []map[string]string
+	store.Subscribe(func(s *Store) {
+		reloads = append(reloads, s.Pins())
+	})
+
+	// missing file
+	reloaded, err := store.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+	require.False(t, store.IsUnsupported("CS:BTC-USD"))
+
+	now := time.Now()
+	writeConfig(t, path, `
+denylist: ["CS:ETH-USD"]
+signals:
+  - signal_id: CS:BTC-USD
+    provider: http://localhost:8080
+    distribution_start_pct: 10
+  - signal_id: CS:BAND-USD
+    unsupported: true
+`, now)
+
+	reloaded, err = store.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.False(t, store.IsUnsupported("CS:BTC-USD"))
+	require.True(t, store.IsUnsupported("CS:ETH-USD"))
+	require.True(t, store.IsUnsupported("CS:BAND-USD"))
+	require.Equal(t, []map[string]string{{"CS:BTC-USD": "http://localhost:8080"}}, reloads)
+
+	start, offset := store.Distribution("CS:BTC-USD")
+	require.Equal(t, uint64(10), start)
+	require.Equal(t, uint64(30), offset)
+	start, offset = store.Distribution("CS:ETH-USD")
+	require.Equal(t, uint64(50), start)
+	require.Equal(t, uint64(30), offset)
+
+	// unchanged file
+	reloaded, err = store.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+
+	// invalid file keeps the current configuration
+	writeConfig(t, path, `
+signals:
+  - signal_id: CS:BTC-USD
+    distribution_offset_pct: 0
+`, now.Add(time.Second))
+
+	reloaded, err = store.Reload()
+	require.Error(t, err)
+	require.False(t, reloaded)
+	require.True(t, store.IsUnsupported("CS:ETH-USD"))
+
+	// allowlist
+	writeConfig(t, path, `allowlist: ["CS:BTC-USD"]`, now.Add(2*time.Second))
+
+	reloaded, err = store.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.False(t, store.IsUnsupported("CS:BTC-USD"))
+	require.True(t, store.IsUnsupported("CS:ETH-USD"))
+	require.Len(t, reloads, 2)
+	require.Empty(t, reloads[1])
+
+	// removed file
+	require.NoError(t, os.Remove(path))
+
+	reloaded, err = store.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.False(t, store.IsUnsupported("CS:ETH-USD"))
+}
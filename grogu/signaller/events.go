@@ -0,0 +1,190 @@
+package signaller
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+const (
+	// subscriber is the name of the event subscriber of the signaller.
+	subscriber = "grogu-signaller"
+	// eventChannelCapacity is the capacity of the event channel of each subscription.
+	eventChannelCapacity = 100
+	// subscribeTimeout is the timeout of a subscription request.
+	subscribeTimeout = 5 * time.Second
+	// silenceTimeout is how long a subscription may go without a new block before it is considered
+	// dropped, as the websocket client does not close the event channel when it gives up.
+	silenceTimeout = time.Minute
+	// The backoff between failed subscription requests starts at resubscribeMinBackoff and doubles
+	// on every failure, up to resubscribeMaxBackoff.
+	resubscribeMinBackoff = time.Second
+	resubscribeMaxBackoff = time.Minute
+)
+
+// stateChange is a set of cached chain states that need to be refreshed.
+type stateChange uint8
+
+const (
+	changeValidValidator stateChange = 1 << iota
+	changeParams
+	changeFeeds
+	changeValidatorPrices
+	changeChainPrices
+
+	changeAll = changeValidValidator | changeParams | changeFeeds | changeValidatorPrices | changeChainPrices
+)
+
+// blockUpdate is the state change of a new block, derived from the events of the feeds module.
+type blockUpdate struct {
+	height int64
+	change stateChange
+	// prices are the on-chain prices updated in the block, where a nil price is not available.
+	prices map[string]*uint64
+}
+
+// subscribe subscribes to new blocks through all event clients and merges them into one channel.
+// A dropped subscription is subscribed again, and the signaller falls back to polling while no
+// subscription is live. It returns nil if there is no event client.
+func (s *Signaller) subscribe() <-chan blockUpdate {
+	if len(s.eventClients) == 0 {
+		return nil
+	}
+
+	updates := make(chan blockUpdate, eventChannelCapacity)
+	for _, c := range s.eventClients {
+		go s.keepSubscribed(c, updates)
+	}
+
+	return updates
+}
+
+// keepSubscribed subscribes to new blocks through the event client and forwards their updates,
+// subscribing again with backoff whenever the subscription is dropped.
+func (s *Signaller) keepSubscribed(c rpcclient.EventsClient, updates chan<- blockUpdate) {
+	backoff := resubscribeMinBackoff
+	for {
+		events, err := subscribeNewBlock(c)
+		if err != nil {
+			s.logger.Error("[Signaller] failed to subscribe to new blocks, retrying in %v: %v", backoff, err)
+			time.Sleep(backoff)
+			backoff = min(backoff*2, resubscribeMaxBackoff)
+			continue
+		}
+		backoff = resubscribeMinBackoff
+
+		s.liveSubscriptions.Add(1)
+		forwardBlockUpdates(events, updates, silenceTimeout)
+		s.liveSubscriptions.Add(-1)
+
+		s.logger.Warn("[Signaller] subscription to new blocks dropped, subscribing again")
+		unsubscribeNewBlock(c)
+	}
+}
+
+// forwardBlockUpdates forwards the updates of the new block events until the event channel is
+// closed or no event arrives within the timeout.
+func forwardBlockUpdates(events <-chan ctypes.ResultEvent, updates chan<- blockUpdate, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			if update, ok := parseBlockUpdate(ev); ok {
+				updates <- update
+			}
+			timer.Reset(timeout)
+		case <-timer.C:
+			return
+		}
+	}
+}
+
+func subscribeNewBlock(c rpcclient.EventsClient) (<-chan ctypes.ResultEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
+	defer cancel()
+
+	return c.Subscribe(ctx, subscriber, tmtypes.EventQueryNewBlock.String(), eventChannelCapacity)
+}
+
+func unsubscribeNewBlock(c rpcclient.EventsClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
+	defer cancel()
+
+	// The subscription may already be gone on the node.
+	_ = c.Unsubscribe(ctx, subscriber, tmtypes.EventQueryNewBlock.String())
+}
+
+// parseBlockUpdate derives the state change from the events of a new block. Every new block may
+// change the validity of the validator, while the events of the feeds module tell whether the
+// params, the current feeds and the on-chain prices changed.
+func parseBlockUpdate(ev ctypes.ResultEvent) (blockUpdate, bool) {
+	data, ok := ev.Data.(tmtypes.EventDataNewBlock)
+	if !ok || data.Block == nil {
+		return blockUpdate{}, false
+	}
+
+	update := blockUpdate{
+		height: data.Block.Height,
+		change: changeValidValidator,
+		prices: make(map[string]*uint64),
+	}
+	for _, event := range data.ResultFinalizeBlock.Events {
+		switch event.Type {
+		case types.EventTypeUpdateParams:
+			update.change |= changeParams
+		case types.EventTypeUpdateCurrentFeeds:
+			// The prices are reset when the current feeds are updated.
+			update.change |= changeFeeds | changeChainPrices
+		case types.EventTypeUpdatePrice:
+			var signalID, status, price string
+			for _, attr := range event.Attributes {
+				switch attr.Key {
+				case types.AttributeKeySignalID:
+					signalID = attr.Value
+				case types.AttributeKeyPriceStatus:
+					status = attr.Value
+				case types.AttributeKeyPrice:
+					price = attr.Value
+				}
+			}
+
+			p, err := strconv.ParseUint(price, 10, 64)
+			if err != nil || status != types.PRICE_STATUS_AVAILABLE.String() {
+				update.prices[signalID] = nil
+				continue
+			}
+			update.prices[signalID] = &p
+		}
+	}
+
+	return update, true
+}
+
+// applyBlockUpdate applies the update of a new block to the caches of the signaller.
+func (s *Signaller) applyBlockUpdate(update blockUpdate) {
+	// Updates of the same block may arrive from several clients.
+	if update.height <= s.lastBlockHeight {
+		return
+	}
+	s.lastBlockHeight = update.height
+
+	s.changes |= update.change
+	for signalID, price := range update.prices {
+		if price == nil {
+			delete(s.chainPrices, signalID)
+			continue
+		}
+		s.chainPrices[signalID] = *price
+	}
+}
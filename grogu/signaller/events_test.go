@@ -0,0 +1,180 @@
+package signaller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func newBlockEvent(height int64, events ...abci.Event) ctypes.ResultEvent {
+	return ctypes.ResultEvent{
+		Data: tmtypes.EventDataNewBlock{
+			Block:               &tmtypes.Block{Header: tmtypes.Header{Height: height}},
+			ResultFinalizeBlock: abci.ResponseFinalizeBlock{Events: events},
+		},
+	}
+}
+
+func updatePriceEvent(signalID string, status feeds.PriceStatus, price string) abci.Event {
+	return abci.Event{
+		Type: feeds.EventTypeUpdatePrice,
+		Attributes: []abci.EventAttribute{
+			{Key: feeds.AttributeKeySignalID, Value: signalID},
+			{Key: feeds.AttributeKeyPriceStatus, Value: status.String()},
+			{Key: feeds.AttributeKeyPrice, Value: price},
+		},
+	}
+}
+
+// fakeEventsClient serves the event channels in order, one per subscription.
+type fakeEventsClient struct {
+	mu            sync.Mutex
+	subscriptions []chan ctypes.ResultEvent
+	subscribed    int
+}
+
+func (c *fakeEventsClient) Subscribe(
+	_ context.Context,
+	_, _ string,
+	_ ...int,
+) (<-chan ctypes.ResultEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := c.subscriptions[min(c.subscribed, len(c.subscriptions)-1)]
+	c.subscribed++
+	return ch, nil
+}
+
+func (c *fakeEventsClient) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (c *fakeEventsClient) UnsubscribeAll(context.Context, string) error { return nil }
+
+func (s *SignallerTestSuite) TestSubscribeAgainAfterDrop() {
+	dropped := make(chan ctypes.ResultEvent)
+	close(dropped)
+	live := make(chan ctypes.ResultEvent, 1)
+	live <- newBlockEvent(10)
+
+	client := &fakeEventsClient{subscriptions: []chan ctypes.ResultEvent{dropped, live}}
+	s.Signaller.eventClients = []rpcclient.EventsClient{client}
+
+	updates := s.Signaller.subscribe()
+	s.Require().NotNil(updates)
+
+	select {
+	case update := <-updates:
+		s.Require().Equal(int64(10), update.height)
+	case <-time.After(5 * time.Second):
+		s.FailNow("no update after the subscription was dropped")
+	}
+	s.Require().Equal(int32(1), s.Signaller.liveSubscriptions.Load())
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	s.Require().Equal(2, client.subscribed)
+}
+
+func (s *SignallerTestSuite) TestSubscribeWithoutEventClient() {
+	s.Signaller.eventClients = nil
+	s.Require().Nil(s.Signaller.subscribe())
+}
+
+func (s *SignallerTestSuite) TestParseBlockUpdate() {
+	update, ok := parseBlockUpdate(newBlockEvent(10))
+	s.Require().True(ok)
+	s.Require().Equal(int64(10), update.height)
+	s.Require().Equal(changeValidValidator, update.change)
+	s.Require().Empty(update.prices)
+
+	update, ok = parseBlockUpdate(newBlockEvent(
+		11,
+		abci.Event{Type: feeds.EventTypeUpdateCurrentFeeds},
+		abci.Event{Type: feeds.EventTypeUpdateParams},
+		updatePriceEvent("signal1", feeds.PRICE_STATUS_AVAILABLE, "12000"),
+		updatePriceEvent("signal2", feeds.PRICE_STATUS_NOT_READY, "0"),
+	))
+	s.Require().True(ok)
+	s.Require().Equal(changeValidValidator|changeParams|changeFeeds|changeChainPrices, update.change)
+	s.Require().Len(update.prices, 2)
+	s.Require().Equal(uint64(12000), *update.prices["signal1"])
+	s.Require().Nil(update.prices["signal2"])
+
+	_, ok = parseBlockUpdate(ctypes.ResultEvent{Data: tmtypes.EventDataTx{}})
+	s.Require().False(ok)
+}
+
+func (s *SignallerTestSuite) TestApplyBlockUpdate() {
+	s.TestUpdateInternalVariables()
+	s.Require().Equal(stateChange(changeValidValidator), s.Signaller.changes)
+
+	update, _ := parseBlockUpdate(newBlockEvent(
+		10,
+		updatePriceEvent("signal1", feeds.PRICE_STATUS_AVAILABLE, "12000"),
+		updatePriceEvent("signal2", feeds.PRICE_STATUS_AVAILABLE, "500"),
+	))
+	s.Signaller.applyBlockUpdate(update)
+	s.Require().Equal(map[string]uint64{"signal1": 12000, "signal2": 500}, s.Signaller.chainPrices)
+
+	// Updates of the same block from another client are ignored.
+	update, _ = parseBlockUpdate(newBlockEvent(
+		10,
+		abci.Event{Type: feeds.EventTypeUpdateParams},
+		updatePriceEvent("signal1", feeds.PRICE_STATUS_NOT_READY, "0"),
+	))
+	s.Signaller.applyBlockUpdate(update)
+	s.Require().Equal(map[string]uint64{"signal1": 12000, "signal2": 500}, s.Signaller.chainPrices)
+	s.Require().Equal(stateChange(changeValidValidator), s.Signaller.changes)
+
+	update.height = 11
+	s.Signaller.applyBlockUpdate(update)
+	s.Require().Equal(map[string]uint64{"signal2": 500}, s.Signaller.chainPrices)
+	s.Require().Equal(changeValidValidator|changeParams, s.Signaller.changes)
+}
+
+func (s *SignallerTestSuite) TestRefreshStates() {
+	s.Require().True(s.Signaller.refreshStates())
+	s.Require().True(s.Signaller.valid)
+	s.Require().Equal(stateChange(0), s.Signaller.changes)
+	s.Require().NotEmpty(s.Signaller.signalIDToFeed)
+	s.Require().Equal(map[string]uint64{"signal1": 10000}, s.Signaller.chainPrices)
+
+	// A finished submission refreshes the validator prices.
+	s.Signaller.submitPrices([]feeds.SignalPrice{
+		{SignalID: "signal2", Price: 100, Status: feeds.SIGNAL_PRICE_STATUS_AVAILABLE},
+	}, "uuid")
+	<-s.SubmitCh
+	s.Signaller.checkFinishedSubmissions()
+	s.Require().Equal(stateChange(0), s.Signaller.changes)
+
+	s.Signaller.pendingSignalIDs.Delete("signal2")
+	s.Signaller.checkFinishedSubmissions()
+	s.Require().Equal(changeValidatorPrices, s.Signaller.changes)
+	s.Require().Empty(s.Signaller.submittedSignalIDs)
+
+	s.Require().True(s.Signaller.refreshStates())
+	s.Require().Equal(stateChange(0), s.Signaller.changes)
+}
+
+func (s *SignallerTestSuite) TestNextWakeUp() {
+	s.TestUpdateInternalVariables()
+
+	// signal1 was reported at 0, so it becomes due after the cooldown, at its assigned time and at
+	// the urgent deadline.
+	now := time.Unix(0, 0)
+	s.Require().Equal(now.Add(s.Signaller.interval), s.Signaller.nextWakeUp(now))
+
+	s.Signaller.interval = time.Hour
+	cooldownTime := time.Unix(s.Signaller.params.CooldownTime+TimeBuffer, 0)
+	s.Require().Equal(cooldownTime, s.Signaller.nextWakeUp(now))
+	s.Require().Equal(s.assignedTime, s.Signaller.nextWakeUp(cooldownTime))
+	s.Require().Equal(time.Unix(60-FixedIntervalOffset+1, 0), s.Signaller.nextWakeUp(s.assignedTime))
+	s.Require().Equal(time.Unix(60, 0).Add(time.Hour), s.Signaller.nextWakeUp(time.Unix(60, 0)))
+}
@@ -3,13 +3,13 @@ package signaller
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
 
 	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
 )
 
-type BothanClient interface {
-	bothanclient.Client
+type PriceProvider interface {
+	GetPrices(signalIDs []string) (*bothan.GetPricesResponse, error)
 }
 
 type FeedQuerier interface {
@@ -17,4 +17,5 @@ type FeedQuerier interface {
 	QueryValidatorPrices(valAddress sdk.ValAddress) (*feeds.QueryValidatorPricesResponse, error)
 	QueryParams() (*feeds.QueryParamsResponse, error)
 	QueryCurrentFeeds() (*feeds.QueryCurrentFeedsResponse, error)
+	QueryPrices(signalIDs []string) (*feeds.QueryPricesResponse, error)
 }
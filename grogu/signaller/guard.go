@@ -0,0 +1,213 @@
+package signaller
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// GuardAction is the action taken on a price that violates the guard.
+type GuardAction string
+
+const (
+	// GuardActionUnavailable submits the violating price as unavailable.
+	GuardActionUnavailable GuardAction = "unavailable"
+	// GuardActionHold does not submit the violating price at all.
+	GuardActionHold GuardAction = "hold"
+)
+
+// ViolationReason is the reason a price violates the guard.
+type ViolationReason string
+
+const (
+	ViolationZero     ViolationReason = "zero"
+	ViolationOverflow ViolationReason = "overflow"
+	ViolationJump     ViolationReason = "jump"
+	ViolationStale    ViolationReason = "stale"
+)
+
+// GuardConfig holds the configuration of the sanity checks on outgoing prices.
+type GuardConfig struct {
+	// MaxJumpBps is the maximum deviation in basis points of a price from the on-chain price, or from
+	// the validator's last price if the on-chain price is not available. Zero disables the check.
+	MaxJumpBps uint64
+	// MaxPrice is the maximum price that can be submitted. Zero disables the check.
+	MaxPrice uint64
+	// StaleDuration is the duration after which a price of a signal in StaleSignalIDs that has not
+	// changed is considered stale. Zero disables the check.
+	StaleDuration time.Duration
+	// StaleSignalIDs are the signals whose prices are checked for staleness. Signals not listed here,
+	// such as stablecoins whose prices legitimately stay flat, are never considered stale.
+	StaleSignalIDs []string
+	// Action is the action taken on a violating price.
+	Action GuardAction
+}
+
+// Validate checks the guard configuration.
+func (c GuardConfig) Validate() error {
+	switch c.Action {
+	case GuardActionUnavailable, GuardActionHold:
+	default:
+		return fmt.Errorf("invalid price guard action: %s", c.Action)
+	}
+	if c.StaleDuration < 0 {
+		return fmt.Errorf("price stale duration cannot be negative")
+	}
+	if len(c.StaleSignalIDs) > 0 && c.StaleDuration == 0 {
+		return fmt.Errorf("price stale duration must be set to check the staleness of signals")
+	}
+	return nil
+}
+
+// ViolationCount is the number of violations of a signal for a reason.
+type ViolationCount struct {
+	SignalID string
+	Reason   ViolationReason
+	Count    uint64
+}
+
+type violationKey struct {
+	signalID string
+	reason   ViolationReason
+}
+
+type lastChange struct {
+	price uint64
+	since time.Time
+}
+
+// Guard runs local sanity checks on the prices before they are submitted. Since Bothan does not
+// report when a price was last updated by its sources, staleness is detected locally as a price
+// that has not changed for longer than the configured duration. As this also flags prices that are
+// legitimately flat, only the signals that opt in through StaleSignalIDs are checked.
+type Guard struct {
+	config       GuardConfig
+	staleSignals map[string]struct{}
+
+	mu          sync.Mutex
+	lastChanges map[string]lastChange
+	violations  map[violationKey]uint64
+}
+
+// NewGuard creates a new Guard with the given configuration.
+func NewGuard(config GuardConfig) *Guard {
+	staleSignals := make(map[string]struct{}, len(config.StaleSignalIDs))
+	for _, signalID := range config.StaleSignalIDs {
+		staleSignals[signalID] = struct{}{}
+	}
+
+	return &Guard{
+		config:       config,
+		staleSignals: staleSignals,
+		lastChanges:  make(map[string]lastChange),
+		violations:   make(map[violationKey]uint64),
+	}
+}
+
+// Action returns the action taken on a violating price.
+func (g *Guard) Action() GuardAction {
+	return g.config.Action
+}
+
+// Observe records the last change of a price fetched from the provider. It should be called on
+// every fetched price, not only the ones due to be submitted, so that a change of the price between
+// two submissions resets its staleness.
+func (g *Guard) Observe(price types.SignalPrice, now time.Time) {
+	if _, ok := g.staleSignals[price.SignalID]; !ok {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if price.Status != types.SIGNAL_PRICE_STATUS_AVAILABLE {
+		delete(g.lastChanges, price.SignalID)
+		return
+	}
+
+	last, ok := g.lastChanges[price.SignalID]
+	if !ok || last.price != price.Price {
+		g.lastChanges[price.SignalID] = lastChange{price: price.Price, since: now}
+	}
+}
+
+// Check returns the reason the price violates the guard, or an empty reason if it does not. The
+// reference price is the last known price of the signal, zero if there is none. Only available
+// prices are checked.
+func (g *Guard) Check(price types.SignalPrice, reference uint64, now time.Time) ViolationReason {
+	if price.Status != types.SIGNAL_PRICE_STATUS_AVAILABLE {
+		return ""
+	}
+
+	reason := g.check(price, reference, now)
+	if reason != "" {
+		g.recordViolation(price.SignalID, reason)
+	}
+	return reason
+}
+
+func (g *Guard) check(price types.SignalPrice, reference uint64, now time.Time) ViolationReason {
+	if price.Price == 0 {
+		return ViolationZero
+	}
+
+	if g.config.MaxPrice > 0 && price.Price > g.config.MaxPrice {
+		return ViolationOverflow
+	}
+
+	if g.isStale(price, now) {
+		return ViolationStale
+	}
+
+	if g.config.MaxJumpBps > 0 && reference > 0 && deviationBps(reference, price.Price) > g.config.MaxJumpBps {
+		return ViolationJump
+	}
+
+	return ""
+}
+
+// isStale checks whether the price has not changed for longer than the stale duration since it was
+// last observed to change.
+func (g *Guard) isStale(price types.SignalPrice, now time.Time) bool {
+	if g.config.StaleDuration == 0 {
+		return false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	last, ok := g.lastChanges[price.SignalID]
+	if !ok || last.price != price.Price {
+		return false
+	}
+
+	return now.Sub(last.since) > g.config.StaleDuration
+}
+
+// deviationBps returns the deviation of the new price from the reference price in basis points.
+func deviationBps(reference uint64, price uint64) uint64 {
+	diff := math.Abs(float64(price) - float64(reference))
+	return uint64(diff * 10000 / float64(reference))
+}
+
+func (g *Guard) recordViolation(signalID string, reason ViolationReason) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.violations[violationKey{signalID, reason}]++
+}
+
+// Violations returns the number of violations of each signal and reason.
+func (g *Guard) Violations() []ViolationCount {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	counts := make([]ViolationCount, 0, len(g.violations))
+	for key, count := range g.violations {
+		counts = append(counts, ViolationCount{SignalID: key.signalID, Reason: key.reason, Count: count})
+	}
+	return counts
+}
@@ -0,0 +1,99 @@
+package signaller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func availablePrice(price uint64) types.SignalPrice {
+	return types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "signal1", price)
+}
+
+func TestGuardConfigValidate(t *testing.T) {
+	require.NoError(t, GuardConfig{Action: GuardActionUnavailable}.Validate())
+	require.NoError(t, GuardConfig{Action: GuardActionHold}.Validate())
+	require.Error(t, GuardConfig{Action: "drop"}.Validate())
+	require.Error(t, GuardConfig{Action: GuardActionHold, StaleDuration: -time.Second}.Validate())
+	require.NoError(t, GuardConfig{
+		Action:         GuardActionHold,
+		StaleDuration:  time.Minute,
+		StaleSignalIDs: []string{"signal1"},
+	}.Validate())
+	require.Error(t, GuardConfig{Action: GuardActionHold, StaleSignalIDs: []string{"signal1"}}.Validate())
+}
+
+func TestGuardCheck(t *testing.T) {
+	g := NewGuard(GuardConfig{MaxJumpBps: 1000, MaxPrice: 1000000, Action: GuardActionUnavailable})
+	now := time.Unix(100, 0)
+
+	require.Equal(t, ViolationReason(""), g.Check(availablePrice(1000), 0, now))
+	require.Equal(t, ViolationZero, g.Check(availablePrice(0), 1000, now))
+	require.Equal(t, ViolationOverflow, g.Check(availablePrice(1000001), 0, now))
+
+	// The maximum jump is inclusive.
+	require.Equal(t, ViolationReason(""), g.Check(availablePrice(1100), 1000, now))
+	require.Equal(t, ViolationReason(""), g.Check(availablePrice(900), 1000, now))
+	require.Equal(t, ViolationJump, g.Check(availablePrice(1101), 1000, now))
+	require.Equal(t, ViolationJump, g.Check(availablePrice(899), 1000, now))
+
+	// Unavailable prices are not checked.
+	unavailable := types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, "signal1", 0)
+	require.Equal(t, ViolationReason(""), g.Check(unavailable, 1000, now))
+
+	require.ElementsMatch(t, []ViolationCount{
+		{SignalID: "signal1", Reason: ViolationZero, Count: 1},
+		{SignalID: "signal1", Reason: ViolationOverflow, Count: 1},
+		{SignalID: "signal1", Reason: ViolationJump, Count: 2},
+	}, g.Violations())
+}
+
+func TestGuardCheckDisabled(t *testing.T) {
+	g := NewGuard(GuardConfig{Action: GuardActionHold})
+	now := time.Unix(100, 0)
+
+	require.Equal(t, ViolationReason(""), g.Check(availablePrice(1000000), 1, now))
+	require.Equal(t, ViolationReason(""), g.Check(availablePrice(1000000), 1, now.Add(time.Hour)))
+	// Zero prices are always rejected.
+	require.Equal(t, ViolationZero, g.Check(availablePrice(0), 1, now))
+}
+
+func TestGuardCheckStale(t *testing.T) {
+	g := NewGuard(GuardConfig{
+		StaleDuration:  time.Minute,
+		StaleSignalIDs: []string{"signal1"},
+		Action:         GuardActionHold,
+	})
+	start := time.Unix(100, 0)
+
+	check := func(price types.SignalPrice, now time.Time) ViolationReason {
+		g.Observe(price, now)
+		return g.Check(price, 0, now)
+	}
+
+	require.Equal(t, ViolationReason(""), check(availablePrice(1000), start))
+	require.Equal(t, ViolationReason(""), check(availablePrice(1000), start.Add(time.Minute)))
+	require.Equal(t, ViolationStale, check(availablePrice(1000), start.Add(61*time.Second)))
+
+	// A change of the price resets the staleness.
+	require.Equal(t, ViolationReason(""), check(availablePrice(1001), start.Add(62*time.Second)))
+
+	// So does a change observed between two checks.
+	g.Observe(availablePrice(1002), start.Add(90*time.Second))
+	g.Observe(availablePrice(1001), start.Add(100*time.Second))
+	require.Equal(t, ViolationReason(""), g.Check(availablePrice(1001), 0, start.Add(150*time.Second)))
+	require.Equal(t, ViolationStale, g.Check(availablePrice(1001), 0, start.Add(161*time.Second)))
+
+	// So does a price becoming unavailable.
+	unavailable := types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, "signal1", 0)
+	require.Equal(t, ViolationReason(""), check(unavailable, start.Add(10*time.Minute)))
+	require.Equal(t, ViolationReason(""), check(availablePrice(1001), start.Add(11*time.Minute)))
+
+	// Signals that do not opt in are never stale, such as a stablecoin with a flat price.
+	stablecoin := types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_AVAILABLE, "signal2", 100000000)
+	require.Equal(t, ViolationReason(""), check(stablecoin, start))
+	require.Equal(t, ViolationReason(""), check(stablecoin, start.Add(time.Hour)))
+}
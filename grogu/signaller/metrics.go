@@ -0,0 +1,32 @@
+package signaller
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type guardCollector struct {
+	guard              *Guard
+	violationCountDesc *prometheus.Desc
+}
+
+// NewCollector creates a prometheus collector of the price guard violations.
+func NewCollector(g *Guard) prometheus.Collector {
+	return &guardCollector{
+		guard: g,
+		violationCountDesc: prometheus.NewDesc(
+			"grogu_price_guard_violation_total",
+			"Number of prices violating the price guard since last grogu restart",
+			[]string{"signal_id", "reason"}, nil),
+	}
+}
+
+func (collector guardCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.violationCountDesc
+}
+
+func (collector guardCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, v := range collector.guard.Violations() {
+		ch <- prometheus.MustNewConstMetric(collector.violationCountDesc, prometheus.CounterValue,
+			float64(v.Count), v.SignalID, string(v.Reason))
+	}
+}
@@ -2,12 +2,17 @@ package signaller
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
 
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
+	"github.com/bandprotocol/chain/v3/grogu/signalconfig"
 	"github.com/bandprotocol/chain/v3/grogu/submitter"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/x/feeds/types"
@@ -19,99 +24,196 @@ const (
 )
 
 type Signaller struct {
-	feedQuerier  FeedQuerier
-	bothanClient BothanClient
+	feedQuerier   FeedQuerier
+	priceProvider PriceProvider
+	eventClients  []rpcclient.EventsClient
 	// How often to check for signal changes
-	interval         time.Duration
+	interval time.Duration
+	// How often to refresh all cached chain states regardless of events
+	resyncInterval   time.Duration
 	submitCh         chan<- submitter.SignalPriceSubmission
 	logger           *logger.Logger
 	valAddress       sdk.ValAddress
 	pendingSignalIDs *sync.Map
 
-	distributionStartPercentage  uint64
-	distributionOffsetPercentage uint64
+	guard        *Guard
+	metrics      *metrics.Metrics
+	signalConfig *signalconfig.Store
 
 	signalIDToFeed           map[string]types.FeedWithDeviation
+	feedsLastUpdateTimestamp int64
 	signalIDToValidatorPrice map[string]types.ValidatorPrice
+	chainPrices              map[string]uint64
 	params                   *types.Params
+
+	// valid is whether the validator is required to feed prices.
+	valid bool
+	// changes are the cached chain states that need to be refreshed.
+	changes stateChange
+	// lastBlockHeight is the height of the last new block received from the event subscriptions.
+	lastBlockHeight int64
+	// liveSubscriptions is the number of event subscriptions currently receiving new blocks.
+	liveSubscriptions atomic.Int32
+	// submittedSignalIDs are the signal IDs submitted by the signaller that were pending.
+	submittedSignalIDs map[string]struct{}
 }
 
 func New(
 	feedQuerier FeedQuerier,
-	bothanClient BothanClient,
+	priceProvider PriceProvider,
+	eventClients []rpcclient.EventsClient,
 	interval time.Duration,
+	resyncInterval time.Duration,
 	submitCh chan<- submitter.SignalPriceSubmission,
 	logger *logger.Logger,
 	valAddress sdk.ValAddress,
 	pendingSignalIDs *sync.Map,
-	distributionStartPercentage uint64,
-	distributionOffsetPercentage uint64,
+	guard *Guard,
+	metrics *metrics.Metrics,
+	signalConfig *signalconfig.Store,
 ) *Signaller {
 	return &Signaller{
-		feedQuerier:                  feedQuerier,
-		bothanClient:                 bothanClient,
-		interval:                     interval,
-		submitCh:                     submitCh,
-		logger:                       logger,
-		valAddress:                   valAddress,
-		pendingSignalIDs:             pendingSignalIDs,
-		distributionStartPercentage:  distributionStartPercentage,
-		distributionOffsetPercentage: distributionOffsetPercentage,
-		signalIDToFeed:               make(map[string]types.FeedWithDeviation),
-		signalIDToValidatorPrice:     make(map[string]types.ValidatorPrice),
-		params:                       nil,
+		feedQuerier:              feedQuerier,
+		priceProvider:            priceProvider,
+		eventClients:             eventClients,
+		interval:                 interval,
+		resyncInterval:           resyncInterval,
+		submitCh:                 submitCh,
+		logger:                   logger,
+		valAddress:               valAddress,
+		pendingSignalIDs:         pendingSignalIDs,
+		guard:                    guard,
+		metrics:                  metrics,
+		signalConfig:             signalConfig,
+		signalIDToFeed:           make(map[string]types.FeedWithDeviation),
+		signalIDToValidatorPrice: make(map[string]types.ValidatorPrice),
+		chainPrices:              make(map[string]uint64),
+		params:                   nil,
+		valid:                    false,
+		changes:                  changeAll,
+		submittedSignalIDs:       make(map[string]struct{}),
 	}
 }
 
+// Start runs the signaller. The cached chain states are refreshed only when new blocks tell that
+// they changed, or every resync interval, while the prices are checked every interval or when a
+// signal becomes due for submission, whichever comes first.
 func (s *Signaller) Start() {
+	updates := s.subscribe()
+	if updates == nil {
+		s.logger.Info("[Signaller] no event client, polling chain states every %v", s.interval)
+	}
+
+	resync := time.NewTicker(s.resyncInterval)
+	defer resync.Stop()
+
+	wakeUp := time.Now()
 	for {
-		time.Sleep(s.interval)
+		select {
+		case update := <-updates:
+			s.applyBlockUpdate(update)
+		case <-resync.C:
+			s.changes = changeAll
+		case <-time.After(time.Until(wakeUp)):
+			// Poll the chain states while no subscription is live.
+			if s.liveSubscriptions.Load() == 0 {
+				s.changes = changeAll
+			}
+		}
+
+		wakeUp = time.Now().Add(s.interval)
+		if !s.refreshStates() {
+			continue
+		}
+
+		s.execute()
+		wakeUp = s.nextWakeUp(time.Now())
+	}
+}
 
+// refreshStates refreshes the changed chain states and returns whether the validator is required
+// to feed prices with up-to-date states.
+func (s *Signaller) refreshStates() bool {
+	s.checkFinishedSubmissions()
+
+	if s.changes&changeValidValidator != 0 {
 		resp, err := s.feedQuerier.QueryValidValidator(s.valAddress)
 		if err != nil {
 			s.logger.Error("[Signaller] failed to query valid validator: %v", err)
-			continue
+			return false
 		}
+		s.changes &^= changeValidValidator
 
-		if !resp.Valid {
+		if resp.Valid && !s.valid {
+			// The states may have changed while the validator was not required to feed prices.
+			s.changes |= changeAll &^ changeValidValidator
+		}
+		if !resp.Valid && s.valid {
 			s.logger.Info("[Signaller] validator is not required to feed prices")
-			continue
 		}
+		s.valid = resp.Valid
+	}
 
-		if !s.updateInternalVariables() {
-			s.logger.Error("[Signaller] failed to update internal variables")
-			continue
-		}
+	if !s.valid {
+		s.metrics.SetReportDeadlines(nil)
+		return false
+	}
 
-		s.execute()
+	if !s.updateInternalVariables() {
+		s.logger.Error("[Signaller] failed to update internal variables")
+		return false
 	}
+	s.metrics.SetReportDeadlines(s.getReportDeadlines())
+
+	return true
 }
 
+// updateInternalVariables refreshes the changed params, current feeds and validator prices
+// concurrently, followed by the on-chain prices of the current feeds. A failure to refresh the
+// on-chain prices is not fatal, as they are only used for sanity checks and metrics.
 func (s *Signaller) updateInternalVariables() bool {
-	resultCh := make(chan bool, 3)
+	updaters := []struct {
+		change stateChange
+		update func() bool
+	}{
+		{changeParams, s.updateParams},
+		{changeFeeds, s.updateFeedMap},
+		{changeValidatorPrices, s.updateValidatorPriceMap},
+	}
+
+	results := make([]bool, len(updaters))
 	var wg sync.WaitGroup
+	for i, u := range updaters {
+		if s.changes&u.change == 0 {
+			results[i] = true
+			continue
+		}
 
-	updater := func(f func() bool) {
-		defer wg.Done()
-		resultCh <- f()
+		wg.Add(1)
+		go func(i int, update func() bool) {
+			defer wg.Done()
+			results[i] = update()
+		}(i, u.update)
 	}
-
-	wg.Add(3)
-	go updater(s.updateParams)
-	go updater(s.updateFeedMap)
-	go updater(s.updateValidatorPriceMap)
 	wg.Wait()
-	close(resultCh)
 
 	success := true
-	for result := range resultCh {
-		if !result {
+	for i, u := range updaters {
+		if !results[i] {
 			success = false
-			break
+			continue
 		}
+		s.changes &^= u.change
+	}
+	if !success {
+		return false
+	}
+
+	if s.changes&changeChainPrices != 0 && s.updateChainPriceMap() {
+		s.changes &^= changeChainPrices
 	}
 
-	return success
+	return true
 }
 
 func (s *Signaller) updateParams() bool {
@@ -135,6 +237,7 @@ func (s *Signaller) updateFeedMap() bool {
 	s.signalIDToFeed = sliceToMap(resp.CurrentFeeds.Feeds, func(feed types.FeedWithDeviation) string {
 		return feed.SignalID
 	})
+	s.feedsLastUpdateTimestamp = resp.CurrentFeeds.LastUpdateTimestamp
 
 	return true
 }
@@ -153,6 +256,65 @@ func (s *Signaller) updateValidatorPriceMap() bool {
 	return true
 }
 
+func (s *Signaller) updateChainPriceMap() bool {
+	resp, err := s.feedQuerier.QueryPrices(s.getAllSignalIDs())
+	if err != nil {
+		// The validator's own last prices are used as the reference of the guard instead.
+		s.logger.Error("[Signaller] failed to query on-chain prices: %v", err)
+		return false
+	}
+
+	s.chainPrices = make(map[string]uint64, len(resp.Prices))
+	for _, price := range resp.Prices {
+		if price.Status == types.PRICE_STATUS_AVAILABLE {
+			s.chainPrices[price.SignalID] = price.Price
+		}
+	}
+
+	return true
+}
+
+// checkFinishedSubmissions marks the validator prices to be refreshed when a submission of the
+// signaller is no longer pending, as the submission has been either committed or given up.
+func (s *Signaller) checkFinishedSubmissions() {
+	for signalID := range s.submittedSignalIDs {
+		if _, ok := s.pendingSignalIDs.Load(signalID); !ok {
+			delete(s.submittedSignalIDs, signalID)
+			s.changes |= changeValidatorPrices
+		}
+	}
+}
+
+// nextWakeUp returns the next time to check the prices, which is the earliest time a signal
+// becomes due for submission, but no later than the interval.
+func (s *Signaller) nextWakeUp(now time.Time) time.Time {
+	wakeUp := now.Add(s.interval)
+	if s.params == nil {
+		return wakeUp
+	}
+
+	for signalID, feed := range s.signalIDToFeed {
+		valPrice, ok := s.signalIDToValidatorPrice[signalID]
+		if !ok {
+			continue
+		}
+
+		dueTimes := []time.Time{
+			time.Unix(valPrice.Timestamp+s.params.CooldownTime+TimeBuffer, 0),
+			s.getAssignedTime(feed, valPrice.Timestamp),
+			// An unavailable price becomes urgent right after this time.
+			time.Unix(valPrice.Timestamp+feed.Interval-FixedIntervalOffset+1, 0),
+		}
+		for _, t := range dueTimes {
+			if t.After(now) && t.Before(wakeUp) {
+				wakeUp = t
+			}
+		}
+	}
+
+	return wakeUp
+}
+
 func (s *Signaller) execute() {
 	now := time.Now()
 
@@ -165,42 +327,86 @@ func (s *Signaller) execute() {
 		return
 	}
 
-	s.logger.Debug("[Signaller] querying prices from bothan: %v", nonPendingSignalIDs)
-	res, err := s.bothanClient.GetPrices(nonPendingSignalIDs)
-	if err != nil {
-		s.logger.Error("[Signaller] failed to query prices from bothan: %v", err)
-		return
+	// The signals forced to be unsupported by the signal config are not queried.
+	querySignalIDs := make([]string, 0, len(nonPendingSignalIDs))
+	var prices []*bothan.Price
+	for _, signalID := range nonPendingSignalIDs {
+		if s.signalConfig.IsUnsupported(signalID) {
+			prices = append(prices, &bothan.Price{SignalId: signalID, Status: bothan.Status_STATUS_UNSUPPORTED})
+			continue
+		}
+		querySignalIDs = append(querySignalIDs, signalID)
 	}
 
-	prices, uuid := res.Prices, res.Uuid
+	uuid := ""
+	if len(querySignalIDs) > 0 {
+		s.logger.Debug("[Signaller] querying prices from price providers: %v", querySignalIDs)
+		res, err := s.priceProvider.GetPrices(querySignalIDs)
+		if err != nil {
+			s.logger.Error("[Signaller] failed to query prices from price providers: %v", err)
+			return
+		}
+		prices, uuid = append(prices, res.Prices...), res.Uuid
+	}
 
 	s.logger.Debug("[Signaller] filtering prices")
-	signalPrices := s.filterAndPrepareSignalPrices(prices, nonPendingSignalIDs, now)
+	signalPrices := s.filterAndPrepareSignalPrices(prices, nonPendingSignalIDs, s.chainPrices, now)
 	if len(signalPrices) == 0 {
 		s.logger.Debug("[Signaller] no prices to submit")
 		return
 	}
 
+	for _, p := range signalPrices {
+		if chainPrice, ok := s.chainPrices[p.SignalID]; ok && p.Status == types.SIGNAL_PRICE_STATUS_AVAILABLE {
+			s.metrics.RecordPriceDistance(p.SignalID, p.Price, chainPrice)
+		}
+	}
+
 	s.logger.Debug("[Signaller] submitting prices: %v", signalPrices)
 	s.submitPrices(signalPrices, uuid)
 }
 
 func (s *Signaller) submitPrices(prices []types.SignalPrice, uuid string) {
+	reportDeadlines := s.getReportDeadlines()
+	deadlines := make(map[string]time.Time, len(prices))
 	for _, p := range prices {
 		_, loaded := s.pendingSignalIDs.LoadOrStore(p.SignalID, struct{}{})
 		if loaded {
 			s.logger.Debug("[Signaller] Attempted to store Signal ID %s which was already pending", p.SignalID)
 		}
+		s.submittedSignalIDs[p.SignalID] = struct{}{}
+		deadlines[p.SignalID] = reportDeadlines[p.SignalID]
 	}
 
 	signalPriceSubmission := submitter.SignalPriceSubmission{
 		SignalPrices: prices,
 		UUID:         uuid,
+		Deadlines:    deadlines,
 	}
 
 	s.submitCh <- signalPriceSubmission
 }
 
+// getReportDeadlines returns the time before which each signal must be reported to avoid a miss
+// report. It is conservative, as the bonding time of the validator and the block heights are not
+// taken into account.
+func (s *Signaller) getReportDeadlines() map[string]time.Time {
+	deadlines := make(map[string]time.Time, len(s.signalIDToFeed))
+	for signalID, feed := range s.signalIDToFeed {
+		deadline := s.feedsLastUpdateTimestamp + s.params.GracePeriod
+
+		valPrice, ok := s.signalIDToValidatorPrice[signalID]
+		if ok && valPrice.SignalPriceStatus != types.SIGNAL_PRICE_STATUS_UNSPECIFIED &&
+			valPrice.Timestamp+feed.Interval > deadline {
+			deadline = valPrice.Timestamp + feed.Interval
+		}
+
+		deadlines[signalID] = time.Unix(deadline, 0)
+	}
+
+	return deadlines
+}
+
 func (s *Signaller) getAllSignalIDs() []string {
 	signalIDs := make([]string, 0, len(s.signalIDToFeed))
 	for signalID := range s.signalIDToFeed {
@@ -225,6 +431,7 @@ func (s *Signaller) getNonPendingSignalIDs() []string {
 func (s *Signaller) filterAndPrepareSignalPrices(
 	prices []*bothan.Price,
 	signalIDs []string,
+	chainPrices map[string]uint64,
 	currentTime time.Time,
 ) []types.SignalPrice {
 	pricesMap := sliceToMap(prices, func(price *bothan.Price) string {
@@ -246,10 +453,20 @@ func (s *Signaller) filterAndPrepareSignalPrices(
 			continue
 		}
 
+		// Every fetched price is observed, so that its staleness is not only sampled when it is due.
+		s.guard.Observe(signalPrice, currentTime)
+
 		if !s.isPriceValid(signalPrice, currentTime) {
 			continue
 		}
 
+		// Only guard the prices due to be submitted, so that a violating price is not reported on
+		// every tick before it is due.
+		signalPrice, ok = s.guardPrice(signalPrice, chainPrices, currentTime)
+		if !ok {
+			continue
+		}
+
 		if s.isNonUrgentUnavailablePrices(signalPrice, currentTime.Unix()) {
 			s.logger.Debug("[Signaller] non-urgent unavailable price: %v", signalPrice)
 			continue
@@ -261,6 +478,38 @@ func (s *Signaller) filterAndPrepareSignalPrices(
 	return signalPrices
 }
 
+// guardPrice runs the sanity checks of the guard on the price and applies the configured action
+// if they fail. It returns false if the price should be held back.
+func (s *Signaller) guardPrice(
+	signalPrice types.SignalPrice,
+	chainPrices map[string]uint64,
+	now time.Time,
+) (types.SignalPrice, bool) {
+	reference, ok := chainPrices[signalPrice.SignalID]
+	if !ok {
+		if valPrice, found := s.signalIDToValidatorPrice[signalPrice.SignalID]; found &&
+			valPrice.SignalPriceStatus == types.SIGNAL_PRICE_STATUS_AVAILABLE {
+			reference = valPrice.Price
+		}
+	}
+
+	reason := s.guard.Check(signalPrice, reference, now)
+	if reason == "" {
+		return signalPrice, true
+	}
+
+	s.logger.Error(
+		"[Signaller] price %d of %s violates the price guard (%s), reference: %d, action: %s",
+		signalPrice.Price, signalPrice.SignalID, reason, reference, s.guard.Action(),
+	)
+
+	if s.guard.Action() == GuardActionHold {
+		return types.SignalPrice{}, false
+	}
+
+	return types.NewSignalPrice(types.SIGNAL_PRICE_STATUS_UNAVAILABLE, signalPrice.SignalID, 0), true
+}
+
 func (s *Signaller) isNonUrgentUnavailablePrices(
 	signalPrice types.SignalPrice,
 	now int64,
@@ -312,13 +561,7 @@ func (s *Signaller) shouldUpdatePrice(
 	}
 
 	// Check if the price is past the assigned time, if it is, add it to the list of prices to update
-	assignedTime := calculateAssignedTime(
-		s.valAddress,
-		feed.Interval,
-		oldPrice.Timestamp,
-		s.distributionOffsetPercentage,
-		s.distributionStartPercentage,
-	)
+	assignedTime := s.getAssignedTime(feed, oldPrice.Timestamp)
 
 	if !now.Before(assignedTime) {
 		return true
@@ -331,3 +574,11 @@ func (s *Signaller) shouldUpdatePrice(
 	// Check if the price is deviated from the last submission, if it is, add it to the list of prices to update
 	return isDeviated(feed.DeviationBasisPoint, oldPrice.Price, newPrice.Price)
 }
+
+// getAssignedTime returns the assigned time of the validator to send the price of the feed, using
+// the distribution percentages overridden for the signal if any.
+func (s *Signaller) getAssignedTime(feed types.FeedWithDeviation, timestamp int64) time.Time {
+	start, offset := s.signalConfig.Distribution(feed.SignalID)
+
+	return CalculateAssignedTime(s.valAddress, feed.Interval, timestamp, offset, start)
+}
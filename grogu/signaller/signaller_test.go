@@ -1,6 +1,9 @@
 package signaller
 
 import (
+	"math"
+	"os"
+	"path/filepath"
 	"sort"
 	"sync"
 	"testing"
@@ -15,6 +18,8 @@ import (
 
 	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
 
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
+	"github.com/bandprotocol/chain/v3/grogu/signalconfig"
 	"github.com/bandprotocol/chain/v3/grogu/signaller/testutil"
 	"github.com/bandprotocol/chain/v3/grogu/submitter"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
@@ -77,9 +82,20 @@ func (s *SignallerTestSuite) SetupTest() {
 			},
 		}}, nil).
 		AnyTimes()
+	mockFeedQuerier.EXPECT().
+		QueryPrices(gomock.Any()).
+		Return(&feeds.QueryPricesResponse{Prices: []feeds.Price{
+			{
+				Status:    feeds.PRICE_STATUS_AVAILABLE,
+				SignalID:  "signal1",
+				Price:     10000,
+				Timestamp: 0,
+			},
+		}}, nil).
+		AnyTimes()
 
-	mockBothanClient := testutil.NewMockBothanClient(ctrl)
-	mockBothanClient.EXPECT().GetPrices(gomock.Any()).
+	mockPriceProvider := testutil.NewMockPriceProvider(ctrl)
+	mockPriceProvider.EXPECT().GetPrices(gomock.Any()).
 		Return(&bothan.GetPricesResponse{
 			Prices: []*bothan.Price{
 				{
@@ -105,22 +121,25 @@ func (s *SignallerTestSuite) SetupTest() {
 	// Create signaller instance
 	s.Signaller = New(
 		mockFeedQuerier,
-		mockBothanClient,
+		mockPriceProvider,
+		nil,
 		time.Second,
+		time.Minute,
 		submitCh,
 		l,
 		validAddress,
 		&pendingSignalIDs,
-		50,
-		30,
+		NewGuard(GuardConfig{MaxPrice: math.MaxInt64, Action: GuardActionUnavailable}),
+		metrics.New(),
+		signalconfig.NewStore("", 50, 30),
 	)
 	s.SubmitCh = submitCh
-	s.assignedTime = calculateAssignedTime(
+	s.assignedTime = CalculateAssignedTime(
 		s.Signaller.valAddress,
 		60,
 		0,
-		s.Signaller.distributionOffsetPercentage,
-		s.Signaller.distributionStartPercentage,
+		30,
+		50,
 	)
 }
 
@@ -148,13 +167,13 @@ func (s *SignallerTestSuite) TestFilterAndPrepareSignalPrices() {
 	// Test with time in the middle of the interval
 	middleIntervalTime := time.Unix(30, 0)
 
-	submitPrices := s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, middleIntervalTime)
+	submitPrices := s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, nil, middleIntervalTime)
 	s.Require().Empty(submitPrices)
 
 	// Test with time at the end of the interval
 	endIntervalTime := time.Unix(60, 0)
 
-	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, endIntervalTime)
+	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, nil, endIntervalTime)
 	s.Require().NotEmpty(submitPrices)
 	s.Require().Equal("signal1", submitPrices[0].SignalID)
 	s.Require().Equal(uint64(10000), submitPrices[0].Price)
@@ -170,15 +189,87 @@ func (s *SignallerTestSuite) TestFilterAndPrepareSignalPrices() {
 
 	// Test with time after the urgent deadline
 	afterUrgentDeadlineTime := time.Unix(51, 0)
-	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, afterUrgentDeadlineTime)
+	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, nil, afterUrgentDeadlineTime)
 	s.Require().NotEmpty(submitPrices)
 	s.Require().Equal("signal1", submitPrices[0].SignalID)
 	s.Require().Equal(uint64(0), submitPrices[0].Price)
 
 	// Test with time before the urgent deadline
 	beforeUrgentDeadlineTime := time.Unix(49, 0)
-	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, beforeUrgentDeadlineTime)
+	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, nil, beforeUrgentDeadlineTime)
+	s.Require().Empty(submitPrices)
+}
+
+func (s *SignallerTestSuite) TestFilterAndPrepareSignalPricesWithGuard() {
+	s.TestUpdateInternalVariables()
+	s.Signaller.guard = NewGuard(GuardConfig{MaxJumpBps: 1000, Action: GuardActionUnavailable})
+
+	signalIDs := []string{"signal1"}
+	chainPrices := s.Signaller.chainPrices
+	s.Require().Equal(map[string]uint64{"signal1": 10000}, chainPrices)
+
+	// Test with a price jumping too far from the on-chain price
+	prices := []*bothan.Price{
+		{
+			SignalId: "signal1",
+			Price:    20000,
+			Status:   bothan.Status_STATUS_AVAILABLE,
+		},
+	}
+
+	// A price that is not due yet is not guarded
+	middleIntervalTime := time.Unix(30, 0)
+	submitPrices := s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, chainPrices, middleIntervalTime)
+	s.Require().Empty(submitPrices)
+	s.Require().Empty(s.Signaller.guard.Violations())
+
+	endIntervalTime := time.Unix(60, 0)
+	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, chainPrices, endIntervalTime)
+	s.Require().Len(submitPrices, 1)
+	s.Require().Equal(feeds.SIGNAL_PRICE_STATUS_UNAVAILABLE, submitPrices[0].Status)
+	s.Require().Equal(uint64(0), submitPrices[0].Price)
+
+	// Test with a price within the maximum jump
+	prices[0].Price = 10500
+	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, chainPrices, endIntervalTime)
+	s.Require().Len(submitPrices, 1)
+	s.Require().Equal(feeds.SIGNAL_PRICE_STATUS_AVAILABLE, submitPrices[0].Status)
+	s.Require().Equal(uint64(10500), submitPrices[0].Price)
+
+	// Test holding back a violating price
+	s.Signaller.guard = NewGuard(GuardConfig{MaxJumpBps: 1000, Action: GuardActionHold})
+	prices[0].Price = 20000
+	submitPrices = s.Signaller.filterAndPrepareSignalPrices(prices, signalIDs, chainPrices, endIntervalTime)
 	s.Require().Empty(submitPrices)
+
+	violations := s.Signaller.guard.Violations()
+	s.Require().Equal([]ViolationCount{{SignalID: "signal1", Reason: ViolationJump, Count: 1}}, violations)
+}
+
+func (s *SignallerTestSuite) TestGetReportDeadlines() {
+	s.TestUpdateInternalVariables()
+
+	// signal1 was reported at 0 and signal2 was never reported, so only the grace period applies.
+	deadlines := s.Signaller.getReportDeadlines()
+	s.Require().Equal(map[string]time.Time{
+		"signal1": time.Unix(60, 0),
+		"signal2": time.Unix(feeds.DefaultGracePeriod, 0),
+	}, deadlines)
+}
+
+func (s *SignallerTestSuite) TestGetAssignedTime() {
+	path := filepath.Join(s.T().TempDir(), "signals.yaml")
+	content := "signals:\n  - signal_id: signal2\n    distribution_start_pct: 0\n    distribution_offset_pct: 1\n"
+	s.Require().NoError(os.WriteFile(path, []byte(content), 0o644))
+	s.Signaller.signalConfig = signalconfig.NewStore(path, 50, 30)
+	_, err := s.Signaller.signalConfig.Reload()
+	s.Require().NoError(err)
+
+	feed := feeds.FeedWithDeviation{SignalID: "signal1", Interval: 60}
+	s.Require().Equal(s.assignedTime, s.Signaller.getAssignedTime(feed, 0))
+
+	feed.SignalID = "signal2"
+	s.Require().Equal(CalculateAssignedTime(s.Signaller.valAddress, 60, 0, 1, 0), s.Signaller.getAssignedTime(feed, 0))
 }
 
 func (s *SignallerTestSuite) TestGetAllSignalIDs() {
@@ -283,12 +374,12 @@ func (s *SignallerTestSuite) TestShouldUpdatePrice() {
 	s.Require().False(s.Signaller.shouldUpdatePrice(feed, valPrice, newPrice, thresholdTime.Add(-time.Second)))
 
 	// Test case: Time after thresholdTime and assignedTime
-	assignedTime := calculateAssignedTime(
+	assignedTime := CalculateAssignedTime(
 		s.Signaller.valAddress,
 		feed.Interval,
 		valPrice.Timestamp,
-		s.Signaller.distributionOffsetPercentage,
-		s.Signaller.distributionStartPercentage,
+		30,
+		50,
 	)
 	s.Require().True(s.Signaller.shouldUpdatePrice(feed, valPrice, newPrice, assignedTime.Add(time.Second)))
 
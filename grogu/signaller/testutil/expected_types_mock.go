@@ -18,47 +18,32 @@ import (
 	gomock "go.uber.org/mock/gomock"
 )
 
-// MockBothanClient is a mock of BothanClient interface.
-type MockBothanClient struct {
+// MockPriceProvider is a mock of PriceProvider interface.
+type MockPriceProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockBothanClientMockRecorder
+	recorder *MockPriceProviderMockRecorder
 	isgomock struct{}
 }
 
-// MockBothanClientMockRecorder is the mock recorder for MockBothanClient.
-type MockBothanClientMockRecorder struct {
-	mock *MockBothanClient
+// MockPriceProviderMockRecorder is the mock recorder for MockPriceProvider.
+type MockPriceProviderMockRecorder struct {
+	mock *MockPriceProvider
 }
 
-// NewMockBothanClient creates a new mock instance.
-func NewMockBothanClient(ctrl *gomock.Controller) *MockBothanClient {
-	mock := &MockBothanClient{ctrl: ctrl}
-	mock.recorder = &MockBothanClientMockRecorder{mock}
+// NewMockPriceProvider creates a new mock instance.
+func NewMockPriceProvider(ctrl *gomock.Controller) *MockPriceProvider {
+	mock := &MockPriceProvider{ctrl: ctrl}
+	mock.recorder = &MockPriceProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBothanClient) EXPECT() *MockBothanClientMockRecorder {
+func (m *MockPriceProvider) EXPECT() *MockPriceProviderMockRecorder {
 	return m.recorder
 }
 
-// GetInfo mocks base method.
-func (m *MockBothanClient) GetInfo() (*proto.GetInfoResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetInfo")
-	ret0, _ := ret[0].(*proto.GetInfoResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetInfo indicates an expected call of GetInfo.
-func (mr *MockBothanClientMockRecorder) GetInfo() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInfo", reflect.TypeOf((*MockBothanClient)(nil).GetInfo))
-}
-
 // GetPrices mocks base method.
-func (m *MockBothanClient) GetPrices(signalIDs []string) (*proto.GetPricesResponse, error) {
+func (m *MockPriceProvider) GetPrices(signalIDs []string) (*proto.GetPricesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPrices", signalIDs)
 	ret0, _ := ret[0].(*proto.GetPricesResponse)
@@ -67,37 +52,9 @@ func (m *MockBothanClient) GetPrices(signalIDs []string) (*proto.GetPricesRespon
 }
 
 // GetPrices indicates an expected call of GetPrices.
-func (mr *MockBothanClientMockRecorder) GetPrices(signalIDs any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrices", reflect.TypeOf((*MockBothanClient)(nil).GetPrices), signalIDs)
-}
-
-// PushMonitoringRecords mocks base method.
-func (m *MockBothanClient) PushMonitoringRecords(uuid, txHash string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PushMonitoringRecords", uuid, txHash)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// PushMonitoringRecords indicates an expected call of PushMonitoringRecords.
-func (mr *MockBothanClientMockRecorder) PushMonitoringRecords(uuid, txHash any) *gomock.Call {
+func (mr *MockPriceProviderMockRecorder) GetPrices(signalIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushMonitoringRecords", reflect.TypeOf((*MockBothanClient)(nil).PushMonitoringRecords), uuid, txHash)
-}
-
-// UpdateRegistry mocks base method.
-func (m *MockBothanClient) UpdateRegistry(ipfsHash, version string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRegistry", ipfsHash, version)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpdateRegistry indicates an expected call of UpdateRegistry.
-func (mr *MockBothanClientMockRecorder) UpdateRegistry(ipfsHash, version any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegistry", reflect.TypeOf((*MockBothanClient)(nil).UpdateRegistry), ipfsHash, version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrices", reflect.TypeOf((*MockPriceProvider)(nil).GetPrices), signalIDs)
 }
 
 // MockFeedQuerier is a mock of FeedQuerier interface.
@@ -154,6 +111,21 @@ func (mr *MockFeedQuerierMockRecorder) QueryParams() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryParams", reflect.TypeOf((*MockFeedQuerier)(nil).QueryParams))
 }
 
+// QueryPrices mocks base method.
+func (m *MockFeedQuerier) QueryPrices(signalIDs []string) (*types.QueryPricesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryPrices", signalIDs)
+	ret0, _ := ret[0].(*types.QueryPricesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryPrices indicates an expected call of QueryPrices.
+func (mr *MockFeedQuerierMockRecorder) QueryPrices(signalIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryPrices", reflect.TypeOf((*MockFeedQuerier)(nil).QueryPrices), signalIDs)
+}
+
 // QueryValidValidator mocks base method.
 func (m *MockFeedQuerier) QueryValidValidator(valAddress types0.ValAddress) (*types.QueryValidValidatorResponse, error) {
 	m.ctrl.T.Helper()
@@ -70,14 +70,14 @@ func convertPriceData(price *bothan.Price) (types.SignalPrice, error) {
 	}
 }
 
-// calculateAssignedTime calculates the assigned time for a validator to send prices
+// CalculateAssignedTime calculates the assigned time for a validator to send prices
 //
 // The assigned time is calculated as follows:
 //  1. Hash the validator address and timestamp using SHA256.
 //  2. Calculate the offset by taking the modulo of the hashed value with distribution offset percentage and adding distribution percentage start.
 //  3. Calculate the time offset by multiplying the interval with the offset and dividing by 100.
 //  4. Add the time offset to the received timestamp to get the assigned time.
-func calculateAssignedTime(
+func CalculateAssignedTime(
 	valAddr sdk.ValAddress,
 	interval int64,
 	timestamp int64,
@@ -0,0 +1,163 @@
+package submitter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+// maxMemoCharacters is the default maximum length of a transaction memo.
+const maxMemoCharacters = 256
+
+// errGasLimitExceeded is returned when the gas of a transaction exceeds the batch gas limit.
+var errGasLimitExceeded = errors.New("batch gas limit exceeded")
+
+// batch is a set of signal prices submitted in one transaction, coalesced from submissions.
+type batch struct {
+	signalPrices []types.SignalPrice
+	// uuids are the UUIDs of the submissions of the signal prices, by index.
+	uuids []string
+}
+
+// collectSubmissions waits for a submission and collects the other submissions received within the
+// batch window after it.
+func (s *Submitter) collectSubmissions() []SignalPriceSubmission {
+	submissions := []SignalPriceSubmission{<-s.submitSignalPriceCh}
+	if s.batchWindow <= 0 {
+		return submissions
+	}
+
+	timer := time.NewTimer(s.batchWindow)
+	defer timer.Stop()
+	for {
+		select {
+		case priceSubmission := <-s.submitSignalPriceCh:
+			submissions = append(submissions, priceSubmission)
+		case <-timer.C:
+			return submissions
+		}
+	}
+}
+
+// getMaxBatchSignals returns the maximum number of signal prices in a batch, which is the
+// MaxSignalIDsPerSigning param of the chain.
+func (s *Submitter) getMaxBatchSignals() uint64 {
+	resp, err := s.paramsQuerier.QueryParams()
+	if err != nil {
+		s.logger.Error("[Submitter] failed to query params, using default max signals per batch: %v", err)
+		return types.DefaultMaxSignalIDsPerSigning
+	}
+
+	return resp.Params.MaxSignalIDsPerSigning
+}
+
+// nextBatches waits for the next submissions and coalesces them into batches. Each submission is a
+// batch of its own if batching is disabled.
+func (s *Submitter) nextBatches() []batch {
+	submissions := s.collectSubmissions()
+	if s.batchWindow <= 0 {
+		batches := make([]batch, 0, len(submissions))
+		for _, priceSubmission := range submissions {
+			batches = append(batches, makeBatches([]SignalPriceSubmission{priceSubmission}, 0)...)
+		}
+		return batches
+	}
+
+	return makeBatches(submissions, s.getMaxBatchSignals())
+}
+
+// makeBatches coalesces the submissions into batches of at most maxSignals signal prices, or of
+// any size if it is zero. The signals with the earliest deadlines go first, followed by the signals
+// without deadlines in order of submission. A signal submitted more than once keeps its last price.
+func makeBatches(submissions []SignalPriceSubmission, maxSignals uint64) []batch {
+	type entry struct {
+		signalPrice types.SignalPrice
+		deadline    time.Time
+		uuid        string
+	}
+
+	var entries []entry
+	indexes := make(map[string]int)
+	for _, priceSubmission := range submissions {
+		for _, p := range priceSubmission.SignalPrices {
+			e := entry{signalPrice: p, deadline: priceSubmission.Deadlines[p.SignalID], uuid: priceSubmission.UUID}
+			if i, ok := indexes[p.SignalID]; ok {
+				entries[i] = e
+				continue
+			}
+			indexes[p.SignalID] = len(entries)
+			entries = append(entries, e)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		di, dj := entries[i].deadline, entries[j].deadline
+		if di.IsZero() || dj.IsZero() {
+			return !di.IsZero() && dj.IsZero()
+		}
+		return di.Before(dj)
+	})
+
+	var batches []batch
+	for start := 0; start < len(entries); {
+		end := len(entries)
+		if maxSignals > 0 && uint64(end-start) > maxSignals {
+			end = start + int(maxSignals)
+		}
+
+		b := batch{
+			signalPrices: make([]types.SignalPrice, 0, end-start),
+			uuids:        make([]string, 0, end-start),
+		}
+		for _, e := range entries[start:end] {
+			b.signalPrices = append(b.signalPrices, e.signalPrice)
+			b.uuids = append(b.uuids, e.uuid)
+		}
+		batches = append(batches, b)
+		start = end
+	}
+
+	return batches
+}
+
+// split splits the batch into two halves, the first one with the earlier deadlines.
+func (b batch) split() (batch, batch) {
+	mid := len(b.signalPrices) / 2
+	return batch{signalPrices: b.signalPrices[:mid], uuids: b.uuids[:mid]},
+		batch{signalPrices: b.signalPrices[mid:], uuids: b.uuids[mid:]}
+}
+
+// uniqueUUIDs returns the distinct non-empty UUIDs of the batch in order.
+func (b batch) uniqueUUIDs() []string {
+	var uuids []string
+	seen := make(map[string]bool)
+	for _, uuid := range b.uuids {
+		if uuid != "" && !seen[uuid] {
+			seen[uuid] = true
+			uuids = append(uuids, uuid)
+		}
+	}
+
+	return uuids
+}
+
+// memo returns the memo of the batch transaction, listing as many UUIDs as the memo length allows.
+func (b batch) memo(version string) string {
+	memo := fmt.Sprintf("grogu: %s, uuid: ", version)
+	joined := ""
+	for _, uuid := range b.uniqueUUIDs() {
+		next := uuid
+		if joined != "" {
+			next = joined + "," + uuid
+		}
+		if len(memo)+len(next) > maxMemoCharacters {
+			break
+		}
+		joined = next
+	}
+
+	return memo + joined
+}
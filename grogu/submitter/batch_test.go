@@ -0,0 +1,92 @@
+package submitter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+func available(signalID string, price uint64) types.SignalPrice {
+	return types.SignalPrice{SignalID: signalID, Price: price, Status: types.SIGNAL_PRICE_STATUS_AVAILABLE}
+}
+
+func TestMakeBatches(t *testing.T) {
+	now := time.Unix(1000, 0)
+	submissions := []SignalPriceSubmission{
+		{
+			SignalPrices: []types.SignalPrice{available("signal1", 1), available("signal2", 2)},
+			UUID:         "uuid1",
+			Deadlines: map[string]time.Time{
+				"signal1": now.Add(30 * time.Second),
+				"signal2": now.Add(10 * time.Second),
+			},
+		},
+		{
+			SignalPrices: []types.SignalPrice{available("signal3", 3), available("signal1", 11)},
+			UUID:         "uuid2",
+			Deadlines: map[string]time.Time{
+				"signal1": now.Add(20 * time.Second),
+			},
+		},
+		{
+			SignalPrices: []types.SignalPrice{available("signal4", 4)},
+			UUID:         "uuid3",
+			Deadlines: map[string]time.Time{
+				"signal4": now.Add(5 * time.Second),
+			},
+		},
+	}
+
+	// single batch ordered by deadline, signals without deadlines last
+	batches := makeBatches(submissions, 0)
+	require.Equal(t, []batch{
+		{
+			signalPrices: []types.SignalPrice{
+				available("signal4", 4),
+				available("signal2", 2),
+				available("signal1", 11),
+				available("signal3", 3),
+			},
+			uuids: []string{"uuid3", "uuid1", "uuid2", "uuid2"},
+		},
+	}, batches)
+	require.Equal(t, []string{"uuid3", "uuid1", "uuid2"}, batches[0].uniqueUUIDs())
+
+	// limited number of signals per batch
+	batches = makeBatches(submissions, 3)
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0].signalPrices, 3)
+	require.Equal(t, []types.SignalPrice{available("signal3", 3)}, batches[1].signalPrices)
+	require.Equal(t, []string{"uuid2"}, batches[1].uniqueUUIDs())
+
+	// split
+	first, second := batches[0].split()
+	require.Equal(t, []types.SignalPrice{available("signal4", 4)}, first.signalPrices)
+	require.Equal(t, []string{"uuid3"}, first.uuids)
+	require.Equal(t, []types.SignalPrice{available("signal2", 2), available("signal1", 11)}, second.signalPrices)
+	require.Equal(t, []string{"uuid1", "uuid2"}, second.uuids)
+
+	require.Empty(t, makeBatches(nil, 3))
+}
+
+func TestBatchMemo(t *testing.T) {
+	b := batch{uuids: []string{"uuid1", "", "uuid2", "uuid1"}}
+	require.Equal(t, "grogu: v1, uuid: uuid1,uuid2", b.memo("v1"))
+
+	b = batch{uuids: []string{"", ""}}
+	require.Equal(t, "grogu: v1, uuid: ", b.memo("v1"))
+
+	// the UUIDs exceeding the memo length are left out
+	uuid := strings.Repeat("a", 36)
+	b = batch{uuids: make([]string, 10)}
+	for i := range b.uuids {
+		b.uuids[i] = uuid + string(rune('0'+i))
+	}
+	memo := b.memo("v1")
+	require.LessOrEqual(t, len(memo), maxMemoCharacters)
+	require.Equal(t, "grogu: v1, uuid: "+strings.Join(b.uuids[:6], ","), memo)
+}
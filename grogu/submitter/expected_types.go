@@ -7,6 +7,8 @@ import (
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	bothanclient "github.com/bandprotocol/bothan/bothan-api/client/go-client"
+
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
 )
 
 type RemoteClient interface {
@@ -24,3 +26,16 @@ type AuthQuerier interface {
 type TxQuerier interface {
 	QueryTx(hash string) (*sdk.TxResponse, error)
 }
+
+type ParamsQuerier interface {
+	QueryParams() (*feeds.QueryParamsResponse, error)
+}
+
+type NodePool interface {
+	Available() []int
+	RecordResult(node int, err error)
+}
+
+type GasPriceProvider interface {
+	GasPrices() string
+}
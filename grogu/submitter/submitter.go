@@ -2,6 +2,7 @@ package submitter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/x/feeds/types"
 )
@@ -23,23 +25,30 @@ import (
 type SignalPriceSubmission struct {
 	SignalPrices []types.SignalPrice
 	UUID         string
+	// Deadlines are the miss report deadlines of the signals, the earliest ones are submitted first.
+	Deadlines map[string]time.Time
 }
 
 type Submitter struct {
 	clientCtx           client.Context
 	clients             []rpcclient.RemoteClient
+	nodes               NodePool
 	bothanClient        BothanClient
 	logger              *logger.Logger
 	submitSignalPriceCh <-chan SignalPriceSubmission
 	authQuerier         AuthQuerier
 	txQuerier           TxQuerier
+	paramsQuerier       ParamsQuerier
 	valAddress          sdk.ValAddress
 	pendingSignalIDs    *sync.Map
 
 	broadcastTimeout time.Duration
 	broadcastMaxTry  uint64
 	pollingInterval  time.Duration
-	gasPrices        string
+	gasPrices        GasPriceProvider
+	metrics          *metrics.Metrics
+	batchWindow      time.Duration
+	batchMaxGas      uint64
 
 	idleKeyIDChannel chan string
 }
@@ -47,17 +56,22 @@ type Submitter struct {
 func New(
 	clientCtx client.Context,
 	clients []rpcclient.RemoteClient,
+	nodes NodePool,
 	bothanClient BothanClient,
 	logger *logger.Logger,
 	submitSignalPriceCh <-chan SignalPriceSubmission,
 	authQuerier AuthQuerier,
 	txQuerier TxQuerier,
+	paramsQuerier ParamsQuerier,
 	valAddress sdk.ValAddress,
 	pendingSignalIDs *sync.Map,
 	broadcastTimeout time.Duration,
 	broadcastMaxTry uint64,
 	pollingInterval time.Duration,
-	gasPrices string,
+	gasPrices GasPriceProvider,
+	metrics *metrics.Metrics,
+	batchWindow time.Duration,
+	batchMaxGas uint64,
 ) (*Submitter, error) {
 	if len(clients) == 0 {
 		return nil, fmt.Errorf("clients cannot be empty")
@@ -80,51 +94,69 @@ func New(
 	return &Submitter{
 		clientCtx:           clientCtx,
 		clients:             clients,
+		nodes:               nodes,
 		bothanClient:        bothanClient,
 		logger:              logger,
 		submitSignalPriceCh: submitSignalPriceCh,
 		authQuerier:         authQuerier,
 		txQuerier:           txQuerier,
+		paramsQuerier:       paramsQuerier,
 		valAddress:          valAddress,
 		pendingSignalIDs:    pendingSignalIDs,
 		broadcastTimeout:    broadcastTimeout,
 		broadcastMaxTry:     broadcastMaxTry,
 		pollingInterval:     pollingInterval,
 		gasPrices:           gasPrices,
+		metrics:             metrics,
+		batchWindow:         batchWindow,
+		batchMaxGas:         batchMaxGas,
 		idleKeyIDChannel:    idleKeyIDChannel,
 	}, nil
 }
 
 func (s *Submitter) Start() {
 	for {
-		priceSubmission := <-s.submitSignalPriceCh
-		keyID := <-s.idleKeyIDChannel
-		go func(sps SignalPriceSubmission, kid string) {
-			s.logger.Debug("[Submitter] starting submission")
-			s.submitPrice(sps, kid)
-		}(priceSubmission, keyID)
+		for _, b := range s.nextBatches() {
+			keyID := <-s.idleKeyIDChannel
+			go func(b batch, kid string) {
+				s.logger.Debug("[Submitter] starting submission of %d prices", len(b.signalPrices))
+				s.submitPrice(b, kid)
+			}(b, keyID)
+		}
 	}
 }
 
-func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID string) {
-	signalPrices, uuid := pricesSubmission.SignalPrices, pricesSubmission.UUID
+func (s *Submitter) submitPrice(b batch, keyID string) {
 	defer func() {
-		s.removePending(signalPrices)
+		s.removePending(b.signalPrices)
 		s.idleKeyIDChannel <- keyID
 	}()
 
+	key, err := s.clientCtx.Keyring.Key(keyID)
+	if err != nil {
+		s.logger.Error("[Submitter] failed to get key: %v", err)
+		return
+	}
+
+	s.submitBatch(key, b)
+}
+
+// submitBatch submits the batch in a transaction, splitting it into halves submitted one after
+// another if the gas exceeds the batch gas limit.
+func (s *Submitter) submitBatch(key *keyring.Record, b batch) {
+	signalPrices := b.signalPrices
 	msg := types.MsgSubmitSignalPrices{
 		Validator:    s.valAddress.String(),
 		Timestamp:    time.Now().Unix(),
 		SignalPrices: signalPrices,
 	}
 	msgs := []sdk.Msg{&msg}
-	memo := fmt.Sprintf("grogu: %s, uuid: %s", version.Version, uuid)
+	memo := b.memo(version.Version)
 
-	key, err := s.clientCtx.Keyring.Key(keyID)
-	if err != nil {
-		s.logger.Error("[Submitter] failed to get key: %v", err)
-		return
+	// a single signal price cannot be split, so it is submitted regardless of the gas limit
+	gasLimit := s.batchMaxGas
+	if len(signalPrices) <= 1 {
+		gasLimit = 0
 	}
 
 	gasAdjustment := 1.3
@@ -134,12 +166,25 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 			msgs,
 			gasAdjustment,
 			memo,
+			gasLimit,
 		)
+		if errors.Is(err, errGasLimitExceeded) {
+			s.logger.Info("[Submitter] splitting %d prices into two transactions: %v", len(signalPrices), err)
+			first, second := b.split()
+			s.submitBatch(key, first)
+			s.submitBatch(key, second)
+			return
+		}
 		if err != nil {
 			s.logger.Error("[Submitter] failed to broadcast: %v", err)
+			s.metrics.RecordBroadcastError()
 			continue
 		}
 
+		if txResp.Code != 0 {
+			s.metrics.RecordTxFailure(txResp.Codespace, txResp.Code)
+		}
+
 		// if the transaction is out of gas, increase the gas adjustment
 		if txResp.Codespace == sdkerrors.RootCodespace && txResp.Code == sdkerrors.ErrOutOfGas.ABCICode() {
 			s.logger.Info("[Submitter] transaction is out of gas, retrying with increased gas adjustment")
@@ -153,13 +198,21 @@ func (s *Submitter) submitPrice(pricesSubmission SignalPriceSubmission, keyID st
 		finalizedTxResp, err := s.getTxResponse(txResp.TxHash)
 		if err != nil {
 			s.logger.Error("[Submitter] failed to get tx response: %v", err)
+			s.metrics.RecordBroadcastError()
 			continue
 		}
 
+		if finalizedTxResp.Code != 0 {
+			s.metrics.RecordTxFailure(finalizedTxResp.Codespace, finalizedTxResp.Code)
+		}
+
 		switch {
 		case finalizedTxResp.Code == 0:
 			s.logger.Info("[Submitter] price submitted at %v", finalizedTxResp.TxHash)
-			s.pushMonitoringRecords(uuid, finalizedTxResp.TxHash)
+			s.metrics.RecordSubmission(signalIDs(signalPrices), time.Now())
+			for _, uuid := range b.uniqueUUIDs() {
+				s.pushMonitoringRecords(uuid, finalizedTxResp.TxHash)
+			}
 			return
 		case finalizedTxResp.Codespace == sdkerrors.RootCodespace && finalizedTxResp.Code == sdkerrors.ErrOutOfGas.ABCICode():
 			s.logger.Info("[Submitter] transaction is out of gas, retrying with increased gas adjustment")
@@ -213,6 +266,14 @@ func (s *Submitter) getAccountFromKey(key *keyring.Record) (client.Account, erro
 	return acc, nil
 }
 
+func signalIDs(prices []types.SignalPrice) []string {
+	ids := make([]string, 0, len(prices))
+	for _, p := range prices {
+		ids = append(ids, p.SignalID)
+	}
+	return ids
+}
+
 func (s *Submitter) removePending(prices []types.SignalPrice) {
 	for _, p := range prices {
 		_, loaded := s.pendingSignalIDs.LoadAndDelete(p.SignalID)
@@ -227,31 +288,35 @@ func (s *Submitter) broadcastMsg(
 	msgs []sdk.Msg,
 	gasAdjustment float64,
 	memo string,
+	gasLimit uint64,
 ) (*sdk.TxResponse, error) {
 	if len(s.clients) == 0 {
 		return nil, fmt.Errorf("no client provided")
 	}
 
-	txBytes, err := s.buildSignedTx(key, msgs, gasAdjustment, memo)
+	txBytes, err := s.buildSignedTx(key, msgs, gasAdjustment, memo, gasLimit)
 	if err != nil {
 		return nil, err
 	}
 
-	resultsCh := make(chan *sdk.TxResponse, len(s.clients))
-	failureCh := make(chan error, len(s.clients))
-	for _, client := range s.clients {
-		go func(client rpcclient.RemoteClient) {
-			res, err := s.clientCtx.WithClient(client).BroadcastTx(txBytes)
+	// broadcast to the available nodes, recording the failures so that broken nodes are ejected
+	indexes := s.nodes.Available()
+	resultsCh := make(chan *sdk.TxResponse, len(indexes))
+	failureCh := make(chan error, len(indexes))
+	for _, i := range indexes {
+		go func(i int) {
+			res, err := s.clientCtx.WithClient(s.clients[i]).BroadcastTx(txBytes)
+			s.nodes.RecordResult(i, err)
 			if err != nil {
 				failureCh <- err
 				return
 			}
 			resultsCh <- res
-		}(client)
+		}(i)
 	}
 
 	var res *sdk.TxResponse
-	for range s.clients {
+	for range indexes {
 		select {
 		case currentResult := <-resultsCh:
 			if currentResult.Code == 0 {
@@ -276,6 +341,7 @@ func (s *Submitter) buildSignedTx(
 	msgs []sdk.Msg,
 	gasAdjustment float64,
 	memo string,
+	gasLimit uint64,
 ) ([]byte, error) {
 	account, err := s.getAccountFromKey(key)
 	if err != nil {
@@ -288,8 +354,9 @@ func (s *Submitter) buildSignedTx(
 	}
 
 	execMsg := authz.NewMsgExec(addr, msgs)
-	gasCh := make(chan uint64, len(s.clients))
-	errCh := make(chan error, len(s.clients))
+	indexes := s.nodes.Available()
+	gasCh := make(chan uint64, len(indexes))
+	errCh := make(chan error, len(indexes))
 
 	txf := tx.Factory{}.
 		WithAccountNumber(account.GetAccountNumber()).
@@ -299,12 +366,14 @@ func (s *Submitter) buildSignedTx(
 		WithGasAdjustment(gasAdjustment).
 		WithChainID(s.clientCtx.ChainID).
 		WithMemo(memo).
-		WithGasPrices(s.gasPrices).
+		WithGasPrices(s.gasPrices.GasPrices()).
 		WithKeybase(s.clientCtx.Keyring).
 		WithFromName(key.Name).
 		WithAccountRetriever(s.clientCtx.AccountRetriever)
 
-	for _, client := range s.clients {
+	// the simulation failures are not recorded to the node pool, as they are mostly caused by the
+	// transaction rather than the node
+	for _, i := range indexes {
 		go func(client rpcclient.RemoteClient) {
 			_, adjusted, err := tx.CalculateGas(s.clientCtx.WithClient(client), txf, &execMsg)
 			if err != nil {
@@ -313,11 +382,11 @@ func (s *Submitter) buildSignedTx(
 			}
 
 			gasCh <- adjusted
-		}(client)
+		}(s.clients[i])
 	}
 
 	maxGas := uint64(0)
-	for range s.clients {
+	for range indexes {
 		select {
 		case gas := <-gasCh:
 			if gas > maxGas {
@@ -332,6 +401,10 @@ func (s *Submitter) buildSignedTx(
 		return nil, fmt.Errorf("failed to calculate gas with error: %v", err)
 	}
 
+	if gasLimit > 0 && maxGas > gasLimit {
+		return nil, fmt.Errorf("%w: %d > %d", errGasLimitExceeded, maxGas, gasLimit)
+	}
+
 	txf = txf.WithGas(maxGas)
 
 	txb, err := txf.BuildUnsignedTx(&execMsg)
@@ -33,7 +33,9 @@ import (
 	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
 
 	band "github.com/bandprotocol/chain/v3/app"
+	"github.com/bandprotocol/chain/v3/grogu/metrics"
 	"github.com/bandprotocol/chain/v3/grogu/submitter/testutil"
+	"github.com/bandprotocol/chain/v3/pkg/gasprice"
 	"github.com/bandprotocol/chain/v3/pkg/logger"
 	"github.com/bandprotocol/chain/v3/x/feeds/types"
 )
@@ -146,6 +148,12 @@ func (s *SubmitterTestSuite) SetupTest() {
 		Return(&sdk.TxResponse{TxHash: "mock-tx-hash", Code: 0}, nil).
 		AnyTimes()
 
+	mockParamsQuerier := testutil.NewMockParamsQuerier(ctrl)
+	mockParamsQuerier.EXPECT().
+		QueryParams().
+		Return(&types.QueryParamsResponse{Params: types.DefaultParams()}, nil).
+		AnyTimes()
+
 	// Initialize logger
 	allowLevel, _ := log.ParseLogLevel("info")
 	l := logger.NewLogger(allowLevel)
@@ -159,21 +167,35 @@ func (s *SubmitterTestSuite) SetupTest() {
 	// Initialize pending signal IDs map
 	pendingSignalIDs := sync.Map{}
 
+	// Set up gas price provider
+	gasPriceProvider, err := gasprice.NewProvider("0.025stake")
+	s.Require().NoError(err)
+
 	// Create submitter instance
+	// Set up node pool with a single healthy node
+	mockNodePool := testutil.NewMockNodePool(ctrl)
+	mockNodePool.EXPECT().Available().Return([]int{0}).AnyTimes()
+	mockNodePool.EXPECT().RecordResult(gomock.Any(), gomock.Any()).AnyTimes()
+
 	submitterInstance, err := New(
 		clientCtx,
 		mockRPCClients,
+		mockNodePool,
 		mockBothanClient,
 		l,
 		submitSignalPriceCh,
 		mockAuthQuerier,
 		mockTxQuerier,
+		mockParamsQuerier,
 		validAddress,
 		&pendingSignalIDs,
 		10*time.Second,
 		3,
 		1*time.Second,
-		"0.025stake",
+		gasPriceProvider,
+		metrics.New(),
+		0,
+		0,
 	)
 	s.Require().NoError(err)
 	s.Submitter = submitterInstance
@@ -217,7 +239,7 @@ func (s *SubmitterTestSuite) TestSubmitterSubmitPrice() {
 	keyID := <-s.Submitter.idleKeyIDChannel
 	s.Require().Len(s.Submitter.idleKeyIDChannel, 0)
 
-	s.Submitter.submitPrice(signalPriceSubmission, keyID)
+	s.Submitter.submitPrice(makeBatches([]SignalPriceSubmission{signalPriceSubmission}, 0)[0], keyID)
 
 	// Check pending signal IDs
 	_, pending := s.Submitter.pendingSignalIDs.Load("signal1")
@@ -264,7 +286,7 @@ func (s *SubmitterTestSuite) TestSubmitterSubmitPrice_OutOfGas() {
 	keyID := <-s.Submitter.idleKeyIDChannel
 	s.Require().Len(s.Submitter.idleKeyIDChannel, 0)
 
-	s.Submitter.submitPrice(signalPriceSubmission, keyID)
+	s.Submitter.submitPrice(makeBatches([]SignalPriceSubmission{signalPriceSubmission}, 0)[0], keyID)
 
 	// Check pending signal IDs
 	_, pending := s.Submitter.pendingSignalIDs.Load("signal1")
@@ -292,7 +314,7 @@ func (s *SubmitterTestSuite) TestSubmitterBuildSignedTx() {
 	}
 	msgs := []sdk.Msg{&msg}
 
-	txBytes, err := s.Submitter.buildSignedTx(key, msgs, 1.3, "test-memo")
+	txBytes, err := s.Submitter.buildSignedTx(key, msgs, 1.3, "test-memo", 0)
 	s.Require().NoError(err)
 	s.Require().NotNil(txBytes)
 }
@@ -326,7 +348,7 @@ func (s *SubmitterTestSuite) TestSubmitterBroadcastMsg() {
 	}
 	msgs := []sdk.Msg{&msg}
 
-	_, err = s.Submitter.broadcastMsg(key, msgs, 1.3, "test-memo")
+	_, err = s.Submitter.broadcastMsg(key, msgs, 1.3, "test-memo", 0)
 	s.Require().NoError(err)
 }
 
@@ -339,3 +361,42 @@ func (s *SubmitterTestSuite) TestSubmitterGetTxResponse() {
 	s.Require().Equal(txHash, resp.TxHash)
 	s.Require().Equal(uint32(0), resp.Code)
 }
+
+func (s *SubmitterTestSuite) TestSubmitterSubmitPrice_GasLimitExceeded() {
+	// Expect the batch to be split into two transactions, as its gas exceeds the limit
+	mockClient := s.Submitter.clients[0].(*testutil.MockRemoteClient)
+	mockClient.EXPECT().
+		BroadcastTxSync(gomock.Any(), gomock.Any()).
+		Return(&coretypes.ResultBroadcastTx{Code: 0}, nil).
+		Times(2)
+
+	s.Submitter.clients = []rpcclient.RemoteClient{mockClient}
+	s.Submitter.batchMaxGas = 1
+
+	prices := []types.SignalPrice{
+		{
+			SignalID: "signal1",
+			Price:    12345,
+			Status:   types.SIGNAL_PRICE_STATUS_AVAILABLE,
+		},
+		{
+			SignalID: "signal2",
+			Price:    23456,
+			Status:   types.SIGNAL_PRICE_STATUS_AVAILABLE,
+		},
+	}
+	s.Submitter.pendingSignalIDs.Store("signal1", struct{}{})
+	s.Submitter.pendingSignalIDs.Store("signal2", struct{}{})
+
+	keyID := <-s.Submitter.idleKeyIDChannel
+	s.Submitter.submitPrice(makeBatches([]SignalPriceSubmission{{SignalPrices: prices, UUID: "uuid1"}}, 0)[0], keyID)
+
+	// Check pending signal IDs
+	_, pending := s.Submitter.pendingSignalIDs.Load("signal1")
+	s.Require().False(pending, "Signal ID should have been removed from pendingSignalIDs")
+	_, pending = s.Submitter.pendingSignalIDs.Load("signal2")
+	s.Require().False(pending, "Signal ID should have been removed from pendingSignalIDs")
+
+	// Check key ID added back to idleKeyIDChannel
+	s.Require().Len(s.Submitter.idleKeyIDChannel, 1)
+}
@@ -14,13 +14,14 @@ import (
 	reflect "reflect"
 
 	proto "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+	types "github.com/bandprotocol/chain/v3/x/feeds/types"
 	bytes "github.com/cometbft/cometbft/libs/bytes"
 	log "github.com/cometbft/cometbft/libs/log"
 	client "github.com/cometbft/cometbft/rpc/client"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
-	types "github.com/cometbft/cometbft/types"
-	types0 "github.com/cosmos/cosmos-sdk/types"
-	types1 "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types0 "github.com/cometbft/cometbft/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -169,7 +170,7 @@ func (mr *MockRemoteClientMockRecorder) BlockchainInfo(ctx, minHeight, maxHeight
 }
 
 // BroadcastEvidence mocks base method.
-func (m *MockRemoteClient) BroadcastEvidence(arg0 context.Context, arg1 types.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
+func (m *MockRemoteClient) BroadcastEvidence(arg0 context.Context, arg1 types0.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BroadcastEvidence", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultBroadcastEvidence)
@@ -184,7 +185,7 @@ func (mr *MockRemoteClientMockRecorder) BroadcastEvidence(arg0, arg1 any) *gomoc
 }
 
 // BroadcastTxAsync mocks base method.
-func (m *MockRemoteClient) BroadcastTxAsync(arg0 context.Context, arg1 types.Tx) (*coretypes.ResultBroadcastTx, error) {
+func (m *MockRemoteClient) BroadcastTxAsync(arg0 context.Context, arg1 types0.Tx) (*coretypes.ResultBroadcastTx, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BroadcastTxAsync", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultBroadcastTx)
@@ -199,7 +200,7 @@ func (mr *MockRemoteClientMockRecorder) BroadcastTxAsync(arg0, arg1 any) *gomock
 }
 
 // BroadcastTxCommit mocks base method.
-func (m *MockRemoteClient) BroadcastTxCommit(arg0 context.Context, arg1 types.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+func (m *MockRemoteClient) BroadcastTxCommit(arg0 context.Context, arg1 types0.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BroadcastTxCommit", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultBroadcastTxCommit)
@@ -214,7 +215,7 @@ func (mr *MockRemoteClientMockRecorder) BroadcastTxCommit(arg0, arg1 any) *gomoc
 }
 
 // BroadcastTxSync mocks base method.
-func (m *MockRemoteClient) BroadcastTxSync(arg0 context.Context, arg1 types.Tx) (*coretypes.ResultBroadcastTx, error) {
+func (m *MockRemoteClient) BroadcastTxSync(arg0 context.Context, arg1 types0.Tx) (*coretypes.ResultBroadcastTx, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BroadcastTxSync", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultBroadcastTx)
@@ -229,7 +230,7 @@ func (mr *MockRemoteClientMockRecorder) BroadcastTxSync(arg0, arg1 any) *gomock.
 }
 
 // CheckTx mocks base method.
-func (m *MockRemoteClient) CheckTx(arg0 context.Context, arg1 types.Tx) (*coretypes.ResultCheckTx, error) {
+func (m *MockRemoteClient) CheckTx(arg0 context.Context, arg1 types0.Tx) (*coretypes.ResultCheckTx, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CheckTx", arg0, arg1)
 	ret0, _ := ret[0].(*coretypes.ResultCheckTx)
@@ -788,10 +789,10 @@ func (m *MockAuthQuerier) EXPECT() *MockAuthQuerierMockRecorder {
 }
 
 // QueryAccount mocks base method.
-func (m *MockAuthQuerier) QueryAccount(address types0.Address) (*types1.QueryAccountResponse, error) {
+func (m *MockAuthQuerier) QueryAccount(address types1.Address) (*types2.QueryAccountResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryAccount", address)
-	ret0, _ := ret[0].(*types1.QueryAccountResponse)
+	ret0, _ := ret[0].(*types2.QueryAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -827,10 +828,10 @@ func (m *MockTxQuerier) EXPECT() *MockTxQuerierMockRecorder {
 }
 
 // QueryTx mocks base method.
-func (m *MockTxQuerier) QueryTx(hash string) (*types0.TxResponse, error) {
+func (m *MockTxQuerier) QueryTx(hash string) (*types1.TxResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryTx", hash)
-	ret0, _ := ret[0].(*types0.TxResponse)
+	ret0, _ := ret[0].(*types1.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -840,3 +841,130 @@ func (mr *MockTxQuerierMockRecorder) QueryTx(hash any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTx", reflect.TypeOf((*MockTxQuerier)(nil).QueryTx), hash)
 }
+
+// MockParamsQuerier is a mock of ParamsQuerier interface.
+type MockParamsQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockParamsQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockParamsQuerierMockRecorder is the mock recorder for MockParamsQuerier.
+type MockParamsQuerierMockRecorder struct {
+	mock *MockParamsQuerier
+}
+
+// NewMockParamsQuerier creates a new mock instance.
+func NewMockParamsQuerier(ctrl *gomock.Controller) *MockParamsQuerier {
+	mock := &MockParamsQuerier{ctrl: ctrl}
+	mock.recorder = &MockParamsQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParamsQuerier) EXPECT() *MockParamsQuerierMockRecorder {
+	return m.recorder
+}
+
+// QueryParams mocks base method.
+func (m *MockParamsQuerier) QueryParams() (*types.QueryParamsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryParams")
+	ret0, _ := ret[0].(*types.QueryParamsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryParams indicates an expected call of QueryParams.
+func (mr *MockParamsQuerierMockRecorder) QueryParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryParams", reflect.TypeOf((*MockParamsQuerier)(nil).QueryParams))
+}
+
+// MockNodePool is a mock of NodePool interface.
+type MockNodePool struct {
+	ctrl     *gomock.Controller
+	recorder *MockNodePoolMockRecorder
+	isgomock struct{}
+}
+
+// MockNodePoolMockRecorder is the mock recorder for MockNodePool.
+type MockNodePoolMockRecorder struct {
+	mock *MockNodePool
+}
+
+// NewMockNodePool creates a new mock instance.
+func NewMockNodePool(ctrl *gomock.Controller) *MockNodePool {
+	mock := &MockNodePool{ctrl: ctrl}
+	mock.recorder = &MockNodePoolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNodePool) EXPECT() *MockNodePoolMockRecorder {
+	return m.recorder
+}
+
+// Available mocks base method.
+func (m *MockNodePool) Available() []int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Available")
+	ret0, _ := ret[0].([]int)
+	return ret0
+}
+
+// Available indicates an expected call of Available.
+func (mr *MockNodePoolMockRecorder) Available() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Available", reflect.TypeOf((*MockNodePool)(nil).Available))
+}
+
+// RecordResult mocks base method.
+func (m *MockNodePool) RecordResult(node int, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordResult", node, err)
+}
+
+// RecordResult indicates an expected call of RecordResult.
+func (mr *MockNodePoolMockRecorder) RecordResult(node, err any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordResult", reflect.TypeOf((*MockNodePool)(nil).RecordResult), node, err)
+}
+
+// MockGasPriceProvider is a mock of GasPriceProvider interface.
+type MockGasPriceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasPriceProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockGasPriceProviderMockRecorder is the mock recorder for MockGasPriceProvider.
+type MockGasPriceProviderMockRecorder struct {
+	mock *MockGasPriceProvider
+}
+
+// NewMockGasPriceProvider creates a new mock instance.
+func NewMockGasPriceProvider(ctrl *gomock.Controller) *MockGasPriceProvider {
+	mock := &MockGasPriceProvider{ctrl: ctrl}
+	mock.recorder = &MockGasPriceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasPriceProvider) EXPECT() *MockGasPriceProviderMockRecorder {
+	return m.recorder
+}
+
+// GasPrices mocks base method.
+func (m *MockGasPriceProvider) GasPrices() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GasPrices")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GasPrices indicates an expected call of GasPrices.
+func (mr *MockGasPriceProviderMockRecorder) GasPrices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GasPrices", reflect.TypeOf((*MockGasPriceProvider)(nil).GasPrices))
+}
@@ -0,0 +1,54 @@
+package updater
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type updaterCollector struct {
+	updater               *Updater
+	outcomeCountDesc      *prometheus.Desc
+	successRatioGaugeDesc *prometheus.Desc
+	blockedGaugeDesc      *prometheus.Desc
+}
+
+// NewCollector creates a prometheus collector of the outcomes of the registry updates.
+func NewCollector(u *Updater) prometheus.Collector {
+	return &updaterCollector{
+		updater: u,
+		outcomeCountDesc: prometheus.NewDesc(
+			"grogu_registry_update_total",
+			"Number of outcomes of the Bothan registry updates since last grogu restart",
+			[]string{"outcome"}, nil),
+		successRatioGaugeDesc: prometheus.NewDesc(
+			"grogu_registry_price_success_ratio",
+			"Ratio of available prices of the last dry price fetch of a staged registry",
+			nil, nil),
+		blockedGaugeDesc: prometheus.NewDesc(
+			"grogu_registry_update_blocked",
+			"Whether Bothan is not updated to the registry on chain because it failed the verification or was rolled back",
+			nil, nil),
+	}
+}
+
+func (collector updaterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.outcomeCountDesc
+	ch <- collector.successRatioGaugeDesc
+	ch <- collector.blockedGaugeDesc
+}
+
+func (collector updaterCollector) Collect(ch chan<- prometheus.Metric) {
+	for outcome, count := range collector.updater.Outcomes() {
+		ch <- prometheus.MustNewConstMetric(collector.outcomeCountDesc, prometheus.CounterValue,
+			float64(count), string(outcome))
+	}
+
+	if ratio, ok := collector.updater.PriceSuccessRatio(); ok {
+		ch <- prometheus.MustNewConstMetric(collector.successRatioGaugeDesc, prometheus.GaugeValue, ratio)
+	}
+
+	blocked := 0.0
+	if _, ok := collector.updater.Blocked(); ok {
+		blocked = 1
+	}
+	ch <- prometheus.MustNewConstMetric(collector.blockedGaugeDesc, prometheus.GaugeValue, blocked)
+}
@@ -0,0 +1,303 @@
+package updater
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/btcutil/base58"
+)
+
+const (
+	// codecRaw and codecDagPB are the multicodecs of the supported IPFS blocks.
+	codecRaw   = 0x55
+	codecDagPB = 0x70
+
+	// hashSHA256 is the multihash code of sha2-256, the only supported hash function.
+	hashSHA256 = 0x12
+
+	// maxRegistryBlockSize is the maximum size of an IPFS block of the registry.
+	maxRegistryBlockSize = 2 * 1024 * 1024
+	// maxRegistrySize is the maximum size of the registry content.
+	maxRegistrySize = 16 * 1024 * 1024
+	// maxRegistryDepth is the maximum depth of the IPFS DAG of the registry.
+	maxRegistryDepth = 8
+)
+
+// cid is a parsed IPFS content identifier with a sha2-256 multihash.
+type cid struct {
+	codec  uint64
+	digest []byte
+}
+
+// parseCID parses a CIDv0 (base58btc) or a CIDv1 in base32 as used by IPFS by default.
+func parseCID(s string) (cid, error) {
+	if strings.HasPrefix(s, "Qm") && len(s) == 46 {
+		return parseCIDv0(base58.Decode(s))
+	}
+	if strings.HasPrefix(s, "b") {
+		bz, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(s[1:]))
+		if err != nil {
+			return cid{}, fmt.Errorf("invalid base32 CID %s: %w", s, err)
+		}
+		return parseBinaryCID(bz)
+	}
+
+	return cid{}, fmt.Errorf("unsupported CID %s", s)
+}
+
+// parseBinaryCID parses a CID in its binary form, as in the links of dag-pb blocks.
+func parseBinaryCID(bz []byte) (cid, error) {
+	if len(bz) > 0 && bz[0] == hashSHA256 {
+		return parseCIDv0(bz)
+	}
+
+	version, n := binary.Uvarint(bz)
+	if n <= 0 || version != 1 {
+		return cid{}, fmt.Errorf("unsupported CID version")
+	}
+	codec, m := binary.Uvarint(bz[n:])
+	if m <= 0 {
+		return cid{}, fmt.Errorf("invalid CID codec")
+	}
+	digest, err := parseMultihash(bz[n+m:])
+	if err != nil {
+		return cid{}, err
+	}
+
+	return cid{codec: codec, digest: digest}, nil
+}
+
+func parseCIDv0(bz []byte) (cid, error) {
+	digest, err := parseMultihash(bz)
+	if err != nil {
+		return cid{}, err
+	}
+
+	return cid{codec: codecDagPB, digest: digest}, nil
+}
+
+func parseMultihash(bz []byte) ([]byte, error) {
+	code, n := binary.Uvarint(bz)
+	if n <= 0 || code != hashSHA256 {
+		return nil, fmt.Errorf("unsupported multihash, only sha2-256 is supported")
+	}
+	length, m := binary.Uvarint(bz[n:])
+	if m <= 0 || length != sha256.Size || len(bz[n+m:]) != sha256.Size {
+		return nil, fmt.Errorf("invalid sha2-256 multihash length")
+	}
+
+	return bz[n+m:], nil
+}
+
+// String returns the CIDv1 of the cid in base32, which is accepted by IPFS gateways.
+func (c cid) String() string {
+	bz := binary.AppendUvarint(nil, 1)
+	bz = binary.AppendUvarint(bz, c.codec)
+	bz = binary.AppendUvarint(bz, hashSHA256)
+	bz = binary.AppendUvarint(bz, uint64(len(c.digest)))
+	bz = append(bz, c.digest...)
+
+	return "b" + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(bz))
+}
+
+// RegistryVerifier fetches the registry from an IPFS gateway and verifies that its content matches
+// the IPFS hash. The blocks are fetched raw and verified one by one, so the gateway does not need
+// to be trusted.
+type RegistryVerifier struct {
+	gateway string
+	client  *http.Client
+}
+
+// NewRegistryVerifier creates a new RegistryVerifier fetching from the given IPFS gateway URL.
+func NewRegistryVerifier(gateway string, timeout time.Duration) *RegistryVerifier {
+	return &RegistryVerifier{
+		gateway: strings.TrimSuffix(gateway, "/"),
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Verify fetches the registry of the IPFS hash and returns its content if it matches the hash and
+// is a JSON object.
+func (v *RegistryVerifier) Verify(ipfsHash string) ([]byte, error) {
+	c, err := parseCID(ipfsHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var content bytes.Buffer
+	if err := v.fetchContent(ipfsHash, c, &content, 0); err != nil {
+		return nil, err
+	}
+
+	var registry map[string]json.RawMessage
+	if err := json.Unmarshal(content.Bytes(), &registry); err != nil {
+		return nil, fmt.Errorf("registry is not a JSON object: %w", err)
+	}
+
+	return content.Bytes(), nil
+}
+
+// fetchContent fetches the block of the CID and writes the file content it holds, including the
+// content of the linked blocks, to the buffer.
+func (v *RegistryVerifier) fetchContent(ref string, c cid, content *bytes.Buffer, depth int) error {
+	if depth > maxRegistryDepth {
+		return fmt.Errorf("registry DAG is too deep")
+	}
+
+	block, err := v.fetchBlock(ref)
+	if err != nil {
+		return err
+	}
+	if digest := sha256.Sum256(block); !bytes.Equal(digest[:], c.digest) {
+		return fmt.Errorf("block %s does not match its hash", ref)
+	}
+
+	switch c.codec {
+	case codecRaw:
+		return writeContent(content, block)
+	case codecDagPB:
+		links, data, err := decodeDagPB(block)
+		if err != nil {
+			return fmt.Errorf("invalid dag-pb block %s: %w", ref, err)
+		}
+		if err := writeContent(content, data); err != nil {
+			return err
+		}
+		for _, link := range links {
+			if err := v.fetchContent(link.String(), link, content, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported codec 0x%x of block %s", c.codec, ref)
+	}
+}
+
+func (v *RegistryVerifier) fetchBlock(ref string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/ipfs/%s?format=raw", v.gateway, ref), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.ipld.raw")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch block %s: status %s", ref, resp.Status)
+	}
+
+	block, err := io.ReadAll(io.LimitReader(resp.Body, maxRegistryBlockSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(block) > maxRegistryBlockSize {
+		return nil, fmt.Errorf("block %s is too large", ref)
+	}
+
+	return block, nil
+}
+
+func writeContent(content *bytes.Buffer, data []byte) error {
+	if content.Len()+len(data) > maxRegistrySize {
+		return fmt.Errorf("registry is too large")
+	}
+	content.Write(data)
+	return nil
+}
+
+// decodeDagPB decodes a dag-pb block of a UnixFS file into the links to its children and the data
+// it holds itself.
+func decodeDagPB(block []byte) ([]cid, []byte, error) {
+	var links []cid
+	var unixfs []byte
+	for len(block) > 0 {
+		num, typ, n := protowire.ConsumeTag(block)
+		if n < 0 {
+			return nil, nil, protowire.ParseError(n)
+		}
+		block = block[n:]
+
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, block)
+			if n < 0 {
+				return nil, nil, protowire.ParseError(n)
+			}
+			block = block[n:]
+			continue
+		}
+
+		value, n := protowire.ConsumeBytes(block)
+		if n < 0 {
+			return nil, nil, protowire.ParseError(n)
+		}
+		block = block[n:]
+
+		switch num {
+		case 1: // PBNode.Data
+			unixfs = value
+		case 2: // PBNode.Links
+			hash, err := consumeField(value, 1)
+			if err != nil {
+				return nil, nil, err
+			}
+			link, err := parseBinaryCID(hash)
+			if err != nil {
+				return nil, nil, err
+			}
+			links = append(links, link)
+		}
+	}
+
+	// UnixFS.Data holds the file content of the block, if any
+	data, err := consumeField(unixfs, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return links, data, nil
+}
+
+// consumeField returns the value of the bytes field with the given number in the message.
+func consumeField(msg []byte, field protowire.Number) ([]byte, error) {
+	var result []byte
+	for len(msg) > 0 {
+		num, typ, n := protowire.ConsumeTag(msg)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		msg = msg[n:]
+
+		if num == field && typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(msg)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			result = value
+			msg = msg[n:]
+			continue
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, msg)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		msg = msg[n:]
+	}
+
+	return result, nil
+}
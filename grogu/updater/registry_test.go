@@ -0,0 +1,127 @@
+package updater
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/btcutil/base58"
+)
+
+// dagPBBlock encodes a dag-pb block of a UnixFS file with the data and the links to the children.
+func dagPBBlock(data []byte, links ...[]byte) []byte {
+	var unixfs []byte
+	unixfs = protowire.AppendTag(unixfs, 1, protowire.VarintType)
+	unixfs = protowire.AppendVarint(unixfs, 2)
+	if data != nil {
+		unixfs = protowire.AppendTag(unixfs, 2, protowire.BytesType)
+		unixfs = protowire.AppendBytes(unixfs, data)
+	}
+
+	var block []byte
+	for _, link := range links {
+		var pbLink []byte
+		pbLink = protowire.AppendTag(pbLink, 1, protowire.BytesType)
+		pbLink = protowire.AppendBytes(pbLink, link)
+		block = protowire.AppendTag(block, 2, protowire.BytesType)
+		block = protowire.AppendBytes(block, pbLink)
+	}
+	block = protowire.AppendTag(block, 1, protowire.BytesType)
+	return protowire.AppendBytes(block, unixfs)
+}
+
+func cidV0(block []byte) string {
+	digest := sha256.Sum256(block)
+	return base58.Encode(append([]byte{hashSHA256, sha256.Size}, digest[:]...))
+}
+
+func rawCID(block []byte) cid {
+	digest := sha256.Sum256(block)
+	return cid{codec: codecRaw, digest: digest[:]}
+}
+
+func binaryCID(c cid) []byte {
+	return append([]byte{1, byte(c.codec), hashSHA256, sha256.Size}, c.digest...)
+}
+
+func newGateway(t *testing.T, blocks map[string][]byte) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		block, ok := blocks[strings.TrimPrefix(r.URL.Path, "/ipfs/")]
+		if !ok || r.URL.Query().Get("format") != "raw" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(block)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestParseCID(t *testing.T) {
+	block := []byte(`{"CS:BTC-USD":{}}`)
+	digest := sha256.Sum256(block)
+
+	c, err := parseCID(cidV0(block))
+	require.NoError(t, err)
+	require.Equal(t, cid{codec: codecDagPB, digest: digest[:]}, c)
+
+	raw := rawCID(block)
+	c, err = parseCID(raw.String())
+	require.NoError(t, err)
+	require.Equal(t, raw, c)
+
+	c, err = parseBinaryCID(binaryCID(raw))
+	require.NoError(t, err)
+	require.Equal(t, raw, c)
+
+	_, err = parseCID("zb2rhe5P4gXftAwvA4eXQ5HJwsER2owDyS9sKaQRRVQPn93bA")
+	require.ErrorContains(t, err, "unsupported CID")
+
+	_, err = parseCID("bafkqaaa")
+	require.Error(t, err)
+}
+
+func TestRegistryVerifier(t *testing.T) {
+	// single block registry
+	content := []byte(`{"CS:BTC-USD":{"sources":[]}}`)
+	single := dagPBBlock(content)
+
+	// registry split into two raw leaves
+	first, second := rawCID([]byte(`{"CS:ETH-USD":`)), rawCID([]byte(`{"sources":[]}}`))
+	root := dagPBBlock(nil, binaryCID(first), binaryCID(second))
+
+	notJSON := dagPBBlock([]byte("not json"))
+
+	server := newGateway(t, map[string][]byte{
+		cidV0(single):     single,
+		cidV0(root):       root,
+		first.String():    []byte(`{"CS:ETH-USD":`),
+		second.String():   []byte(`{"sources":[]}}`),
+		cidV0(notJSON):    notJSON,
+		cidV0([]byte("")): single, // tampered block
+	})
+	verifier := NewRegistryVerifier(server.URL+"/", time.Second)
+
+	bz, err := verifier.Verify(cidV0(single))
+	require.NoError(t, err)
+	require.Equal(t, content, bz)
+
+	bz, err = verifier.Verify(cidV0(root))
+	require.NoError(t, err)
+	require.Equal(t, `{"CS:ETH-USD":{"sources":[]}}`, string(bz))
+
+	_, err = verifier.Verify(cidV0([]byte("")))
+	require.ErrorContains(t, err, "does not match its hash")
+
+	_, err = verifier.Verify(cidV0(notJSON))
+	require.ErrorContains(t, err, "not a JSON object")
+
+	_, err = verifier.Verify(cidV0([]byte("missing")))
+	require.ErrorContains(t, err, "404")
+}
@@ -0,0 +1,183 @@
+package updater
+
+import (
+	"fmt"
+	"time"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+)
+
+// RegistryOutcome is the outcome of an attempt to update the registry of Bothan.
+type RegistryOutcome string
+
+const (
+	// RegistryUpdated is a registry pushed to Bothan.
+	RegistryUpdated RegistryOutcome = "updated"
+	// RegistryVerificationFailed is a registry whose content could not be verified against its hash.
+	RegistryVerificationFailed RegistryOutcome = "verification_failed"
+	// RegistryUpdateFailed is a registry that Bothan failed to update to.
+	RegistryUpdateFailed RegistryOutcome = "update_failed"
+	// RegistryCommitted is a staged registry kept after its observation period.
+	RegistryCommitted RegistryOutcome = "committed"
+	// RegistryRolledBack is a staged registry rolled back to the previous one.
+	RegistryRolledBack RegistryOutcome = "rolled_back"
+	// RegistryRollbackFailed is a staged registry that could not be rolled back.
+	RegistryRollbackFailed RegistryOutcome = "rollback_failed"
+)
+
+// RolloutConfig is the configuration of staged rollouts of new registries.
+type RolloutConfig struct {
+	// Enabled is whether new registries are staged, and rolled back if the price fetch success
+	// ratio drops.
+	Enabled bool
+	// Warmup is the duration after switching to a new registry before its dry price fetch, which is
+	// done on the first update check after it.
+	Warmup time.Duration
+	// Observation is the duration after switching during which the new registry is rolled back on
+	// a drop of the price fetch success ratio.
+	Observation time.Duration
+	// MaxSuccessDrop is the maximum drop of the price fetch success ratio, between 0 and 1, from the
+	// one of the previous registry.
+	MaxSuccessDrop float64
+}
+
+// Validate checks the rollout configuration.
+func (c RolloutConfig) Validate() error {
+	if c.MaxSuccessDrop < 0 || c.MaxSuccessDrop > 1 {
+		return fmt.Errorf("max success drop must be between 0 and 1")
+	}
+	if c.Warmup < 0 || c.Observation < 0 {
+		return fmt.Errorf("rollout warmup and observation cannot be negative")
+	}
+
+	return nil
+}
+
+// registry is a registry set to Bothan.
+type registry struct {
+	ipfsHash string
+	version  string
+}
+
+// rollout is a staged registry under observation.
+type rollout struct {
+	previous *registry
+	target   registry
+	// baseline is the price fetch success ratio of the previous registry.
+	baseline float64
+	start    time.Time
+}
+
+// stageRegistry switches Bothan to the target registry, which is rolled back by checkRollout if the
+// dry price fetch after the warmup is less successful than with the previous registry.
+//
+// Bothan only fetches prices with its active registry, so the target registry cannot be tried
+// before switching to it. A registry that breaks the prices is used until the first update check
+// after the warmup.
+func (u *Updater) stageRegistry(target registry) {
+	baseline, err := u.queryPriceSuccessRatio()
+	if err != nil {
+		u.logger.Error("[Updater] failed to fetch prices with the current registry, postponing update: %v", err)
+		return
+	}
+
+	if !u.updateRegistry(target) {
+		return
+	}
+
+	u.rollout = &rollout{
+		previous: u.active,
+		target:   target,
+		baseline: baseline,
+		start:    time.Now(),
+	}
+	u.active = &target
+
+	u.logger.Info(
+		"[Updater] staged registry %s with baseline price fetch success ratio %.2f, checking after %v",
+		target.ipfsHash, baseline, u.rolloutConfig.Warmup,
+	)
+}
+
+// checkRollout rolls the staged registry back if its price fetch success ratio dropped after the
+// warmup, or commits it after the observation period.
+func (u *Updater) checkRollout() {
+	r := u.rollout
+	if r == nil || time.Since(r.start) < u.rolloutConfig.Warmup {
+		return
+	}
+
+	ratio, err := u.queryPriceSuccessRatio()
+	if err != nil {
+		u.logger.Error("[Updater] failed to fetch prices with staged registry %s: %v", r.target.ipfsHash, err)
+		return
+	}
+
+	if r.baseline-ratio <= u.rolloutConfig.MaxSuccessDrop {
+		if time.Since(r.start) >= u.rolloutConfig.Observation {
+			u.rollout = nil
+			u.recordOutcome(RegistryCommitted)
+			u.logger.Info("[Updater] committed registry %s", r.target.ipfsHash)
+		}
+		return
+	}
+
+	u.logger.Error(
+		"[Updater] price fetch success ratio dropped from %.2f to %.2f with registry %s, rolling back",
+		r.baseline, ratio, r.target.ipfsHash,
+	)
+	u.rollout = nil
+	u.rejected[r.target.ipfsHash] = true
+
+	if r.previous == nil {
+		u.recordOutcome(RegistryRollbackFailed)
+		u.logger.Error("[Updater] no previous registry to roll back to")
+		return
+	}
+	if err := u.bothanClient.UpdateRegistry(r.previous.ipfsHash, r.previous.version); err != nil {
+		u.recordOutcome(RegistryRollbackFailed)
+		u.logger.Error("[Updater] failed to roll back to registry %s: %v", r.previous.ipfsHash, err)
+		return
+	}
+
+	u.active = r.previous
+	u.recordOutcome(RegistryRolledBack)
+	u.logger.Info("[Updater] rolled back to registry %s", r.previous.ipfsHash)
+}
+
+// queryPriceSuccessRatio fetches the prices of the current feeds from Bothan without submitting
+// them, and returns the ratio of the available ones.
+func (u *Updater) queryPriceSuccessRatio() (float64, error) {
+	resp, err := u.feedQuerier.QueryCurrentFeeds()
+	if err != nil {
+		return 0, err
+	}
+
+	signalIDs := make([]string, 0, len(resp.CurrentFeeds.Feeds))
+	for _, feed := range resp.CurrentFeeds.Feeds {
+		signalIDs = append(signalIDs, feed.SignalID)
+	}
+	if len(signalIDs) == 0 {
+		return 1, nil
+	}
+
+	prices, err := u.bothanClient.GetPrices(signalIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	available := 0
+	for _, price := range prices.Prices {
+		if price.Status == bothan.Status_STATUS_AVAILABLE {
+			available++
+		}
+	}
+
+	ratio := float64(available) / float64(len(signalIDs))
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.priceSuccessRatio = &ratio
+
+	return ratio, nil
+}
@@ -2,6 +2,7 @@ package updater
 
 import (
 	"os"
+	"sync"
 	"time"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -14,8 +15,25 @@ type Updater struct {
 	bothanClient BothanClient
 	clients      []rpcclient.RemoteClient
 	logger       *logger.Logger
+	// verifier verifies the registries before updating Bothan, or nil if the verification is disabled.
+	verifier *RegistryVerifier
 
 	queryInterval time.Duration
+	rolloutConfig RolloutConfig
+
+	// active is the registry known to be set to Bothan, if any.
+	active *registry
+	// rollout is the staged registry under observation, if any.
+	rollout *rollout
+	// rejected are the IPFS hashes of the registries rolled back, which are not updated to again.
+	rejected map[string]bool
+
+	mu                sync.Mutex
+	outcomes          map[RegistryOutcome]uint64
+	priceSuccessRatio *float64
+	// blocked is the IPFS hash of the registry on chain that Bothan is not updated to, because it
+	// failed the verification or was rolled back, if any.
+	blocked string
 }
 
 func New(
@@ -24,13 +42,19 @@ func New(
 	clients []rpcclient.RemoteClient,
 	logger *logger.Logger,
 	queryInterval time.Duration,
+	verifier *RegistryVerifier,
+	rolloutConfig RolloutConfig,
 ) *Updater {
 	return &Updater{
 		feedQuerier:   feedQuerier,
 		bothanClient:  bothanClient,
 		clients:       clients,
 		logger:        logger,
+		verifier:      verifier,
 		queryInterval: queryInterval,
+		rolloutConfig: rolloutConfig,
+		rejected:      make(map[string]bool),
+		outcomes:      make(map[RegistryOutcome]uint64),
 	}
 }
 
@@ -63,17 +87,100 @@ func (u *Updater) checkAndUpdateBothan() {
 		return
 	}
 
+	target := registry{ipfsHash: rfc.RegistryIPFSHash, version: rfc.RegistryVersion}
 	if rfc.RegistryIPFSHash == bothanInfo.RegistryIpfsHash {
+		u.setBlocked("")
+		u.active = &target
+		u.checkRollout()
 		u.logger.Debug("[Updater] chain and Bothan config match, skipping update")
 		return
 	}
 
-	u.logger.Info("[Updater] chain and Bothan config mismatch detected, updating registry")
-	err = u.bothanClient.UpdateRegistry(rfc.RegistryIPFSHash, rfc.RegistryVersion)
+	if u.rejected[rfc.RegistryIPFSHash] {
+		u.setBlocked(rfc.RegistryIPFSHash)
+		u.logger.Warn("[Updater] registry %s was rolled back, skipping update", rfc.RegistryIPFSHash)
+		return
+	}
+
+	if u.verifier != nil {
+		u.logger.Info("[Updater] chain and Bothan config mismatch detected, verifying registry")
+		if _, err := u.verifier.Verify(rfc.RegistryIPFSHash); err != nil {
+			u.setBlocked(rfc.RegistryIPFSHash)
+			u.recordOutcome(RegistryVerificationFailed)
+			u.logger.Error("[Updater] failed to verify registry %s, skipping update: %v", rfc.RegistryIPFSHash, err)
+			return
+		}
+	} else {
+		u.logger.Info("[Updater] chain and Bothan config mismatch detected, updating registry without verification")
+	}
+	u.setBlocked("")
+
+	if u.rolloutConfig.Enabled {
+		u.stageRegistry(target)
+		return
+	}
+
+	if u.updateRegistry(target) {
+		u.active = &target
+	}
+}
+
+// updateRegistry updates the registry of Bothan and returns whether it succeeded.
+func (u *Updater) updateRegistry(target registry) bool {
+	err := u.bothanClient.UpdateRegistry(target.ipfsHash, target.version)
 	if err != nil {
+		u.recordOutcome(RegistryUpdateFailed)
 		u.logger.Error("[Updater] failed to update registry: %v", err)
-		return
+		return false
 	}
 
-	u.logger.Info("[Updater] successfully updated registry with IPFS hash: %s", rfc.RegistryIPFSHash)
+	u.recordOutcome(RegistryUpdated)
+	u.logger.Info("[Updater] successfully updated registry with IPFS hash: %s", target.ipfsHash)
+	return true
+}
+
+func (u *Updater) setBlocked(ipfsHash string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.blocked = ipfsHash
+}
+
+// Blocked returns the IPFS hash of the registry on chain that Bothan is not updated to, because it
+// failed the verification or was rolled back, if any.
+func (u *Updater) Blocked() (string, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.blocked, u.blocked != ""
+}
+
+func (u *Updater) recordOutcome(outcome RegistryOutcome) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.outcomes[outcome]++
+}
+
+// Outcomes returns the number of each outcome of the registry updates.
+func (u *Updater) Outcomes() map[RegistryOutcome]uint64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	outcomes := make(map[RegistryOutcome]uint64, len(u.outcomes))
+	for outcome, count := range u.outcomes {
+		outcomes[outcome] = count
+	}
+	return outcomes
+}
+
+// PriceSuccessRatio returns the ratio of available prices of the last dry price fetch, if any.
+func (u *Updater) PriceSuccessRatio() (float64, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.priceSuccessRatio == nil {
+		return 0, false
+	}
+	return *u.priceSuccessRatio, true
 }
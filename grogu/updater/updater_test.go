@@ -0,0 +1,235 @@
+package updater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/log"
+
+	bothan "github.com/bandprotocol/bothan/bothan-api/client/go-client/proto/bothan/v1"
+
+	"github.com/bandprotocol/chain/v3/grogu/updater/testutil"
+	"github.com/bandprotocol/chain/v3/pkg/logger"
+	feeds "github.com/bandprotocol/chain/v3/x/feeds/types"
+)
+
+type UpdaterTestSuite struct {
+	suite.Suite
+
+	Updater *Updater
+
+	// chainHash and bothanHash are the registries on chain and in Bothan
+	chainHash  string
+	bothanHash string
+	// available is the number of available prices returned by Bothan
+	available int
+	// updates are the registries Bothan is updated to
+	updates []registry
+
+	oldHash string
+	newHash string
+}
+
+func TestUpdaterTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdaterTestSuite))
+}
+
+func (s *UpdaterTestSuite) SetupTest() {
+	oldBlock := dagPBBlock([]byte(`{"CS:BTC-USD":{}}`))
+	newBlock := dagPBBlock([]byte(`{"CS:BTC-USD":{},"CS:ETH-USD":{}}`))
+	s.oldHash, s.newHash = cidV0(oldBlock), cidV0(newBlock)
+	s.chainHash, s.bothanHash = s.oldHash, s.oldHash
+	s.available = 2
+	s.updates = nil
+
+	server := newGateway(s.T(), map[string][]byte{
+		s.oldHash: oldBlock,
+		s.newHash: newBlock,
+	})
+
+	ctrl := gomock.NewController(s.T())
+	mockFeedQuerier := testutil.NewMockFeedQuerier(ctrl)
+	mockFeedQuerier.EXPECT().
+		QueryReferenceSourceConfig().
+		DoAndReturn(func() (*feeds.QueryReferenceSourceConfigResponse, error) {
+			return &feeds.QueryReferenceSourceConfigResponse{
+				ReferenceSourceConfig: feeds.NewReferenceSourceConfig(s.chainHash, "v"+s.chainHash),
+			}, nil
+		}).
+		AnyTimes()
+	mockFeedQuerier.EXPECT().
+		QueryCurrentFeeds().
+		Return(&feeds.QueryCurrentFeedsResponse{CurrentFeeds: feeds.CurrentFeedWithDeviations{
+			Feeds: []feeds.FeedWithDeviation{{SignalID: "CS:BTC-USD"}, {SignalID: "CS:ETH-USD"}},
+		}}, nil).
+		AnyTimes()
+
+	mockBothanClient := testutil.NewMockBothanClient(ctrl)
+	mockBothanClient.EXPECT().
+		GetInfo().
+		DoAndReturn(func() (*bothan.GetInfoResponse, error) {
+			return &bothan.GetInfoResponse{RegistryIpfsHash: s.bothanHash}, nil
+		}).
+		AnyTimes()
+	mockBothanClient.EXPECT().
+		UpdateRegistry(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ipfsHash string, version string) error {
+			s.bothanHash = ipfsHash
+			s.updates = append(s.updates, registry{ipfsHash: ipfsHash, version: version})
+			return nil
+		}).
+		AnyTimes()
+	mockBothanClient.EXPECT().
+		GetPrices(gomock.Any()).
+		DoAndReturn(func(signalIDs []string) (*bothan.GetPricesResponse, error) {
+			prices := make([]*bothan.Price, 0, len(signalIDs))
+			for i, signalID := range signalIDs {
+				status := bothan.Status_STATUS_UNAVAILABLE
+				if i < s.available {
+					status = bothan.Status_STATUS_AVAILABLE
+				}
+				prices = append(prices, &bothan.Price{SignalId: signalID, Status: status})
+			}
+			return &bothan.GetPricesResponse{Prices: prices}, nil
+		}).
+		AnyTimes()
+
+	allowLevel, _ := log.ParseLogLevel("info")
+	s.Updater = New(
+		mockFeedQuerier,
+		mockBothanClient,
+		nil,
+		logger.NewLogger(allowLevel),
+		time.Minute,
+		NewRegistryVerifier(server.URL, time.Second),
+		RolloutConfig{},
+	)
+}
+
+func (s *UpdaterTestSuite) TestCheckAndUpdateBothan() {
+	// matching registries
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Empty(s.updates)
+	s.Require().Equal(&registry{ipfsHash: s.oldHash, version: "v" + s.oldHash}, s.Updater.active)
+
+	s.chainHash = s.newHash
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Equal([]registry{{ipfsHash: s.newHash, version: "v" + s.newHash}}, s.updates)
+	s.Require().Equal(map[RegistryOutcome]uint64{RegistryUpdated: 1}, s.Updater.Outcomes())
+}
+
+func (s *UpdaterTestSuite) TestCheckAndUpdateBothanVerificationFailed() {
+	s.chainHash = cidV0([]byte("missing"))
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Empty(s.updates)
+	s.Require().Equal(map[RegistryOutcome]uint64{RegistryVerificationFailed: 1}, s.Updater.Outcomes())
+
+	blocked, ok := s.Updater.Blocked()
+	s.Require().True(ok)
+	s.Require().Equal(s.chainHash, blocked)
+
+	// the block is lifted once the registries match again
+	s.chainHash = s.oldHash
+	s.Updater.checkAndUpdateBothan()
+	_, ok = s.Updater.Blocked()
+	s.Require().False(ok)
+}
+
+func (s *UpdaterTestSuite) TestCheckAndUpdateBothanWithoutVerifier() {
+	s.Updater.verifier = nil
+
+	s.chainHash = cidV0([]byte("missing"))
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Equal([]registry{{ipfsHash: s.chainHash, version: "v" + s.chainHash}}, s.updates)
+	s.Require().Equal(map[RegistryOutcome]uint64{RegistryUpdated: 1}, s.Updater.Outcomes())
+}
+
+func (s *UpdaterTestSuite) TestStagedRolloutRollback() {
+	s.Updater.rolloutConfig = RolloutConfig{Enabled: true, Observation: time.Hour, MaxSuccessDrop: 0.1}
+	s.Updater.checkAndUpdateBothan()
+
+	// the new registry is staged and under observation
+	s.chainHash = s.newHash
+	s.Updater.checkAndUpdateBothan()
+	s.Require().NotNil(s.Updater.rollout)
+	s.Require().Equal(map[RegistryOutcome]uint64{RegistryUpdated: 1}, s.Updater.Outcomes())
+
+	// the new registry then fails to fetch the price of a signal
+	s.available = 1
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Equal([]registry{
+		{ipfsHash: s.newHash, version: "v" + s.newHash},
+		{ipfsHash: s.oldHash, version: "v" + s.oldHash},
+	}, s.updates)
+	s.Require().Equal(s.oldHash, s.bothanHash)
+	s.Require().Nil(s.Updater.rollout)
+	s.Require().Equal(map[RegistryOutcome]uint64{RegistryUpdated: 1, RegistryRolledBack: 1}, s.Updater.Outcomes())
+
+	ratio, ok := s.Updater.PriceSuccessRatio()
+	s.Require().True(ok)
+	s.Require().Equal(0.5, ratio)
+
+	// the rolled back registry is not updated to again
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Len(s.updates, 2)
+
+	blocked, ok := s.Updater.Blocked()
+	s.Require().True(ok)
+	s.Require().Equal(s.newHash, blocked)
+}
+
+func (s *UpdaterTestSuite) TestStagedRolloutWarmup() {
+	s.Updater.rolloutConfig = RolloutConfig{Enabled: true, Warmup: time.Hour, MaxSuccessDrop: 0.1}
+	s.Updater.checkAndUpdateBothan()
+
+	s.chainHash = s.newHash
+	s.Updater.checkAndUpdateBothan()
+	s.Require().NotNil(s.Updater.rollout)
+
+	// the staged registry is not checked during the warmup
+	s.available = 1
+	s.Updater.checkAndUpdateBothan()
+	s.Require().NotNil(s.Updater.rollout)
+	s.Require().Equal(s.newHash, s.bothanHash)
+
+	// the staged registry is checked on the first update check after the warmup
+	s.Updater.rollout.start = time.Now().Add(-time.Hour)
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Nil(s.Updater.rollout)
+	s.Require().Equal(s.oldHash, s.bothanHash)
+	s.Require().Equal(map[RegistryOutcome]uint64{RegistryUpdated: 1, RegistryRolledBack: 1}, s.Updater.Outcomes())
+}
+
+func (s *UpdaterTestSuite) TestStagedRolloutCommit() {
+	s.Updater.rolloutConfig = RolloutConfig{Enabled: true, MaxSuccessDrop: 0.1}
+	s.Updater.checkAndUpdateBothan()
+
+	s.chainHash = s.newHash
+	s.Updater.checkAndUpdateBothan()
+	s.Require().NotNil(s.Updater.rollout)
+
+	// the staged registry is committed on the next update check
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Equal([]registry{{ipfsHash: s.newHash, version: "v" + s.newHash}}, s.updates)
+	s.Require().Nil(s.Updater.rollout)
+	s.Require().Equal(map[RegistryOutcome]uint64{RegistryUpdated: 1, RegistryCommitted: 1}, s.Updater.Outcomes())
+}
+
+func (s *UpdaterTestSuite) TestStagedRolloutWithoutPrevious() {
+	s.Updater.rolloutConfig = RolloutConfig{Enabled: true, Observation: time.Hour}
+
+	// the registry of Bothan is unknown as it does not match the chain
+	s.bothanHash = cidV0([]byte("unknown"))
+	s.chainHash = s.newHash
+	s.available = 1
+	s.Updater.checkAndUpdateBothan()
+	s.Require().NotNil(s.Updater.rollout)
+
+	s.available = 0
+	s.Updater.checkAndUpdateBothan()
+	s.Require().Equal([]registry{{ipfsHash: s.newHash, version: "v" + s.newHash}}, s.updates)
+	s.Require().Equal(map[RegistryOutcome]uint64{RegistryUpdated: 1, RegistryRollbackFailed: 1}, s.Updater.Outcomes())
+}
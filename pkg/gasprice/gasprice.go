@@ -0,0 +1,207 @@
+package gasprice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier queries the gas price requirements of a BandChain node.
+type Querier interface {
+	// GlobalMinGasPrices returns the minimum gas prices of the globalfee module.
+	GlobalMinGasPrices(ctx context.Context) (sdk.DecCoins, error)
+	// NodeMinGasPrices returns the minimum gas prices configured locally on the node.
+	NodeMinGasPrices(ctx context.Context) (sdk.DecCoins, error)
+}
+
+// Logger is the logger used by Provider to report refreshes of the gas prices.
+type Logger interface {
+	Info(format string, args ...interface{})
+	Error(format string, args ...interface{})
+}
+
+// Provider provides the gas prices to submit transactions with. The configured gas prices are raised
+// to the requirement of the chain, which is refreshed periodically from the given queriers.
+type Provider struct {
+	configured sdk.DecCoins
+	queriers   []Querier
+	timeout    time.Duration
+
+	mu        sync.RWMutex
+	gasPrices sdk.DecCoins
+}
+
+// NewProvider creates a new Provider from the configured gas prices string. Without any querier,
+// the configured gas prices are always used.
+func NewProvider(configured string, queriers ...Querier) (*Provider, error) {
+	gasPrices, err := sdk.ParseDecCoins(configured)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas prices: %w", err)
+	}
+
+	return &Provider{
+		configured: gasPrices,
+		queriers:   queriers,
+		timeout:    10 * time.Second,
+		gasPrices:  gasPrices,
+	}, nil
+}
+
+// GasPrices returns the current gas prices as a string accepted by the transaction factory.
+func (p *Provider) GasPrices() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.gasPrices.String()
+}
+
+// Refresh queries the gas price requirement and updates the gas prices. It returns whether the gas
+// prices changed. The gas prices are kept unchanged if none of the queriers succeeds.
+func (p *Provider) Refresh() (bool, error) {
+	if len(p.queriers) == 0 {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	required, err := queryRequirement(ctx, p.queriers)
+	if err != nil {
+		return false, err
+	}
+	gasPrices := EffectiveGasPrices(p.configured, required)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	changed := !gasPrices.Equal(p.gasPrices)
+	p.gasPrices = gasPrices
+	return changed, nil
+}
+
+// Start refreshes the gas prices once and then keeps refreshing them every interval in the
+// background. It does nothing if the interval is not positive or there is no querier.
+func (p *Provider) Start(interval time.Duration, l Logger) {
+	if interval <= 0 || len(p.queriers) == 0 {
+		return
+	}
+
+	p.refresh(l)
+	go func() {
+		for {
+			time.Sleep(interval)
+			p.refresh(l)
+		}
+	}()
+}
+
+// refresh refreshes the gas prices and logs the outcome.
+func (p *Provider) refresh(l Logger) {
+	changed, err := p.Refresh()
+	if err != nil {
+		l.Error(":cold_sweat: Failed to refresh gas prices: %s", err)
+	} else if changed {
+		l.Info(":fuelpump: Gas prices changed to %s", p.GasPrices())
+	}
+}
+
+// queryRequirement returns the gas prices required by all the nodes of the queriers. The globalfee
+// module is the same on every node, while the local minimum gas prices of the nodes are combined by
+// taking the highest amount of each denom, as a transaction may be broadcast to any of them.
+func queryRequirement(ctx context.Context, queriers []Querier) (sdk.DecCoins, error) {
+	var globalMinGasPrices, nodeMinGasPrices sdk.DecCoins
+	var errs []error
+	succeeded := false
+	for _, q := range queriers {
+		global, err := q.GlobalMinGasPrices(ctx)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		node, err := q.NodeMinGasPrices(ctx)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		globalMinGasPrices = global
+		nodeMinGasPrices = maxDecCoins(nodeMinGasPrices, node)
+		succeeded = true
+	}
+	if !succeeded {
+		return nil, errors.Join(errs...)
+	}
+
+	return CombinedGasPricesRequirement(globalMinGasPrices, nodeMinGasPrices), nil
+}
+
+// CombinedGasPricesRequirement combines the global minimum gas prices and the minimum gas prices of
+// a node into the gas prices accepted by the node. Both arguments must be valid.
+func CombinedGasPricesRequirement(globalMinGasPrices, minGasPrices sdk.DecCoins) sdk.DecCoins {
+	// return globalMinGasPrices if minGasPrices has not been set
+	if minGasPrices.Empty() {
+		return globalMinGasPrices
+	}
+	// return minGasPrices if globalMinGasPrices is empty
+	if globalMinGasPrices.Empty() {
+		return minGasPrices
+	}
+
+	// if min_gas_price denom is in globalfee, and the amount is higher than globalfee, add min_gas_price to allGasPrices
+	var allGasPrices sdk.DecCoins
+	for _, gmgp := range globalMinGasPrices {
+		// min_gas_price denom in global fee
+		mgp := minGasPrices.AmountOf(gmgp.Denom)
+		if mgp.GT(gmgp.Amount) {
+			allGasPrices = append(allGasPrices, sdk.NewDecCoinFromDec(gmgp.Denom, mgp))
+		} else {
+			allGasPrices = append(allGasPrices, sdk.NewDecCoinFromDec(gmgp.Denom, gmgp.Amount))
+		}
+	}
+
+	return allGasPrices.Sort()
+}
+
+// EffectiveGasPrices returns the gas prices to use given the configured and the required ones. The
+// configured denoms are raised to their required amounts. If none of the configured denoms is
+// accepted, the required gas prices are used instead. Zero configured gas prices are kept as is,
+// since they are only meant for transactions whose fees are waived, such as oracle reports.
+func EffectiveGasPrices(configured, required sdk.DecCoins) sdk.DecCoins {
+	if configured.IsZero() || required.IsZero() {
+		return configured
+	}
+
+	var gasPrices sdk.DecCoins
+	accepted := false
+	for _, coin := range configured {
+		amount := coin.Amount
+		if requiredAmount := required.AmountOf(coin.Denom); requiredAmount.IsPositive() {
+			accepted = true
+			if requiredAmount.GT(amount) {
+				amount = requiredAmount
+			}
+		}
+		gasPrices = append(gasPrices, sdk.NewDecCoinFromDec(coin.Denom, amount))
+	}
+	if !accepted {
+		return required
+	}
+
+	return gasPrices.Sort()
+}
+
+// maxDecCoins returns the highest amount of each denom of the given coins.
+func maxDecCoins(a, b sdk.DecCoins) sdk.DecCoins {
+	result := sdk.NewDecCoins(a...)
+	for _, coin := range b {
+		if amount := result.AmountOf(coin.Denom); coin.Amount.GT(amount) {
+			result = result.Add(sdk.NewDecCoinFromDec(coin.Denom, coin.Amount.Sub(amount)))
+		}
+	}
+
+	return result
+}
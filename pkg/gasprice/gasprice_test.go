@@ -0,0 +1,121 @@
+package gasprice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockQuerier struct {
+	global sdk.DecCoins
+	node   sdk.DecCoins
+	err    error
+}
+
+func (q *mockQuerier) GlobalMinGasPrices(ctx context.Context) (sdk.DecCoins, error) {
+	return q.global, q.err
+}
+
+func (q *mockQuerier) NodeMinGasPrices(ctx context.Context) (sdk.DecCoins, error) {
+	return q.node, q.err
+}
+
+func mustParse(t *testing.T, s string) sdk.DecCoins {
+	coins, err := sdk.ParseDecCoins(s)
+	require.NoError(t, err)
+	return coins
+}
+
+func TestCombinedGasPricesRequirement(t *testing.T) {
+	testCases := []struct {
+		name     string
+		global   string
+		node     string
+		expected string
+	}{
+		{"no requirement", "", "", ""},
+		{"global only", "0.0025uband", "", "0.0025uband"},
+		{"node only", "", "0.01uband", "0.01uband"},
+		{"node higher", "0.0025uband", "0.01uband", "0.01uband"},
+		{"node lower", "0.0025uband", "0.001uband", "0.0025uband"},
+		{"node denom not in global", "0.0025uband", "0.01stake", "0.0025uband"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := CombinedGasPricesRequirement(mustParse(t, tc.global), mustParse(t, tc.node))
+			require.Equal(t, mustParse(t, tc.expected).String(), res.String())
+		})
+	}
+}
+
+func TestEffectiveGasPrices(t *testing.T) {
+	testCases := []struct {
+		name       string
+		configured string
+		required   string
+		expected   string
+	}{
+		{"zero configured", "0uband", "0.0025uband", ""},
+		{"no requirement", "0.001uband", "", "0.001uband"},
+		{"configured higher", "0.01uband", "0.0025uband", "0.01uband"},
+		{"configured lower", "0.001uband", "0.0025uband", "0.0025uband"},
+		{"multiple denoms", "0.001uband,0.5stake", "0.0025uband", "0.5stake,0.0025uband"},
+		{"no accepted denom", "0.5stake", "0.0025uband", "0.0025uband"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := EffectiveGasPrices(mustParse(t, tc.configured), mustParse(t, tc.required))
+			require.Equal(t, mustParse(t, tc.expected).String(), res.String())
+		})
+	}
+}
+
+func TestProviderRefresh(t *testing.T) {
+	q1 := &mockQuerier{global: mustParse(t, "0.0025uband"), node: mustParse(t, "0.003uband")}
+	q2 := &mockQuerier{global: mustParse(t, "0.0025uband"), node: mustParse(t, "0.004uband")}
+	p, err := NewProvider("0.001uband", q1, q2)
+	require.NoError(t, err)
+	require.Equal(t, "0.001000000000000000uband", p.GasPrices())
+
+	// The highest requirement of the nodes is used.
+	changed, err := p.Refresh()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, "0.004000000000000000uband", p.GasPrices())
+
+	changed, err = p.Refresh()
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// A failing node is skipped.
+	q2.err = errors.New("connection refused")
+	changed, err = p.Refresh()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, "0.003000000000000000uband", p.GasPrices())
+
+	// The gas prices are kept if no node succeeds.
+	q1.err = errors.New("connection refused")
+	_, err = p.Refresh()
+	require.Error(t, err)
+	require.Equal(t, "0.003000000000000000uband", p.GasPrices())
+}
+
+func TestProviderWithoutQuerier(t *testing.T) {
+	p, err := NewProvider("0.025stake")
+	require.NoError(t, err)
+
+	changed, err := p.Refresh()
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, "0.025000000000000000stake", p.GasPrices())
+
+	_, err = NewProvider("invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package gasprice
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	globalfeetypes "github.com/bandprotocol/chain/v3/x/globalfee/types"
+)
+
+var _ Querier = &GRPCQuerier{}
+
+// GRPCQuerier queries the gas price requirements of a node through its gRPC query services.
+type GRPCQuerier struct {
+	globalfeeClient globalfeetypes.QueryClient
+	nodeClient      node.ServiceClient
+}
+
+// NewGRPCQuerier creates a new GRPCQuerier on the given connection, e.g. a client.Context.
+func NewGRPCQuerier(conn gogogrpc.ClientConn) *GRPCQuerier {
+	return &GRPCQuerier{
+		globalfeeClient: globalfeetypes.NewQueryClient(conn),
+		nodeClient:      node.NewServiceClient(conn),
+	}
+}
+
+// GlobalMinGasPrices implements Querier.
+func (q *GRPCQuerier) GlobalMinGasPrices(ctx context.Context) (sdk.DecCoins, error) {
+	res, err := q.globalfeeClient.Params(ctx, &globalfeetypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Params.MinimumGasPrices.Sort(), nil
+}
+
+// NodeMinGasPrices implements Querier.
+func (q *GRPCQuerier) NodeMinGasPrices(ctx context.Context) (sdk.DecCoins, error) {
+	res, err := q.nodeClient.Config(ctx, &node.ConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ParseDecCoins(res.MinimumGasPrice)
+}
@@ -0,0 +1,241 @@
+package obi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// schemaType is a node of a parsed compact OBI individual schema.
+type schemaType struct {
+	kind   string
+	elem   *schemaType
+	fields []schemaField
+}
+
+// schemaField is a named field of an OBI struct schema.
+type schemaField struct {
+	name string
+	typ  *schemaType
+}
+
+type schemaParser struct {
+	s   string
+	pos int
+}
+
+func (p *schemaParser) skipSpaces() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *schemaParser) expect(c byte) error {
+	p.skipSpaces()
+	if p.pos >= len(p.s) || p.s[p.pos] != c {
+		return fmt.Errorf("obi: expect '%c' at position %d of schema %q", c, p.pos, p.s)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *schemaParser) ident() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] == '_' || unicode.IsLetter(rune(p.s[p.pos])) || unicode.IsDigit(rune(p.s[p.pos]))) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *schemaParser) parseType() (*schemaType, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.s) {
+		return nil, fmt.Errorf("obi: unexpected end of schema %q", p.s)
+	}
+	switch p.s[p.pos] {
+	case '[':
+		p.pos++
+		elem, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(']'); err != nil {
+			return nil, err
+		}
+		return &schemaType{kind: "vector", elem: elem}, nil
+	case '{':
+		p.pos++
+		t := &schemaType{kind: "struct"}
+		for {
+			name := p.ident()
+			if name == "" {
+				return nil, fmt.Errorf("obi: expect field name at position %d of schema %q", p.pos, p.s)
+			}
+			if err := p.expect(':'); err != nil {
+				return nil, err
+			}
+			typ, err := p.parseType()
+			if err != nil {
+				return nil, err
+			}
+			t.fields = append(t.fields, schemaField{name: name, typ: typ})
+			p.skipSpaces()
+			if p.pos < len(p.s) && p.s[p.pos] == ',' {
+				p.pos++
+				continue
+			}
+			if err := p.expect('}'); err != nil {
+				return nil, err
+			}
+			return t, nil
+		}
+	default:
+		kind := p.ident()
+		switch kind {
+		case "u8", "u16", "u32", "u64", "i8", "i16", "i32", "i64", "string", "bytes":
+			return &schemaType{kind: kind}, nil
+		default:
+			return nil, fmt.Errorf("obi: unsupported type %q in schema %q", kind, p.s)
+		}
+	}
+}
+
+// parseSchema parses the given compact OBI individual schema, e.g. "{symbols:[string],px:u64}".
+func parseSchema(schema string) (*schemaType, error) {
+	p := &schemaParser{s: schema}
+	t, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos != len(p.s) {
+		return nil, fmt.Errorf("obi: unexpected trailing characters in schema %q", schema)
+	}
+	return t, nil
+}
+
+func decodeJSONImpl(buf *bytes.Buffer, data []byte, t *schemaType) ([]byte, error) {
+	switch t.kind {
+	case "u8":
+		val, rem, err := DecodeUnsigned8(data)
+		buf.WriteString(strconv.FormatUint(uint64(val), 10))
+		return rem, err
+	case "u16":
+		val, rem, err := DecodeUnsigned16(data)
+		buf.WriteString(strconv.FormatUint(uint64(val), 10))
+		return rem, err
+	case "u32":
+		val, rem, err := DecodeUnsigned32(data)
+		buf.WriteString(strconv.FormatUint(uint64(val), 10))
+		return rem, err
+	case "u64":
+		val, rem, err := DecodeUnsigned64(data)
+		buf.WriteString(strconv.FormatUint(val, 10))
+		return rem, err
+	case "i8":
+		val, rem, err := DecodeSigned8(data)
+		buf.WriteString(strconv.FormatInt(int64(val), 10))
+		return rem, err
+	case "i16":
+		val, rem, err := DecodeSigned16(data)
+		buf.WriteString(strconv.FormatInt(int64(val), 10))
+		return rem, err
+	case "i32":
+		val, rem, err := DecodeSigned32(data)
+		buf.WriteString(strconv.FormatInt(int64(val), 10))
+		return rem, err
+	case "i64":
+		val, rem, err := DecodeSigned64(data)
+		buf.WriteString(strconv.FormatInt(val, 10))
+		return rem, err
+	case "string":
+		val, rem, err := DecodeString(data)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(val)
+		buf.Write(bz)
+		return rem, err
+	case "bytes":
+		val, rem, err := DecodeBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(val)
+		buf.Write(bz)
+		return rem, err
+	case "vector":
+		length, rem, err := DecodeUnsigned32(data)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("[")
+		for idx := 0; idx < int(length); idx++ {
+			if idx != 0 {
+				buf.WriteString(",")
+			}
+			rem, err = decodeJSONImpl(buf, rem, t.elem)
+			if err != nil {
+				return nil, err
+			}
+		}
+		buf.WriteString("]")
+		return rem, nil
+	case "struct":
+		rem := data
+		buf.WriteString("{")
+		for idx, field := range t.fields {
+			if idx != 0 {
+				buf.WriteString(",")
+			}
+			bz, err := json.Marshal(field.name)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(bz)
+			buf.WriteString(":")
+			rem, err = decodeJSONImpl(buf, rem, field.typ)
+			if err != nil {
+				return nil, err
+			}
+		}
+		buf.WriteString("}")
+		return rem, nil
+	default:
+		return nil, fmt.Errorf("obi: unsupported value type: %s", t.kind)
+	}
+}
+
+// DecodeJSON decodes the given OBI encoded data using the given compact OBI individual schema
+// and returns the result as JSON, keeping struct fields in their schema order. Bytes values are
+// encoded as base64 strings.
+func DecodeJSON(schema string, data []byte) ([]byte, error) {
+	t, err := parseSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	rem, err := decodeJSONImpl(buf, data, t)
+	if err != nil {
+		return nil, err
+	}
+	if len(rem) != 0 {
+		return nil, errors.New("obi: not all data was consumed while decoding")
+	}
+	return buf.Bytes(), nil
+}
+
+// SplitSchema splits the full OBI schema of an oracle script, which is in the form of
+// "input/output", into its input and output individual schemas.
+func SplitSchema(schema string) (string, string, error) {
+	parts := strings.Split(schema, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("obi: invalid oracle script schema %q", schema)
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), nil
+}
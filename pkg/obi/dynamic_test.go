@@ -0,0 +1,52 @@
+package obi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	data := ExampleData{
+		Symbol: "BTC",
+		Px:     9000,
+		In: Inner{
+			A: 1,
+			B: 2,
+		},
+		Arr: []int16{10, -11},
+	}
+	actual, err := DecodeJSON(MustGetSchema(data), MustEncode(data))
+	require.NoError(t, err)
+	require.Equal(t, `{"symbol":"BTC","px":9000,"in":{"a":1,"b":2},"arr":[10,-11]}`, string(actual))
+}
+
+func TestDecodeJSONBytesAndString(t *testing.T) {
+	actual, err := DecodeJSON("{name:string, raw:bytes}", MustEncode("a\"b", []byte{0x1, 0x2}))
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"a\"b","raw":"AQI="}`, string(actual))
+}
+
+func TestDecodeJSONFail(t *testing.T) {
+	_, err := DecodeJSON("{px:u64", MustEncode(uint64(1)))
+	require.EqualError(t, err, `obi: expect '}' at position 7 of schema "{px:u64"`)
+
+	_, err = DecodeJSON("{px:bool}", MustEncode(uint64(1)))
+	require.EqualError(t, err, `obi: unsupported type "bool" in schema "{px:bool}"`)
+
+	_, err = DecodeJSON("u64", MustEncode(uint32(1)))
+	require.EqualError(t, err, "obi: out of range")
+
+	_, err = DecodeJSON("u32", MustEncode(uint64(1)))
+	require.EqualError(t, err, "obi: not all data was consumed while decoding")
+}
+
+func TestSplitSchema(t *testing.T) {
+	input, output, err := SplitSchema("{symbols:[string]}/{rates:[u64]}")
+	require.NoError(t, err)
+	require.Equal(t, "{symbols:[string]}", input)
+	require.Equal(t, "{rates:[u64]}", output)
+
+	_, _, err = SplitSchema("{symbols:[string]}")
+	require.Error(t, err)
+}
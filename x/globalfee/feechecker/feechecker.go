@@ -146,6 +146,10 @@ func (fc FeeChecker) IsBypassMinFeeMsg(ctx sdk.Context, msg sdk.Msg) bool {
 		if err := checkValidMsgReport(ctx, fc.OracleKeeper, msg); err != nil {
 			return false
 		}
+	case *oracletypes.MsgCommitReport:
+		if err := checkValidMsgCommitReport(ctx, fc.OracleKeeper, msg); err != nil {
+			return false
+		}
 	case *feedstypes.MsgSubmitSignalPrices:
 		if _, err := fc.FeedsMsgServer.SubmitSignalPrices(ctx, msg); err != nil {
 			return false
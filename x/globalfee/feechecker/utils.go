@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/bandprotocol/chain/v3/pkg/gasprice"
 	oraclekeeper "github.com/bandprotocol/chain/v3/x/oracle/keeper"
 	oracletypes "github.com/bandprotocol/chain/v3/x/oracle/types"
 )
@@ -34,35 +35,36 @@ func getMinGasPrices(ctx sdk.Context) sdk.DecCoins {
 
 // CombinedGasPricesRequirement will combine the global min_gas_prices and min_gas_prices. Both globalMinGasPrices and minGasPrices must be valid
 func CombinedGasPricesRequirement(globalMinGasPrices, minGasPrices sdk.DecCoins) sdk.DecCoins {
-	// return globalMinGasPrices if minGasPrices has not been set
-	if minGasPrices.Empty() {
-		return globalMinGasPrices
-	}
-	// return minGasPrices if globalMinGasPrices is empty
-	if globalMinGasPrices.Empty() {
-		return minGasPrices
+	return gasprice.CombinedGasPricesRequirement(globalMinGasPrices, minGasPrices)
+}
+
+func checkValidMsgReport(ctx sdk.Context, oracleKeeper *oraclekeeper.Keeper, msg *oracletypes.MsgReportData) error {
+	validator, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return err
 	}
 
-	// if min_gas_price denom is in globalfee, and the amount is higher than globalfee, add min_gas_price to allGasPrices
-	var allGasPrices sdk.DecCoins
-	for _, gmgp := range globalMinGasPrices {
-		// min_gas_price denom in global fee
-		mgp := minGasPrices.AmountOf(gmgp.Denom)
-		if mgp.GT(gmgp.Amount) {
-			allGasPrices = append(allGasPrices, sdk.NewDecCoinFromDec(gmgp.Denom, mgp))
-		} else {
-			allGasPrices = append(allGasPrices, sdk.NewDecCoinFromDec(gmgp.Denom, gmgp.Amount))
-		}
+	if err := oracleKeeper.CheckValidReport(ctx, msg.RequestID, validator, msg.RawReports); err != nil {
+		return err
 	}
 
-	return allGasPrices.Sort()
+	return oracleKeeper.CheckValidReveal(ctx, msg.RequestID, validator, msg.RawReports, msg.Salt)
 }
 
-func checkValidMsgReport(ctx sdk.Context, oracleKeeper *oraclekeeper.Keeper, msg *oracletypes.MsgReportData) error {
+func checkValidMsgCommitReport(
+	ctx sdk.Context,
+	oracleKeeper *oraclekeeper.Keeper,
+	msg *oracletypes.MsgCommitReport,
+) error {
 	validator, err := sdk.ValAddressFromBech32(msg.Validator)
 	if err != nil {
 		return err
 	}
 
-	return oracleKeeper.CheckValidReport(ctx, msg.RequestID, validator, msg.RawReports)
+	if msg.RequestID <= oracleKeeper.GetRequestLastExpired(ctx) {
+		return oracletypes.ErrRequestAlreadyExpired
+	}
+
+	// The given context is a cache context, so adding the commit here does not affect the state.
+	return oracleKeeper.AddReportCommit(ctx, msg.RequestID, validator, msg.Hash)
 }
@@ -91,6 +91,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "signature"},
 					},
 				},
+				{
+					RpcMethod:      "TipPercentiles",
+					Use:            "tip-percentiles [denom]",
+					Short:          "Get percentiles of tips paid by the most recent requests",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "SimulateRequest",
+					Use:       "simulate-request [oracle-script-id] [calldata] [ask-count] [min-count] [prepare-gas] [execute-gas]",
+					Short:     "Simulate a request of an oracle script, optionally executing it with mock external data",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "oracle_script_id"},
+						{ProtoField: "calldata"},
+						{ProtoField: "ask_count"},
+						{ProtoField: "min_count"},
+						{ProtoField: "prepare_gas"},
+						{ProtoField: "execute_gas"},
+					},
+				},
+				{
+					RpcMethod:      "ReportCommits",
+					Use:            "report-commits [request-id]",
+					Short:          "Get report commits of a commit-reveal request and whether its reveal phase has started",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "request_id"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
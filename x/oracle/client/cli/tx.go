@@ -33,9 +33,15 @@ const (
 	flagExecuteGas    = "execute-gas"
 	flagTSSEncoder    = "tss-encoder"
 	flagFeeLimit      = "fee-limit"
+	flagTip           = "tip"
+	flagCommitReveal  = "commit-reveal"
 	flagFee           = "fee"
 	flagTreasury      = "treasury"
 	flagExpiration    = "expiration"
+
+	flagAllowedRequesters = "allowed-requesters"
+	flagAllowedChannels   = "allowed-channels"
+	flagMinFeeLimit       = "min-fee-limit"
 )
 
 // NewTxCmd returns the transaction commands for this module
@@ -53,6 +59,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdEditDataSource(),
 		GetCmdCreateOracleScript(),
 		GetCmdEditOracleScript(),
+		GetCmdUpdateDataSourceAccessPolicy(),
+		GetCmdUpdateOracleScriptAccessPolicy(),
 		GetCmdActivate(),
 		GetCmdAddReporters(),
 		GetCmdRemoveReporters(),
@@ -64,7 +72,7 @@ func NewTxCmd() *cobra.Command {
 // GetCmdRequest implements the request command handler.
 func GetCmdRequest() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit])",
+		Use:   "request [oracle-script-id] [ask-count] [min-count] (-c [calldata]) (-m [client-id]) (--prepare-gas=[prepare-gas] (--execute-gas=[execute-gas])) (--fee-limit=[fee-limit]) (--tip=[tip]) (--commit-reveal)",
 		Short: "Make a new data request via an existing oracle script",
 		Args:  cobra.ExactArgs(3),
 		Long: strings.TrimSpace(
@@ -72,8 +80,10 @@ func GetCmdRequest() *cobra.Command {
 Example:
 $ %s tx oracle request 1 4 3 -c 1234abcdef -x 20 -m client-id --from mykey
 $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-limit 10uband --from mykey
+$ %s tx oracle request 1 4 3 --calldata 1234abcdef --fee-limit 10uband --tip 100uband --from mykey
+$ %s tx oracle request 1 4 3 --calldata 1234abcdef --fee-limit 10uband --commit-reveal --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -133,6 +143,21 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				return err
 			}
 
+			tipStr, err := cmd.Flags().GetString(flagTip)
+			if err != nil {
+				return err
+			}
+
+			tip, err := sdk.ParseCoinsNormalized(tipStr)
+			if err != nil {
+				return err
+			}
+
+			commitReveal, err := cmd.Flags().GetBool(flagCommitReveal)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRequestData(
 				oracleScriptID,
 				calldata,
@@ -145,6 +170,8 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 				clientCtx.GetFromAddress(),
 				types.Encoder(tssEncoder),
 			)
+			msg.Tip = tip
+			msg.CommitReveal = commitReveal
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -162,6 +189,10 @@ $ %s tx oracle request 1 4 3 --calldata 1234abcdef --client-id cliend-id --fee-l
 		String(flagFeeLimit, "", "The maximum tokens paid to all data source and TSS signature providers, if any")
 	cmd.Flags().
 		Int32(flagTSSEncoder, 0, "The encode type of oracle result that will be sent to TSS (1=proto, 2=ABI, 3=Partial ABI)")
+	cmd.Flags().
+		String(flagTip, "", "The optional tokens split among validators who report before the request is resolved")
+	cmd.Flags().
+		Bool(flagCommitReveal, false, "Require validators to commit to their reports before revealing them")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -550,6 +581,146 @@ $ %s tx oracle edit-oracle-script 1 --name eth-price --description "Oracle scrip
 	return cmd
 }
 
+// GetCmdUpdateDataSourceAccessPolicy implements the update data source access policy command handler.
+func GetCmdUpdateDataSourceAccessPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-data-source-access-policy [id] (--allowed-requesters [requester1,requester2,...]) (--allowed-channels [port1/channel1,port2/channel2,...]) (--min-fee-limit [coins])",
+		Short: "Update the access policy of an existing data source. Omitted flags are cleared.",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the access policy of an existing data source. Omitted flags are cleared.
+Example:
+$ %s tx oracle update-data-source-access-policy 1 --allowed-requesters band15d4apf20449ajvwycq8ruaypt7v6d345n9fpt9 --allowed-channels oracle/channel-0 --min-fee-limit 100uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			accessPolicy, err := parseAccessPolicyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateDataSourceAccessPolicy(
+				types.DataSourceID(id),
+				accessPolicy,
+				clientCtx.GetFromAddress(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addAccessPolicyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdateOracleScriptAccessPolicy implements the update oracle script access policy command handler.
+func GetCmdUpdateOracleScriptAccessPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-oracle-script-access-policy [id] (--allowed-requesters [requester1,requester2,...]) (--allowed-channels [port1/channel1,port2/channel2,...]) (--min-fee-limit [coins])",
+		Short: "Update the access policy of an existing oracle script. Omitted flags are cleared.",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the access policy of an existing oracle script. Omitted flags are cleared.
+Example:
+$ %s tx oracle update-oracle-script-access-policy 1 --allowed-requesters band15d4apf20449ajvwycq8ruaypt7v6d345n9fpt9 --allowed-channels oracle/channel-0 --min-fee-limit 100uband --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			accessPolicy, err := parseAccessPolicyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateOracleScriptAccessPolicy(
+				types.OracleScriptID(id),
+				accessPolicy,
+				clientCtx.GetFromAddress(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addAccessPolicyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addAccessPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagAllowedRequesters, nil, "Addresses that are allowed to send requests directly")
+	cmd.Flags().
+		StringSlice(flagAllowedChannels, nil, "IBC channels in port/channel format that are allowed to send requests")
+	cmd.Flags().String(flagMinFeeLimit, "", "Minimum fee limit that requests must be willing to pay")
+}
+
+func parseAccessPolicyFlags(cmd *cobra.Command) (types.AccessPolicy, error) {
+	requesterStrs, err := cmd.Flags().GetStringSlice(flagAllowedRequesters)
+	if err != nil {
+		return types.AccessPolicy{}, err
+	}
+	requesters := make([]sdk.AccAddress, 0, len(requesterStrs))
+	for _, requesterStr := range requesterStrs {
+		requester, err := sdk.AccAddressFromBech32(requesterStr)
+		if err != nil {
+			return types.AccessPolicy{}, err
+		}
+		requesters = append(requesters, requester)
+	}
+
+	channelStrs, err := cmd.Flags().GetStringSlice(flagAllowedChannels)
+	if err != nil {
+		return types.AccessPolicy{}, err
+	}
+	channels := make([]types.IBCChannel, 0, len(channelStrs))
+	for _, channelStr := range channelStrs {
+		portID, channelID, found := strings.Cut(channelStr, "/")
+		if !found {
+			return types.AccessPolicy{}, fmt.Errorf("invalid channel %q, expected port/channel", channelStr)
+		}
+		channels = append(channels, types.NewIBCChannel(portID, channelID))
+	}
+
+	coinStr, err := cmd.Flags().GetString(flagMinFeeLimit)
+	if err != nil {
+		return types.AccessPolicy{}, err
+	}
+	minFeeLimit, err := sdk.ParseCoinsNormalized(coinStr)
+	if err != nil {
+		return types.AccessPolicy{}, err
+	}
+
+	return types.NewAccessPolicy(requesters, channels, minFeeLimit), nil
+}
+
 // GetCmdActivate implements the activate command handler.
 func GetCmdActivate() *cobra.Command {
 	cmd := &cobra.Command{
@@ -611,23 +782,25 @@ $ %s tx oracle add-reporters band1p40yh3zkmhcv0ecqp3mcazy83sa57rgjp07dun band1m5
 			}
 
 			validator := clientCtx.GetFromAddress()
-			msgs := make([]sdk.Msg, len(args))
-			for i, raw := range args {
+			msgs := make([]sdk.Msg, 0, len(args)*len(types.ReporterMsgTypeURLs()))
+			for _, raw := range args {
 				reporter, err := sdk.AccAddressFromBech32(raw)
 				if err != nil {
 					return err
 				}
 				expUnix := time.Unix(exp, 0)
-				msg, err := authz.NewMsgGrant(
-					validator,
-					reporter,
-					authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgReportData{})),
-					&expUnix,
-				)
-				if err != nil {
-					return err
+				for _, msgType := range types.ReporterMsgTypeURLs() {
+					msg, err := authz.NewMsgGrant(
+						validator,
+						reporter,
+						authz.NewGenericAuthorization(msgType),
+						&expUnix,
+					)
+					if err != nil {
+						return err
+					}
+					msgs = append(msgs, msg)
 				}
-				msgs[i] = msg
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
 		},
@@ -659,18 +832,20 @@ $ %s tx oracle remove-reporters band1p40yh3zkmhcv0ecqp3mcazy83sa57rgjp07dun band
 			}
 
 			validator := clientCtx.GetFromAddress()
-			msgs := make([]sdk.Msg, len(args))
-			for i, raw := range args {
+			msgs := make([]sdk.Msg, 0, len(args)*len(types.ReporterMsgTypeURLs()))
+			for _, raw := range args {
 				reporter, err := sdk.AccAddressFromBech32(raw)
 				if err != nil {
 					return err
 				}
-				msg := authz.NewMsgRevoke(
-					validator,
-					reporter,
-					sdk.MsgTypeURL(&types.MsgReportData{}),
-				)
-				msgs[i] = &msg
+				for _, msgType := range types.ReporterMsgTypeURLs() {
+					msg := authz.NewMsgRevoke(
+						validator,
+						reporter,
+						msgType,
+					)
+					msgs = append(msgs, &msg)
+				}
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
 		},
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -60,6 +61,33 @@ func (k Keeper) MustEditDataSource(ctx sdk.Context, id types.DataSourceID, new t
 	k.SetDataSource(ctx, id, dataSource)
 }
 
+// CheckDataSourceAccessPolicies checks that the access policies of all data sources used by the
+// given raw requests allow a request from the given requester or IBC channel with the given fee limit.
+func (k Keeper) CheckDataSourceAccessPolicies(
+	ctx sdk.Context,
+	rawRequests []types.RawRequest,
+	requester sdk.AccAddress,
+	ibcChannel *types.IBCChannel,
+	feeLimit sdk.Coins,
+) error {
+	checked := make(map[types.DataSourceID]bool)
+	for _, rawReq := range rawRequests {
+		if checked[rawReq.DataSourceID] {
+			continue
+		}
+		checked[rawReq.DataSourceID] = true
+
+		dataSource, err := k.GetDataSource(ctx, rawReq.DataSourceID)
+		if err != nil {
+			return err
+		}
+		if err := dataSource.AccessPolicy.CheckRequest(requester, ibcChannel, feeLimit); err != nil {
+			return errorsmod.Wrapf(err, "data source id: %d", rawReq.DataSourceID)
+		}
+	}
+	return nil
+}
+
 // GetAllDataSources returns the list of all data sources in the store, or nil if there is none.
 func (k Keeper) GetAllDataSources(ctx sdk.Context) (dataSources []types.DataSource) {
 	store := ctx.KVStore(k.storeKey)
@@ -484,3 +484,68 @@ func (k Querier) RequestVerification(
 		IsDelay:      false,
 	}, nil
 }
+
+// TipPercentiles queries the percentiles of tips paid by the most recent unexpired requests.
+func (k Querier) TipPercentiles(
+	c context.Context,
+	req *types.QueryTipPercentilesRequest,
+) (*types.QueryTipPercentilesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultTipSampleLimit
+	}
+	if limit > MaxTipSampleLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit %d exceeds maximum %d", limit, MaxTipSampleLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	percentiles, sampleCount := k.GetTipPercentiles(ctx, req.Denom, limit)
+	return &types.QueryTipPercentilesResponse{
+		SampleCount: sampleCount,
+		Percentiles: percentiles,
+	}, nil
+}
+
+// SimulateRequest simulates a request of an oracle script without creating it.
+func (k Querier) SimulateRequest(
+	c context.Context,
+	req *types.QuerySimulateRequestRequest,
+) (*types.QuerySimulateRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Use a cache context so that nothing from the simulation is persisted.
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+	return k.SimulateRequest(ctx, req)
+}
+
+// ReportCommits queries the report commits of a request in commit-reveal mode.
+func (k Querier) ReportCommits(
+	c context.Context,
+	req *types.QueryReportCommitsRequest,
+) (*types.QueryReportCommitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	rid := types.RequestID(req.RequestId)
+	r, err := k.GetRequest(ctx, rid)
+	if err != nil {
+		return nil, err
+	}
+	if !r.CommitReveal {
+		return nil, types.ErrCommitRevealNotEnabled.Wrapf("reqID: %d", rid)
+	}
+	return &types.QueryReportCommitsResponse{
+		Commits:       k.GetReportCommits(ctx, rid),
+		RevealStarted: k.IsRevealStarted(ctx, rid, r),
+	}, nil
+}
@@ -223,12 +223,22 @@ func (k Keeper) IsReporter(ctx sdk.Context, validator sdk.ValAddress, reporter s
 // GrantReporter grants the reporter to validator for testing
 func (k Keeper) GrantReporter(ctx sdk.Context, validator sdk.ValAddress, reporter sdk.AccAddress) error {
 	expiration := ctx.BlockTime().Add(10 * time.Minute)
-	return k.authzKeeper.SaveGrant(ctx, reporter, sdk.AccAddress(validator),
-		authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgReportData{})), &expiration,
-	)
+	for _, msgType := range types.ReporterMsgTypeURLs() {
+		if err := k.authzKeeper.SaveGrant(ctx, reporter, sdk.AccAddress(validator),
+			authz.NewGenericAuthorization(msgType), &expiration,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // RevokeReporter revokes grant from the reporter for testing
 func (k Keeper) RevokeReporter(ctx sdk.Context, validator sdk.ValAddress, reporter sdk.AccAddress) error {
-	return k.authzKeeper.DeleteGrant(ctx, reporter, sdk.AccAddress(validator), sdk.MsgTypeURL(&types.MsgReportData{}))
+	for _, msgType := range types.ReporterMsgTypeURLs() {
+		if err := k.authzKeeper.DeleteGrant(ctx, reporter, sdk.AccAddress(validator), msgType); err != nil {
+			return err
+		}
+	}
+	return nil
 }
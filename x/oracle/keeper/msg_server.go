@@ -34,10 +34,18 @@ func (k msgServer) RequestData(
 		return nil, err
 	}
 
-	_, err = k.PrepareRequest(ctx, msg, payer, nil)
+	id, err := k.PrepareRequest(ctx, msg, payer, nil)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := k.CollectTip(ctx, id, payer, msg.Tip); err != nil {
+		return nil, err
+	}
+
+	if msg.CommitReveal {
+		k.EnableCommitReveal(ctx, id)
+	}
 	return &types.MsgRequestDataResponse{}, nil
 }
 
@@ -61,6 +69,11 @@ func (k msgServer) ReportData(goCtx context.Context, msg *types.MsgReportData) (
 		return nil, types.ErrRequestAlreadyExpired
 	}
 
+	// Reports to requests in commit-reveal mode must match their commits.
+	if err := k.CheckValidReveal(ctx, msg.RequestID, validator, msg.RawReports, msg.Salt); err != nil {
+		return nil, err
+	}
+
 	reportInTime := !k.HasResult(ctx, msg.RequestID)
 	err = k.AddReport(ctx, msg.RequestID, validator, reportInTime, msg.RawReports)
 	if err != nil {
@@ -85,6 +98,37 @@ func (k msgServer) ReportData(goCtx context.Context, msg *types.MsgReportData) (
 	return &types.MsgReportDataResponse{}, nil
 }
 
+func (k msgServer) CommitReport(
+	goCtx context.Context,
+	msg *types.MsgCommitReport,
+) (*types.MsgCommitReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// check request must not expire.
+	if msg.RequestID <= k.GetRequestLastExpired(ctx) {
+		return nil, types.ErrRequestAlreadyExpired
+	}
+
+	if err := k.AddReportCommit(ctx, msg.RequestID, validator, msg.Hash); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCommitReport,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", msg.RequestID)),
+		sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+	))
+
+	// No event marks the start of the reveal phase, as it mostly starts when the commit phase
+	// ends rather than on a message. Reporters query the report commits of the request instead.
+	return &types.MsgCommitReportResponse{}, nil
+}
+
 func (k msgServer) CreateDataSource(
 	goCtx context.Context,
 	msg *types.MsgCreateDataSource,
@@ -272,6 +316,60 @@ func (k msgServer) EditOracleScript(
 	return &types.MsgEditOracleScriptResponse{}, nil
 }
 
+func (k msgServer) UpdateDataSourceAccessPolicy(
+	goCtx context.Context,
+	msg *types.MsgUpdateDataSourceAccessPolicy,
+) (*types.MsgUpdateDataSourceAccessPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	dataSource, err := k.GetDataSource(ctx, msg.DataSourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender must be the owner of data source
+	if dataSource.Owner != msg.Sender {
+		return nil, types.ErrEditorNotAuthorized
+	}
+
+	dataSource.AccessPolicy = msg.AccessPolicy
+	k.SetDataSource(ctx, msg.DataSourceID, dataSource)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateAccessPolicy,
+		sdk.NewAttribute(types.AttributeKeyDataSourceID, fmt.Sprintf("%d", msg.DataSourceID)),
+	))
+
+	return &types.MsgUpdateDataSourceAccessPolicyResponse{}, nil
+}
+
+func (k msgServer) UpdateOracleScriptAccessPolicy(
+	goCtx context.Context,
+	msg *types.MsgUpdateOracleScriptAccessPolicy,
+) (*types.MsgUpdateOracleScriptAccessPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	oracleScript, err := k.GetOracleScript(ctx, msg.OracleScriptID)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender must be the owner of oracle script
+	if oracleScript.Owner != msg.Sender {
+		return nil, types.ErrEditorNotAuthorized
+	}
+
+	oracleScript.AccessPolicy = msg.AccessPolicy
+	k.SetOracleScript(ctx, msg.OracleScriptID, oracleScript)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateAccessPolicy,
+		sdk.NewAttribute(types.AttributeKeyOracleScriptID, fmt.Sprintf("%d", msg.OracleScriptID)),
+	))
+
+	return &types.MsgUpdateOracleScriptAccessPolicyResponse{}, nil
+}
+
 func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivate) (*types.MsgActivateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -125,6 +125,9 @@ func (k Keeper) PrepareRequest(
 	if err != nil {
 		return 0, err
 	}
+	if err := script.AccessPolicy.CheckRequest(feePayer, ibcChannel, r.GetFeeLimit()); err != nil {
+		return 0, err
+	}
 
 	// Consume fee and execute owasm code
 	ctx.GasMeter().ConsumeGas(params.BaseOwasmGas, "BASE_OWASM_FEE")
@@ -140,6 +143,9 @@ func (k Keeper) PrepareRequest(
 	if len(req.RawRequests) == 0 {
 		return 0, types.ErrEmptyRawRequests
 	}
+	if err := k.CheckDataSourceAccessPolicies(ctx, req.RawRequests, feePayer, ibcChannel, r.GetFeeLimit()); err != nil {
+		return 0, err
+	}
 	// Collect ds fee
 	totalFees, err := k.CollectFee(ctx, feePayer, req.FeeLimit, askCount, req.RawRequests)
 	if err != nil {
@@ -1410,3 +1410,95 @@ func (suite *KeeperTestSuite) TestCollectFeeWithWithManyUnitSuccess() {
 			Equal(coins),
 	)
 }
+
+func (suite *KeeperTestSuite) TestPrepareRequestOracleScriptAccessPolicy() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	suite.mockSimulateRollingSeed()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	script := k.MustGetOracleScript(ctx, 1)
+	script.AccessPolicy = types.NewAccessPolicy(
+		[]sdk.AccAddress{alice},
+		[]types.IBCChannel{types.NewIBCChannel("oracle", "channel-0")},
+		coins100000000uband,
+	)
+	k.SetOracleScript(ctx, 1, script)
+
+	msg := types.NewMsgRequestData(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		coins100000000uband,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		bob,
+		0,
+	)
+
+	// Bob is not in the allowed requesters.
+	_, err := k.PrepareRequest(ctx, msg, bob, nil)
+	require.ErrorIs(err, types.ErrRequesterNotAllowed)
+
+	// Only allowed channels can request through IBC, even if the relayer is an allowed requester.
+	channel := types.NewIBCChannel("oracle", "channel-1")
+	_, err = k.PrepareRequest(ctx, msg, alice, &channel)
+	require.ErrorIs(err, types.ErrIBCChannelNotAllowed)
+
+	// The fee limit must not be lower than the minimum.
+	msg.FeeLimit = coins1000000uband
+	_, err = k.PrepareRequest(ctx, msg, alice, nil)
+	require.ErrorIs(err, types.ErrFeeLimitTooLow)
+
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, coins1000000uband).Times(3)
+	msg.FeeLimit = coins100000000uband
+	id, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.NoError(err)
+	require.Equal(types.RequestID(1), id)
+}
+
+func (suite *KeeperTestSuite) TestPrepareRequestDataSourceAccessPolicy() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	suite.mockSimulateRollingSeed()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	dataSource := k.MustGetDataSource(ctx, 2)
+	dataSource.AccessPolicy = types.NewAccessPolicy(
+		nil,
+		[]types.IBCChannel{types.NewIBCChannel("oracle", "channel-0")},
+		nil,
+	)
+	k.SetDataSource(ctx, 2, dataSource)
+
+	msg := types.NewMsgRequestData(
+		1,
+		basicCalldata,
+		1,
+		1,
+		basicClientID,
+		coins100000000uband,
+		testDefaultPrepareGas,
+		testDefaultExecuteGas,
+		alice,
+		0,
+	)
+
+	// DS#2 only allows requests through IBC channel-0.
+	_, err := k.PrepareRequest(ctx, msg, alice, nil)
+	require.ErrorIs(err, types.ErrRequesterNotAllowed)
+
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), alice, treasury, coins1000000uband).Times(3)
+	channel := types.NewIBCChannel("oracle", "channel-0")
+	id, err := k.PrepareRequest(ctx, msg, alice, &channel)
+	require.NoError(err)
+	require.Equal(types.RequestID(1), id)
+}
@@ -23,6 +23,7 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		OracleRewardPercentage:  50,
 		InactivePenaltyDuration: 1000,
 		IBCRequestEnabled:       true,
+		CommitRevealBlockCount:  10,
 	}
 	err := k.SetParams(ctx, expectedParams)
 	require.NoError(err)
@@ -40,6 +41,7 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		OracleRewardPercentage:  80,
 		InactivePenaltyDuration: 10000,
 		IBCRequestEnabled:       false,
+		CommitRevealBlockCount:  10,
 	}
 	err = k.SetParams(ctx, expectedParams)
 	require.NoError(err)
@@ -57,6 +59,7 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		OracleRewardPercentage:  0,
 		InactivePenaltyDuration: 0,
 		IBCRequestEnabled:       false,
+		CommitRevealBlockCount:  10,
 	}
 	err = k.SetParams(ctx, expectedParams)
 	require.NoError(err)
@@ -74,7 +77,20 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 		OracleRewardPercentage:  80,
 		InactivePenaltyDuration: 10000,
 		IBCRequestEnabled:       false,
+		CommitRevealBlockCount:  10,
 	}
 	err = k.SetParams(ctx, expectedParams)
 	require.EqualError(fmt.Errorf("max raw request count must be positive: 0"), err.Error())
+
+	expectedParams = types.DefaultParams()
+	expectedParams.CommitRevealBlockCount = 0
+	err = k.SetParams(ctx, expectedParams)
+	require.EqualError(fmt.Errorf("commit reveal block count must be positive: 0"), err.Error())
+
+	expectedParams.CommitRevealBlockCount = expectedParams.ExpirationBlockCount
+	err = k.SetParams(ctx, expectedParams)
+	require.EqualError(
+		fmt.Errorf("commit reveal block count must be less than expiration block count: 100 >= 100"),
+		err.Error(),
+	)
 }
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"bytes"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// HasReportCommit checks if the report commit of this request and validator exists in the storage.
+func (k Keeper) HasReportCommit(ctx sdk.Context, rid types.RequestID, val sdk.ValAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ReportCommitOfValidatorKey(rid, val))
+}
+
+// GetReportCommit returns the report commit of the given request and validator.
+func (k Keeper) GetReportCommit(ctx sdk.Context, rid types.RequestID, val sdk.ValAddress) (types.ReportCommit, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReportCommitOfValidatorKey(rid, val))
+	if bz == nil {
+		return types.ReportCommit{}, types.ErrReportCommitNotFound.Wrapf("reqID: %d, val: %s", rid, val.String())
+	}
+	var commit types.ReportCommit
+	k.cdc.MustUnmarshal(bz, &commit)
+	return commit, nil
+}
+
+// SetReportCommit saves the report commit to the storage without performing validation.
+func (k Keeper) SetReportCommit(ctx sdk.Context, rid types.RequestID, commit types.ReportCommit) {
+	val, _ := sdk.ValAddressFromBech32(commit.Validator)
+	ctx.KVStore(k.storeKey).Set(types.ReportCommitOfValidatorKey(rid, val), k.cdc.MustMarshal(&commit))
+}
+
+// EnableCommitReveal puts the given request in commit-reveal mode, so validators must commit
+// to their reports before revealing them.
+func (k Keeper) EnableCommitReveal(ctx sdk.Context, id types.RequestID) {
+	req := k.MustGetRequest(ctx, id)
+	req.CommitReveal = true
+	k.SetRequest(ctx, id, req)
+}
+
+// AddReportCommit performs sanity checks and adds a new report commit from one validator to one
+// request in commit-reveal mode.
+func (k Keeper) AddReportCommit(ctx sdk.Context, rid types.RequestID, val sdk.ValAddress, hash []byte) error {
+	req, err := k.GetRequest(ctx, rid)
+	if err != nil {
+		return err
+	}
+	if !req.CommitReveal {
+		return types.ErrCommitRevealNotEnabled.Wrapf("reqID: %d", rid)
+	}
+	found := false
+	for _, reqVal := range req.RequestedValidators {
+		if reqVal == val.String() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return types.ErrValidatorNotRequested.Wrapf("reqID: %d, val: %s", rid, val.String())
+	}
+	if k.HasReportCommit(ctx, rid, val) {
+		return types.ErrValidatorAlreadyCommitted.Wrapf("reqID: %d, val: %s", rid, val.String())
+	}
+	if k.IsRevealStarted(ctx, rid, req) {
+		return types.ErrRevealAlreadyStarted.Wrapf("reqID: %d", rid)
+	}
+	k.SetReportCommit(ctx, rid, types.NewReportCommit(val, hash))
+	return nil
+}
+
+// CheckValidReveal checks that the given raw reports match the commit of the validator if the
+// request is in commit-reveal mode, and that its reveal phase has started.
+func (k Keeper) CheckValidReveal(
+	ctx sdk.Context,
+	rid types.RequestID,
+	val sdk.ValAddress,
+	rawReports []types.RawReport,
+	salt []byte,
+) error {
+	req, err := k.GetRequest(ctx, rid)
+	if err != nil {
+		return err
+	}
+	if !req.CommitReveal {
+		return nil
+	}
+	commit, err := k.GetReportCommit(ctx, rid, val)
+	if err != nil {
+		return err
+	}
+	if !k.IsRevealStarted(ctx, rid, req) {
+		return types.ErrRevealNotStarted.Wrapf("reqID: %d", rid)
+	}
+	if !bytes.Equal(commit.Hash, types.ReportCommitHash(rid, val, rawReports, salt)) {
+		return types.ErrReportCommitMismatch.Wrapf("reqID: %d, val: %s", rid, val.String())
+	}
+	return nil
+}
+
+// IsRevealStarted returns whether validators can reveal their reports to the given request. The
+// commit phase lasts CommitRevealBlockCount blocks after the request, so that every requested
+// validator has the chance to commit, unless all of them have committed earlier.
+func (k Keeper) IsRevealStarted(ctx sdk.Context, rid types.RequestID, req types.Request) bool {
+	if k.GetReportCommitCount(ctx, rid) >= uint64(len(req.RequestedValidators)) {
+		return true
+	}
+	blockCount := k.GetParams(ctx).CommitRevealBlockCount
+	return ctx.BlockHeight() >= req.RequestHeight+int64(blockCount)
+}
+
+// GetReportCommitIterator returns the iterator for all report commits of the given request ID.
+func (k Keeper) GetReportCommitIterator(ctx sdk.Context, rid types.RequestID) dbm.Iterator {
+	return storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReportCommitStoreKey(rid))
+}
+
+// GetReportCommitCount returns the number of report commits for the given request ID.
+func (k Keeper) GetReportCommitCount(ctx sdk.Context, rid types.RequestID) (count uint64) {
+	iterator := k.GetReportCommitIterator(ctx, rid)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// GetReportCommits returns all report commits for the given request ID, or nil if there is none.
+func (k Keeper) GetReportCommits(ctx sdk.Context, rid types.RequestID) (commits []types.ReportCommit) {
+	iterator := k.GetReportCommitIterator(ctx, rid)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commit types.ReportCommit
+		k.cdc.MustUnmarshal(iterator.Value(), &commit)
+		commits = append(commits, commit)
+	}
+	return commits
+}
+
+// DeleteReportCommits removes all report commits for the given request ID.
+func (k Keeper) DeleteReportCommits(ctx sdk.Context, rid types.RequestID) {
+	var keys [][]byte
+	iterator := k.GetReportCommitIterator(ctx, rid)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		ctx.KVStore(k.storeKey).Delete(key)
+	}
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var (
+	commitRawReports = []types.RawReport{
+		types.NewRawReport(1, 0, []byte("data1/1")),
+		types.NewRawReport(2, 1, []byte("data2/1")),
+		types.NewRawReport(3, 0, []byte("data3/1")),
+	}
+	commitSalt = []byte("SALT")
+)
+
+func commitRevealRequest() types.Request {
+	req := defaultRequest()
+	req.CommitReveal = true
+	return req
+}
+
+func (suite *KeeperTestSuite) TestAddReportCommitSuccess() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 1, commitRevealRequest())
+	hash := types.ReportCommitHash(1, validators[0].Address, commitRawReports, commitSalt)
+	err := k.AddReportCommit(ctx, 1, validators[0].Address, hash)
+	require.NoError(err)
+	require.True(k.HasReportCommit(ctx, 1, validators[0].Address))
+	require.Equal(
+		[]types.ReportCommit{types.NewReportCommit(validators[0].Address, hash)},
+		k.GetReportCommits(ctx, 1),
+	)
+	require.Equal(uint64(1), k.GetReportCommitCount(ctx, 1))
+}
+
+func (suite *KeeperTestSuite) TestAddReportCommitNotEnabled() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 1, defaultRequest())
+	hash := types.ReportCommitHash(1, validators[0].Address, commitRawReports, commitSalt)
+	err := k.AddReportCommit(ctx, 1, validators[0].Address, hash)
+	require.ErrorIs(err, types.ErrCommitRevealNotEnabled)
+}
+
+func (suite *KeeperTestSuite) TestAddReportCommitByNotRequestedValidator() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 1, commitRevealRequest())
+	hash := types.ReportCommitHash(1, validators[2].Address, commitRawReports, commitSalt)
+	err := k.AddReportCommit(ctx, 1, validators[2].Address, hash)
+	require.ErrorIs(err, types.ErrValidatorNotRequested)
+}
+
+func (suite *KeeperTestSuite) TestDuplicateReportCommit() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 1, commitRevealRequest())
+	hash := types.ReportCommitHash(1, validators[0].Address, commitRawReports, commitSalt)
+	err := k.AddReportCommit(ctx, 1, validators[0].Address, hash)
+	require.NoError(err)
+	err = k.AddReportCommit(ctx, 1, validators[0].Address, hash)
+	require.ErrorIs(err, types.ErrValidatorAlreadyCommitted)
+}
+
+func (suite *KeeperTestSuite) TestRevealStartsOnAllCommits() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := commitRevealRequest()
+	k.SetRequest(ctx, 1, req)
+	hash0 := types.ReportCommitHash(1, validators[0].Address, commitRawReports, commitSalt)
+	require.NoError(k.AddReportCommit(ctx, 1, validators[0].Address, hash0))
+
+	// Revealing before the reveal phase starts must fail.
+	err := k.CheckValidReveal(ctx, 1, validators[0].Address, commitRawReports, commitSalt)
+	require.ErrorIs(err, types.ErrRevealNotStarted)
+	require.False(k.IsRevealStarted(ctx, 1, req))
+
+	// Both requested validators have committed, so the reveal phase starts.
+	hash1 := types.ReportCommitHash(1, validators[1].Address, commitRawReports, commitSalt)
+	require.NoError(k.AddReportCommit(ctx, 1, validators[1].Address, hash1))
+	require.True(k.IsRevealStarted(ctx, 1, req))
+
+	err = k.CheckValidReveal(ctx, 1, validators[0].Address, commitRawReports, commitSalt)
+	require.NoError(err)
+	err = k.CheckValidReveal(ctx, 1, validators[0].Address, commitRawReports, []byte("WRONG_SALT"))
+	require.ErrorIs(err, types.ErrReportCommitMismatch)
+	err = k.CheckValidReveal(ctx, 1, validators[1].Address, commitRawReports[:2], commitSalt)
+	require.ErrorIs(err, types.ErrReportCommitMismatch)
+}
+
+func (suite *KeeperTestSuite) TestCommitAfterMinCount() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	// With min count 1 of 2 requested validators, the second one can still commit after the first.
+	req := commitRevealRequest()
+	req.MinCount = 1
+	k.SetRequest(ctx, 1, req)
+	hash0 := types.ReportCommitHash(1, validators[0].Address, commitRawReports, commitSalt)
+	require.NoError(k.AddReportCommit(ctx, 1, validators[0].Address, hash0))
+	require.False(k.IsRevealStarted(ctx, 1, req))
+
+	hash1 := types.ReportCommitHash(1, validators[1].Address, commitRawReports, commitSalt)
+	require.NoError(k.AddReportCommit(ctx, 1, validators[1].Address, hash1))
+	require.True(k.IsRevealStarted(ctx, 1, req))
+}
+
+func (suite *KeeperTestSuite) TestRevealStartsOnBlockCount() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := commitRevealRequest()
+	k.SetRequest(ctx, 1, req)
+	hash := types.ReportCommitHash(1, validators[0].Address, commitRawReports, commitSalt)
+	require.NoError(k.AddReportCommit(ctx, 1, validators[0].Address, hash))
+
+	blockCount := int64(k.GetParams(ctx).CommitRevealBlockCount)
+	ctx = ctx.WithBlockHeight(req.RequestHeight + blockCount - 1)
+	require.False(k.IsRevealStarted(ctx, 1, req))
+
+	ctx = ctx.WithBlockHeight(req.RequestHeight + blockCount)
+	require.True(k.IsRevealStarted(ctx, 1, req))
+	require.NoError(k.CheckValidReveal(ctx, 1, validators[0].Address, commitRawReports, commitSalt))
+
+	// Committing after the reveal phase has started is not allowed.
+	hash = types.ReportCommitHash(1, validators[1].Address, commitRawReports, commitSalt)
+	err := k.AddReportCommit(ctx, 1, validators[1].Address, hash)
+	require.ErrorIs(err, types.ErrRevealAlreadyStarted)
+
+	// A validator that did not commit cannot reveal.
+	err = k.CheckValidReveal(ctx, 1, validators[1].Address, commitRawReports, commitSalt)
+	require.ErrorIs(err, types.ErrReportCommitNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCheckValidRevealWithoutCommitReveal() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 1, defaultRequest())
+	require.NoError(k.CheckValidReveal(ctx, 1, validators[0].Address, commitRawReports, nil))
+}
+
+func (suite *KeeperTestSuite) TestDeleteReportCommits() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 1, commitRevealRequest())
+	for _, val := range validators[:2] {
+		hash := types.ReportCommitHash(1, val.Address, commitRawReports, commitSalt)
+		require.NoError(k.AddReportCommit(ctx, 1, val.Address, hash))
+	}
+	require.Equal(uint64(2), k.GetReportCommitCount(ctx, 1))
+
+	k.DeleteReportCommits(ctx, 1)
+	require.Equal(uint64(0), k.GetReportCommitCount(ctx, 1))
+	require.Nil(k.GetReportCommits(ctx, 1))
+}
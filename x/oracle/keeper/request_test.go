@@ -243,6 +243,7 @@ func (suite *KeeperTestSuite) TestProcessExpiredRequests() {
 
 	params := k.GetParams(ctx)
 	params.ExpirationBlockCount = 3
+	params.CommitRevealBlockCount = 2
 	err := k.SetParams(ctx, params)
 	require.NoError(err)
 
@@ -200,6 +200,7 @@ func (k Keeper) SaveResult(
 		status,                             // ResolveStatus
 		result,                             // Result
 	))
+	k.SettleTip(ctx, id, r, status)
 
 	if r.IBCChannel != nil {
 		sourceChannel := r.IBCChannel.ChannelId
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/pkg/obi"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SimulateRequest runs the prepare function of the given oracle script against the current
+// state and, if mock external data is given, runs its execute function with every requested
+// validator reporting the mock data. Nothing is written to the store. Errors of the owasm
+// executions are returned as part of the response instead of failing the simulation.
+func (k Keeper) SimulateRequest(
+	ctx sdk.Context,
+	req *types.QuerySimulateRequestRequest,
+) (*types.QuerySimulateRequestResponse, error) {
+	params := k.GetParams(ctx)
+	if calldataSize := len(req.Calldata); calldataSize > int(k.GetSpanSize(ctx)) {
+		return nil, types.WrapMaxError(types.ErrTooLargeCalldata, calldataSize, int(k.GetSpanSize(ctx)))
+	}
+	if req.MinCount <= 0 {
+		return nil, types.ErrInvalidMinCount.Wrapf("got: %d", req.MinCount)
+	}
+	if req.AskCount < req.MinCount {
+		return nil, types.ErrInvalidAskCount.Wrapf("got: %d, min count: %d", req.AskCount, req.MinCount)
+	}
+	if req.AskCount > params.MaxAskCount {
+		return nil, types.WrapMaxError(types.ErrInvalidAskCount, int(req.AskCount), int(params.MaxAskCount))
+	}
+	if req.PrepareGas <= 0 {
+		return nil, types.ErrInvalidOwasmGas.Wrapf("invalid prepare gas: %d", req.PrepareGas)
+	}
+	if req.ExecuteGas <= 0 {
+		return nil, types.ErrInvalidOwasmGas.Wrapf("invalid execute gas: %d", req.ExecuteGas)
+	}
+	if req.PrepareGas+req.ExecuteGas > types.MaximumOwasmGas {
+		return nil, types.ErrInvalidOwasmGas.Wrapf(
+			"sum of prepare gas and execute gas (%d) exceed %d",
+			req.PrepareGas+req.ExecuteGas,
+			types.MaximumOwasmGas,
+		)
+	}
+
+	oracleScriptID := types.OracleScriptID(req.OracleScriptId)
+	script, err := k.GetOracleScript(ctx, oracleScriptID)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := k.GetRandomValidators(ctx, int(req.AskCount), k.GetRequestCount(ctx)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	request := types.NewRequest(
+		oracleScriptID,
+		req.Calldata,
+		validators,
+		req.MinCount,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+		"",
+		nil,
+		nil,
+		req.ExecuteGas,
+		0,
+		"",
+		nil,
+	)
+
+	res := &types.QuerySimulateRequestResponse{}
+	code := k.GetFile(script.Filename)
+	prepareEnv := types.NewPrepareEnv(
+		request,
+		int64(params.MaxCalldataSize),
+		int64(params.MaxRawRequestCount),
+		int64(k.GetSpanSize(ctx)),
+	)
+	prepareOutput, err := k.owasmVM.Prepare(code, ConvertToOwasmGas(req.PrepareGas), prepareEnv)
+	if err != nil {
+		res.PrepareError = types.ErrBadWasmExecution.Wrap(err.Error()).Error()
+		return res, nil
+	}
+	res.PrepareGasUsed = prepareOutput.GasUsed
+	res.RawRequests = prepareEnv.GetRawRequests()
+	if len(res.RawRequests) == 0 {
+		res.PrepareError = types.ErrEmptyRawRequests.Error()
+		return res, nil
+	}
+	if len(req.ExternalData) == 0 {
+		return res, nil
+	}
+
+	mocks := make(map[types.DataSourceID]types.MockExternalData)
+	for _, mock := range req.ExternalData {
+		mocks[types.DataSourceID(mock.DataSourceId)] = mock
+	}
+	rawReports := make([]types.RawReport, 0, len(res.RawRequests))
+	for _, rawReq := range res.RawRequests {
+		mock, ok := mocks[rawReq.DataSourceID]
+		if !ok {
+			return nil, types.ErrDataSourceNotFound.Wrapf("no mock external data for data source id: %d", rawReq.DataSourceID)
+		}
+		rawReports = append(rawReports, types.NewRawReport(rawReq.ExternalID, mock.ExitCode, mock.Data))
+	}
+	reports := make([]types.Report, 0, len(validators))
+	for _, val := range validators {
+		reports = append(reports, types.NewReport(val, true, rawReports))
+	}
+
+	request.RawRequests = res.RawRequests
+	executeEnv := types.NewExecuteEnv(request, reports, ctx.BlockTime(), int64(k.GetSpanSize(ctx)))
+	executeOutput, err := k.owasmVM.Execute(code, ConvertToOwasmGas(req.ExecuteGas), executeEnv)
+	if err != nil {
+		res.ExecuteError = err.Error()
+		return res, nil
+	}
+	res.ExecuteGasUsed = executeOutput.GasUsed
+	if executeEnv.Retdata == nil {
+		res.ExecuteError = "no return data"
+		return res, nil
+	}
+	res.Result = executeEnv.Retdata
+
+	if script.Schema != "" {
+		if _, outputSchema, err := obi.SplitSchema(script.Schema); err == nil {
+			if decoded, err := obi.DecodeJSON(outputSchema, res.Result); err == nil {
+				res.DecodedResult = string(decoded)
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) mockSimulateRollingSeed() {
+	suite.rollingseedKeeper.
+		EXPECT().
+		GetRollingSeed(gomock.Any()).
+		Return([]byte("ROLLING_SEED_A_WITH_LONG_ENOUGH_ENTROPY")).
+		AnyTimes()
+}
+
+func (suite *KeeperTestSuite) TestSimulateRequestPrepareOnly() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	suite.mockSimulateRollingSeed()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+
+	// OracleScript#1: Prepare asks for DS#1,2,3 with ExtID#1,2,3 and calldata "test"
+	res, err := k.SimulateRequest(ctx, &types.QuerySimulateRequestRequest{
+		OracleScriptId: 1,
+		Calldata:       basicCalldata,
+		AskCount:       1,
+		MinCount:       1,
+		PrepareGas:     testDefaultPrepareGas,
+		ExecuteGas:     testDefaultExecuteGas,
+	})
+	require.NoError(err)
+	require.Equal([]types.RawRequest{
+		types.NewRawRequest(1, 1, []byte("test")),
+		types.NewRawRequest(2, 2, []byte("test")),
+		types.NewRawRequest(3, 3, []byte("test")),
+	}, res.RawRequests)
+	require.NotZero(res.PrepareGasUsed)
+	require.Empty(res.PrepareError)
+	require.Zero(res.ExecuteGasUsed)
+	require.Nil(res.Result)
+
+	// Nothing should be written to the store.
+	require.Equal(uint64(0), k.GetRequestCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestSimulateRequestWithExternalData() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	suite.mockSimulateRollingSeed()
+	ctx := suite.ctx.WithBlockTime(bandtesting.ParseTime(1581589790))
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	addSimpleDataSourceAndOracleScript(ctx, k, suite.fileDir)
+	script := k.MustGetOracleScript(ctx, 1)
+	script.Schema = "{symbol:string}/{value:u32}"
+	k.SetOracleScript(ctx, 1, script)
+
+	req := &types.QuerySimulateRequestRequest{
+		OracleScriptId: 1,
+		Calldata:       basicCalldata,
+		AskCount:       2,
+		MinCount:       1,
+		PrepareGas:     testDefaultPrepareGas,
+		ExecuteGas:     testDefaultExecuteGas,
+		ExternalData: []types.MockExternalData{
+			{DataSourceId: 1, ExitCode: 0, Data: []byte("test")},
+			{DataSourceId: 2, ExitCode: 0, Data: []byte("test")},
+		},
+	}
+
+	// Every data source asked by the prepare function must have mock external data.
+	_, err := k.SimulateRequest(ctx, req)
+	require.ErrorIs(err, types.ErrDataSourceNotFound)
+
+	// OracleScript#1: Execute returns "test"
+	req.ExternalData = append(req.ExternalData, types.MockExternalData{DataSourceId: 3, Data: []byte("test")})
+	res, err := k.SimulateRequest(ctx, req)
+	require.NoError(err)
+	require.Len(res.RawRequests, 3)
+	require.Empty(res.PrepareError)
+	require.Empty(res.ExecuteError)
+	require.NotZero(res.ExecuteGasUsed)
+	require.Equal([]byte("test"), res.Result)
+	require.Equal(`{"value":1952805748}`, res.DecodedResult)
+}
+
+func (suite *KeeperTestSuite) TestSimulateRequestBadWasmExecution() {
+	suite.activeAllValidators()
+	suite.mockIterateBondedValidatorsByPower()
+	suite.mockSimulateRollingSeed()
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	res, err := k.SimulateRequest(ctx, &types.QuerySimulateRequestRequest{
+		OracleScriptId: 2,
+		Calldata:       basicCalldata,
+		AskCount:       1,
+		MinCount:       1,
+		PrepareGas:     testDefaultPrepareGas,
+		ExecuteGas:     testDefaultExecuteGas,
+	})
+	require.NoError(err)
+	require.Equal("OEI action to invoke is not available: bad wasm execution", res.PrepareError)
+	require.Empty(res.RawRequests)
+}
+
+func (suite *KeeperTestSuite) TestSimulateRequestInvalid() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	_, err := k.SimulateRequest(ctx, &types.QuerySimulateRequestRequest{
+		OracleScriptId: 1,
+		AskCount:       1,
+		MinCount:       2,
+		PrepareGas:     testDefaultPrepareGas,
+		ExecuteGas:     testDefaultExecuteGas,
+	})
+	require.ErrorIs(err, types.ErrInvalidAskCount)
+
+	_, err = k.SimulateRequest(ctx, &types.QuerySimulateRequestRequest{
+		OracleScriptId: 1,
+		AskCount:       1,
+		MinCount:       1,
+		PrepareGas:     0,
+		ExecuteGas:     testDefaultExecuteGas,
+	})
+	require.ErrorIs(err, types.ErrInvalidOwasmGas)
+
+	_, err = k.SimulateRequest(ctx, &types.QuerySimulateRequestRequest{
+		OracleScriptId: 999,
+		AskCount:       1,
+		MinCount:       1,
+		PrepareGas:     testDefaultPrepareGas,
+		ExecuteGas:     testDefaultExecuteGas,
+	})
+	require.ErrorIs(err, types.ErrOracleScriptNotFound)
+}
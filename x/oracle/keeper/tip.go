@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// TipPercentileList is the list of percentiles reported by the TipPercentiles query.
+var TipPercentileList = []uint64{25, 50, 75, 90}
+
+const (
+	// DefaultTipSampleLimit is the number of recent requests sampled if no limit is given.
+	DefaultTipSampleLimit = 100
+	// MaxTipSampleLimit is the maximum number of recent requests that can be sampled.
+	MaxTipSampleLimit = 1000
+)
+
+// CollectTip escrows the tip from the payer to the oracle module account and records it
+// on the given request.
+func (k Keeper) CollectTip(ctx sdk.Context, id types.RequestID, payer sdk.AccAddress, tip sdk.Coins) error {
+	if tip.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, tip); err != nil {
+		return err
+	}
+
+	req := k.MustGetRequest(ctx, id)
+	req.Tip = tip
+	k.SetRequest(ctx, id, req)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCollectTip,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyTip, tip.String()),
+	))
+	return nil
+}
+
+// SettleTip distributes the tip of a resolved request to its reporters, or refunds it to the
+// requester if the request expired or the distribution cannot be done. The tip is kept in escrow
+// if the refund fails as well, so a single request cannot halt the chain.
+func (k Keeper) SettleTip(ctx sdk.Context, id types.RequestID, req types.Request, status types.ResolveStatus) {
+	if req.Tip.IsZero() {
+		return
+	}
+
+	if status != types.RESOLVE_STATUS_EXPIRED {
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := k.DistributeTip(cacheCtx, id, req.Tip)
+		if err == nil {
+			writeFn()
+			return
+		}
+		k.Logger(ctx).Error(fmt.Sprintf("failed to distribute tip of request %d: %s", id, err))
+	}
+
+	if err := k.RefundTip(ctx, id, req.Requester, req.Tip); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to refund tip of request %d, keeping it in escrow: %s", id, err))
+	}
+}
+
+// DistributeTip splits the tip equally among validators who reported to the request. The
+// rounding remainder goes to the last reporter.
+func (k Keeper) DistributeTip(ctx sdk.Context, id types.RequestID, tip sdk.Coins) error {
+	var reporters []stakingtypes.ValidatorI
+	for _, report := range k.GetReports(ctx, id) {
+		valAddr, err := sdk.ValAddressFromBech32(report.Validator)
+		if err != nil {
+			return err
+		}
+		val, err := k.stakingKeeper.Validator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		reporters = append(reporters, val)
+	}
+	if len(reporters) == 0 {
+		return types.ErrEmptyReport
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, tip); err != nil {
+		return err
+	}
+
+	remaining := sdk.NewDecCoinsFromCoins(tip...)
+	share := remaining.QuoDecTruncate(math.LegacyNewDec(int64(len(reporters))))
+	for i, val := range reporters {
+		reward := share
+		if i == len(reporters)-1 {
+			reward = remaining
+		}
+		if err := k.distrKeeper.AllocateTokensToValidator(ctx, val, reward); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(reward)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDistributeTip,
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
+			sdk.NewAttribute(types.AttributeKeyTip, reward.String()),
+		))
+	}
+
+	return nil
+}
+
+// RefundTip returns the escrowed tip back to the requester. It fails if the requester cannot
+// receive funds, for example a blocked module account.
+func (k Keeper) RefundTip(ctx sdk.Context, id types.RequestID, requester string, tip sdk.Coins) error {
+	requesterAddr, err := sdk.AccAddressFromBech32(requester)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, requesterAddr, tip); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundTip,
+		sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyTip, tip.String()),
+	))
+	return nil
+}
+
+// GetTipPercentiles returns the tips in the given denom at each of TipPercentileList, sampled
+// from at most limit of the most recent unexpired requests, along with the sample count.
+func (k Keeper) GetTipPercentiles(ctx sdk.Context, denom string, limit uint64) ([]types.TipPercentile, uint64) {
+	var tips []math.Int
+	lastExpired := k.GetRequestLastExpired(ctx)
+	for id := types.RequestID(k.GetRequestCount(ctx)); id > lastExpired && uint64(len(tips)) < limit; id-- {
+		req, err := k.GetRequest(ctx, id)
+		if err != nil {
+			continue
+		}
+		tips = append(tips, req.Tip.AmountOf(denom))
+	}
+
+	percentiles := make([]types.TipPercentile, 0, len(TipPercentileList))
+	if len(tips) == 0 {
+		return percentiles, 0
+	}
+
+	sort.Slice(tips, func(i, j int) bool { return tips[i].LT(tips[j]) })
+	for _, p := range TipPercentileList {
+		// Nearest-rank method: the smallest tip that is greater than or equal to p% of samples.
+		rank := (p*uint64(len(tips)) + 99) / 100
+		if rank == 0 {
+			rank = 1
+		}
+		percentiles = append(percentiles, types.TipPercentile{
+			Percentile: p,
+			Tip:        sdk.NewCoin(denom, tips[rank-1]),
+		})
+	}
+
+	return percentiles, uint64(len(tips))
+}
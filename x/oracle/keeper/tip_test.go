@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	bandtesting "github.com/bandprotocol/chain/v3/testing"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) TestCollectTip() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, defaultRequest())
+
+	// Zero tip should not touch the bank nor the request.
+	require.NoError(k.CollectTip(ctx, 42, alice, emptyCoins))
+	require.True(k.MustGetRequest(ctx, 42).Tip.IsZero())
+
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, coins10uband)
+	require.NoError(k.CollectTip(ctx, 42, alice, coins10uband))
+	require.Equal(coins10uband, k.MustGetRequest(ctx, 42).Tip)
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeCollectTip,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyTip, "10uband"),
+	)}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestCollectTipInsufficientFunds() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	k.SetRequest(ctx, 42, defaultRequest())
+
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), alice, types.ModuleName, coins10uband).
+		Return(sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(k.CollectTip(ctx, 42, alice, coins10uband), sdkerrors.ErrInsufficientFunds)
+	require.True(k.MustGetRequest(ctx, 42).Tip.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSettleTipDistribute() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := defaultRequest()
+	req.Tip = coins20uband
+	k.SetRequest(ctx, 42, req)
+	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, nil))
+	k.SetReport(ctx, 42, types.NewReport(validators[1].Address, true, nil))
+
+	suite.stakingKeeper.EXPECT().Validator(gomock.Any(), validators[0].Address).Return(validators[0].Validator, nil)
+	suite.stakingKeeper.EXPECT().Validator(gomock.Any(), validators[1].Address).Return(validators[1].Validator, nil)
+	share := sdk.NewDecCoins(sdk.NewDecCoin("uband", math.NewInt(10)))
+	send := suite.bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, distrtypes.ModuleName, coins20uband)
+	suite.distrKeeper.EXPECT().AllocateTokensToValidator(gomock.Any(), validators[0].Validator, share).After(send)
+	suite.distrKeeper.EXPECT().AllocateTokensToValidator(gomock.Any(), validators[1].Validator, share).After(send)
+
+	k.SettleTip(ctx, 42, req, types.RESOLVE_STATUS_SUCCESS)
+	require.ElementsMatch(sdk.Events{sdk.NewEvent(
+		types.EventTypeDistributeTip,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyValidator, validators[0].Address.String()),
+		sdk.NewAttribute(types.AttributeKeyTip, "10.000000000000000000uband"),
+	), sdk.NewEvent(
+		types.EventTypeDistributeTip,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyValidator, validators[1].Address.String()),
+		sdk.NewAttribute(types.AttributeKeyTip, "10.000000000000000000uband"),
+	)}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestSettleTipRefundExpired() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := defaultRequest()
+	req.Tip = coins20uband
+	k.SetRequest(ctx, 42, req)
+	k.SetReport(ctx, 42, types.NewReport(validators[0].Address, true, nil))
+
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, bandtesting.FeePayer.Address, coins20uband)
+
+	k.SettleTip(ctx, 42, req, types.RESOLVE_STATUS_EXPIRED)
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeRefundTip,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyTip, "20uband"),
+	)}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestSettleTipRefundNoReport() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := defaultRequest()
+	req.Tip = coins20uband
+	k.SetRequest(ctx, 42, req)
+
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, bandtesting.FeePayer.Address, coins20uband)
+
+	k.SettleTip(ctx, 42, req, types.RESOLVE_STATUS_FAILURE)
+	require.Equal(sdk.Events{sdk.NewEvent(
+		types.EventTypeRefundTip,
+		sdk.NewAttribute(types.AttributeKeyID, "42"),
+		sdk.NewAttribute(types.AttributeKeyTip, "20uband"),
+	)}, ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestSettleTipRefundFailed() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	req := defaultRequest()
+	req.Tip = coins20uband
+	k.SetRequest(ctx, 42, req)
+
+	// The requester cannot receive funds, so the tip is kept in escrow without halting the chain.
+	suite.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, bandtesting.FeePayer.Address, coins20uband).
+		Return(sdkerrors.ErrUnauthorized)
+
+	require.NotPanics(func() { k.SettleTip(ctx, 42, req, types.RESOLVE_STATUS_EXPIRED) })
+	require.Empty(ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestGetTipPercentiles() {
+	ctx := suite.ctx
+	k := suite.oracleKeeper
+	require := suite.Require()
+
+	percentiles, count := k.GetTipPercentiles(ctx, "uband", 100)
+	require.Equal(uint64(0), count)
+	require.Empty(percentiles)
+
+	// Requests #1 to #10 tip 10, 20, ..., 100 uband.
+	for i := int64(1); i <= 10; i++ {
+		req := defaultRequest()
+		req.Tip = sdk.NewCoins(sdk.NewInt64Coin("uband", i*10))
+		k.SetRequest(ctx, types.RequestID(i), req)
+	}
+	k.SetRequestCount(ctx, 10)
+
+	percentiles, count = k.GetTipPercentiles(ctx, "uband", 100)
+	require.Equal(uint64(10), count)
+	require.Equal([]types.TipPercentile{
+		{Percentile: 25, Tip: sdk.NewInt64Coin("uband", 30)},
+		{Percentile: 50, Tip: sdk.NewInt64Coin("uband", 50)},
+		{Percentile: 75, Tip: sdk.NewInt64Coin("uband", 80)},
+		{Percentile: 90, Tip: sdk.NewInt64Coin("uband", 90)},
+	}, percentiles)
+
+	// Only the 4 most recent requests (#7 to #10) are sampled.
+	percentiles, count = k.GetTipPercentiles(ctx, "uband", 4)
+	require.Equal(uint64(4), count)
+	require.Equal(sdk.NewInt64Coin("uband", 80), percentiles[1].Tip)
+
+	// Expired requests are not sampled.
+	k.SetRequestLastExpired(ctx, 8)
+	_, count = k.GetTipPercentiles(ctx, "uband", 100)
+	require.Equal(uint64(2), count)
+
+	// Tips in other denoms count as zero.
+	percentiles, _ = k.GetTipPercentiles(ctx, "stake", 100)
+	require.Equal(sdk.NewInt64Coin("stake", 0), percentiles[3].Tip)
+}
@@ -27,6 +27,11 @@ func Migrate(
 	var currParams types.Params
 	legacySubspace.GetParamSet(ctx, &currParams)
 
+	// the commit reveal block count is not one of the legacy parameters
+	if currParams.CommitRevealBlockCount == 0 {
+		currParams.CommitRevealBlockCount = types.DefaultCommitRevealBlockCount
+	}
+
 	if err := currParams.Validate(); err != nil {
 		return err
 	}
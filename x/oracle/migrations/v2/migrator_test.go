@@ -45,4 +45,11 @@ func TestMigrate(t *testing.T) {
 	bz := store.Get(types.ParamsKeyPrefix)
 	require.NoError(t, cdc.Unmarshal(bz, &res))
 	require.Equal(t, legacySubspace.ps, res)
+
+	// the commit reveal block count is not one of the legacy parameters
+	legacyParams := types.DefaultParams()
+	legacyParams.CommitRevealBlockCount = 0
+	require.NoError(t, v2.Migrate(ctx, store, newMockSubspace(legacyParams), cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKeyPrefix), &res))
+	require.Equal(t, types.DefaultParams(), res)
 }
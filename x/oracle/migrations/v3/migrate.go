@@ -0,0 +1,128 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// AccountKeeper defines the account keeper used by the migration.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// AuthzKeeper defines the authz keeper used by the migration.
+type AuthzKeeper interface {
+	IterateGrants(ctx context.Context, handler func(granterAddr, granteeAddr sdk.AccAddress, grant authz.Grant) bool)
+	GetAuthorization(
+		ctx context.Context,
+		grantee, granter sdk.AccAddress,
+		msgType string,
+	) (authz.Authorization, *time.Time)
+	SaveGrant(
+		ctx context.Context,
+		grantee, granter sdk.AccAddress,
+		authorization authz.Authorization,
+		expiration *time.Time,
+	) error
+}
+
+// Migrate migrates the x/oracle module state from the consensus version 2 to
+// version 3. Specifically, it sets the new commit reveal block count parameter
+// to its default, grants MsgCommitReport to the existing reporters, and sets up
+// the oracle module account escrowing the request tips. An ordinary account
+// already at the module address, e.g. one that received coins, is converted
+// into the module account, keeping its account number and balances.
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	ak AccountKeeper,
+	authzKeeper AuthzKeeper,
+) error {
+	var params types.Params
+	if bz := store.Get(types.ParamsKeyPrefix); bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+	if params.CommitRevealBlockCount == 0 {
+		params.CommitRevealBlockCount = types.DefaultCommitRevealBlockCount
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&params))
+
+	if err := grantCommitReport(ctx, authzKeeper); err != nil {
+		return err
+	}
+
+	addr := authtypes.NewModuleAddress(types.ModuleName)
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		// the module account is created on the first access
+		ak.GetModuleAccount(ctx, types.ModuleName)
+		return nil
+	}
+
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return nil
+	}
+
+	baseAcc := authtypes.NewBaseAccount(addr, nil, acc.GetAccountNumber(), acc.GetSequence())
+	ak.SetAccount(ctx, authtypes.NewModuleAccount(baseAcc, types.ModuleName))
+
+	return nil
+}
+
+// grantCommitReport grants MsgCommitReport to every reporter granted MsgReportData, with the same
+// expiration, as reporters must commit to their reports before revealing them with MsgReportData
+// on commit-reveal requests.
+func grantCommitReport(ctx sdk.Context, authzKeeper AuthzKeeper) error {
+	type reporterGrant struct {
+		granter    sdk.AccAddress
+		grantee    sdk.AccAddress
+		expiration *time.Time
+	}
+
+	reportMsgType := sdk.MsgTypeURL(&types.MsgReportData{})
+	var grants []reporterGrant
+	authzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant authz.Grant) bool {
+		if grant.Expiration != nil && !grant.Expiration.After(ctx.BlockTime()) {
+			return false
+		}
+		authorization, err := grant.GetAuthorization()
+		if err != nil || authorization.MsgTypeURL() != reportMsgType {
+			return false
+		}
+		grants = append(grants, reporterGrant{granter: granter, grantee: grantee, expiration: grant.Expiration})
+		return false
+	})
+
+	commitMsgType := sdk.MsgTypeURL(&types.MsgCommitReport{})
+	for _, g := range grants {
+		if authorization, _ := authzKeeper.GetAuthorization(ctx, g.grantee, g.granter, commitMsgType); authorization != nil {
+			continue
+		}
+		if err := authzKeeper.SaveGrant(
+			ctx,
+			g.grantee,
+			g.granter,
+			authz.NewGenericAuthorization(commitMsgType),
+			g.expiration,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package v3_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle"
+	v3 "github.com/bandprotocol/chain/v3/x/oracle/migrations/v3"
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+type mockAccountKeeper struct {
+	accounts map[string]sdk.AccountI
+}
+
+func (ak *mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return ak.accounts[addr.String()]
+}
+
+func (ak *mockAccountKeeper) GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI {
+	addr := authtypes.NewModuleAddress(moduleName)
+	if acc, ok := ak.accounts[addr.String()]; ok {
+		return acc.(sdk.ModuleAccountI)
+	}
+
+	acc := authtypes.NewEmptyModuleAccount(moduleName)
+	ak.SetAccount(ctx, acc)
+	return acc
+}
+
+func (ak *mockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	ak.accounts[acc.GetAddress().String()] = acc
+}
+
+type grantKey struct {
+	granter string
+	grantee string
+	msgType string
+}
+
+type mockAuthzKeeper struct {
+	grants map[grantKey]authz.Grant
+}
+
+func (k *mockAuthzKeeper) IterateGrants(
+	_ context.Context,
+	handler func(granterAddr, granteeAddr sdk.AccAddress, grant authz.Grant) bool,
+) {
+	for key, grant := range k.grants {
+		if handler(sdk.MustAccAddressFromBech32(key.granter), sdk.MustAccAddressFromBech32(key.grantee), grant) {
+			return
+		}
+	}
+}
+
+func (k *mockAuthzKeeper) GetAuthorization(
+	_ context.Context,
+	grantee, granter sdk.AccAddress,
+	msgType string,
+) (authz.Authorization, *time.Time) {
+	grant, ok := k.grants[grantKey{granter.String(), grantee.String(), msgType}]
+	if !ok {
+		return nil, nil
+	}
+	authorization, _ := grant.GetAuthorization()
+	return authorization, grant.Expiration
+}
+
+func (k *mockAuthzKeeper) SaveGrant(
+	_ context.Context,
+	grantee, granter sdk.AccAddress,
+	authorization authz.Authorization,
+	expiration *time.Time,
+) error {
+	authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return err
+	}
+	key := grantKey{granter.String(), grantee.String(), authorization.MsgTypeURL()}
+	k.grants[key] = authz.Grant{Authorization: authorizationAny, Expiration: expiration}
+	return nil
+}
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	addr := authtypes.NewModuleAddress(types.ModuleName)
+
+	// the params of version 2 have no commit reveal block count
+	params := types.DefaultParams()
+	params.CommitRevealBlockCount = 0
+	store.Set(types.ParamsKeyPrefix, cdc.MustMarshal(&params))
+
+	// the module account is created if missing
+	ak := &mockAccountKeeper{accounts: make(map[string]sdk.AccountI)}
+	require.NoError(t, v3.Migrate(ctx, store, cdc, ak, &mockAuthzKeeper{grants: make(map[grantKey]authz.Grant)}))
+	_, ok := ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	require.True(t, ok)
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKeyPrefix), &res))
+	require.Equal(t, types.DefaultParams(), res)
+
+	// an ordinary account at the module address is converted
+	ak = &mockAccountKeeper{accounts: make(map[string]sdk.AccountI)}
+	ak.SetAccount(ctx, authtypes.NewBaseAccount(addr, nil, 7, 0))
+	require.NoError(t, v3.Migrate(ctx, store, cdc, ak, &mockAuthzKeeper{grants: make(map[grantKey]authz.Grant)}))
+	acc, ok := ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	require.True(t, ok)
+	require.Equal(t, types.ModuleName, acc.GetName())
+	require.Equal(t, uint64(7), acc.GetAccountNumber())
+
+	// an existing module account is kept
+	require.NoError(t, v3.Migrate(ctx, store, cdc, ak, &mockAuthzKeeper{grants: make(map[grantKey]authz.Grant)}))
+	require.Equal(t, acc, ak.GetAccount(ctx, addr))
+}
+
+func TestMigrateGrantCommitReport(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+	params := types.DefaultParams()
+	ctx.KVStore(storeKey).Set(types.ParamsKeyPrefix, cdc.MustMarshal(&params))
+
+	validator := sdk.AccAddress("validator")
+	reporter1 := sdk.AccAddress("reporter1")
+	reporter2 := sdk.AccAddress("reporter2")
+	reporter3 := sdk.AccAddress("reporter3")
+	other := sdk.AccAddress("other")
+	later, earlier := now.Add(time.Hour), now.Add(-time.Hour)
+
+	reportMsgType := sdk.MsgTypeURL(&types.MsgReportData{})
+	commitMsgType := sdk.MsgTypeURL(&types.MsgCommitReport{})
+	sendMsgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	authzKeeper := &mockAuthzKeeper{grants: make(map[grantKey]authz.Grant)}
+	grant := func(grantee sdk.AccAddress, msgType string, expiration *time.Time) {
+		require.NoError(t, authzKeeper.SaveGrant(
+			ctx, grantee, validator, authz.NewGenericAuthorization(msgType), expiration,
+		))
+	}
+	grant(reporter1, reportMsgType, nil)
+	grant(reporter2, reportMsgType, &later)
+	grant(reporter3, reportMsgType, &earlier)
+	grant(other, sendMsgType, nil)
+
+	ak := &mockAccountKeeper{accounts: make(map[string]sdk.AccountI)}
+	require.NoError(t, v3.Migrate(ctx, ctx.KVStore(storeKey), cdc, ak, authzKeeper))
+
+	// the reporters are granted MsgCommitReport with the expiration of their MsgReportData grant
+	authorization, expiration := authzKeeper.GetAuthorization(ctx, reporter1, validator, commitMsgType)
+	require.NotNil(t, authorization)
+	require.Nil(t, expiration)
+	authorization, expiration = authzKeeper.GetAuthorization(ctx, reporter2, validator, commitMsgType)
+	require.NotNil(t, authorization)
+	require.Equal(t, &later, expiration)
+
+	// the expired reporter and the other grantees are not
+	authorization, _ = authzKeeper.GetAuthorization(ctx, reporter3, validator, commitMsgType)
+	require.Nil(t, authorization)
+	authorization, _ = authzKeeper.GetAuthorization(ctx, other, validator, commitMsgType)
+	require.Nil(t, authorization)
+	require.Len(t, authzKeeper.grants, 6)
+}
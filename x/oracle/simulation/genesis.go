@@ -66,6 +66,12 @@ func GenIBCRequestEnabled(r *rand.Rand) bool {
 	return r.Int63n(100) < 50
 }
 
+// GenCommitRevealBlockCount returns randomized CommitRevealBlockCount
+func GenCommitRevealBlockCount(r *rand.Rand) uint64 {
+	// less than the minimum randomized ExpirationBlockCount
+	return uint64(simulation.RandIntBetween(r, 1, 10))
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxRawRequestCount uint64
@@ -134,6 +140,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ibcRequestEnabled = GenIBCRequestEnabled(r) },
 	)
 
+	var commitRevealBlockCount uint64
+	simState.AppParams.GetOrGenerate(
+		"CommitRevealBlockCount", &commitRevealBlockCount, simState.Rand,
+		func(r *rand.Rand) { commitRevealBlockCount = GenCommitRevealBlockCount(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.NewParams(
 			maxRawRequestCount,
@@ -147,6 +159,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			oracleRewardPercentage,
 			inactivePenaltyDuration,
 			ibcRequestEnabled,
+			commitRevealBlockCount,
 		),
 		[]types.DataSource{},
 		[]types.OracleScript{},
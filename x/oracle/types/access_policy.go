@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewAccessPolicy creates a new AccessPolicy instance.
+func NewAccessPolicy(
+	allowedRequesters []sdk.AccAddress,
+	allowedChannels []IBCChannel,
+	minFeeLimit sdk.Coins,
+) AccessPolicy {
+	requesters := make([]string, 0, len(allowedRequesters))
+	for _, requester := range allowedRequesters {
+		requesters = append(requesters, requester.String())
+	}
+	return AccessPolicy{
+		AllowedRequesters: requesters,
+		AllowedChannels:   allowedChannels,
+		MinFeeLimit:       minFeeLimit,
+	}
+}
+
+// IsRestricted returns whether the access policy restricts who can send requests.
+func (p AccessPolicy) IsRestricted() bool {
+	return len(p.AllowedRequesters) > 0 || len(p.AllowedChannels) > 0
+}
+
+// Validate checks whether the access policy is well-formed.
+func (p AccessPolicy) Validate() error {
+	if size := len(p.AllowedRequesters) + len(p.AllowedChannels); size > MaxAccessPolicySize {
+		return WrapMaxError(ErrInvalidAccessPolicy, size, MaxAccessPolicySize)
+	}
+	requesters := make(map[string]bool)
+	for _, requester := range p.AllowedRequesters {
+		addr, err := sdk.AccAddressFromBech32(requester)
+		if err != nil {
+			return err
+		}
+		if err := sdk.VerifyAddressFormat(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("allowed requester: %s", requester)
+		}
+		if requesters[requester] {
+			return ErrInvalidAccessPolicy.Wrapf("duplicate allowed requester: %s", requester)
+		}
+		requesters[requester] = true
+	}
+	channels := make(map[IBCChannel]bool)
+	for _, channel := range p.AllowedChannels {
+		if err := host.PortIdentifierValidator(channel.PortId); err != nil {
+			return ErrInvalidAccessPolicy.Wrap(err.Error())
+		}
+		if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+			return ErrInvalidAccessPolicy.Wrap(err.Error())
+		}
+		if channels[channel] {
+			return ErrInvalidAccessPolicy.Wrapf("duplicate allowed channel: %s/%s", channel.PortId, channel.ChannelId)
+		}
+		channels[channel] = true
+	}
+	if !p.MinFeeLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("min fee limit: %s", p.MinFeeLimit)
+	}
+	return nil
+}
+
+// CheckRequest returns an error if the access policy does not allow a request from the given
+// requester, or from the given IBC channel if it is not nil, with the given fee limit.
+func (p AccessPolicy) CheckRequest(requester sdk.AccAddress, ibcChannel *IBCChannel, feeLimit sdk.Coins) error {
+	if p.IsRestricted() {
+		allowed := false
+		if ibcChannel == nil {
+			for _, allowedRequester := range p.AllowedRequesters {
+				if allowedRequester == requester.String() {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return ErrRequesterNotAllowed.Wrapf("requester: %s", requester)
+			}
+		} else {
+			for _, allowedChannel := range p.AllowedChannels {
+				if allowedChannel.Equal(ibcChannel) {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return ErrIBCChannelNotAllowed.Wrapf("port: %s, channel: %s", ibcChannel.PortId, ibcChannel.ChannelId)
+			}
+		}
+	}
+	if !feeLimit.IsAllGTE(p.MinFeeLimit) {
+		return ErrFeeLimitTooLow.Wrapf("fee limit: %s, min fee limit: %s", feeLimit, p.MinFeeLimit)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAccessPolicyValidate(t *testing.T) {
+	require.NoError(t, AccessPolicy{}.Validate())
+	require.NoError(t, NewAccessPolicy(
+		[]sdk.AccAddress{GoodTestAddr, GoodTestAddr2},
+		[]IBCChannel{NewIBCChannel("oracle", "channel-0")},
+		FeeCoins,
+	).Validate())
+
+	require.ErrorIs(t, NewAccessPolicy(
+		[]sdk.AccAddress{GoodTestAddr, GoodTestAddr},
+		nil,
+		nil,
+	).Validate(), ErrInvalidAccessPolicy)
+	require.ErrorIs(t, NewAccessPolicy(
+		nil,
+		[]IBCChannel{NewIBCChannel("oracle", "channel-0"), NewIBCChannel("oracle", "channel-0")},
+		nil,
+	).Validate(), ErrInvalidAccessPolicy)
+	require.ErrorIs(t, NewAccessPolicy(
+		nil,
+		[]IBCChannel{NewIBCChannel("oracle", "")},
+		nil,
+	).Validate(), ErrInvalidAccessPolicy)
+	require.Error(t, NewAccessPolicy(nil, nil, BadCoins).Validate())
+	require.Error(t, AccessPolicy{AllowedRequesters: []string{"invalid"}}.Validate())
+}
+
+func TestAccessPolicyCheckRequest(t *testing.T) {
+	channel0 := NewIBCChannel("oracle", "channel-0")
+	channel1 := NewIBCChannel("oracle", "channel-1")
+
+	// An empty access policy allows everyone.
+	policy := AccessPolicy{}
+	require.NoError(t, policy.CheckRequest(GoodTestAddr, nil, nil))
+	require.NoError(t, policy.CheckRequest(GoodTestAddr, &channel0, nil))
+
+	// Requesters only: no IBC request is allowed.
+	policy = NewAccessPolicy([]sdk.AccAddress{GoodTestAddr}, nil, nil)
+	require.NoError(t, policy.CheckRequest(GoodTestAddr, nil, nil))
+	require.ErrorIs(t, policy.CheckRequest(GoodTestAddr2, nil, nil), ErrRequesterNotAllowed)
+	require.ErrorIs(t, policy.CheckRequest(GoodTestAddr, &channel0, nil), ErrIBCChannelNotAllowed)
+
+	// Channels only: no direct request is allowed.
+	policy = NewAccessPolicy(nil, []IBCChannel{channel0}, nil)
+	require.NoError(t, policy.CheckRequest(GoodTestAddr, &channel0, nil))
+	require.ErrorIs(t, policy.CheckRequest(GoodTestAddr, &channel1, nil), ErrIBCChannelNotAllowed)
+	require.ErrorIs(t, policy.CheckRequest(GoodTestAddr, nil, nil), ErrRequesterNotAllowed)
+
+	// Min fee limit applies to every request.
+	policy = NewAccessPolicy(nil, nil, FeeCoins)
+	require.NoError(t, policy.CheckRequest(GoodTestAddr, nil, FeeCoins))
+	require.ErrorIs(t, policy.CheckRequest(GoodTestAddr, &channel0, nil), ErrFeeLimitTooLow)
+}
@@ -22,10 +22,13 @@ var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRequestData{}, "oracle/Request")
 	legacy.RegisterAminoMsg(cdc, &MsgReportData{}, "oracle/Report")
+	legacy.RegisterAminoMsg(cdc, &MsgCommitReport{}, "oracle/CommitReport")
 	legacy.RegisterAminoMsg(cdc, &MsgCreateDataSource{}, "oracle/CreateDataSource")
 	legacy.RegisterAminoMsg(cdc, &MsgEditDataSource{}, "oracle/EditDataSource")
 	legacy.RegisterAminoMsg(cdc, &MsgCreateOracleScript{}, "oracle/CreateOracleScript")
 	legacy.RegisterAminoMsg(cdc, &MsgEditOracleScript{}, "oracle/EditOracleScript")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDataSourceAccessPolicy{}, "oracle/UpdateDataSourceAccessPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateOracleScriptAccessPolicy{}, "oracle/UpdateOracleScriptAccessPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgActivate{}, "oracle/Activate")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "oracle/UpdateParams")
 
@@ -38,10 +41,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRequestData{},
 		&MsgReportData{},
+		&MsgCommitReport{},
 		&MsgCreateDataSource{},
 		&MsgEditDataSource{},
 		&MsgCreateOracleScript{},
 		&MsgEditOracleScript{},
+		&MsgUpdateDataSourceAccessPolicy{},
+		&MsgUpdateOracleScriptAccessPolicy{},
 		&MsgActivate{},
 		&MsgUpdateParams{},
 	)
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	DoNotModify = "[do-not-modify]"
 
@@ -8,6 +12,8 @@ const (
 	MaxClientIDLength    = 128
 	MaxSchemaLength      = 512
 	MaxURLLength         = 128
+	MaxSaltLength        = 64
+	MaxAccessPolicySize  = 128
 
 	MaxExecutableSize       = 8 * 1024        // 8kB
 	MaxWasmCodeSize         = 512 * 1024      // 512kB
@@ -17,3 +23,12 @@ const (
 )
 
 var DoNotModifyBytes = []byte(DoNotModify)
+
+// ReporterMsgTypeURLs returns message types that a validator grants to its reporters.
+// NOTE: have to be a function, or else sdk cannot find msgTypeURL for granting.
+func ReporterMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgReportData{}),
+		sdk.MsgTypeURL(&MsgCommitReport{}),
+	}
+}
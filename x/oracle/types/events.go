@@ -5,6 +5,7 @@ const (
 	EventTypeEditDataSource        = "edit_data_source"
 	EventTypeCreateOracleScript    = "create_oracle_script"
 	EventTypeEditOracleScript      = "edit_oracle_script"
+	EventTypeUpdateAccessPolicy    = "update_access_policy"
 	EventTypeRequest               = "request"
 	EventTypeRawRequest            = "raw_request"
 	EventTypeReport                = "report"
@@ -16,6 +17,10 @@ const (
 	EventTypeSendPacketFail        = "send_packet_fail"
 	EventTypeUpdateParams          = "update_params"
 	EventTypeHandleRequestSignFail = "handle_request_sign_fail"
+	EventTypeCollectTip            = "collect_tip"
+	EventTypeDistributeTip         = "distribute_tip"
+	EventTypeRefundTip             = "refund_tip"
+	EventTypeCommitReport          = "commit_report"
 
 	AttributeKeyID                  = "id"
 	AttributeKeySigningID           = "signing_id"
@@ -38,4 +43,6 @@ const (
 	AttributeKeyParams              = "params"
 	AttributeKeySigningErrCodespace = "signing_error_codespace"
 	AttributeKeySigningErrCode      = "signing_error_code"
+	AttributeKeyTip                 = "tip"
+	AttributeKeyCommitReveal        = "commit_reveal"
 )
@@ -54,6 +54,8 @@ var (
 	ParamsKeyPrefix = []byte{0x06}
 	// SigningResultStoreKeyPrefix is the prefix for signing ID store.
 	SigningResultStoreKeyPrefix = []byte{0x07}
+	// ReportCommitStoreKeyPrefix is the prefix for report commit store.
+	ReportCommitStoreKeyPrefix = []byte{0x08}
 	// ResultStoreKeyPrefix is the prefix for request result store.
 	ResultStoreKeyPrefix = []byte{0xff}
 
@@ -96,6 +98,16 @@ func ResultStoreKey(requestID RequestID) []byte {
 	return append(ResultStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
 }
 
+// ReportCommitStoreKey returns the key to retrieve all report commits for a request.
+func ReportCommitStoreKey(requestID RequestID) []byte {
+	return append(ReportCommitStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
+// ReportCommitOfValidatorKey returns the key to the report commit of a validator for a request.
+func ReportCommitOfValidatorKey(reqID RequestID, val sdk.ValAddress) []byte {
+	return append(ReportCommitStoreKey(reqID), val.Bytes()...)
+}
+
 // ReportsOfValidatorPrefixKey returns the prefix key to get all reports for a request from a validator.
 func ReportsOfValidatorPrefixKey(reqID RequestID, val sdk.ValAddress) []byte {
 	buf := append(ReportStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(reqID))...)
@@ -50,3 +50,9 @@ func TestReportsOfValidatorPrefixKey(t *testing.T) {
 	expect, _ := hex.DecodeString("020000000000000014b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
 	require.Equal(t, expect, ReportsOfValidatorPrefixKey(20, val))
 }
+
+func TestReportCommitOfValidatorKey(t *testing.T) {
+	val, _ := sdk.ValAddressFromHex("b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
+	expect, _ := hex.DecodeString("080000000000000014b80f2a5df7d5710b15622d1a9f1e3830ded5bda8")
+	require.Equal(t, expect, ReportCommitOfValidatorKey(20, val))
+}
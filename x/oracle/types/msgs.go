@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -12,6 +13,7 @@ import (
 var (
 	_ sdk.Msg = (*MsgRequestData)(nil)
 	_ sdk.Msg = (*MsgReportData)(nil)
+	_ sdk.Msg = (*MsgCommitReport)(nil)
 	_ sdk.Msg = (*MsgCreateDataSource)(nil)
 	_ sdk.Msg = (*MsgEditDataSource)(nil)
 	_ sdk.Msg = (*MsgCreateOracleScript)(nil)
@@ -21,6 +23,7 @@ var (
 
 	_ sdk.HasValidateBasic = (*MsgRequestData)(nil)
 	_ sdk.HasValidateBasic = (*MsgReportData)(nil)
+	_ sdk.HasValidateBasic = (*MsgCommitReport)(nil)
 	_ sdk.HasValidateBasic = (*MsgCreateDataSource)(nil)
 	_ sdk.HasValidateBasic = (*MsgEditDataSource)(nil)
 	_ sdk.HasValidateBasic = (*MsgCreateOracleScript)(nil)
@@ -88,6 +91,9 @@ func (m MsgRequestData) ValidateBasic() error {
 	if !m.FeeLimit.IsValid() {
 		return sdkerrors.ErrInvalidCoins.Wrap(m.FeeLimit.String())
 	}
+	if !m.Tip.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("tip: %s", m.Tip.String())
+	}
 
 	if _, ok := Encoder_name[int32(m.TSSEncoder)]; !ok {
 		return ErrInvalidOracleEncoder.Wrapf("invalid encoder type: %d", m.TSSEncoder)
@@ -124,6 +130,33 @@ func (m MsgReportData) ValidateBasic() error {
 		}
 		uniqueMap[r.ExternalID] = true
 	}
+	if len(m.Salt) > MaxSaltLength {
+		return WrapMaxError(ErrTooLongSalt, len(m.Salt), MaxSaltLength)
+	}
+	return nil
+}
+
+// NewMsgCommitReport creates a new MsgCommitReport instance
+func NewMsgCommitReport(requestID RequestID, hash []byte, validator sdk.ValAddress) *MsgCommitReport {
+	return &MsgCommitReport{
+		RequestID: requestID,
+		Hash:      hash,
+		Validator: validator.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgCommitReport instance (sdk.Msg interface).
+func (m MsgCommitReport) ValidateBasic() error {
+	valAddr, err := sdk.ValAddressFromBech32(m.Validator)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(valAddr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("validator: %s", m.Validator)
+	}
+	if len(m.Hash) != sha256.Size {
+		return ErrInvalidReportCommitHash.Wrapf("got: %d bytes, expected: %d bytes", len(m.Hash), sha256.Size)
+	}
 	return nil
 }
 
@@ -359,6 +392,52 @@ func (m MsgEditOracleScript) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgUpdateDataSourceAccessPolicy creates a new MsgUpdateDataSourceAccessPolicy instance
+func NewMsgUpdateDataSourceAccessPolicy(
+	dataSourceID DataSourceID, accessPolicy AccessPolicy, sender sdk.AccAddress,
+) *MsgUpdateDataSourceAccessPolicy {
+	return &MsgUpdateDataSourceAccessPolicy{
+		DataSourceID: dataSourceID,
+		AccessPolicy: accessPolicy,
+		Sender:       sender.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgUpdateDataSourceAccessPolicy instance (sdk.Msg interface).
+func (m MsgUpdateDataSourceAccessPolicy) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", m.Sender)
+	}
+	return m.AccessPolicy.Validate()
+}
+
+// NewMsgUpdateOracleScriptAccessPolicy creates a new MsgUpdateOracleScriptAccessPolicy instance
+func NewMsgUpdateOracleScriptAccessPolicy(
+	oracleScriptID OracleScriptID, accessPolicy AccessPolicy, sender sdk.AccAddress,
+) *MsgUpdateOracleScriptAccessPolicy {
+	return &MsgUpdateOracleScriptAccessPolicy{
+		OracleScriptID: oracleScriptID,
+		AccessPolicy:   accessPolicy,
+		Sender:         sender.String(),
+	}
+}
+
+// ValidateBasic checks whether the given MsgUpdateOracleScriptAccessPolicy instance (sdk.Msg interface).
+func (m MsgUpdateOracleScriptAccessPolicy) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", m.Sender)
+	}
+	return m.AccessPolicy.Validate()
+}
+
 // NewMsgActivate creates a new MsgActivate instance
 func NewMsgActivate(validator sdk.ValAddress) *MsgActivate {
 	return &MsgActivate{
@@ -371,15 +371,62 @@ func TestMsgRequestDataValidation(t *testing.T) {
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", BadCoins, 1, 1, GoodTestAddr, 0)},
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 0, 1, GoodTestAddr, 0)},
 		{false, NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 0, GoodTestAddr, 0)},
+		{true, msgRequestDataWithTip(GoodCoins)},
+		{false, msgRequestDataWithTip(BadCoins)},
 	})
 }
 
+func msgRequestDataWithTip(tip sdk.Coins) *MsgRequestData {
+	msg := NewMsgRequestData(1, []byte("calldata"), 10, 5, "client-id", GoodCoins, 1, 1, GoodTestAddr, 0)
+	msg.Tip = tip
+	return msg
+}
+
 func TestMsgReportDataValidation(t *testing.T) {
 	performValidateTests(t, []validateTestCase{
 		{true, NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}, {2, 2, []byte("data2")}}, GoodTestValAddr)},
 		{false, NewMsgReportData(1, []RawReport{}, GoodTestValAddr)},
 		{false, NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}, {1, 1, []byte("data2")}}, GoodTestValAddr)},
 		{false, NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}, {2, 2, []byte("data2")}}, EmptyValAddr)},
+		{true, msgReportDataWithSalt([]byte("salt"))},
+		{false, msgReportDataWithSalt(make([]byte, MaxSaltLength+1))},
+	})
+}
+
+func msgReportDataWithSalt(salt []byte) *MsgReportData {
+	msg := NewMsgReportData(1, []RawReport{{1, 1, []byte("data1")}}, GoodTestValAddr)
+	msg.Salt = salt
+	return msg
+}
+
+func TestMsgCommitReportValidation(t *testing.T) {
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgCommitReport(1, make([]byte, 32), GoodTestValAddr)},
+		{false, NewMsgCommitReport(1, make([]byte, 31), GoodTestValAddr)},
+		{false, NewMsgCommitReport(1, nil, GoodTestValAddr)},
+		{false, NewMsgCommitReport(1, make([]byte, 32), EmptyValAddr)},
+	})
+}
+
+func TestMsgUpdateDataSourceAccessPolicyValidation(t *testing.T) {
+	goodPolicy := NewAccessPolicy([]sdk.AccAddress{GoodTestAddr2}, nil, FeeCoins)
+	badPolicy := NewAccessPolicy([]sdk.AccAddress{GoodTestAddr2, GoodTestAddr2}, nil, nil)
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgUpdateDataSourceAccessPolicy(1, goodPolicy, GoodTestAddr)},
+		{true, NewMsgUpdateDataSourceAccessPolicy(1, AccessPolicy{}, GoodTestAddr)},
+		{false, NewMsgUpdateDataSourceAccessPolicy(1, badPolicy, GoodTestAddr)},
+		{false, NewMsgUpdateDataSourceAccessPolicy(1, goodPolicy, EmptyAddr)},
+	})
+}
+
+func TestMsgUpdateOracleScriptAccessPolicyValidation(t *testing.T) {
+	goodPolicy := NewAccessPolicy([]sdk.AccAddress{GoodTestAddr2}, nil, FeeCoins)
+	badPolicy := NewAccessPolicy([]sdk.AccAddress{GoodTestAddr2, GoodTestAddr2}, nil, nil)
+	performValidateTests(t, []validateTestCase{
+		{true, NewMsgUpdateOracleScriptAccessPolicy(1, goodPolicy, GoodTestAddr)},
+		{true, NewMsgUpdateOracleScriptAccessPolicy(1, AccessPolicy{}, GoodTestAddr)},
+		{false, NewMsgUpdateOracleScriptAccessPolicy(1, badPolicy, GoodTestAddr)},
+		{false, NewMsgUpdateOracleScriptAccessPolicy(1, goodPolicy, EmptyAddr)},
 	})
 }
 
@@ -39,7 +39,7 @@ const (
 	RESOLVE_STATUS_OPEN ResolveStatus = 0
 	// Success - the request has been resolved successfully with no errors.
 	RESOLVE_STATUS_SUCCESS ResolveStatus = 1
-	// Failure - an error occured during the request's resolve call.
+	// Failure - an error occurred during the request's resolve call.
 	RESOLVE_STATUS_FAILURE ResolveStatus = 2
 	// Expired - the request does not get enough reports from validator within the
 	// timeframe.
@@ -120,6 +120,8 @@ type DataSource struct {
 	// Fee is the data source fee per ask_count that data provider will receive
 	// from requester.
 	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// AccessPolicy restricts who can request data from the data source.
+	AccessPolicy AccessPolicy `protobuf:"bytes,7,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy"`
 }
 
 func (m *DataSource) Reset()         { *m = DataSource{} }
@@ -197,6 +199,13 @@ func (m *DataSource) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *DataSource) GetAccessPolicy() AccessPolicy {
+	if m != nil {
+		return m.AccessPolicy
+	}
+	return AccessPolicy{}
+}
+
 // OracleScript is the data structure for storing oracle scripts in the storage.
 type OracleScript struct {
 	// Owner is an address of the account who own the oracle script
@@ -216,6 +225,8 @@ type OracleScript struct {
 	// It is recommendded to store source code on IPFS and get its URL to preserve
 	// decentralization.
 	SourceCodeURL string `protobuf:"bytes,6,opt,name=source_code_url,json=sourceCodeUrl,proto3" json:"source_code_url,omitempty"`
+	// AccessPolicy restricts who can request the oracle script.
+	AccessPolicy AccessPolicy `protobuf:"bytes,7,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy"`
 }
 
 func (m *OracleScript) Reset()         { *m = OracleScript{} }
@@ -293,6 +304,81 @@ func (m *OracleScript) GetSourceCodeURL() string {
 	return ""
 }
 
+func (m *OracleScript) GetAccessPolicy() AccessPolicy {
+	if m != nil {
+		return m.AccessPolicy
+	}
+	return AccessPolicy{}
+}
+
+// AccessPolicy is the data structure for restricting the usage of an oracle
+// script or a data source. An empty access policy allows everyone.
+type AccessPolicy struct {
+	// AllowedRequesters is the list of account addresses that can send requests
+	// directly. If empty while AllowedChannels is not, no direct request is
+	// allowed.
+	AllowedRequesters []string `protobuf:"bytes,1,rep,name=allowed_requesters,json=allowedRequesters,proto3" json:"allowed_requesters,omitempty"`
+	// AllowedChannels is the list of IBC channels that can send requests. If
+	// empty while AllowedRequesters is not, no IBC request is allowed.
+	AllowedChannels []IBCChannel `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	// MinFeeLimit is the minimum fee limit that requests must be willing to pay.
+	MinFeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_fee_limit,json=minFeeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee_limit"`
+}
+
+func (m *AccessPolicy) Reset()         { *m = AccessPolicy{} }
+func (m *AccessPolicy) String() string { return proto.CompactTextString(m) }
+func (*AccessPolicy) ProtoMessage()    {}
+func (*AccessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{2}
+}
+func (m *AccessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessPolicy.Merge(m, src)
+}
+func (m *AccessPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessPolicy proto.InternalMessageInfo
+
+func (m *AccessPolicy) GetAllowedRequesters() []string {
+	if m != nil {
+		return m.AllowedRequesters
+	}
+	return nil
+}
+
+func (m *AccessPolicy) GetAllowedChannels() []IBCChannel {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *AccessPolicy) GetMinFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFeeLimit
+	}
+	return nil
+}
+
 // RawRequest is the data structure for storing raw requests in the storage.
 type RawRequest struct {
 	// ExternalID is an ID of the raw request
@@ -308,7 +394,7 @@ func (m *RawRequest) Reset()         { *m = RawRequest{} }
 func (m *RawRequest) String() string { return proto.CompactTextString(m) }
 func (*RawRequest) ProtoMessage()    {}
 func (*RawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{2}
+	return fileDescriptor_9714783eaff1514b, []int{3}
 }
 func (m *RawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +462,7 @@ func (m *RawReport) Reset()         { *m = RawReport{} }
 func (m *RawReport) String() string { return proto.CompactTextString(m) }
 func (*RawReport) ProtoMessage()    {}
 func (*RawReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{3}
+	return fileDescriptor_9714783eaff1514b, []int{4}
 }
 func (m *RawReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,13 +546,19 @@ type Request struct {
 	Requester string `protobuf:"bytes,12,opt,name=requester,proto3" json:"requester,omitempty"`
 	// FeeLimit is the maximum tokens that will be paid for this request.
 	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// Tip is the amount escrowed by the requester to be split among the
+	// validators who report before the request is resolved.
+	Tip github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=tip,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tip"`
+	// CommitReveal is a flag indicating whether validators must commit to their
+	// reports before revealing them.
+	CommitReveal bool `protobuf:"varint,15,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{4}
+	return fileDescriptor_9714783eaff1514b, []int{5}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,6 +678,20 @@ func (m *Request) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Request) GetTip() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tip
+	}
+	return nil
+}
+
+func (m *Request) GetCommitReveal() bool {
+	if m != nil {
+		return m.CommitReveal
+	}
+	return false
+}
+
 // Report is the data structure for storing reports in the storage.
 type Report struct {
 	// Validator is a validator address who submit the report
@@ -602,7 +708,7 @@ func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{5}
+	return fileDescriptor_9714783eaff1514b, []int{6}
 }
 func (m *Report) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,6 +758,62 @@ func (m *Report) GetRawReports() []RawReport {
 	return nil
 }
 
+// ReportCommit is the data structure for storing the commit of a report in
+// commit-reveal mode.
+type ReportCommit struct {
+	// Validator is a validator address who submit the commit
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Hash is the SHA256 hash of the report to be revealed
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *ReportCommit) Reset()         { *m = ReportCommit{} }
+func (m *ReportCommit) String() string { return proto.CompactTextString(m) }
+func (*ReportCommit) ProtoMessage()    {}
+func (*ReportCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9714783eaff1514b, []int{7}
+}
+func (m *ReportCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportCommit.Merge(m, src)
+}
+func (m *ReportCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportCommit proto.InternalMessageInfo
+
+func (m *ReportCommit) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ReportCommit) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // OracleRequestPacketData encodes an oracle request sent from other blockchains
 // to BandChain.
 type OracleRequestPacketData struct {
@@ -687,7 +849,7 @@ func (m *OracleRequestPacketData) Reset()         { *m = OracleRequestPacketData
 func (m *OracleRequestPacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketData) ProtoMessage()    {}
 func (*OracleRequestPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{6}
+	return fileDescriptor_9714783eaff1514b, []int{8}
 }
 func (m *OracleRequestPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +952,7 @@ func (m *OracleRequestPacketAcknowledgement) Reset()         { *m = OracleReques
 func (m *OracleRequestPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
 func (*OracleRequestPacketAcknowledgement) ProtoMessage()    {}
 func (*OracleRequestPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{7}
+	return fileDescriptor_9714783eaff1514b, []int{9}
 }
 func (m *OracleRequestPacketAcknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +1018,7 @@ func (m *OracleResponsePacketData) Reset()         { *m = OracleResponsePacketDa
 func (m *OracleResponsePacketData) String() string { return proto.CompactTextString(m) }
 func (*OracleResponsePacketData) ProtoMessage()    {}
 func (*OracleResponsePacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{8}
+	return fileDescriptor_9714783eaff1514b, []int{10}
 }
 func (m *OracleResponsePacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1137,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{9}
+	return fileDescriptor_9714783eaff1514b, []int{11}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1257,7 @@ func (m *SigningResult) Reset()         { *m = SigningResult{} }
 func (m *SigningResult) String() string { return proto.CompactTextString(m) }
 func (*SigningResult) ProtoMessage()    {}
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{10}
+	return fileDescriptor_9714783eaff1514b, []int{12}
 }
 func (m *SigningResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1321,7 @@ func (m *ValidatorStatus) Reset()         { *m = ValidatorStatus{} }
 func (m *ValidatorStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatus) ProtoMessage()    {}
 func (*ValidatorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{11}
+	return fileDescriptor_9714783eaff1514b, []int{13}
 }
 func (m *ValidatorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,7 +1376,7 @@ func (m *ActiveValidator) Reset()         { *m = ActiveValidator{} }
 func (m *ActiveValidator) String() string { return proto.CompactTextString(m) }
 func (*ActiveValidator) ProtoMessage()    {}
 func (*ActiveValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{12}
+	return fileDescriptor_9714783eaff1514b, []int{14}
 }
 func (m *ActiveValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,13 +1452,18 @@ type Params struct {
 	// IBCRequestEnabled is a flag indicating whether sending oracle request via
 	// IBC is allowed
 	IBCRequestEnabled bool `protobuf:"varint,11,opt,name=ibc_request_enabled,json=ibcRequestEnabled,proto3" json:"ibc_request_enabled,omitempty"`
+	// CommitRevealBlockCount is the number of blocks after a request in
+	// commit-reveal mode is made during which the requested validators can
+	// commit, after which its reveal phase starts. The reveal phase starts
+	// earlier if all requested validators have committed.
+	CommitRevealBlockCount uint64 `protobuf:"varint,12,opt,name=commit_reveal_block_count,json=commitRevealBlockCount,proto3" json:"commit_reveal_block_count,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{13}
+	return fileDescriptor_9714783eaff1514b, []int{15}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,6 +1569,13 @@ func (m *Params) GetIBCRequestEnabled() bool {
 	return false
 }
 
+func (m *Params) GetCommitRevealBlockCount() uint64 {
+	if m != nil {
+		return m.CommitRevealBlockCount
+	}
+	return 0
+}
+
 // PendingResolveList is a list of requests that are waiting to be resolved
 type PendingResolveList struct {
 	// RequestIDs is a list of request IDs that are waiting to be resolved
@@ -1412,7 +1586,7 @@ func (m *PendingResolveList) Reset()         { *m = PendingResolveList{} }
 func (m *PendingResolveList) String() string { return proto.CompactTextString(m) }
 func (*PendingResolveList) ProtoMessage()    {}
 func (*PendingResolveList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{14}
+	return fileDescriptor_9714783eaff1514b, []int{16}
 }
 func (m *PendingResolveList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1463,7 +1637,7 @@ func (m *IBCChannel) Reset()         { *m = IBCChannel{} }
 func (m *IBCChannel) String() string { return proto.CompactTextString(m) }
 func (*IBCChannel) ProtoMessage()    {}
 func (*IBCChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{15}
+	return fileDescriptor_9714783eaff1514b, []int{17}
 }
 func (m *IBCChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +1699,7 @@ func (m *RequestVerification) Reset()         { *m = RequestVerification{} }
 func (m *RequestVerification) String() string { return proto.CompactTextString(m) }
 func (*RequestVerification) ProtoMessage()    {}
 func (*RequestVerification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{16}
+	return fileDescriptor_9714783eaff1514b, []int{18}
 }
 func (m *RequestVerification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1610,7 +1784,7 @@ func (m *PriceResult) Reset()         { *m = PriceResult{} }
 func (m *PriceResult) String() string { return proto.CompactTextString(m) }
 func (*PriceResult) ProtoMessage()    {}
 func (*PriceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{17}
+	return fileDescriptor_9714783eaff1514b, []int{19}
 }
 func (m *PriceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +1860,7 @@ func (m *OracleResultSignatureOrder) Reset()         { *m = OracleResultSignatur
 func (m *OracleResultSignatureOrder) String() string { return proto.CompactTextString(m) }
 func (*OracleResultSignatureOrder) ProtoMessage()    {}
 func (*OracleResultSignatureOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9714783eaff1514b, []int{18}
+	return fileDescriptor_9714783eaff1514b, []int{20}
 }
 func (m *OracleResultSignatureOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1720,10 +1894,12 @@ func init() {
 	proto.RegisterEnum("band.oracle.v1.Encoder", Encoder_name, Encoder_value)
 	proto.RegisterType((*DataSource)(nil), "band.oracle.v1.DataSource")
 	proto.RegisterType((*OracleScript)(nil), "band.oracle.v1.OracleScript")
+	proto.RegisterType((*AccessPolicy)(nil), "band.oracle.v1.AccessPolicy")
 	proto.RegisterType((*RawRequest)(nil), "band.oracle.v1.RawRequest")
 	proto.RegisterType((*RawReport)(nil), "band.oracle.v1.RawReport")
 	proto.RegisterType((*Request)(nil), "band.oracle.v1.Request")
 	proto.RegisterType((*Report)(nil), "band.oracle.v1.Report")
+	proto.RegisterType((*ReportCommit)(nil), "band.oracle.v1.ReportCommit")
 	proto.RegisterType((*OracleRequestPacketData)(nil), "band.oracle.v1.OracleRequestPacketData")
 	proto.RegisterType((*OracleRequestPacketAcknowledgement)(nil), "band.oracle.v1.OracleRequestPacketAcknowledgement")
 	proto.RegisterType((*OracleResponsePacketData)(nil), "band.oracle.v1.OracleResponsePacketData")
@@ -1742,130 +1918,141 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/oracle.proto", fileDescriptor_9714783eaff1514b) }
 
 var fileDescriptor_9714783eaff1514b = []byte{
-	// 1965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdb, 0x6f, 0x63, 0x47,
-	0x19, 0xcf, 0xb1, 0x9d, 0xc4, 0xfe, 0xec, 0x38, 0xc9, 0x6c, 0xba, 0xf1, 0x7a, 0xb7, 0x71, 0x88,
-	0x0a, 0x2c, 0x2b, 0xb0, 0xc9, 0x16, 0x21, 0x76, 0x01, 0x89, 0xf8, 0xb2, 0xd4, 0x34, 0xda, 0x58,
-	0xe3, 0x64, 0x85, 0x90, 0xd0, 0xd1, 0xf8, 0x9c, 0x89, 0x33, 0xcd, 0xb9, 0x31, 0x73, 0x9c, 0x38,
-	0x7d, 0xe3, 0xad, 0xea, 0xd3, 0x3e, 0x23, 0x55, 0xaa, 0xd4, 0x37, 0x5e, 0x11, 0xff, 0x01, 0x88,
-	0xf2, 0x44, 0x1f, 0x91, 0x90, 0x52, 0xe4, 0x95, 0x10, 0xff, 0x00, 0x2f, 0xf0, 0x82, 0xe6, 0x72,
-	0x7c, 0xab, 0xe9, 0x76, 0x77, 0x0b, 0x0f, 0x3c, 0xf9, 0x7c, 0x97, 0x99, 0xf9, 0x6e, 0xbf, 0x6f,
-	0xbe, 0x31, 0xdc, 0xee, 0x91, 0xc0, 0xad, 0x85, 0x9c, 0x38, 0x1e, 0xad, 0x5d, 0xec, 0x9b, 0xaf,
-	0x6a, 0xc4, 0xc3, 0x38, 0x44, 0x45, 0x29, 0xac, 0x1a, 0xd6, 0xc5, 0x7e, 0x79, 0xab, 0x1f, 0xf6,
-	0x43, 0x25, 0xaa, 0xc9, 0x2f, 0xad, 0x55, 0xae, 0xf4, 0xc3, 0xb0, 0xef, 0xd1, 0x9a, 0xa2, 0x7a,
-	0x83, 0xd3, 0x5a, 0xcc, 0x7c, 0x2a, 0x62, 0xe2, 0x47, 0x46, 0x61, 0xc7, 0x09, 0x85, 0x1f, 0x8a,
-	0x5a, 0x8f, 0x08, 0x79, 0x46, 0x8f, 0xc6, 0x64, 0xbf, 0xe6, 0x84, 0x2c, 0xd0, 0xf2, 0xbd, 0x7f,
-	0x58, 0x00, 0x4d, 0x12, 0x93, 0x6e, 0x38, 0xe0, 0x0e, 0x45, 0x5b, 0xb0, 0x1c, 0x5e, 0x06, 0x94,
-	0x97, 0xac, 0x5d, 0xeb, 0x6e, 0x0e, 0x6b, 0x02, 0x21, 0xc8, 0x04, 0xc4, 0xa7, 0xa5, 0x94, 0x62,
-	0xaa, 0x6f, 0xb4, 0x0b, 0x79, 0x97, 0x0a, 0x87, 0xb3, 0x28, 0x66, 0x61, 0x50, 0x4a, 0x2b, 0xd1,
-	0x34, 0x0b, 0x95, 0x21, 0x7b, 0xca, 0x3c, 0xaa, 0x56, 0x66, 0x94, 0x78, 0x4c, 0x4b, 0x59, 0xcc,
-	0x29, 0x11, 0x03, 0x7e, 0x55, 0x5a, 0xd6, 0xb2, 0x84, 0x46, 0x3f, 0x87, 0xf4, 0x29, 0xa5, 0xa5,
-	0x95, 0xdd, 0xf4, 0xdd, 0xfc, 0xfd, 0x5b, 0x55, 0xed, 0x40, 0x55, 0x3a, 0x50, 0x35, 0x0e, 0x54,
-	0x1b, 0x21, 0x0b, 0xea, 0xdf, 0xfe, 0xf8, 0xba, 0xb2, 0xf4, 0xeb, 0x4f, 0x2b, 0x77, 0xfb, 0x2c,
-	0x3e, 0x1b, 0xf4, 0xaa, 0x4e, 0xe8, 0xd7, 0x8c, 0xb7, 0xfa, 0xe7, 0x5b, 0xc2, 0x3d, 0xaf, 0xc5,
-	0x57, 0x11, 0x15, 0x6a, 0x81, 0xc0, 0x72, 0xdf, 0x87, 0x99, 0xbf, 0x7f, 0x58, 0xb1, 0xf6, 0xfe,
-	0x64, 0x41, 0xe1, 0x48, 0x05, 0xb7, 0xab, 0x0c, 0xfe, 0x9f, 0x79, 0x7e, 0x13, 0x56, 0x84, 0x73,
-	0x46, 0x7d, 0x62, 0xfc, 0x36, 0x14, 0x7a, 0x00, 0xeb, 0x42, 0xe5, 0xc0, 0x76, 0x42, 0x97, 0xda,
-	0x03, 0xee, 0x95, 0x56, 0xa4, 0x42, 0x7d, 0x73, 0x74, 0x5d, 0x59, 0xd3, 0xe9, 0x69, 0x84, 0x2e,
-	0x3d, 0xc1, 0x87, 0x78, 0x4d, 0x4c, 0x48, 0xee, 0x19, 0x8f, 0x7e, 0x6b, 0x01, 0x60, 0x72, 0x89,
-	0xe9, 0x2f, 0x06, 0x54, 0xc4, 0xe8, 0x87, 0x90, 0xa7, 0xc3, 0x98, 0xf2, 0x80, 0x78, 0x36, 0x73,
-	0x95, 0x57, 0x99, 0xfa, 0x9d, 0xd1, 0x75, 0x05, 0x5a, 0x86, 0xdd, 0x6e, 0xfe, 0x73, 0x86, 0xc2,
-	0x90, 0x2c, 0x68, 0xbb, 0xe8, 0x11, 0x14, 0x5d, 0x12, 0x13, 0xdb, 0xd8, 0xc4, 0x5c, 0x15, 0x82,
-	0x4c, 0x7d, 0x77, 0x74, 0x5d, 0x29, 0x4c, 0x0a, 0x46, 0xed, 0x31, 0x43, 0xe3, 0x82, 0x3b, 0xa1,
-	0x5c, 0x19, 0x0a, 0x87, 0x78, 0x9e, 0xe4, 0xa9, 0x48, 0x15, 0xf0, 0x98, 0x36, 0x76, 0xff, 0xd2,
-	0x82, 0x9c, 0xb2, 0x3b, 0x0a, 0xf9, 0x2b, 0x9b, 0x7d, 0x1b, 0x72, 0x74, 0xc8, 0x62, 0x15, 0x43,
-	0x65, 0xf1, 0x1a, 0xce, 0x4a, 0x86, 0x0c, 0x95, 0x4c, 0xe6, 0x94, 0x1d, 0x99, 0x29, 0x1b, 0x7e,
-	0xbf, 0x0c, 0xab, 0x49, 0xe0, 0x1e, 0xc3, 0x86, 0x46, 0x9d, 0xad, 0x13, 0x3a, 0x31, 0xe3, 0x8d,
-	0xd1, 0x75, 0xa5, 0x38, 0x5d, 0x34, 0xca, 0x94, 0x39, 0x0e, 0x2e, 0x86, 0xd3, 0xf4, 0x6c, 0x04,
-	0x52, 0xb3, 0x11, 0x40, 0xfb, 0xb0, 0xc5, 0xf5, 0xb1, 0xd4, 0xb5, 0x2f, 0x88, 0xc7, 0x5c, 0x12,
-	0x87, 0x5c, 0x94, 0xd2, 0xbb, 0xe9, 0xbb, 0x39, 0x7c, 0x63, 0x2c, 0x7b, 0x32, 0x16, 0x49, 0x0f,
-	0x7d, 0x16, 0xd8, 0x4e, 0x38, 0x08, 0x62, 0x55, 0x5c, 0x19, 0x9c, 0xf5, 0x59, 0xd0, 0x90, 0x34,
-	0xfa, 0x2a, 0x14, 0xcd, 0x1a, 0xfb, 0x8c, 0xb2, 0xfe, 0x59, 0xac, 0x8a, 0x2c, 0x8d, 0xd7, 0x0c,
-	0xf7, 0x2d, 0xc5, 0x44, 0x5f, 0x81, 0x42, 0xa2, 0x26, 0xfb, 0x85, 0x2a, 0xb4, 0x34, 0xce, 0x1b,
-	0xde, 0x31, 0xf3, 0x29, 0xfa, 0x06, 0xe4, 0x1c, 0x8f, 0xd1, 0x40, 0xb9, 0xbf, 0xaa, 0x0a, 0xb1,
-	0x30, 0xba, 0xae, 0x64, 0x1b, 0x8a, 0xd9, 0x6e, 0xe2, 0xac, 0x16, 0xb7, 0x5d, 0xd4, 0x80, 0x02,
-	0x27, 0x97, 0xb6, 0x59, 0x2d, 0x4a, 0x59, 0x05, 0xdc, 0x72, 0x75, 0xb6, 0x81, 0x55, 0x27, 0xb5,
-	0x59, 0xcf, 0x48, 0xe4, 0xe2, 0x3c, 0x1f, 0x73, 0x04, 0x7a, 0x1b, 0xf2, 0xac, 0xe7, 0xd8, 0xce,
-	0x19, 0x09, 0x02, 0xea, 0x95, 0x72, 0xbb, 0xd6, 0xa2, 0x3d, 0xda, 0xf5, 0x46, 0x43, 0x6b, 0xd4,
-	0x8b, 0xb2, 0x26, 0x26, 0x34, 0x06, 0xd6, 0x73, 0xcc, 0x37, 0xaa, 0xc8, 0x22, 0xa2, 0xce, 0x20,
-	0xa6, 0x76, 0x9f, 0x88, 0x12, 0xa8, 0x28, 0x81, 0x61, 0xfd, 0x98, 0x08, 0xf4, 0x16, 0xe4, 0x63,
-	0x21, 0x6c, 0x1a, 0xc8, 0x3a, 0xe1, 0xa5, 0xfc, 0xae, 0x75, 0xb7, 0x78, 0x7f, 0x7b, 0xfe, 0xb4,
-	0x96, 0x16, 0xeb, 0xa3, 0x8e, 0xbb, 0x5d, 0x43, 0x63, 0x88, 0x85, 0x30, 0xdf, 0xe8, 0x0e, 0xe4,
-	0x92, 0x2c, 0xf1, 0x52, 0x41, 0x21, 0x7a, 0xc2, 0x40, 0x67, 0x90, 0x3b, 0xa5, 0xd4, 0xf6, 0x98,
-	0xcf, 0xe2, 0xd2, 0xda, 0x97, 0xdf, 0xd0, 0xb2, 0xa7, 0x94, 0x1e, 0xca, 0xcd, 0x4d, 0x1d, 0xff,
-	0xca, 0x82, 0x15, 0x03, 0xa4, 0x3b, 0x90, 0x1b, 0x17, 0x94, 0xe9, 0x69, 0x13, 0x06, 0xba, 0x07,
-	0x9b, 0x2c, 0xb0, 0x7b, 0xf4, 0x34, 0xe4, 0xd4, 0xe6, 0x54, 0x84, 0xde, 0x85, 0xc6, 0x4b, 0x16,
-	0xaf, 0xb3, 0xa0, 0xae, 0xf8, 0x58, 0xb3, 0xd1, 0x8f, 0x20, 0xaf, 0xf3, 0x2b, 0xf7, 0xd5, 0xb5,
-	0x29, 0xdd, 0x58, 0x94, 0x5e, 0xa9, 0x61, 0xb2, 0x0b, 0x3c, 0x61, 0x08, 0x63, 0xdc, 0xdf, 0xd2,
-	0xb0, 0xad, 0xb1, 0x62, 0xb2, 0xde, 0x21, 0xce, 0x39, 0x8d, 0x65, 0xf3, 0x98, 0x2d, 0x37, 0xeb,
-	0x73, 0xcb, 0x6d, 0x11, 0x3e, 0x53, 0x5f, 0x12, 0x3e, 0xe7, 0x3a, 0x94, 0x04, 0x1b, 0x11, 0xe7,
-	0xb3, 0x60, 0x23, 0xe2, 0x5c, 0x83, 0x6d, 0x06, 0x89, 0xcb, 0x73, 0x48, 0x9c, 0xc9, 0xfc, 0xca,
-	0x7f, 0x31, 0xf3, 0xb2, 0xd8, 0x23, 0x4e, 0x23, 0xc2, 0x75, 0xb1, 0xaf, 0xea, 0x62, 0x37, 0x2c,
-	0x59, 0xec, 0x73, 0x68, 0xc8, 0x3e, 0x0f, 0x0d, 0xb9, 0x97, 0x46, 0x83, 0x49, 0x34, 0x85, 0xbd,
-	0x05, 0x79, 0x3e, 0x70, 0xce, 0x83, 0xf0, 0xd2, 0xa3, 0x6e, 0x9f, 0xfa, 0x34, 0x88, 0xd1, 0x03,
-	0x80, 0xa4, 0x09, 0x8d, 0x3b, 0x6c, 0x79, 0x74, 0x5d, 0xc9, 0x99, 0x55, 0x2a, 0x79, 0x13, 0x62,
-	0x0c, 0xab, 0xb6, 0x6b, 0x8e, 0xf9, 0x43, 0x0a, 0x4a, 0xc9, 0x39, 0x22, 0x0a, 0x03, 0x41, 0x5f,
-	0xae, 0xa0, 0x66, 0x0d, 0x49, 0xbd, 0x80, 0x21, 0xaa, 0x3e, 0x02, 0x61, 0x4a, 0x20, 0x6d, 0xea,
-	0x23, 0x10, 0xba, 0x04, 0xe6, 0xbb, 0x6c, 0xe6, 0xb3, 0x5d, 0x56, 0xa9, 0x28, 0x94, 0x69, 0x95,
-	0xe5, 0x44, 0x45, 0xf1, 0x94, 0x4a, 0x53, 0xb6, 0x74, 0xad, 0x22, 0x62, 0x12, 0x0f, 0x84, 0xea,
-	0xd6, 0xc5, 0xfb, 0xaf, 0x7f, 0x06, 0x80, 0x5a, 0xab, 0xab, 0x94, 0x64, 0xc7, 0x9f, 0x22, 0xe5,
-	0xd4, 0xc1, 0xa9, 0x18, 0x78, 0xb1, 0xaa, 0x8f, 0x02, 0x36, 0x94, 0x89, 0xe4, 0x5f, 0xd2, 0xb2,
-	0x6d, 0x48, 0xc6, 0xff, 0x1f, 0x10, 0x67, 0xb3, 0xbb, 0xf2, 0xd2, 0xd9, 0x5d, 0x7d, 0x4e, 0x76,
-	0xb3, 0xcf, 0xcf, 0x6e, 0xee, 0x8b, 0x64, 0x17, 0x5e, 0x29, 0xbb, 0xf9, 0x05, 0xd9, 0xfd, 0xa3,
-	0x05, 0x6b, 0x5d, 0xd6, 0x0f, 0x58, 0xd0, 0x37, 0x49, 0x7e, 0x07, 0x40, 0x68, 0xc6, 0x04, 0x7a,
-	0x6f, 0xcb, 0x98, 0x18, 0x35, 0x15, 0x93, 0x87, 0x53, 0xbd, 0x48, 0x1a, 0xa3, 0xde, 0x0b, 0x4e,
-	0xe8, 0xd5, 0x9c, 0x33, 0xc2, 0x82, 0xda, 0xc5, 0x9b, 0xb5, 0xa1, 0xe2, 0xc7, 0x42, 0x98, 0xce,
-	0x34, 0x5e, 0x8d, 0x73, 0x66, 0xfb, 0xb6, 0x8b, 0xbe, 0x0e, 0xeb, 0x94, 0xf3, 0x90, 0xab, 0x91,
-	0x4c, 0x44, 0xc4, 0x49, 0x86, 0xe9, 0xa2, 0x62, 0x37, 0x12, 0x2e, 0x7a, 0x1d, 0x60, 0xa2, 0x68,
-	0xc0, 0x94, 0x1b, 0xeb, 0x18, 0x5f, 0x22, 0x58, 0x1f, 0xcf, 0x42, 0xc6, 0xf9, 0xdb, 0x90, 0x63,
-	0xc2, 0x26, 0x4e, 0xcc, 0x2e, 0xa8, 0xf2, 0x25, 0x8b, 0xb3, 0x4c, 0x1c, 0x28, 0x1a, 0x3d, 0x84,
-	0x65, 0xc1, 0x02, 0x73, 0xa6, 0x1c, 0x28, 0xf4, 0x7b, 0xa9, 0x9a, 0xbc, 0x97, 0xaa, 0xc7, 0xc9,
-	0x7b, 0xa9, 0x9e, 0x95, 0x3d, 0xf8, 0xe9, 0xa7, 0x15, 0x0b, 0xeb, 0x25, 0xe6, 0xc4, 0x03, 0x58,
-	0xd7, 0x7b, 0x8d, 0xcf, 0x45, 0x25, 0x58, 0x25, 0xae, 0xcb, 0xa9, 0x10, 0xe6, 0x62, 0x4d, 0x48,
-	0xf9, 0x88, 0x88, 0xc2, 0x4b, 0xca, 0x35, 0x0e, 0xb0, 0x26, 0xf6, 0x7e, 0x97, 0x81, 0x95, 0x0e,
-	0xe1, 0xc4, 0x17, 0x68, 0x1f, 0x5e, 0xf3, 0xc9, 0xd0, 0x9e, 0x9a, 0x97, 0x4c, 0x79, 0xa9, 0x24,
-	0x60, 0xe4, 0x93, 0xe1, 0x64, 0x4e, 0xd2, 0x85, 0xb6, 0x07, 0x6b, 0x72, 0xc9, 0xa4, 0xfc, 0xf5,
-	0xde, 0x79, 0x9f, 0x0c, 0x0f, 0x12, 0x04, 0xdc, 0x83, 0x4d, 0xa9, 0x93, 0xc0, 0xc5, 0x16, 0xec,
-	0xdd, 0x24, 0x84, 0xeb, 0x3e, 0x19, 0x36, 0x0c, 0xbf, 0xcb, 0xde, 0xa5, 0xa8, 0x06, 0x5b, 0xca,
-	0x04, 0x75, 0x37, 0xdb, 0x13, 0x75, 0x8d, 0x2a, 0xb9, 0x8f, 0xbe, 0xb6, 0x9b, 0xc9, 0x82, 0xef,
-	0xc0, 0x4d, 0x3a, 0x8c, 0x18, 0x27, 0xf2, 0x6d, 0x63, 0xf7, 0xbc, 0xd0, 0x39, 0x9f, 0xc1, 0xda,
-	0xd6, 0x44, 0x5a, 0x97, 0x42, 0x6d, 0xd2, 0x1b, 0x50, 0x94, 0xf7, 0x9c, 0x1d, 0x5e, 0x12, 0xe1,
-	0xab, 0x8b, 0x47, 0x61, 0x0f, 0x17, 0x24, 0xf7, 0x48, 0x32, 0xe5, 0xd5, 0xf3, 0x00, 0x6e, 0x45,
-	0x94, 0x4f, 0x46, 0xdf, 0x71, 0x54, 0x26, 0x57, 0xd9, 0xcd, 0x88, 0xf2, 0x71, 0xec, 0x4d, 0x64,
-	0xe4, 0xd2, 0x6f, 0x02, 0x12, 0xc4, 0x8f, 0x3c, 0x59, 0xc5, 0x31, 0xbf, 0x32, 0x26, 0xe9, 0xdb,
-	0x6d, 0x23, 0x91, 0x1c, 0xf3, 0x2b, 0x6d, 0xce, 0xf7, 0xa0, 0x64, 0x9a, 0x15, 0xa7, 0x97, 0x84,
-	0xbb, 0x76, 0x44, 0xb9, 0x43, 0x83, 0x98, 0xf4, 0x35, 0x2e, 0x33, 0xf8, 0x66, 0x68, 0xee, 0x12,
-	0x29, 0xee, 0x8c, 0xa5, 0xe8, 0x21, 0xdc, 0x62, 0x81, 0x2e, 0x2f, 0x3b, 0xa2, 0x01, 0xf1, 0xe2,
-	0x2b, 0xdb, 0x1d, 0x68, 0x7f, 0xcd, 0x68, 0xb9, 0x9d, 0x28, 0x74, 0xb4, 0xbc, 0x69, 0xc4, 0xa8,
-	0x05, 0x37, 0xe4, 0x54, 0x9b, 0x38, 0x45, 0x03, 0xd2, 0xf3, 0xa8, 0xab, 0x50, 0x9a, 0xad, 0xbf,
-	0x36, 0xba, 0xae, 0x6c, 0xb6, 0xeb, 0x0d, 0xe3, 0x53, 0x4b, 0x0b, 0xf1, 0x26, 0xeb, 0x39, 0xb3,
-	0x2c, 0x53, 0x89, 0xdf, 0x07, 0xd4, 0xa1, 0x81, 0xab, 0x61, 0x2c, 0xd1, 0x7f, 0xc8, 0x84, 0xba,
-	0xfe, 0x27, 0xfd, 0x4d, 0x16, 0x64, 0x5a, 0xde, 0xee, 0xe3, 0x26, 0x96, 0x0c, 0x5f, 0x3f, 0x81,
-	0xa9, 0x61, 0x19, 0x6d, 0xc3, 0xaa, 0xca, 0x7e, 0xd2, 0xe3, 0xf1, 0x8a, 0x24, 0xdb, 0xae, 0x04,
-	0xa1, 0x19, 0xc1, 0x93, 0x6e, 0x9e, 0xc3, 0x39, 0xc3, 0x19, 0x5f, 0xbc, 0x1f, 0xa5, 0xe0, 0x86,
-	0xb1, 0xf0, 0x09, 0xe5, 0xec, 0x94, 0x39, 0xda, 0xdb, 0xaf, 0x41, 0x56, 0xf5, 0x86, 0xc9, 0xd5,
-	0x91, 0x1f, 0x5d, 0x57, 0x56, 0x1b, 0x92, 0xd7, 0x6e, 0xe2, 0x55, 0x25, 0x6c, 0xbb, 0xb3, 0xa3,
-	0x69, 0x6a, 0x7e, 0x34, 0x9d, 0x6d, 0xd8, 0xe9, 0x17, 0x69, 0xd8, 0x73, 0x8f, 0xc7, 0xcc, 0x2b,
-	0xbf, 0x79, 0x97, 0x5f, 0xe6, 0xcd, 0x6b, 0xa2, 0xf4, 0x1b, 0x0b, 0xf2, 0x1d, 0xce, 0x1c, 0x6a,
-	0x9a, 0xae, 0x7c, 0xf8, 0x5f, 0xf9, 0xbd, 0xd0, 0x4b, 0x42, 0xae, 0x29, 0xb4, 0x03, 0xe0, 0x0f,
-	0xbc, 0x98, 0x45, 0x1e, 0x1b, 0x37, 0x8e, 0x29, 0x0e, 0x2a, 0x42, 0x2a, 0x1a, 0x1a, 0x30, 0xa7,
-	0xa2, 0xe1, 0x5c, 0x7c, 0x32, 0x2f, 0x12, 0x9f, 0xe7, 0x8f, 0x1b, 0x7b, 0x4f, 0x2d, 0x28, 0x8f,
-	0x87, 0xaa, 0x81, 0x17, 0xcb, 0x9e, 0x4e, 0xe2, 0x01, 0xa7, 0x47, 0x5c, 0x3e, 0x77, 0x5e, 0x7e,
-	0x68, 0x43, 0xfb, 0xb0, 0x9a, 0x4c, 0x98, 0xe9, 0xcf, 0x9d, 0x30, 0x71, 0xa2, 0xf7, 0x30, 0xf3,
-	0xde, 0x87, 0x95, 0xa5, 0x7b, 0xff, 0xb2, 0x60, 0x6d, 0xe6, 0xfa, 0x43, 0x3f, 0x80, 0x0a, 0x6e,
-	0x75, 0x8f, 0x0e, 0x9f, 0xb4, 0xec, 0xee, 0xf1, 0xc1, 0xf1, 0x49, 0xd7, 0x3e, 0xea, 0xb4, 0x1e,
-	0xdb, 0x27, 0x8f, 0xbb, 0x9d, 0x56, 0xa3, 0xfd, 0xa8, 0xdd, 0x6a, 0x6e, 0x2c, 0x95, 0xb7, 0xdf,
-	0xff, 0x60, 0xf7, 0xc6, 0x02, 0x35, 0xf4, 0x5d, 0xb8, 0x39, 0xc7, 0xee, 0x9e, 0x34, 0x1a, 0xad,
-	0x6e, 0x77, 0xc3, 0x2a, 0x97, 0xdf, 0xff, 0x60, 0xf7, 0x3f, 0x48, 0x17, 0xac, 0x7b, 0x74, 0xd0,
-	0x3e, 0x3c, 0xc1, 0xad, 0x8d, 0xd4, 0xc2, 0x75, 0x46, 0xba, 0x60, 0x5d, 0xeb, 0xa7, 0x9d, 0x36,
-	0x6e, 0x35, 0x37, 0xd2, 0x0b, 0xd7, 0x19, 0x69, 0x39, 0xf3, 0xde, 0x47, 0x3b, 0x4b, 0xf7, 0xde,
-	0x81, 0xd5, 0xe4, 0xad, 0xb9, 0x0d, 0x37, 0x5a, 0x8f, 0x1b, 0x47, 0xcd, 0x16, 0x9e, 0x75, 0x15,
-	0x6d, 0xc2, 0x5a, 0x22, 0xe8, 0xe0, 0xa3, 0xe3, 0xa3, 0x0d, 0x0b, 0x6d, 0xc1, 0x46, 0xc2, 0x7a,
-	0x74, 0x72, 0x78, 0x68, 0x1f, 0xd4, 0xdb, 0x1b, 0xa9, 0xe9, 0x1d, 0x3a, 0x07, 0xf8, 0xb8, 0x7d,
-	0xa0, 0x05, 0x69, 0x7d, 0x56, 0xbd, 0xfd, 0xf1, 0x68, 0xc7, 0xfa, 0x64, 0xb4, 0x63, 0xfd, 0x75,
-	0xb4, 0x63, 0x3d, 0x7d, 0xb6, 0xb3, 0xf4, 0xc9, 0xb3, 0x9d, 0xa5, 0x3f, 0x3f, 0xdb, 0x59, 0xfa,
-	0x59, 0xed, 0x0b, 0x0c, 0x03, 0xe6, 0x8f, 0x4c, 0x35, 0x0b, 0xf4, 0x56, 0x94, 0xc6, 0x9b, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x11, 0x4d, 0x45, 0xe4, 0x14, 0x00, 0x00,
+	// 2133 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x63, 0x57,
+	0x15, 0xcf, 0xb3, 0x9d, 0xd8, 0x3e, 0xfe, 0x48, 0x72, 0x27, 0x9d, 0xb8, 0x99, 0x69, 0x6c, 0x42,
+	0x81, 0x30, 0xa2, 0x36, 0x99, 0x22, 0xc4, 0x0c, 0x20, 0x11, 0x3b, 0x4e, 0x6b, 0x1a, 0x4d, 0xac,
+	0xeb, 0x64, 0x84, 0x90, 0xd0, 0xd3, 0xf5, 0x7b, 0x37, 0xce, 0x6d, 0xde, 0x17, 0xef, 0x3e, 0xe7,
+	0xa3, 0x3b, 0x76, 0x55, 0x57, 0xb3, 0x44, 0x48, 0x95, 0x2a, 0x75, 0xc7, 0x16, 0xb1, 0x65, 0x4b,
+	0xbb, 0xeb, 0x12, 0x09, 0x29, 0x45, 0x1e, 0x09, 0xf1, 0x37, 0xc0, 0x02, 0x74, 0x3f, 0xde, 0xf3,
+	0x47, 0x4d, 0xa7, 0x93, 0x99, 0xb2, 0x60, 0x15, 0x9f, 0xdf, 0x39, 0xf7, 0xbe, 0x73, 0xcf, 0x3d,
+	0xbf, 0x73, 0xce, 0x0d, 0xdc, 0xe9, 0x13, 0xcf, 0x6e, 0xf8, 0x21, 0xb1, 0x1c, 0xda, 0x38, 0xdf,
+	0xd1, 0xbf, 0xea, 0x41, 0xe8, 0x47, 0x3e, 0x2a, 0x0b, 0x65, 0x5d, 0x43, 0xe7, 0x3b, 0x1b, 0x6b,
+	0x03, 0x7f, 0xe0, 0x4b, 0x55, 0x43, 0xfc, 0x52, 0x56, 0x1b, 0xd5, 0x81, 0xef, 0x0f, 0x1c, 0xda,
+	0x90, 0x52, 0x7f, 0x78, 0xd2, 0x88, 0x98, 0x4b, 0x79, 0x44, 0xdc, 0x40, 0x1b, 0x6c, 0x5a, 0x3e,
+	0x77, 0x7d, 0xde, 0xe8, 0x13, 0x2e, 0xbe, 0xd1, 0xa7, 0x11, 0xd9, 0x69, 0x58, 0x3e, 0xf3, 0x94,
+	0x7e, 0xeb, 0x4f, 0x29, 0x80, 0x3d, 0x12, 0x91, 0x9e, 0x3f, 0x0c, 0x2d, 0x8a, 0xd6, 0x60, 0xd1,
+	0xbf, 0xf0, 0x68, 0x58, 0x31, 0x6a, 0xc6, 0x76, 0x1e, 0x2b, 0x01, 0x21, 0xc8, 0x78, 0xc4, 0xa5,
+	0x95, 0x94, 0x04, 0xe5, 0x6f, 0x54, 0x83, 0x82, 0x4d, 0xb9, 0x15, 0xb2, 0x20, 0x62, 0xbe, 0x57,
+	0x49, 0x4b, 0xd5, 0x24, 0x84, 0x36, 0x20, 0x77, 0xc2, 0x1c, 0x2a, 0x57, 0x66, 0xa4, 0x3a, 0x91,
+	0x85, 0x2e, 0x0a, 0x29, 0xe1, 0xc3, 0xf0, 0xaa, 0xb2, 0xa8, 0x74, 0xb1, 0x8c, 0x7e, 0x05, 0xe9,
+	0x13, 0x4a, 0x2b, 0x4b, 0xb5, 0xf4, 0x76, 0xe1, 0xfe, 0xab, 0x75, 0x75, 0x80, 0xba, 0x38, 0x40,
+	0x5d, 0x1f, 0xa0, 0xde, 0xf2, 0x99, 0xd7, 0xfc, 0xfe, 0x27, 0xd7, 0xd5, 0x85, 0xdf, 0x7f, 0x5e,
+	0xdd, 0x1e, 0xb0, 0xe8, 0x74, 0xd8, 0xaf, 0x5b, 0xbe, 0xdb, 0xd0, 0xa7, 0x55, 0x7f, 0xde, 0xe0,
+	0xf6, 0x59, 0x23, 0xba, 0x0a, 0x28, 0x97, 0x0b, 0x38, 0x16, 0xfb, 0xa2, 0xb7, 0xa0, 0x44, 0x2c,
+	0x8b, 0x72, 0x6e, 0x06, 0xbe, 0xc3, 0xac, 0xab, 0x4a, 0xb6, 0x66, 0x6c, 0x17, 0xee, 0xdf, 0xad,
+	0x4f, 0x07, 0xbc, 0xbe, 0x2b, 0x8d, 0xba, 0xd2, 0xa6, 0x99, 0x11, 0xdf, 0xc2, 0x45, 0x32, 0x81,
+	0x3d, 0xcc, 0xfc, 0xe3, 0xa3, 0xaa, 0xb1, 0xf5, 0xdb, 0x14, 0x14, 0x0f, 0xe5, 0xa2, 0x9e, 0x3c,
+	0xf9, 0xff, 0x2c, 0x84, 0xb7, 0x61, 0x89, 0x5b, 0xa7, 0xd4, 0x25, 0x3a, 0x80, 0x5a, 0x42, 0x0f,
+	0x60, 0x99, 0xcb, 0xcb, 0x34, 0x2d, 0xdf, 0xa6, 0xe6, 0x30, 0x74, 0x2a, 0x4b, 0xc2, 0xa0, 0xb9,
+	0x3a, 0xba, 0xae, 0x96, 0xd4, 0x3d, 0xb7, 0x7c, 0x9b, 0x1e, 0xe3, 0x03, 0x5c, 0xe2, 0x63, 0x31,
+	0x74, 0x5e, 0x76, 0x68, 0x7e, 0x93, 0x82, 0xe2, 0xa4, 0x29, 0x7a, 0x03, 0x10, 0x71, 0x1c, 0xff,
+	0x82, 0xda, 0x66, 0x48, 0x7f, 0x3d, 0xa4, 0x3c, 0xa2, 0x21, 0xaf, 0x18, 0xb5, 0xf4, 0x76, 0x1e,
+	0xaf, 0x6a, 0x0d, 0x4e, 0x14, 0xe8, 0x1d, 0x58, 0x89, 0xcd, 0xad, 0x53, 0xe2, 0x79, 0xd4, 0xe1,
+	0x95, 0x94, 0xcc, 0x8a, 0x8d, 0x59, 0x8f, 0x3a, 0xcd, 0x56, 0x4b, 0x99, 0x68, 0x7f, 0x96, 0xf5,
+	0x4a, 0x8d, 0x72, 0xe4, 0x43, 0xc9, 0x65, 0x9e, 0x79, 0x42, 0xa9, 0xe9, 0x30, 0x97, 0x45, 0x95,
+	0xf4, 0xcb, 0xcf, 0xaf, 0x82, 0xcb, 0xbc, 0x7d, 0x4a, 0x0f, 0xc4, 0xfe, 0x3a, 0x06, 0x7f, 0x34,
+	0x00, 0x30, 0xb9, 0xd0, 0xa7, 0x42, 0x3f, 0x85, 0x02, 0xbd, 0x8c, 0x68, 0xe8, 0x11, 0xc7, 0x64,
+	0xb6, 0x4c, 0x91, 0x4c, 0xf3, 0xee, 0xe8, 0xba, 0x0a, 0x6d, 0x0d, 0x77, 0xf6, 0xfe, 0x39, 0x25,
+	0x61, 0x88, 0x17, 0x74, 0x6c, 0xb4, 0x0f, 0x65, 0x9b, 0x44, 0xc4, 0xd4, 0x17, 0xcc, 0x6c, 0x99,
+	0x4f, 0x99, 0x66, 0x6d, 0x74, 0x5d, 0x2d, 0x8e, 0x69, 0x2c, 0xf7, 0x98, 0x92, 0x71, 0xd1, 0x1e,
+	0x4b, 0xb6, 0xc8, 0x2b, 0x8b, 0x38, 0x8e, 0xc0, 0x64, 0xda, 0x15, 0x71, 0x22, 0xc7, 0x77, 0x67,
+	0x40, 0x5e, 0xfa, 0x1d, 0xf8, 0xe1, 0x0b, 0xbb, 0x7d, 0x07, 0xf2, 0xf4, 0x92, 0x45, 0x32, 0x21,
+	0xa5, 0xc7, 0x25, 0x9c, 0x13, 0x80, 0xc8, 0x3b, 0xc1, 0x8c, 0x09, 0x3f, 0x32, 0x13, 0x3e, 0x7c,
+	0xba, 0x04, 0xd9, 0x38, 0x70, 0x8f, 0x60, 0x45, 0xdd, 0xb6, 0xa9, 0xd8, 0x31, 0x76, 0xe3, 0xf5,
+	0xd1, 0x75, 0xb5, 0x3c, 0xc9, 0x40, 0xe9, 0xca, 0x0c, 0x82, 0xcb, 0xfe, 0xa4, 0x3c, 0x1d, 0x81,
+	0xd4, 0x74, 0x04, 0xd0, 0x0e, 0xac, 0xc5, 0xe9, 0x69, 0x9b, 0xe7, 0xc4, 0x61, 0x36, 0x89, 0xfc,
+	0x90, 0xcb, 0x8c, 0xc9, 0xe3, 0x5b, 0x89, 0xee, 0x71, 0xa2, 0x12, 0x27, 0x14, 0xd9, 0x65, 0xf9,
+	0x43, 0x2f, 0x92, 0x4c, 0xcd, 0xe0, 0x9c, 0xcb, 0xbc, 0x96, 0x90, 0xd1, 0xb7, 0xa0, 0xac, 0xd7,
+	0x98, 0xa7, 0x94, 0x0d, 0x4e, 0x23, 0xc9, 0xd8, 0x34, 0x2e, 0x69, 0xf4, 0x6d, 0x09, 0xa2, 0x6f,
+	0x40, 0x31, 0x36, 0x13, 0x55, 0x5c, 0xb2, 0x36, 0x8d, 0x0b, 0x1a, 0x3b, 0x62, 0x2e, 0x45, 0xdf,
+	0x85, 0xbc, 0xe5, 0x30, 0xea, 0xc9, 0xe3, 0x67, 0x25, 0xab, 0x8b, 0xa3, 0xeb, 0x6a, 0xae, 0x25,
+	0xc1, 0xce, 0x1e, 0xce, 0x29, 0x75, 0xc7, 0x46, 0x2d, 0x28, 0x86, 0xe4, 0x22, 0xe6, 0x19, 0xaf,
+	0xe4, 0xe6, 0x13, 0x67, 0x9c, 0x9b, 0x9a, 0x38, 0x85, 0x30, 0x41, 0x04, 0x03, 0x0b, 0xac, 0x6f,
+	0xc5, 0xec, 0xab, 0xe4, 0x6b, 0xc6, 0xbc, 0x3d, 0x26, 0xc8, 0x57, 0x16, 0x39, 0x31, 0x96, 0x31,
+	0xb0, 0xbe, 0xa5, 0x7f, 0xa3, 0xaa, 0x48, 0x22, 0x6a, 0x0d, 0x23, 0x6a, 0x0e, 0x08, 0xaf, 0x80,
+	0x8c, 0x12, 0x68, 0xe8, 0x2d, 0xc2, 0xd1, 0xdb, 0x50, 0x88, 0x38, 0x37, 0xa9, 0x27, 0xf2, 0x24,
+	0xac, 0x14, 0x6a, 0xc6, 0x76, 0xf9, 0xfe, 0xfa, 0xec, 0xd7, 0xda, 0x4a, 0xad, 0x3e, 0x75, 0xd4,
+	0xeb, 0x69, 0x19, 0x43, 0xc4, 0xb9, 0xfe, 0x8d, 0xee, 0x42, 0x3e, 0x29, 0x30, 0x95, 0xa2, 0x2c,
+	0x8f, 0x63, 0x00, 0x9d, 0x42, 0x7e, 0x5c, 0x06, 0x4a, 0x2f, 0xbf, 0x0c, 0xe4, 0x4e, 0x74, 0x0d,
+	0x10, 0xad, 0x2c, 0x62, 0x41, 0xa5, 0xfc, 0x35, 0xb4, 0xb2, 0x88, 0x05, 0xe8, 0x9b, 0x50, 0xb2,
+	0x7c, 0xd7, 0x65, 0x91, 0x19, 0xd2, 0x73, 0x4a, 0x9c, 0xca, 0x72, 0xcd, 0xd8, 0xce, 0xe1, 0xa2,
+	0x02, 0xb1, 0xc4, 0x34, 0x97, 0x7e, 0x67, 0xc0, 0x92, 0x26, 0xf3, 0x5d, 0xc8, 0x27, 0x49, 0xad,
+	0x9b, 0xd4, 0x18, 0x40, 0xf7, 0x60, 0x95, 0x79, 0x66, 0x9f, 0x9e, 0xf8, 0x21, 0x35, 0x43, 0xca,
+	0x7d, 0xe7, 0x5c, 0x71, 0x36, 0x87, 0x97, 0x99, 0xd7, 0x94, 0x38, 0x56, 0x30, 0xfa, 0x19, 0x14,
+	0x54, 0x8e, 0x89, 0x7d, 0x79, 0x52, 0x51, 0xe7, 0xa5, 0x98, 0xb0, 0xd0, 0x19, 0x06, 0x61, 0x0c,
+	0x70, 0xed, 0xdc, 0x3e, 0x14, 0x15, 0xd0, 0x92, 0x8e, 0x3f, 0xc3, 0x43, 0x04, 0x99, 0x53, 0xc2,
+	0x4f, 0x35, 0x6d, 0xe5, 0x6f, 0xbd, 0xcf, 0xdf, 0xd3, 0xb0, 0xae, 0x78, 0xaf, 0x33, 0xb8, 0x4b,
+	0xac, 0x33, 0x1a, 0x89, 0x42, 0x38, 0x4d, 0x1d, 0xe3, 0x4b, 0xa9, 0x33, 0xaf, 0xd6, 0xa4, 0x5e,
+	0x52, 0xad, 0x99, 0xa9, 0xb6, 0xa2, 0x70, 0x10, 0x7e, 0x36, 0x5d, 0x38, 0x08, 0x3f, 0x53, 0x85,
+	0x63, 0xaa, 0xaa, 0x2c, 0xce, 0x54, 0x95, 0xa9, 0x2c, 0x5e, 0xfa, 0x3a, 0xb3, 0xb8, 0x0a, 0x85,
+	0x20, 0xa4, 0x01, 0x09, 0x15, 0x71, 0xb3, 0x8a, 0xb8, 0x1a, 0x12, 0xc4, 0x9d, 0x61, 0x76, 0xee,
+	0x59, 0xcc, 0xce, 0xdf, 0x98, 0xd9, 0xfa, 0xa2, 0x29, 0x6c, 0xcd, 0xb9, 0xe7, 0x5d, 0xeb, 0xcc,
+	0xf3, 0x2f, 0x1c, 0x6a, 0x0f, 0xa8, 0x4b, 0xbd, 0x08, 0x3d, 0x00, 0x88, 0x0b, 0x6a, 0xd2, 0x2d,
+	0x36, 0x46, 0xd7, 0xd5, 0xbc, 0x5e, 0x25, 0x2f, 0x6f, 0x2c, 0x24, 0x25, 0xa2, 0x63, 0xeb, 0xcf,
+	0xfc, 0x39, 0x05, 0x95, 0xf8, 0x3b, 0x3c, 0xf0, 0x3d, 0x4e, 0x6f, 0x96, 0x50, 0xd3, 0x8e, 0xa4,
+	0x9e, 0xc3, 0x11, 0x99, 0x1f, 0x1e, 0xd7, 0x29, 0x90, 0xd6, 0xf9, 0xe1, 0x71, 0x95, 0x02, 0xb3,
+	0x1d, 0x23, 0xf3, 0xc5, 0x8e, 0x21, 0x4d, 0x24, 0x5b, 0x95, 0xc9, 0x62, 0x6c, 0x22, 0x31, 0x69,
+	0xb2, 0x07, 0x65, 0x2d, 0x9a, 0x3c, 0x22, 0xd1, 0x90, 0xcb, 0xce, 0x53, 0xbe, 0xff, 0xda, 0x17,
+	0x88, 0xac, 0xac, 0x7a, 0xd2, 0x48, 0x74, 0xaf, 0x09, 0x51, 0x8c, 0xa3, 0x21, 0xe5, 0x43, 0x27,
+	0x92, 0xf9, 0x51, 0xc4, 0x5a, 0xd2, 0x91, 0xfc, 0x6b, 0x5a, 0x94, 0x1f, 0x01, 0xfc, 0xff, 0x11,
+	0x71, 0xfa, 0x76, 0x97, 0x6e, 0x7c, 0xbb, 0xd9, 0x67, 0xdc, 0x6e, 0xee, 0xd9, 0xb7, 0x9b, 0xff,
+	0x2a, 0xb7, 0x0b, 0x2f, 0x74, 0xbb, 0x85, 0x39, 0xb7, 0xfb, 0xa9, 0x01, 0xa5, 0x1e, 0x1b, 0x78,
+	0xcc, 0x1b, 0xe8, 0x4b, 0x7e, 0x17, 0x80, 0x2b, 0x60, 0x4c, 0xbd, 0x77, 0x44, 0x4c, 0xb4, 0x99,
+	0x8c, 0xc9, 0xc3, 0x89, 0x5a, 0x24, 0x9c, 0x91, 0x2f, 0x52, 0xcb, 0x77, 0x1a, 0xd6, 0x29, 0x61,
+	0x5e, 0xe3, 0xfc, 0xcd, 0xc6, 0xa5, 0xc4, 0x23, 0xce, 0x75, 0x65, 0x4a, 0x56, 0xe3, 0xbc, 0xde,
+	0xbe, 0x63, 0xa3, 0xef, 0xc0, 0x32, 0x0d, 0x43, 0x3f, 0x94, 0xe3, 0x25, 0x0f, 0x88, 0x15, 0xbf,
+	0xb2, 0xca, 0x12, 0x6e, 0xc5, 0x28, 0x7a, 0x0d, 0x60, 0x6c, 0xa8, 0xc9, 0x94, 0x4f, 0x6c, 0xf4,
+	0x59, 0x02, 0x58, 0x4e, 0xe6, 0x3a, 0x7d, 0xf8, 0x3b, 0x90, 0x67, 0xdc, 0x24, 0x56, 0xc4, 0xce,
+	0xa9, 0x3c, 0x4b, 0x0e, 0xe7, 0x18, 0xdf, 0x95, 0x32, 0x7a, 0x08, 0x8b, 0x9c, 0x79, 0xfa, 0x9b,
+	0x62, 0x38, 0x52, 0x2f, 0xf2, 0x7a, 0xfc, 0x22, 0xaf, 0x1f, 0xc5, 0x2f, 0xf2, 0x66, 0x4e, 0xd4,
+	0xe0, 0x27, 0x9f, 0x57, 0x0d, 0xac, 0x96, 0xe8, 0x2f, 0xee, 0xc2, 0xb2, 0xda, 0x2b, 0xf9, 0x2e,
+	0xaa, 0x40, 0x96, 0xd8, 0x76, 0x48, 0x39, 0xd7, 0xed, 0x2f, 0x16, 0xc5, 0xeb, 0x32, 0xf0, 0x2f,
+	0x68, 0xa8, 0x78, 0x80, 0x95, 0xb0, 0xf5, 0xef, 0x0c, 0x2c, 0x75, 0x49, 0x48, 0x5c, 0x8e, 0x76,
+	0xe0, 0x15, 0x97, 0x5c, 0x9a, 0x13, 0xb3, 0x9f, 0x4e, 0x2f, 0x79, 0x09, 0x18, 0xb9, 0xe4, 0x72,
+	0x3c, 0xf3, 0xa9, 0x44, 0xdb, 0x82, 0x92, 0x58, 0x32, 0x4e, 0x7f, 0xb5, 0x77, 0xc1, 0x25, 0x97,
+	0xbb, 0x31, 0x03, 0xee, 0xc1, 0xaa, 0xb0, 0x89, 0xe9, 0x62, 0x72, 0xf6, 0x5e, 0x1c, 0xc2, 0x65,
+	0x97, 0x5c, 0xb6, 0x34, 0xde, 0x63, 0xef, 0x51, 0xd4, 0x80, 0x35, 0xe9, 0x82, 0x6c, 0xe9, 0xe6,
+	0xd8, 0x5c, 0xb1, 0x4a, 0xec, 0xa3, 0xba, 0xfd, 0x5e, 0xbc, 0xe0, 0x07, 0x70, 0x9b, 0x5e, 0x06,
+	0x2c, 0x24, 0xe2, 0xd1, 0x6b, 0xf6, 0x1d, 0xdf, 0x3a, 0x9b, 0xe2, 0xda, 0xda, 0x58, 0xdb, 0x14,
+	0x4a, 0xe5, 0xd2, 0xeb, 0x50, 0x16, 0x7d, 0xce, 0xf4, 0x2f, 0x08, 0x77, 0x65, 0xe3, 0x91, 0xdc,
+	0xc3, 0x45, 0x81, 0x1e, 0x0a, 0x50, 0xb4, 0x9e, 0x07, 0xf0, 0x6a, 0x40, 0xc3, 0xf1, 0x18, 0x9f,
+	0x44, 0x65, 0xdc, 0xca, 0x6e, 0x07, 0x34, 0x4c, 0x62, 0xaf, 0x23, 0x23, 0x96, 0x7e, 0x0f, 0x10,
+	0x27, 0x6e, 0xe0, 0x88, 0x2c, 0x8e, 0xc2, 0x2b, 0xed, 0x92, 0xea, 0x6e, 0x2b, 0xb1, 0xe6, 0x28,
+	0xbc, 0x52, 0xee, 0xfc, 0x08, 0x2a, 0xba, 0x58, 0x85, 0xf4, 0x82, 0x84, 0xb6, 0x19, 0xd0, 0xd0,
+	0xa2, 0x5e, 0x44, 0x06, 0x8a, 0x97, 0x19, 0x7c, 0xdb, 0xd7, 0xbd, 0x44, 0xa8, 0xbb, 0x89, 0x16,
+	0x3d, 0x84, 0x57, 0x99, 0xa7, 0xd2, 0xcb, 0x0c, 0xa8, 0x47, 0x9c, 0xe8, 0xca, 0xb4, 0x87, 0xea,
+	0xbc, 0x7a, 0x4c, 0x5e, 0x8f, 0x0d, 0xba, 0x4a, 0xbf, 0xa7, 0xd5, 0xa8, 0x0d, 0xb7, 0xc4, 0x84,
+	0x1e, 0x1f, 0x8a, 0x7a, 0xa4, 0xef, 0x50, 0x5b, 0xb2, 0x34, 0xd7, 0x7c, 0x65, 0x74, 0x5d, 0x5d,
+	0xed, 0x34, 0x5b, 0xfa, 0x4c, 0x6d, 0xa5, 0xc4, 0xab, 0xac, 0x6f, 0x4d, 0x43, 0x22, 0x4a, 0x53,
+	0x93, 0xe4, 0xd4, 0x25, 0x14, 0x95, 0xf7, 0x93, 0x53, 0xe5, 0xf8, 0x1a, 0x74, 0x12, 0xff, 0x18,
+	0x50, 0x97, 0x7a, 0xb6, 0xaa, 0x00, 0xa2, 0x70, 0x1c, 0x30, 0x2e, 0x27, 0x87, 0x71, 0x69, 0x54,
+	0x2f, 0xfd, 0x0c, 0x86, 0xa4, 0xfe, 0xc5, 0xf3, 0xdf, 0xcf, 0x61, 0xe2, 0xcd, 0x80, 0xd6, 0x21,
+	0x2b, 0x13, 0x27, 0x6e, 0x0f, 0x78, 0x49, 0x88, 0x1d, 0x5b, 0xf0, 0x57, 0xbf, 0x44, 0xe2, 0x46,
+	0x90, 0xc7, 0x79, 0x8d, 0x24, 0x3d, 0xfb, 0xe3, 0x14, 0xdc, 0xd2, 0x87, 0x7b, 0x4c, 0x43, 0x76,
+	0xc2, 0x2c, 0x15, 0xa8, 0x6f, 0x43, 0x4e, 0x96, 0x95, 0x71, 0xd7, 0x29, 0x8c, 0xae, 0xab, 0xd9,
+	0x96, 0xc0, 0x3a, 0x7b, 0x38, 0x2b, 0x95, 0x1d, 0x7b, 0x7a, 0xf6, 0x4c, 0xcd, 0xce, 0x9e, 0xd3,
+	0xb5, 0x3e, 0xfd, 0x3c, 0xb5, 0x7e, 0xe6, 0x0d, 0x9d, 0x79, 0xe1, 0xa7, 0xff, 0xe2, 0x4d, 0x9e,
+	0xfe, 0x3a, 0x4a, 0x7f, 0x30, 0xa0, 0xd0, 0x0d, 0x99, 0x45, 0x75, 0xbd, 0x16, 0xff, 0x4c, 0xba,
+	0x72, 0xfb, 0xbe, 0x13, 0x87, 0x5c, 0x49, 0x68, 0x13, 0xc0, 0x1d, 0x3a, 0x11, 0x0b, 0x1c, 0x96,
+	0xd4, 0x9c, 0x09, 0x04, 0x95, 0x21, 0x15, 0x5c, 0xea, 0x3a, 0x90, 0x0a, 0x2e, 0x67, 0xe2, 0x93,
+	0x79, 0x9e, 0xf8, 0x3c, 0x7b, 0x52, 0xd9, 0x7a, 0x62, 0xc0, 0x46, 0x32, 0x8f, 0x0d, 0x9d, 0x48,
+	0xb4, 0x03, 0x12, 0x0d, 0x43, 0x7a, 0x18, 0x8a, 0x57, 0xdf, 0xcd, 0xe7, 0x3d, 0xb4, 0x03, 0xd9,
+	0x78, 0x38, 0x4d, 0x7f, 0xe9, 0x70, 0x8a, 0x63, 0xbb, 0x87, 0x99, 0xf7, 0x3f, 0xaa, 0x2e, 0xdc,
+	0xfb, 0x97, 0x01, 0xa5, 0xa9, 0xce, 0x89, 0x7e, 0x02, 0x55, 0xdc, 0xee, 0x1d, 0x1e, 0x3c, 0x6e,
+	0x9b, 0xbd, 0xa3, 0xdd, 0xa3, 0xe3, 0x9e, 0x79, 0xd8, 0x6d, 0x3f, 0x32, 0x8f, 0x1f, 0xf5, 0xba,
+	0xed, 0x56, 0x67, 0xbf, 0xd3, 0xde, 0x5b, 0x59, 0xd8, 0x58, 0xff, 0xe0, 0xc3, 0xda, 0xad, 0x39,
+	0x66, 0xe8, 0x87, 0x70, 0x7b, 0x06, 0xee, 0x1d, 0xb7, 0x5a, 0xed, 0x5e, 0x6f, 0xc5, 0xd8, 0xd8,
+	0xf8, 0xe0, 0xc3, 0xda, 0x7f, 0xd1, 0xce, 0x59, 0xb7, 0xbf, 0xdb, 0x39, 0x38, 0xc6, 0xed, 0x95,
+	0xd4, 0xdc, 0x75, 0x5a, 0x3b, 0x67, 0x5d, 0xfb, 0x17, 0xdd, 0x0e, 0x6e, 0xef, 0xad, 0xa4, 0xe7,
+	0xae, 0xd3, 0xda, 0x8d, 0xcc, 0xfb, 0x1f, 0x6f, 0x2e, 0xdc, 0x7b, 0x17, 0xb2, 0xf1, 0x93, 0x7b,
+	0x1d, 0x6e, 0xb5, 0x1f, 0xb5, 0x0e, 0xf7, 0xda, 0x78, 0xfa, 0xa8, 0x68, 0x15, 0x4a, 0xb1, 0xa2,
+	0x8b, 0x0f, 0x8f, 0x0e, 0x57, 0x0c, 0xb4, 0x06, 0x2b, 0x31, 0xb4, 0x7f, 0x7c, 0x70, 0x60, 0xee,
+	0x36, 0x3b, 0x2b, 0xa9, 0xc9, 0x1d, 0xba, 0xbb, 0xf8, 0xa8, 0xb3, 0xab, 0x14, 0x69, 0xf5, 0xad,
+	0x66, 0xe7, 0x93, 0xd1, 0xa6, 0xf1, 0xd9, 0x68, 0xd3, 0xf8, 0xdb, 0x68, 0xd3, 0x78, 0xf2, 0x74,
+	0x73, 0xe1, 0xb3, 0xa7, 0x9b, 0x0b, 0x7f, 0x79, 0xba, 0xb9, 0xf0, 0xcb, 0xc6, 0x57, 0x98, 0x23,
+	0xf4, 0x7f, 0xd9, 0xe5, 0x18, 0xd1, 0x5f, 0x92, 0x16, 0x6f, 0xfe, 0x67, 0x00, 0x62, 0xbf, 0x11,
+	0x0f, 0x81, 0x17, 0x00, 0x00,
 }
 
 func (this *DataSource) Equal(that interface{}) bool {
@@ -1910,6 +2097,9 @@ func (this *DataSource) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.AccessPolicy.Equal(&that1.AccessPolicy) {
+		return false
+	}
 	return true
 }
 func (this *OracleScript) Equal(that interface{}) bool {
@@ -1949,6 +2139,54 @@ func (this *OracleScript) Equal(that interface{}) bool {
 	if this.SourceCodeURL != that1.SourceCodeURL {
 		return false
 	}
+	if !this.AccessPolicy.Equal(&that1.AccessPolicy) {
+		return false
+	}
+	return true
+}
+func (this *AccessPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessPolicy)
+	if !ok {
+		that2, ok := that.(AccessPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AllowedRequesters) != len(that1.AllowedRequesters) {
+		return false
+	}
+	for i := range this.AllowedRequesters {
+		if this.AllowedRequesters[i] != that1.AllowedRequesters[i] {
+			return false
+		}
+	}
+	if len(this.AllowedChannels) != len(that1.AllowedChannels) {
+		return false
+	}
+	for i := range this.AllowedChannels {
+		if !this.AllowedChannels[i].Equal(&that1.AllowedChannels[i]) {
+			return false
+		}
+	}
+	if len(this.MinFeeLimit) != len(that1.MinFeeLimit) {
+		return false
+	}
+	for i := range this.MinFeeLimit {
+		if !this.MinFeeLimit[i].Equal(&that1.MinFeeLimit[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *RawRequest) Equal(that interface{}) bool {
@@ -2084,6 +2322,17 @@ func (this *Request) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Tip) != len(that1.Tip) {
+		return false
+	}
+	for i := range this.Tip {
+		if !this.Tip[i].Equal(&that1.Tip[i]) {
+			return false
+		}
+	}
+	if this.CommitReveal != that1.CommitReveal {
+		return false
+	}
 	return true
 }
 func (this *Report) Equal(that interface{}) bool {
@@ -2121,6 +2370,33 @@ func (this *Report) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ReportCommit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportCommit)
+	if !ok {
+		that2, ok := that.(ReportCommit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	return true
+}
 func (this *OracleRequestPacketData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2403,6 +2679,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.IBCRequestEnabled != that1.IBCRequestEnabled {
 		return false
 	}
+	if this.CommitRevealBlockCount != that1.CommitRevealBlockCount {
+		return false
+	}
 	return true
 }
 func (this *PendingResolveList) Equal(that interface{}) bool {
@@ -2517,6 +2796,16 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AccessPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.Fee) > 0 {
 		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2589,6 +2878,16 @@ func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AccessPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.SourceCodeURL) > 0 {
 		i -= len(m.SourceCodeURL)
 		copy(dAtA[i:], m.SourceCodeURL)
@@ -2634,6 +2933,66 @@ func (m *OracleScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccessPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFeeLimit) > 0 {
+		for iNdEx := len(m.MinFeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedRequesters) > 0 {
+		for iNdEx := len(m.AllowedRequesters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRequesters[iNdEx])
+			copy(dAtA[i:], m.AllowedRequesters[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.AllowedRequesters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RawRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2734,8 +3093,32 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+	if m.CommitReveal {
+		i--
+		if m.CommitReveal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Tip) > 0 {
+		for iNdEx := len(m.Tip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -2891,6 +3274,43 @@ func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReportCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OracleRequestPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3206,12 +3626,12 @@ func (m *ValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if m.IsActive {
@@ -3282,6 +3702,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitRevealBlockCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.CommitRevealBlockCount))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.IBCRequestEnabled {
 		i--
 		if m.IBCRequestEnabled {
@@ -3366,20 +3791,20 @@ func (m *PendingResolveList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.RequestIds) > 0 {
-		dAtA4 := make([]byte, len(m.RequestIds)*10)
-		var j3 int
+		dAtA6 := make([]byte, len(m.RequestIds)*10)
+		var j5 int
 		for _, num := range m.RequestIds {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j5++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintOracle(dAtA, i, uint64(j3))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintOracle(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3601,6 +4026,8 @@ func (m *DataSource) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	l = m.AccessPolicy.Size()
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -3634,6 +4061,35 @@ func (m *OracleScript) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	l = m.AccessPolicy.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *AccessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedRequesters) > 0 {
+		for _, s := range m.AllowedRequesters {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.MinFeeLimit) > 0 {
+		for _, e := range m.MinFeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3733,6 +4189,15 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	if len(m.Tip) > 0 {
+		for _, e := range m.Tip {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.CommitReveal {
+		n += 2
+	}
 	return n
 }
 
@@ -3758,6 +4223,23 @@ func (m *Report) Size() (n int) {
 	return n
 }
 
+func (m *ReportCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
 func (m *OracleRequestPacketData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3976,6 +4458,9 @@ func (m *Params) Size() (n int) {
 	if m.IBCRequestEnabled {
 		n += 2
 	}
+	if m.CommitRevealBlockCount != 0 {
+		n += 1 + sovOracle(uint64(m.CommitRevealBlockCount))
+	}
 	return n
 }
 
@@ -4307,6 +4792,39 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4549,6 +5067,189 @@ func (m *OracleScript) Unmarshal(dAtA []byte) error {
 			}
 			m.SourceCodeURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRequesters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRequesters = append(m.AllowedRequesters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, IBCChannel{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFeeLimit = append(m.MinFeeLimit, types.Coin{})
+			if err := m.MinFeeLimit[len(m.MinFeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5191,6 +5892,60 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tip = append(m.Tip, types.Coin{})
+			if err := m.Tip[len(m.Tip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitReveal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -5348,6 +6103,122 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReportCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OracleRequestPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6757,6 +7628,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IBCRequestEnabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealBlockCount", wireType)
+			}
+			m.CommitRevealBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitRevealBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
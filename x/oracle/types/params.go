@@ -19,13 +19,14 @@ const (
 	DefaultOracleRewardPercentage  = uint64(70)
 	DefaultInactivePenaltyDuration = uint64(10 * time.Minute)
 	DefaultIBCRequestEnabled       = true
+	DefaultCommitRevealBlockCount  = uint64(10)
 )
 
 // NewParams creates a new parameter configuration for the oracle module
 func NewParams(
 	maxRawRequestCount, maxAskCount, maxCalldataSize, maxReportDataSize, expirationBlockCount, baseRequestGas, perValidatorRequestGas,
 	samplingTryCount, oracleRewardPercentage, inactivePenaltyDuration uint64,
-	ibcRequestEnabled bool,
+	ibcRequestEnabled bool, commitRevealBlockCount uint64,
 ) Params {
 	return Params{
 		MaxRawRequestCount:      maxRawRequestCount,
@@ -39,6 +40,7 @@ func NewParams(
 		OracleRewardPercentage:  oracleRewardPercentage,
 		InactivePenaltyDuration: inactivePenaltyDuration,
 		IBCRequestEnabled:       ibcRequestEnabled,
+		CommitRevealBlockCount:  commitRevealBlockCount,
 	}
 }
 
@@ -56,6 +58,7 @@ func DefaultParams() Params {
 		DefaultOracleRewardPercentage,
 		DefaultInactivePenaltyDuration,
 		DefaultIBCRequestEnabled,
+		DefaultCommitRevealBlockCount,
 	)
 }
 
@@ -94,6 +97,15 @@ func (p Params) Validate() error {
 	if err := validateBool()(p.IBCRequestEnabled); err != nil {
 		return err
 	}
+	if err := validateUint64("commit reveal block count", true)(p.CommitRevealBlockCount); err != nil {
+		return err
+	}
+	if p.CommitRevealBlockCount >= p.ExpirationBlockCount {
+		return fmt.Errorf(
+			"commit reveal block count must be less than expiration block count: %d >= %d",
+			p.CommitRevealBlockCount, p.ExpirationBlockCount,
+		)
+	}
 
 	return nil
 }
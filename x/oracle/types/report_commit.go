@@ -0,0 +1,38 @@
+package types
+
+import (
+	"crypto/sha256"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewReportCommit creates a new ReportCommit instance.
+func NewReportCommit(validator sdk.ValAddress, hash []byte) ReportCommit {
+	return ReportCommit{
+		Validator: validator.String(),
+		Hash:      hash,
+	}
+}
+
+// ReportCommitHash returns the hash that a validator commits to before revealing its raw reports
+// of a request in commit-reveal mode. The raw reports are hashed in the order of their external
+// IDs, so the order in which they are revealed does not matter.
+func ReportCommitHash(rid RequestID, val sdk.ValAddress, rawReports []RawReport, salt []byte) []byte {
+	sorted := make([]RawReport, len(rawReports))
+	copy(sorted, rawReports)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ExternalID < sorted[j].ExternalID })
+
+	h := sha256.New()
+	h.Write(sdk.Uint64ToBigEndian(uint64(rid)))
+	h.Write(val.Bytes())
+	h.Write(sdk.Uint64ToBigEndian(uint64(len(salt))))
+	h.Write(salt)
+	for _, r := range sorted {
+		h.Write(sdk.Uint64ToBigEndian(uint64(r.ExternalID)))
+		h.Write(sdk.Uint64ToBigEndian(uint64(r.ExitCode)))
+		h.Write(sdk.Uint64ToBigEndian(uint64(len(r.Data))))
+		h.Write(r.Data)
+	}
+	return h.Sum(nil)
+}
@@ -58,6 +58,12 @@ type MsgRequestData struct {
 	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 	// TSSEncoder is the mode of encoding oracle result signature order.
 	TSSEncoder Encoder `protobuf:"varint,10,opt,name=tss_encoder,json=tssEncoder,proto3,enum=band.oracle.v1.Encoder" json:"tss_encoder,omitempty"`
+	// Tip is an optional amount on top of the data source fees that will be split
+	// among the validators who report before the request is resolved.
+	Tip github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=tip,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tip"`
+	// CommitReveal is a flag indicating whether validators must commit to their
+	// reports before revealing them.
+	CommitReveal bool `protobuf:"varint,12,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
 }
 
 func (m *MsgRequestData) Reset()         { *m = MsgRequestData{} }
@@ -163,6 +169,20 @@ func (m *MsgRequestData) GetTSSEncoder() Encoder {
 	return ENCODER_UNSPECIFIED
 }
 
+func (m *MsgRequestData) GetTip() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tip
+	}
+	return nil
+}
+
+func (m *MsgRequestData) GetCommitReveal() bool {
+	if m != nil {
+		return m.CommitReveal
+	}
+	return false
+}
+
 // MsgRequestDataResponse is response data for MsgRequestData message
 type MsgRequestDataResponse struct {
 }
@@ -209,6 +229,9 @@ type MsgReportData struct {
 	RawReports []RawReport `protobuf:"bytes,2,rep,name=raw_reports,json=rawReports,proto3" json:"raw_reports"`
 	// Validator is the address of the validator that owns this report.
 	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Salt is the random value used to compute the report commit hash. It is
+	// only required for requests in commit-reveal mode.
+	Salt []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (m *MsgReportData) Reset()         { *m = MsgReportData{} }
@@ -265,6 +288,13 @@ func (m *MsgReportData) GetValidator() string {
 	return ""
 }
 
+func (m *MsgReportData) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
 // MsgReportDataResponse is response data for MsgReportData message
 type MsgReportDataResponse struct {
 }
@@ -302,6 +332,108 @@ func (m *MsgReportDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgReportDataResponse proto.InternalMessageInfo
 
+// MsgCommitReport is a message for committing to a report of a data request in
+// commit-reveal mode by a validator.
+type MsgCommitReport struct {
+	// RequestID is the identifier of the request to be committed to.
+	RequestID RequestID `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3,casttype=RequestID" json:"request_id,omitempty"`
+	// Hash is the SHA256 hash of the report computed by ReportCommitHash.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Validator is the address of the validator that owns this commit.
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *MsgCommitReport) Reset()         { *m = MsgCommitReport{} }
+func (m *MsgCommitReport) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitReport) ProtoMessage()    {}
+func (*MsgCommitReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{4}
+}
+func (m *MsgCommitReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitReport.Merge(m, src)
+}
+func (m *MsgCommitReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitReport proto.InternalMessageInfo
+
+func (m *MsgCommitReport) GetRequestID() RequestID {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
+func (m *MsgCommitReport) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *MsgCommitReport) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// MsgCommitReportResponse is response data for MsgCommitReport message
+type MsgCommitReportResponse struct {
+}
+
+func (m *MsgCommitReportResponse) Reset()         { *m = MsgCommitReportResponse{} }
+func (m *MsgCommitReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitReportResponse) ProtoMessage()    {}
+func (*MsgCommitReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{5}
+}
+func (m *MsgCommitReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitReportResponse.Merge(m, src)
+}
+func (m *MsgCommitReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitReportResponse proto.InternalMessageInfo
+
 // MsgCreateDataSource is a message for creating a new data source.
 type MsgCreateDataSource struct {
 	// Name is the name of this data source used for display (optional).
@@ -328,7 +460,7 @@ func (m *MsgCreateDataSource) Reset()         { *m = MsgCreateDataSource{} }
 func (m *MsgCreateDataSource) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateDataSource) ProtoMessage()    {}
 func (*MsgCreateDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{4}
+	return fileDescriptor_3ffde65d794f19e2, []int{6}
 }
 func (m *MsgCreateDataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +546,7 @@ func (m *MsgCreateDataSourceResponse) Reset()         { *m = MsgCreateDataSource
 func (m *MsgCreateDataSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateDataSourceResponse) ProtoMessage()    {}
 func (*MsgCreateDataSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{5}
+	return fileDescriptor_3ffde65d794f19e2, []int{7}
 }
 func (m *MsgCreateDataSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +604,7 @@ func (m *MsgEditDataSource) Reset()         { *m = MsgEditDataSource{} }
 func (m *MsgEditDataSource) String() string { return proto.CompactTextString(m) }
 func (*MsgEditDataSource) ProtoMessage()    {}
 func (*MsgEditDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{6}
+	return fileDescriptor_3ffde65d794f19e2, []int{8}
 }
 func (m *MsgEditDataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +697,7 @@ func (m *MsgEditDataSourceResponse) Reset()         { *m = MsgEditDataSourceResp
 func (m *MsgEditDataSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEditDataSourceResponse) ProtoMessage()    {}
 func (*MsgEditDataSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{7}
+	return fileDescriptor_3ffde65d794f19e2, []int{9}
 }
 func (m *MsgEditDataSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +750,7 @@ func (m *MsgCreateOracleScript) Reset()         { *m = MsgCreateOracleScript{} }
 func (m *MsgCreateOracleScript) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOracleScript) ProtoMessage()    {}
 func (*MsgCreateOracleScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{8}
+	return fileDescriptor_3ffde65d794f19e2, []int{10}
 }
 func (m *MsgCreateOracleScript) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,7 +837,7 @@ func (m *MsgCreateOracleScriptResponse) Reset()         { *m = MsgCreateOracleSc
 func (m *MsgCreateOracleScriptResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOracleScriptResponse) ProtoMessage()    {}
 func (*MsgCreateOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{9}
+	return fileDescriptor_3ffde65d794f19e2, []int{11}
 }
 func (m *MsgCreateOracleScriptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +893,7 @@ func (m *MsgEditOracleScript) Reset()         { *m = MsgEditOracleScript{} }
 func (m *MsgEditOracleScript) String() string { return proto.CompactTextString(m) }
 func (*MsgEditOracleScript) ProtoMessage()    {}
 func (*MsgEditOracleScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{10}
+	return fileDescriptor_3ffde65d794f19e2, []int{12}
 }
 func (m *MsgEditOracleScript) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -854,7 +986,7 @@ func (m *MsgEditOracleScriptResponse) Reset()         { *m = MsgEditOracleScript
 func (m *MsgEditOracleScriptResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEditOracleScriptResponse) ProtoMessage()    {}
 func (*MsgEditOracleScriptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{11}
+	return fileDescriptor_3ffde65d794f19e2, []int{13}
 }
 func (m *MsgEditOracleScriptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,6 +1015,220 @@ func (m *MsgEditOracleScriptResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditOracleScriptResponse proto.InternalMessageInfo
 
+// MsgUpdateDataSourceAccessPolicy is a message for updating the access policy
+// of a data source.
+type MsgUpdateDataSourceAccessPolicy struct {
+	// DataSourceID is the unique identifier of the data source to be updated.
+	DataSourceID DataSourceID `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3,casttype=DataSourceID" json:"data_source_id,omitempty"`
+	// AccessPolicy is the new access policy of the data source.
+	AccessPolicy AccessPolicy `protobuf:"bytes,2,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy"`
+	// Sender is the signer of this message. Must be the current data source's
+	// owner.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgUpdateDataSourceAccessPolicy) Reset()         { *m = MsgUpdateDataSourceAccessPolicy{} }
+func (m *MsgUpdateDataSourceAccessPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDataSourceAccessPolicy) ProtoMessage()    {}
+func (*MsgUpdateDataSourceAccessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{14}
+}
+func (m *MsgUpdateDataSourceAccessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDataSourceAccessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDataSourceAccessPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDataSourceAccessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDataSourceAccessPolicy.Merge(m, src)
+}
+func (m *MsgUpdateDataSourceAccessPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDataSourceAccessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDataSourceAccessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDataSourceAccessPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateDataSourceAccessPolicy) GetDataSourceID() DataSourceID {
+	if m != nil {
+		return m.DataSourceID
+	}
+	return 0
+}
+
+func (m *MsgUpdateDataSourceAccessPolicy) GetAccessPolicy() AccessPolicy {
+	if m != nil {
+		return m.AccessPolicy
+	}
+	return AccessPolicy{}
+}
+
+func (m *MsgUpdateDataSourceAccessPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgUpdateDataSourceAccessPolicyResponse is response data for
+// MsgUpdateDataSourceAccessPolicy message
+type MsgUpdateDataSourceAccessPolicyResponse struct {
+}
+
+func (m *MsgUpdateDataSourceAccessPolicyResponse) Reset() {
+	*m = MsgUpdateDataSourceAccessPolicyResponse{}
+}
+func (m *MsgUpdateDataSourceAccessPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDataSourceAccessPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateDataSourceAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{15}
+}
+func (m *MsgUpdateDataSourceAccessPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDataSourceAccessPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDataSourceAccessPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDataSourceAccessPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDataSourceAccessPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateDataSourceAccessPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDataSourceAccessPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDataSourceAccessPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDataSourceAccessPolicyResponse proto.InternalMessageInfo
+
+// MsgUpdateOracleScriptAccessPolicy is a message for updating the access policy
+// of an oracle script.
+type MsgUpdateOracleScriptAccessPolicy struct {
+	// OracleScriptID is the unique identifier of the oracle script to be updated.
+	OracleScriptID OracleScriptID `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3,casttype=OracleScriptID" json:"oracle_script_id,omitempty"`
+	// AccessPolicy is the new access policy of the oracle script.
+	AccessPolicy AccessPolicy `protobuf:"bytes,2,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy"`
+	// Sender is the signer of this message. Must be the current oracle script's
+	// owner.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicy) Reset()         { *m = MsgUpdateOracleScriptAccessPolicy{} }
+func (m *MsgUpdateOracleScriptAccessPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracleScriptAccessPolicy) ProtoMessage()    {}
+func (*MsgUpdateOracleScriptAccessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{16}
+}
+func (m *MsgUpdateOracleScriptAccessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracleScriptAccessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleScriptAccessPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOracleScriptAccessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleScriptAccessPolicy.Merge(m, src)
+}
+func (m *MsgUpdateOracleScriptAccessPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracleScriptAccessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleScriptAccessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOracleScriptAccessPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateOracleScriptAccessPolicy) GetOracleScriptID() OracleScriptID {
+	if m != nil {
+		return m.OracleScriptID
+	}
+	return 0
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicy) GetAccessPolicy() AccessPolicy {
+	if m != nil {
+		return m.AccessPolicy
+	}
+	return AccessPolicy{}
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgUpdateOracleScriptAccessPolicyResponse is response data for
+// MsgUpdateOracleScriptAccessPolicy message
+type MsgUpdateOracleScriptAccessPolicyResponse struct {
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) Reset() {
+	*m = MsgUpdateOracleScriptAccessPolicyResponse{}
+}
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateOracleScriptAccessPolicyResponse) ProtoMessage() {}
+func (*MsgUpdateOracleScriptAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ffde65d794f19e2, []int{17}
+}
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleScriptAccessPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleScriptAccessPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleScriptAccessPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOracleScriptAccessPolicyResponse proto.InternalMessageInfo
+
 // MsgEditOracleScript is a message for activating a validator to become an
 // oracle provider. However, the activation can be revoked once the validator
 // is unable to provide data to fulfill requests
@@ -896,7 +1242,7 @@ func (m *MsgActivate) Reset()         { *m = MsgActivate{} }
 func (m *MsgActivate) String() string { return proto.CompactTextString(m) }
 func (*MsgActivate) ProtoMessage()    {}
 func (*MsgActivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{12}
+	return fileDescriptor_3ffde65d794f19e2, []int{18}
 }
 func (m *MsgActivate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1286,7 @@ func (m *MsgActivateResponse) Reset()         { *m = MsgActivateResponse{} }
 func (m *MsgActivateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgActivateResponse) ProtoMessage()    {}
 func (*MsgActivateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{13}
+	return fileDescriptor_3ffde65d794f19e2, []int{19}
 }
 func (m *MsgActivateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1331,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{14}
+	return fileDescriptor_3ffde65d794f19e2, []int{20}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1039,7 +1385,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ffde65d794f19e2, []int{15}
+	return fileDescriptor_3ffde65d794f19e2, []int{21}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,6 +1419,8 @@ func init() {
 	proto.RegisterType((*MsgRequestDataResponse)(nil), "band.oracle.v1.MsgRequestDataResponse")
 	proto.RegisterType((*MsgReportData)(nil), "band.oracle.v1.MsgReportData")
 	proto.RegisterType((*MsgReportDataResponse)(nil), "band.oracle.v1.MsgReportDataResponse")
+	proto.RegisterType((*MsgCommitReport)(nil), "band.oracle.v1.MsgCommitReport")
+	proto.RegisterType((*MsgCommitReportResponse)(nil), "band.oracle.v1.MsgCommitReportResponse")
 	proto.RegisterType((*MsgCreateDataSource)(nil), "band.oracle.v1.MsgCreateDataSource")
 	proto.RegisterType((*MsgCreateDataSourceResponse)(nil), "band.oracle.v1.MsgCreateDataSourceResponse")
 	proto.RegisterType((*MsgEditDataSource)(nil), "band.oracle.v1.MsgEditDataSource")
@@ -1081,6 +1429,10 @@ func init() {
 	proto.RegisterType((*MsgCreateOracleScriptResponse)(nil), "band.oracle.v1.MsgCreateOracleScriptResponse")
 	proto.RegisterType((*MsgEditOracleScript)(nil), "band.oracle.v1.MsgEditOracleScript")
 	proto.RegisterType((*MsgEditOracleScriptResponse)(nil), "band.oracle.v1.MsgEditOracleScriptResponse")
+	proto.RegisterType((*MsgUpdateDataSourceAccessPolicy)(nil), "band.oracle.v1.MsgUpdateDataSourceAccessPolicy")
+	proto.RegisterType((*MsgUpdateDataSourceAccessPolicyResponse)(nil), "band.oracle.v1.MsgUpdateDataSourceAccessPolicyResponse")
+	proto.RegisterType((*MsgUpdateOracleScriptAccessPolicy)(nil), "band.oracle.v1.MsgUpdateOracleScriptAccessPolicy")
+	proto.RegisterType((*MsgUpdateOracleScriptAccessPolicyResponse)(nil), "band.oracle.v1.MsgUpdateOracleScriptAccessPolicyResponse")
 	proto.RegisterType((*MsgActivate)(nil), "band.oracle.v1.MsgActivate")
 	proto.RegisterType((*MsgActivateResponse)(nil), "band.oracle.v1.MsgActivateResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "band.oracle.v1.MsgUpdateParams")
@@ -1090,88 +1442,102 @@ func init() {
 func init() { proto.RegisterFile("band/oracle/v1/tx.proto", fileDescriptor_3ffde65d794f19e2) }
 
 var fileDescriptor_3ffde65d794f19e2 = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0xad, 0x8f, 0x88, 0x23, 0x59, 0x49, 0x98, 0x0f, 0xc9, 0x34, 0x2c, 0x2a, 0xca, 0xc7,
-	0xab, 0x24, 0xb0, 0x98, 0x38, 0xc1, 0x0b, 0xc4, 0x97, 0x36, 0x72, 0xd2, 0xd6, 0x40, 0xd4, 0x16,
-	0x74, 0x53, 0x14, 0x05, 0x5a, 0x61, 0x45, 0x6e, 0x68, 0x36, 0x12, 0xa9, 0x72, 0x57, 0x4a, 0x72,
-	0x2b, 0x7a, 0xec, 0xa5, 0xed, 0x3f, 0xe8, 0xad, 0x40, 0x81, 0x02, 0x39, 0xe4, 0x2f, 0x14, 0xc8,
-	0x31, 0x48, 0x2f, 0x3d, 0xa9, 0x85, 0x72, 0x48, 0xef, 0x3d, 0x14, 0xe8, 0xa9, 0xd8, 0xe5, 0x8a,
-	0x22, 0x65, 0xca, 0x76, 0x8c, 0x34, 0x17, 0x9b, 0xb3, 0xcf, 0x33, 0xb3, 0xc3, 0x79, 0x66, 0x96,
-	0x2b, 0x28, 0x75, 0x90, 0x6b, 0xe9, 0x9e, 0x8f, 0xcc, 0x2e, 0xd6, 0x87, 0x57, 0x75, 0xfa, 0xb0,
-	0xd1, 0xf7, 0x3d, 0xea, 0x29, 0x45, 0x06, 0x34, 0x02, 0xa0, 0x31, 0xbc, 0xaa, 0x9e, 0xb4, 0x3d,
-	0xdb, 0xe3, 0x90, 0xce, 0x9e, 0x02, 0x96, 0xba, 0x32, 0xe3, 0x2e, 0xf8, 0x01, 0x58, 0x31, 0x3d,
-	0xd2, 0xf3, 0x88, 0xde, 0x41, 0x84, 0x81, 0x1d, 0x4c, 0xd1, 0x55, 0xdd, 0xf4, 0x1c, 0x57, 0xe0,
-	0xcb, 0x01, 0xde, 0x0e, 0xa2, 0x06, 0x86, 0x80, 0x4a, 0xc2, 0xb5, 0x47, 0x6c, 0x16, 0xb6, 0x47,
-	0x6c, 0x01, 0x1c, 0x47, 0x3d, 0xc7, 0xf5, 0x74, 0xfe, 0x37, 0x58, 0xaa, 0xfd, 0x9c, 0x86, 0x62,
-	0x8b, 0xd8, 0x06, 0xfe, 0x72, 0x80, 0x09, 0xbd, 0x85, 0x28, 0x52, 0xde, 0x87, 0x63, 0x41, 0x26,
-	0x6d, 0x62, 0xfa, 0x4e, 0x9f, 0xb6, 0x1d, 0xab, 0x2c, 0x55, 0xa5, 0x7a, 0xba, 0x79, 0x6e, 0x3c,
-	0xd2, 0x8a, 0x1f, 0x70, 0x6c, 0x9b, 0x43, 0x5b, 0xb7, 0xfe, 0xd9, 0xb5, 0x62, 0x14, 0xbd, 0xa8,
-	0x6d, 0x29, 0x2a, 0xe4, 0x4c, 0xd4, 0xed, 0x5a, 0x88, 0xa2, 0xf2, 0x62, 0x55, 0xaa, 0x17, 0x8c,
-	0xd0, 0x56, 0x56, 0x40, 0x46, 0xe4, 0x7e, 0xdb, 0xf4, 0x06, 0x2e, 0x2d, 0xa7, 0xd8, 0x26, 0x46,
-	0x0e, 0x91, 0xfb, 0x9b, 0xcc, 0x66, 0x60, 0xcf, 0x71, 0x05, 0x98, 0x0e, 0xc0, 0x9e, 0xe3, 0x06,
-	0xe0, 0x45, 0x90, 0xcd, 0xae, 0x83, 0x5d, 0x9e, 0x5e, 0xa6, 0x2a, 0xd5, 0xe5, 0x66, 0x61, 0x3c,
-	0xd2, 0x72, 0x9b, 0x7c, 0x71, 0xeb, 0x96, 0x91, 0x0b, 0xe0, 0x2d, 0x4b, 0xd9, 0x01, 0xf9, 0x1e,
-	0xc6, 0xed, 0xae, 0xd3, 0x73, 0x68, 0x39, 0x5b, 0x4d, 0xd5, 0xf3, 0xeb, 0xcb, 0x0d, 0x51, 0x31,
-	0x56, 0xde, 0x86, 0x28, 0x6f, 0x63, 0xd3, 0x73, 0xdc, 0xe6, 0x95, 0xa7, 0x23, 0x6d, 0xe1, 0xa7,
-	0xdf, 0xb5, 0xba, 0xed, 0xd0, 0x9d, 0x41, 0xa7, 0x61, 0x7a, 0x3d, 0x51, 0x5e, 0xf1, 0x6f, 0x8d,
-	0x58, 0xf7, 0x75, 0xfa, 0xa8, 0x8f, 0x09, 0x77, 0x20, 0x46, 0xee, 0x1e, 0xc6, 0x77, 0x58, 0x70,
-	0x45, 0x83, 0x7c, 0xdf, 0xc7, 0x7d, 0xe4, 0xe3, 0xb6, 0x8d, 0x48, 0xf9, 0x08, 0xcf, 0x19, 0xc4,
-	0xd2, 0xbb, 0x88, 0x30, 0x02, 0x7e, 0x88, 0xcd, 0x01, 0x0d, 0x08, 0xb9, 0x80, 0x20, 0x96, 0x18,
-	0xe1, 0x0a, 0x64, 0x09, 0x76, 0x2d, 0xec, 0x97, 0x65, 0xfe, 0x4e, 0xe5, 0xe7, 0x4f, 0xd6, 0x4e,
-	0x8a, 0x5c, 0x6f, 0x5a, 0x96, 0x8f, 0x09, 0xd9, 0xa6, 0xbe, 0xe3, 0xda, 0x86, 0xe0, 0x29, 0xef,
-	0x41, 0x9e, 0x12, 0xd2, 0xc6, 0xae, 0xe9, 0x31, 0x37, 0xa8, 0x4a, 0xf5, 0xe2, 0x7a, 0xa9, 0x11,
-	0xef, 0xc0, 0xc6, 0xed, 0x00, 0x6e, 0x16, 0xc7, 0x23, 0x0d, 0x3e, 0xda, 0xde, 0x16, 0xb6, 0x01,
-	0x94, 0x10, 0xf1, 0xbc, 0x51, 0xfb, 0xf3, 0x07, 0x4d, 0xfa, 0xfa, 0xe5, 0xe3, 0x4b, 0x22, 0xf4,
-	0x37, 0x2f, 0x1f, 0x5f, 0x12, 0x62, 0xea, 0xa2, 0x41, 0x6a, 0x65, 0x38, 0x1d, 0x6f, 0x17, 0x03,
-	0x93, 0xbe, 0xe7, 0x12, 0x5c, 0xfb, 0x4b, 0x82, 0x25, 0x0e, 0xf5, 0x3d, 0x3f, 0x68, 0xa4, 0x1b,
-	0x00, 0x7e, 0x40, 0x9c, 0xb6, 0x90, 0x3a, 0x1e, 0x69, 0xb2, 0x70, 0xe7, 0xdd, 0x33, 0x35, 0x0c,
-	0x59, 0xb0, 0xb7, 0x2c, 0xe5, 0x6d, 0xc8, 0xfb, 0xe8, 0x41, 0xdb, 0xe7, 0xc1, 0x48, 0x79, 0x51,
-	0x88, 0x36, 0xf3, 0x52, 0x06, 0x7a, 0x10, 0x6c, 0xd7, 0x4c, 0x33, 0xd1, 0x0c, 0xf0, 0x27, 0x0b,
-	0x44, 0x79, 0x0b, 0xe4, 0x21, 0xea, 0x3a, 0x16, 0xa2, 0x9e, 0xcf, 0x3b, 0x4b, 0x6e, 0x9e, 0x79,
-	0xfe, 0x64, 0x6d, 0x55, 0xd4, 0xf2, 0xe3, 0x09, 0x16, 0x2f, 0xea, 0xd4, 0x67, 0xe3, 0xdc, 0xa4,
-	0x1a, 0xd3, 0x35, 0x56, 0x90, 0xa5, 0xb0, 0x20, 0x6c, 0x9f, 0x5a, 0x09, 0x4e, 0xc5, 0x5e, 0x3a,
-	0x2c, 0xc7, 0xb7, 0x29, 0x38, 0xd1, 0x22, 0xf6, 0xa6, 0x8f, 0x11, 0xc5, 0x0c, 0xd9, 0xf6, 0x06,
-	0xbe, 0x89, 0x15, 0x05, 0xd2, 0x2e, 0xea, 0x61, 0x5e, 0x0e, 0xd9, 0xe0, 0xcf, 0x4a, 0x15, 0xf2,
-	0x16, 0x0e, 0x86, 0xcd, 0xf1, 0x5c, 0x3e, 0x24, 0xb2, 0x11, 0x5d, 0x52, 0x2a, 0x20, 0x9a, 0x04,
-	0x75, 0xba, 0x98, 0xbf, 0x4e, 0xc1, 0x88, 0xac, 0x28, 0x9f, 0x41, 0xea, 0x1e, 0xc6, 0xe5, 0xf4,
-	0xeb, 0x6f, 0x6e, 0x16, 0x57, 0xb9, 0x0e, 0x39, 0xea, 0x63, 0x44, 0x06, 0xfe, 0x23, 0x31, 0x6b,
-	0xf3, 0xfb, 0x32, 0x64, 0x2a, 0x0d, 0xc8, 0x78, 0x0f, 0x5c, 0xec, 0x97, 0xb3, 0xfb, 0xb8, 0x04,
-	0xb4, 0x48, 0xef, 0x1f, 0x39, 0x58, 0xef, 0x6f, 0x5c, 0x4e, 0xe8, 0xd8, 0x92, 0x10, 0x68, 0xb6,
-	0xf2, 0xb5, 0x55, 0x58, 0x49, 0x10, 0x24, 0x14, 0xec, 0xd7, 0x14, 0x1c, 0x6f, 0x11, 0xfb, 0xb6,
-	0xe5, 0xd0, 0x88, 0x5c, 0xef, 0x40, 0x91, 0x1d, 0x54, 0x6d, 0xc2, 0xcd, 0x69, 0x1f, 0x57, 0xc7,
-	0x23, 0xad, 0x30, 0xe5, 0xf1, 0x56, 0x8e, 0xd9, 0x46, 0xc1, 0x9a, 0x5a, 0x56, 0x28, 0xfb, 0xe2,
-	0x7c, 0xd9, 0x53, 0xfb, 0xc9, 0x9e, 0x9e, 0x27, 0x7b, 0xe6, 0x0d, 0xc8, 0x9e, 0x7d, 0x75, 0xd9,
-	0x8f, 0xbc, 0xaa, 0xec, 0xb9, 0x03, 0xca, 0x7e, 0x31, 0x41, 0xf6, 0x53, 0x42, 0xf6, 0xb8, 0x7e,
-	0xb5, 0x15, 0x58, 0xde, 0x25, 0x6a, 0x28, 0xf9, 0x2f, 0x8b, 0x7c, 0x7a, 0x83, 0x96, 0x88, 0x7e,
-	0xc5, 0x0e, 0x39, 0xa5, 0xa7, 0x21, 0x4b, 0xcc, 0x1d, 0xdc, 0x43, 0x42, 0x4b, 0x61, 0x29, 0x37,
-	0xe0, 0xa8, 0xe8, 0x1f, 0x76, 0xd0, 0xb6, 0x07, 0x7e, 0x97, 0x6b, 0x29, 0x37, 0x8f, 0x8f, 0x47,
-	0xda, 0x52, 0x90, 0xd4, 0xa6, 0x67, 0xe1, 0xbb, 0xc6, 0x1d, 0x63, 0x89, 0x4c, 0x4d, 0xbf, 0xcb,
-	0x12, 0x61, 0x3e, 0x7c, 0xea, 0x0a, 0x06, 0x7f, 0x7e, 0x03, 0x73, 0xb5, 0x96, 0x50, 0xe0, 0xe5,
-	0xd8, 0x5c, 0x45, 0xab, 0x55, 0xd3, 0x60, 0x35, 0xb1, 0x8c, 0x61, 0xa1, 0xbf, 0x0f, 0x0e, 0x43,
-	0x26, 0x43, 0xac, 0xcc, 0xaf, 0xfb, 0xaa, 0x71, 0xb8, 0x29, 0x9b, 0xca, 0x96, 0xde, 0x4f, 0xb6,
-	0xcc, 0x2b, 0xca, 0x96, 0x4d, 0x92, 0xed, 0x3f, 0x9b, 0x8b, 0xbd, 0x8e, 0xc3, 0xd9, 0xda, 0x8b,
-	0xe3, 0x70, 0x76, 0x39, 0x94, 0x6c, 0x08, 0xf9, 0x16, 0xb1, 0x6f, 0x9a, 0xd4, 0x19, 0x22, 0x8a,
-	0xe3, 0x9f, 0x53, 0xe9, 0x10, 0x9f, 0xd3, 0x0b, 0xc9, 0x9f, 0xd3, 0xa3, 0x22, 0xbd, 0xc9, 0x46,
-	0xb5, 0x53, 0xbc, 0x53, 0x26, 0x66, 0x98, 0xce, 0x8f, 0x12, 0x1c, 0x6d, 0x11, 0xfb, 0x6e, 0xdf,
-	0x42, 0x14, 0x7f, 0x88, 0x7c, 0xd4, 0x23, 0xca, 0xff, 0x41, 0x46, 0x03, 0xba, 0xe3, 0xf9, 0x0e,
-	0x7d, 0x24, 0x72, 0x9a, 0x5f, 0xa3, 0x29, 0x55, 0xb9, 0x0e, 0xd9, 0x3e, 0x8f, 0xc0, 0xfb, 0x24,
-	0xbf, 0x7e, 0x7a, 0xf6, 0x5e, 0x11, 0xc4, 0x17, 0x97, 0x0a, 0xc1, 0xdd, 0xb8, 0xc0, 0x93, 0x0f,
-	0xa3, 0xb0, 0xe4, 0x4f, 0x88, 0xe4, 0xa3, 0x59, 0xd5, 0x96, 0xa1, 0x34, 0x93, 0xe8, 0xe4, 0x25,
-	0xd6, 0xff, 0xce, 0x40, 0xaa, 0x45, 0x6c, 0xe5, 0x2e, 0xe4, 0xa3, 0x17, 0xee, 0xca, 0xec, 0xfe,
-	0xf1, 0x1b, 0x96, 0x7a, 0x61, 0x6f, 0x7c, 0x12, 0x5e, 0x31, 0x00, 0x22, 0xb7, 0xaf, 0xd5, 0x44,
-	0xaf, 0x09, 0xac, 0x9e, 0xdf, 0x13, 0x0e, 0x63, 0x5a, 0x70, 0x6c, 0xd7, 0x15, 0xe6, 0x6c, 0x82,
-	0xeb, 0x2c, 0x49, 0xbd, 0x7c, 0x00, 0x52, 0xb8, 0xcb, 0xe7, 0x50, 0x9c, 0xf9, 0xee, 0x9e, 0x49,
-	0x70, 0x8f, 0x53, 0xd4, 0x8b, 0xfb, 0x52, 0xc2, 0xf8, 0x5f, 0x80, 0x92, 0x70, 0xc8, 0x9f, 0x9f,
-	0x9b, 0x62, 0x94, 0xa6, 0xae, 0x1d, 0x88, 0x16, 0xad, 0xd8, 0xae, 0x73, 0xee, 0xec, 0x9c, 0x54,
-	0x63, 0xfb, 0x5c, 0x3e, 0x00, 0x29, 0xdc, 0xe5, 0x0e, 0xe4, 0xc2, 0xd9, 0x5c, 0x49, 0x70, 0x9c,
-	0x80, 0xea, 0xd9, 0x3d, 0xc0, 0x30, 0xda, 0x27, 0x50, 0x88, 0x4d, 0x96, 0x96, 0xe0, 0x14, 0x25,
-	0xa8, 0xff, 0xdb, 0x87, 0x30, 0x89, 0xac, 0x66, 0xbe, 0x7a, 0xf9, 0xf8, 0x92, 0xd4, 0xdc, 0x7a,
-	0x3a, 0xae, 0x48, 0xcf, 0xc6, 0x15, 0xe9, 0x8f, 0x71, 0x45, 0xfa, 0xee, 0x45, 0x65, 0xe1, 0xd9,
-	0x8b, 0xca, 0xc2, 0x6f, 0x2f, 0x2a, 0x0b, 0x9f, 0xea, 0x91, 0x2b, 0x09, 0x8b, 0xc9, 0x7f, 0x96,
-	0x9a, 0x5e, 0x57, 0x37, 0x77, 0x90, 0xe3, 0xea, 0xc3, 0x6b, 0xfa, 0xc3, 0xc9, 0x4f, 0x64, 0x7e,
-	0x3f, 0xe9, 0x64, 0x39, 0xe3, 0xda, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xab, 0x06, 0x21,
-	0x7d, 0x0f, 0x00, 0x00,
+	// 1518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x35, 0xf5, 0x17, 0xe9, 0x4a, 0x56, 0x12, 0x26, 0x8e, 0x69, 0x3a, 0x16, 0x15, 0x39, 0x3f,
+	0xb2, 0x0d, 0x8b, 0xb1, 0x13, 0x7c, 0x81, 0xbd, 0xf9, 0x1a, 0x39, 0x69, 0x6a, 0x20, 0x6e, 0x03,
+	0xba, 0x29, 0x8a, 0x02, 0xad, 0x30, 0x26, 0xc7, 0x12, 0x1b, 0x89, 0x54, 0x39, 0x94, 0x12, 0xef,
+	0x8a, 0x6e, 0x5a, 0x74, 0xd1, 0x9f, 0x07, 0x28, 0xd0, 0x5d, 0xd1, 0xae, 0x52, 0x20, 0xaf, 0x50,
+	0x20, 0x9b, 0x02, 0x41, 0xba, 0xe9, 0x4a, 0x2d, 0x94, 0x45, 0xba, 0xe9, 0x0b, 0x74, 0x55, 0x70,
+	0x38, 0xa2, 0x48, 0x99, 0x92, 0xec, 0xc4, 0x49, 0x37, 0x36, 0x67, 0xce, 0xb9, 0x77, 0xee, 0x9c,
+	0x33, 0x33, 0x1c, 0x0a, 0xa6, 0x77, 0x90, 0xa1, 0xc9, 0xa6, 0x85, 0xd4, 0x3a, 0x96, 0xdb, 0x2b,
+	0xb2, 0xfd, 0xa0, 0xd4, 0xb4, 0x4c, 0xdb, 0xe4, 0xb3, 0x0e, 0x50, 0x72, 0x81, 0x52, 0x7b, 0x45,
+	0x3c, 0x5d, 0x35, 0xab, 0x26, 0x85, 0x64, 0xe7, 0xc9, 0x65, 0x89, 0xb3, 0x03, 0xe1, 0x8c, 0xef,
+	0x82, 0x39, 0xd5, 0x24, 0x0d, 0x93, 0xc8, 0x3b, 0x88, 0x38, 0xe0, 0x0e, 0xb6, 0xd1, 0x8a, 0xac,
+	0x9a, 0xba, 0xc1, 0xf0, 0x19, 0x17, 0xaf, 0xb8, 0x59, 0xdd, 0x06, 0x83, 0xa6, 0x59, 0x68, 0x83,
+	0x54, 0x9d, 0xb4, 0x0d, 0x52, 0x65, 0xc0, 0x49, 0xd4, 0xd0, 0x0d, 0x53, 0xa6, 0x7f, 0xdd, 0xae,
+	0xc2, 0xcf, 0x71, 0xc8, 0x6e, 0x91, 0xaa, 0x82, 0x3f, 0x69, 0x61, 0x62, 0xdf, 0x40, 0x36, 0xe2,
+	0xdf, 0x86, 0x13, 0x6e, 0x25, 0x15, 0xa2, 0x5a, 0x7a, 0xd3, 0xae, 0xe8, 0x9a, 0xc0, 0xe5, 0xb9,
+	0x62, 0xac, 0x7c, 0xbe, 0xdb, 0x91, 0xb2, 0xef, 0x50, 0x6c, 0x9b, 0x42, 0x9b, 0x37, 0xfe, 0xd9,
+	0xd7, 0xa3, 0x64, 0x4d, 0x7f, 0x5b, 0xe3, 0x45, 0x48, 0xaa, 0xa8, 0x5e, 0xd7, 0x90, 0x8d, 0x84,
+	0x48, 0x9e, 0x2b, 0x66, 0x14, 0xaf, 0xcd, 0xcf, 0x42, 0x0a, 0x91, 0x7b, 0x15, 0xd5, 0x6c, 0x19,
+	0xb6, 0x10, 0x75, 0x06, 0x51, 0x92, 0x88, 0xdc, 0xdb, 0x70, 0xda, 0x0e, 0xd8, 0xd0, 0x0d, 0x06,
+	0xc6, 0x5c, 0xb0, 0xa1, 0x1b, 0x2e, 0xb8, 0x00, 0x29, 0xb5, 0xae, 0x63, 0x83, 0x96, 0x17, 0xcf,
+	0x73, 0xc5, 0x54, 0x39, 0xd3, 0xed, 0x48, 0xc9, 0x0d, 0xda, 0xb9, 0x79, 0x43, 0x49, 0xba, 0xf0,
+	0xa6, 0xc6, 0xd7, 0x20, 0xb5, 0x8b, 0x71, 0xa5, 0xae, 0x37, 0x74, 0x5b, 0x48, 0xe4, 0xa3, 0xc5,
+	0xf4, 0xea, 0x4c, 0x89, 0x29, 0xe6, 0xc8, 0x5b, 0x62, 0xf2, 0x96, 0x36, 0x4c, 0xdd, 0x28, 0x5f,
+	0x7e, 0xdc, 0x91, 0x26, 0x7e, 0xfa, 0x43, 0x2a, 0x56, 0x75, 0xbb, 0xd6, 0xda, 0x29, 0xa9, 0x66,
+	0x83, 0xc9, 0xcb, 0xfe, 0x2d, 0x13, 0xed, 0x9e, 0x6c, 0xef, 0x35, 0x31, 0xa1, 0x01, 0x44, 0x49,
+	0xee, 0x62, 0x7c, 0xdb, 0x49, 0xce, 0x4b, 0x90, 0x6e, 0x5a, 0xb8, 0x89, 0x2c, 0x5c, 0xa9, 0x22,
+	0x22, 0x1c, 0xa3, 0x35, 0x03, 0xeb, 0xba, 0x85, 0x88, 0x43, 0xc0, 0x0f, 0xb0, 0xda, 0xb2, 0x5d,
+	0x42, 0xd2, 0x25, 0xb0, 0x2e, 0x87, 0x70, 0x19, 0x12, 0x04, 0x1b, 0x1a, 0xb6, 0x84, 0x14, 0x9d,
+	0x93, 0xf0, 0xf4, 0xd1, 0xf2, 0x69, 0x56, 0xeb, 0x75, 0x4d, 0xb3, 0x30, 0x21, 0xdb, 0xb6, 0xa5,
+	0x1b, 0x55, 0x85, 0xf1, 0xf8, 0xb7, 0x20, 0x6d, 0x13, 0x52, 0xc1, 0x86, 0x6a, 0x3a, 0x61, 0x90,
+	0xe7, 0x8a, 0xd9, 0xd5, 0xe9, 0x52, 0x70, 0x05, 0x96, 0x6e, 0xba, 0x70, 0x39, 0xdb, 0xed, 0x48,
+	0xf0, 0xee, 0xf6, 0x36, 0x6b, 0x2b, 0x60, 0x13, 0xc2, 0x9e, 0xf9, 0x0f, 0x21, 0x6a, 0xeb, 0x4d,
+	0x21, 0x7d, 0xf4, 0x0a, 0x39, 0x79, 0xf9, 0x79, 0x98, 0x54, 0xcd, 0x46, 0x43, 0xb7, 0x2b, 0x16,
+	0x6e, 0x63, 0x54, 0x17, 0x32, 0x79, 0xae, 0x98, 0x54, 0x32, 0x6e, 0xa7, 0x42, 0xfb, 0xd6, 0x0b,
+	0x7f, 0x7d, 0x2f, 0x71, 0x9f, 0x3d, 0x7f, 0xb8, 0xc8, 0xa6, 0xf7, 0xe5, 0xf3, 0x87, 0x8b, 0x6c,
+	0x41, 0xc9, 0x6c, 0x91, 0x16, 0x04, 0x38, 0x13, 0x5c, 0xb2, 0x0a, 0x26, 0x4d, 0xd3, 0x20, 0xb8,
+	0xf0, 0x79, 0x04, 0x26, 0x29, 0xd4, 0x34, 0x2d, 0x77, 0x31, 0xaf, 0x01, 0x58, 0x2e, 0xb1, 0xbf,
+	0x8c, 0xc5, 0x6e, 0x47, 0x4a, 0xb1, 0x70, 0xba, 0x82, 0xfb, 0x0d, 0x25, 0xc5, 0xd8, 0x9b, 0x1a,
+	0xff, 0x06, 0xa4, 0x2d, 0x74, 0xbf, 0x62, 0xd1, 0x64, 0x44, 0x88, 0x30, 0x59, 0x06, 0x84, 0x55,
+	0xd0, 0x7d, 0x77, 0xb8, 0x72, 0xcc, 0x91, 0x45, 0x01, 0xab, 0xd7, 0x41, 0xf8, 0xff, 0x43, 0xaa,
+	0x8d, 0xea, 0xba, 0x86, 0x6c, 0xd3, 0xa2, 0xab, 0x3b, 0x55, 0x3e, 0xf7, 0xf4, 0xd1, 0xf2, 0x1c,
+	0x53, 0xf6, 0xbd, 0x1e, 0x16, 0x34, 0xb6, 0x1f, 0xc3, 0xf3, 0x10, 0x23, 0xa8, 0xee, 0x2e, 0xfe,
+	0x8c, 0x42, 0x9f, 0xd7, 0xcf, 0xf7, 0x14, 0xea, 0xf3, 0x1c, 0x91, 0x26, 0x3d, 0x91, 0x9c, 0xb1,
+	0x0b, 0xd3, 0x30, 0x15, 0x10, 0xc2, 0x93, 0xe8, 0x57, 0x0e, 0x8e, 0x6f, 0x91, 0xea, 0x06, 0x13,
+	0xdd, 0xc1, 0x5f, 0x46, 0x24, 0x1e, 0x62, 0x35, 0x44, 0x6a, 0x6c, 0x63, 0xd3, 0xe7, 0x97, 0x9e,
+	0xf6, 0xfa, 0x62, 0xf8, 0x14, 0x4f, 0xb1, 0x29, 0xfa, 0x6b, 0x2f, 0xcc, 0xc0, 0xf4, 0xc0, 0x74,
+	0xbc, 0xa9, 0x7e, 0x1d, 0x85, 0x53, 0x0e, 0x66, 0x61, 0x64, 0x63, 0x47, 0x84, 0x6d, 0xb3, 0x65,
+	0xa9, 0xd8, 0xa9, 0xd9, 0x40, 0x0d, 0x4c, 0x27, 0x9a, 0x52, 0xe8, 0x33, 0x9f, 0x87, 0xb4, 0x86,
+	0xdd, 0xf3, 0x4e, 0x37, 0x0d, 0x3a, 0x9d, 0x94, 0xe2, 0xef, 0xe2, 0x73, 0xc0, 0xf6, 0x29, 0xda,
+	0xa9, 0x63, 0x3a, 0xad, 0x8c, 0xe2, 0xeb, 0x71, 0x76, 0xcf, 0x2e, 0xc6, 0x42, 0xec, 0x15, 0xec,
+	0x9e, 0x5d, 0x8c, 0xf9, 0xab, 0x90, 0xb4, 0x2d, 0x8c, 0x48, 0xcb, 0xda, 0x13, 0xe2, 0x63, 0x8e,
+	0x06, 0x8f, 0xc9, 0x97, 0x20, 0x6e, 0xde, 0x37, 0xb0, 0x25, 0x24, 0xc6, 0x84, 0xb8, 0x34, 0xdf,
+	0xf1, 0x73, 0xec, 0x60, 0xc7, 0xcf, 0xfa, 0x52, 0xc8, 0x86, 0x9d, 0xee, 0x19, 0x35, 0xa0, 0x7c,
+	0x61, 0x0e, 0x66, 0x43, 0x0c, 0xf1, 0x0c, 0xfb, 0x2d, 0x0a, 0x27, 0xb7, 0x48, 0xf5, 0xa6, 0xa6,
+	0xdb, 0x3e, 0xbb, 0xde, 0x84, 0xac, 0xf3, 0xae, 0xa8, 0x10, 0xda, 0xec, 0xaf, 0xd0, 0x7c, 0xb7,
+	0x23, 0x65, 0xfa, 0x3c, 0xba, 0x48, 0x03, 0x6d, 0x25, 0xa3, 0xf5, 0x5b, 0x9a, 0x67, 0x7b, 0x64,
+	0xb8, 0xed, 0xd1, 0x71, 0xb6, 0xc7, 0x86, 0xd9, 0x1e, 0x7f, 0x0d, 0xb6, 0x27, 0x0e, 0x6f, 0xfb,
+	0xb1, 0xc3, 0xda, 0x9e, 0x3c, 0xa0, 0xed, 0x0b, 0x21, 0xb6, 0x4f, 0x31, 0xdb, 0x83, 0xfe, 0x15,
+	0x66, 0x61, 0x66, 0x9f, 0xa9, 0x9e, 0xe5, 0xbf, 0x44, 0x60, 0xca, 0x5b, 0x12, 0xfe, 0x8b, 0xc4,
+	0x0b, 0xee, 0xd2, 0x33, 0x90, 0x20, 0x6a, 0x0d, 0x37, 0x10, 0xf3, 0x92, 0xb5, 0xf8, 0x35, 0x38,
+	0xce, 0xd6, 0x8f, 0xf3, 0xae, 0xab, 0xb4, 0xac, 0x3a, 0xf5, 0x32, 0x55, 0x3e, 0xd9, 0xed, 0x48,
+	0x93, 0x6e, 0x51, 0x1b, 0xa6, 0x86, 0xef, 0x2a, 0xb7, 0x95, 0x49, 0xd2, 0x6f, 0x5a, 0x75, 0xa7,
+	0x10, 0x27, 0x86, 0xee, 0xba, 0x8c, 0x42, 0x9f, 0x5f, 0xc3, 0xbe, 0x5a, 0x0e, 0x11, 0x78, 0x26,
+	0xb0, 0xaf, 0xfc, 0x6a, 0x15, 0x24, 0x98, 0x0b, 0x95, 0xd1, 0x13, 0xfa, 0x5b, 0xf7, 0x30, 0x74,
+	0x6c, 0x08, 0xc8, 0x7c, 0xd4, 0xb7, 0xbd, 0x17, 0xdb, 0x65, 0x7d, 0xdb, 0x62, 0xe3, 0x6c, 0x8b,
+	0x1f, 0xd2, 0xb6, 0x44, 0x98, 0x6d, 0xaf, 0x6c, 0x5f, 0x8c, 0x3a, 0x0e, 0x07, 0xb5, 0x67, 0xc7,
+	0xe1, 0x60, 0xb7, 0x67, 0xd9, 0x77, 0x11, 0x90, 0xb6, 0x48, 0xf5, 0x6e, 0x53, 0x0b, 0x1c, 0x97,
+	0xd7, 0x55, 0x15, 0x13, 0x72, 0xc7, 0xac, 0xeb, 0xea, 0xde, 0x91, 0x1d, 0x8e, 0xb7, 0x60, 0x12,
+	0xd1, 0xbc, 0x95, 0x26, 0x4d, 0x4c, 0xfd, 0x4b, 0xaf, 0x9e, 0x1d, 0xbc, 0xee, 0xf8, 0x07, 0x67,
+	0x37, 0x9e, 0x0c, 0xf2, 0x17, 0xd4, 0x97, 0x2c, 0x7a, 0x40, 0xc9, 0xae, 0x85, 0x48, 0x36, 0xcf,
+	0x24, 0x1b, 0x35, 0xf7, 0xc2, 0x02, 0x5c, 0x1a, 0x23, 0x8f, 0x27, 0xe5, 0x8f, 0x11, 0x38, 0xe7,
+	0x71, 0xfd, 0x62, 0x07, 0xc4, 0x3c, 0xea, 0xbd, 0xf0, 0x1f, 0x8a, 0xba, 0x16, 0x22, 0xea, 0x85,
+	0x80, 0xa8, 0xc3, 0x54, 0x28, 0x2c, 0xc1, 0xc2, 0x58, 0xa9, 0x3c, 0x61, 0xdb, 0x90, 0xde, 0x22,
+	0xd5, 0xeb, 0xaa, 0xad, 0xb7, 0x91, 0x8d, 0x83, 0x57, 0x3f, 0xee, 0x05, 0xae, 0x7e, 0x17, 0xc3,
+	0xaf, 0x7e, 0xc7, 0x59, 0xe9, 0xbd, 0x81, 0x0a, 0x53, 0xf4, 0x34, 0xeb, 0x35, 0xbd, 0x72, 0x7e,
+	0x70, 0x6f, 0xb7, 0x6e, 0xf1, 0x77, 0x90, 0x85, 0x1a, 0x84, 0xff, 0x1f, 0xa4, 0x50, 0xcb, 0xae,
+	0x99, 0x96, 0x6e, 0xef, 0x09, 0xdc, 0x18, 0xfd, 0xfa, 0x54, 0xfe, 0x2a, 0x24, 0x9a, 0x34, 0x03,
+	0xb3, 0xed, 0xcc, 0xa0, 0x6d, 0x6e, 0x7e, 0x66, 0x18, 0xe3, 0xae, 0x5f, 0xa4, 0xc5, 0x7b, 0x59,
+	0xfc, 0xf7, 0x56, 0x7f, 0x55, 0xec, 0xde, 0xea, 0xef, 0xea, 0x4d, 0x62, 0xf5, 0xef, 0x24, 0x44,
+	0xb7, 0x48, 0x95, 0xbf, 0x0b, 0x69, 0xff, 0x77, 0x79, 0x6e, 0x70, 0xfc, 0xe0, 0x47, 0x90, 0x78,
+	0x71, 0x34, 0xde, 0x4b, 0xcf, 0x2b, 0x00, 0xbe, 0x0f, 0xa4, 0xb9, 0xd0, 0xa8, 0x1e, 0x2c, 0x5e,
+	0x18, 0x09, 0x7b, 0x39, 0xdf, 0x87, 0x4c, 0xe0, 0x8b, 0x42, 0x0a, 0x09, 0xf3, 0x13, 0xc4, 0x4b,
+	0x63, 0x08, 0x5e, 0x66, 0x0d, 0x4e, 0xec, 0xbb, 0xc0, 0xcf, 0x87, 0x05, 0x0f, 0x90, 0xc4, 0xa5,
+	0x03, 0x90, 0xbc, 0x51, 0x3e, 0x82, 0xec, 0xc0, 0xad, 0xf3, 0x5c, 0x48, 0x78, 0x90, 0x22, 0x2e,
+	0x8c, 0xa5, 0x78, 0xf9, 0x3f, 0x06, 0x3e, 0xe4, 0x8a, 0x73, 0x61, 0x68, 0x89, 0x7e, 0x9a, 0xb8,
+	0x7c, 0x20, 0x9a, 0x5f, 0xb1, 0x7d, 0x6f, 0xf9, 0xf9, 0x21, 0xa5, 0x06, 0xc6, 0x59, 0x3a, 0x00,
+	0xc9, 0x1b, 0xe5, 0x0b, 0x0e, 0xce, 0x8e, 0x7c, 0x33, 0xc9, 0x21, 0xd9, 0x46, 0x05, 0x88, 0xd7,
+	0x0e, 0x19, 0xe0, 0x95, 0xf2, 0x15, 0x07, 0xb9, 0x31, 0x27, 0xfb, 0xca, 0xd0, 0xdc, 0xc3, 0x42,
+	0xc4, 0xb5, 0x43, 0x87, 0x78, 0x05, 0xdd, 0x86, 0xa4, 0x77, 0x22, 0xce, 0x86, 0xa4, 0xe9, 0x81,
+	0xe2, 0xfc, 0x08, 0xd0, 0xbf, 0xb7, 0x02, 0xe7, 0x99, 0x34, 0xb4, 0x30, 0x97, 0x20, 0x5e, 0x1a,
+	0x43, 0xe8, 0x65, 0x16, 0xe3, 0x9f, 0x3e, 0x7f, 0xb8, 0xc8, 0x95, 0x37, 0x1f, 0x77, 0x73, 0xdc,
+	0x93, 0x6e, 0x8e, 0xfb, 0xb3, 0x9b, 0xe3, 0xbe, 0x79, 0x96, 0x9b, 0x78, 0xf2, 0x2c, 0x37, 0xf1,
+	0xfb, 0xb3, 0xdc, 0xc4, 0x07, 0xb2, 0xef, 0x63, 0xc5, 0xc9, 0x49, 0x7f, 0x33, 0x54, 0xcd, 0xba,
+	0xac, 0xd6, 0x90, 0x6e, 0xc8, 0xed, 0x2b, 0xf2, 0x83, 0xde, 0xef, 0x97, 0xf4, 0xcb, 0x65, 0x27,
+	0x41, 0x19, 0x57, 0xfe, 0x1d, 0x00, 0xa0, 0x8b, 0xb3, 0x53, 0x1a, 0x15, 0x00, 0x00,
 }
 
 func (this *MsgRequestData) Equal(that interface{}) bool {
@@ -1228,6 +1594,17 @@ func (this *MsgRequestData) Equal(that interface{}) bool {
 	if this.TSSEncoder != that1.TSSEncoder {
 		return false
 	}
+	if len(this.Tip) != len(that1.Tip) {
+		return false
+	}
+	for i := range this.Tip {
+		if !this.Tip[i].Equal(&that1.Tip[i]) {
+			return false
+		}
+	}
+	if this.CommitReveal != that1.CommitReveal {
+		return false
+	}
 	return true
 }
 func (this *MsgReportData) Equal(that interface{}) bool {
@@ -1263,16 +1640,19 @@ func (this *MsgReportData) Equal(that interface{}) bool {
 	if this.Validator != that1.Validator {
 		return false
 	}
+	if !bytes.Equal(this.Salt, that1.Salt) {
+		return false
+	}
 	return true
 }
-func (this *MsgCreateDataSource) Equal(that interface{}) bool {
+func (this *MsgCommitReport) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgCreateDataSource)
+	that1, ok := that.(*MsgCommitReport)
 	if !ok {
-		that2, ok := that.(MsgCreateDataSource)
+		that2, ok := that.(MsgCommitReport)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1284,20 +1664,50 @@ func (this *MsgCreateDataSource) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.RequestID != that1.RequestID {
 		return false
 	}
-	if this.Description != that1.Description {
+	if !bytes.Equal(this.Hash, that1.Hash) {
 		return false
 	}
-	if !bytes.Equal(this.Executable, that1.Executable) {
+	if this.Validator != that1.Validator {
 		return false
 	}
-	if len(this.Fee) != len(that1.Fee) {
-		return false
+	return true
+}
+func (this *MsgCreateDataSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.Fee {
-		if !this.Fee[i].Equal(&that1.Fee[i]) {
+
+	that1, ok := that.(*MsgCreateDataSource)
+	if !ok {
+		that2, ok := that.(MsgCreateDataSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !bytes.Equal(this.Executable, that1.Executable) {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
 			return false
 		}
 	}
@@ -1449,6 +1859,66 @@ func (this *MsgEditOracleScript) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateDataSourceAccessPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateDataSourceAccessPolicy)
+	if !ok {
+		that2, ok := that.(MsgUpdateDataSourceAccessPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if !this.AccessPolicy.Equal(&that1.AccessPolicy) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateOracleScriptAccessPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateOracleScriptAccessPolicy)
+	if !ok {
+		that2, ok := that.(MsgUpdateOracleScriptAccessPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if !this.AccessPolicy.Equal(&that1.AccessPolicy) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
 func (this *MsgActivate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1490,6 +1960,9 @@ type MsgClient interface {
 	RequestData(ctx context.Context, in *MsgRequestData, opts ...grpc.CallOption) (*MsgRequestDataResponse, error)
 	// ReportData defines a method for reporting a data to resolve the request.
 	ReportData(ctx context.Context, in *MsgReportData, opts ...grpc.CallOption) (*MsgReportDataResponse, error)
+	// CommitReport defines a method for committing to a report of a request in
+	// commit-reveal mode before revealing it with ReportData.
+	CommitReport(ctx context.Context, in *MsgCommitReport, opts ...grpc.CallOption) (*MsgCommitReportResponse, error)
 	// CreateDataSource defines a method for creating a new data source.
 	CreateDataSource(ctx context.Context, in *MsgCreateDataSource, opts ...grpc.CallOption) (*MsgCreateDataSourceResponse, error)
 	// EditDataSource defines a method for editing an existing data source.
@@ -1498,6 +1971,12 @@ type MsgClient interface {
 	CreateOracleScript(ctx context.Context, in *MsgCreateOracleScript, opts ...grpc.CallOption) (*MsgCreateOracleScriptResponse, error)
 	// EditOracleScript defines a method for editing an existing oracle script.
 	EditOracleScript(ctx context.Context, in *MsgEditOracleScript, opts ...grpc.CallOption) (*MsgEditOracleScriptResponse, error)
+	// UpdateDataSourceAccessPolicy defines a method for updating the access
+	// policy of an existing data source.
+	UpdateDataSourceAccessPolicy(ctx context.Context, in *MsgUpdateDataSourceAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateDataSourceAccessPolicyResponse, error)
+	// UpdateOracleScriptAccessPolicy defines a method for updating the access
+	// policy of an existing oracle script.
+	UpdateOracleScriptAccessPolicy(ctx context.Context, in *MsgUpdateOracleScriptAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateOracleScriptAccessPolicyResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
@@ -1533,6 +2012,15 @@ func (c *msgClient) ReportData(ctx context.Context, in *MsgReportData, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CommitReport(ctx context.Context, in *MsgCommitReport, opts ...grpc.CallOption) (*MsgCommitReportResponse, error) {
+	out := new(MsgCommitReportResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/CommitReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateDataSource(ctx context.Context, in *MsgCreateDataSource, opts ...grpc.CallOption) (*MsgCreateDataSourceResponse, error) {
 	out := new(MsgCreateDataSourceResponse)
 	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/CreateDataSource", in, out, opts...)
@@ -1569,6 +2057,24 @@ func (c *msgClient) EditOracleScript(ctx context.Context, in *MsgEditOracleScrip
 	return out, nil
 }
 
+func (c *msgClient) UpdateDataSourceAccessPolicy(ctx context.Context, in *MsgUpdateDataSourceAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateDataSourceAccessPolicyResponse, error) {
+	out := new(MsgUpdateDataSourceAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/UpdateDataSourceAccessPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateOracleScriptAccessPolicy(ctx context.Context, in *MsgUpdateOracleScriptAccessPolicy, opts ...grpc.CallOption) (*MsgUpdateOracleScriptAccessPolicyResponse, error) {
+	out := new(MsgUpdateOracleScriptAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/UpdateOracleScriptAccessPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Activate(ctx context.Context, in *MsgActivate, opts ...grpc.CallOption) (*MsgActivateResponse, error) {
 	out := new(MsgActivateResponse)
 	err := c.cc.Invoke(ctx, "/band.oracle.v1.Msg/Activate", in, out, opts...)
@@ -1593,6 +2099,9 @@ type MsgServer interface {
 	RequestData(context.Context, *MsgRequestData) (*MsgRequestDataResponse, error)
 	// ReportData defines a method for reporting a data to resolve the request.
 	ReportData(context.Context, *MsgReportData) (*MsgReportDataResponse, error)
+	// CommitReport defines a method for committing to a report of a request in
+	// commit-reveal mode before revealing it with ReportData.
+	CommitReport(context.Context, *MsgCommitReport) (*MsgCommitReportResponse, error)
 	// CreateDataSource defines a method for creating a new data source.
 	CreateDataSource(context.Context, *MsgCreateDataSource) (*MsgCreateDataSourceResponse, error)
 	// EditDataSource defines a method for editing an existing data source.
@@ -1601,6 +2110,12 @@ type MsgServer interface {
 	CreateOracleScript(context.Context, *MsgCreateOracleScript) (*MsgCreateOracleScriptResponse, error)
 	// EditOracleScript defines a method for editing an existing oracle script.
 	EditOracleScript(context.Context, *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error)
+	// UpdateDataSourceAccessPolicy defines a method for updating the access
+	// policy of an existing data source.
+	UpdateDataSourceAccessPolicy(context.Context, *MsgUpdateDataSourceAccessPolicy) (*MsgUpdateDataSourceAccessPolicyResponse, error)
+	// UpdateOracleScriptAccessPolicy defines a method for updating the access
+	// policy of an existing oracle script.
+	UpdateOracleScriptAccessPolicy(context.Context, *MsgUpdateOracleScriptAccessPolicy) (*MsgUpdateOracleScriptAccessPolicyResponse, error)
 	// Activate defines a method for applying to be an oracle validator.
 	Activate(context.Context, *MsgActivate) (*MsgActivateResponse, error)
 	// UpdateParams defines a governance operation for updating the x/oracle module
@@ -1620,6 +2135,9 @@ func (*UnimplementedMsgServer) RequestData(ctx context.Context, req *MsgRequestD
 func (*UnimplementedMsgServer) ReportData(ctx context.Context, req *MsgReportData) (*MsgReportDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportData not implemented")
 }
+func (*UnimplementedMsgServer) CommitReport(ctx context.Context, req *MsgCommitReport) (*MsgCommitReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReport not implemented")
+}
 func (*UnimplementedMsgServer) CreateDataSource(ctx context.Context, req *MsgCreateDataSource) (*MsgCreateDataSourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDataSource not implemented")
 }
@@ -1632,6 +2150,12 @@ func (*UnimplementedMsgServer) CreateOracleScript(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) EditOracleScript(ctx context.Context, req *MsgEditOracleScript) (*MsgEditOracleScriptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleScript not implemented")
 }
+func (*UnimplementedMsgServer) UpdateDataSourceAccessPolicy(ctx context.Context, req *MsgUpdateDataSourceAccessPolicy) (*MsgUpdateDataSourceAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDataSourceAccessPolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOracleScriptAccessPolicy(ctx context.Context, req *MsgUpdateOracleScriptAccessPolicy) (*MsgUpdateOracleScriptAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracleScriptAccessPolicy not implemented")
+}
 func (*UnimplementedMsgServer) Activate(ctx context.Context, req *MsgActivate) (*MsgActivateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
@@ -1679,6 +2203,24 @@ func _Msg_ReportData_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/CommitReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitReport(ctx, req.(*MsgCommitReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateDataSource)
 	if err := dec(in); err != nil {
@@ -1751,6 +2293,42 @@ func _Msg_EditOracleScript_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDataSourceAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDataSourceAccessPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDataSourceAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/UpdateDataSourceAccessPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDataSourceAccessPolicy(ctx, req.(*MsgUpdateDataSourceAccessPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateOracleScriptAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracleScriptAccessPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracleScriptAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/band.oracle.v1.Msg/UpdateOracleScriptAccessPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracleScriptAccessPolicy(ctx, req.(*MsgUpdateOracleScriptAccessPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgActivate)
 	if err := dec(in); err != nil {
@@ -1787,6 +2365,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "band.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1799,6 +2378,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportData",
 			Handler:    _Msg_ReportData_Handler,
 		},
+		{
+			MethodName: "CommitReport",
+			Handler:    _Msg_CommitReport_Handler,
+		},
 		{
 			MethodName: "CreateDataSource",
 			Handler:    _Msg_CreateDataSource_Handler,
@@ -1815,6 +2398,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleScript",
 			Handler:    _Msg_EditOracleScript_Handler,
 		},
+		{
+			MethodName: "UpdateDataSourceAccessPolicy",
+			Handler:    _Msg_UpdateDataSourceAccessPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateOracleScriptAccessPolicy",
+			Handler:    _Msg_UpdateOracleScriptAccessPolicy_Handler,
+		},
 		{
 			MethodName: "Activate",
 			Handler:    _Msg_Activate_Handler,
@@ -1848,6 +2439,30 @@ func (m *MsgRequestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitReveal {
+		i--
+		if m.CommitReveal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Tip) > 0 {
+		for iNdEx := len(m.Tip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.TSSEncoder != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TSSEncoder))
 		i--
@@ -1959,6 +2574,13 @@ func (m *MsgReportData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
@@ -2011,6 +2633,71 @@ func (m *MsgReportDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCommitReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCreateDataSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2415,7 +3102,7 @@ func (m *MsgEditOracleScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgActivate) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateDataSourceAccessPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2425,27 +3112,42 @@ func (m *MsgActivate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgActivate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateDataSourceAccessPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateDataSourceAccessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.AccessPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DataSourceID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateDataSourceAccessPolicyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2455,12 +3157,12 @@ func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateDataSourceAccessPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateDataSourceAccessPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2468,7 +3170,7 @@ func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateOracleScriptAccessPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2478,18 +3180,25 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateOracleScriptAccessPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateOracleScriptAccessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.AccessPolicy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2498,8 +3207,122 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
+	if m.OracleScriptID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
@@ -2584,6 +3407,15 @@ func (m *MsgRequestData) Size() (n int) {
 	if m.TSSEncoder != 0 {
 		n += 1 + sovTx(uint64(m.TSSEncoder))
 	}
+	if len(m.Tip) > 0 {
+		for _, e := range m.Tip {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CommitReveal {
+		n += 2
+	}
 	return n
 }
 
@@ -2615,6 +3447,10 @@ func (m *MsgReportData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2627,6 +3463,35 @@ func (m *MsgReportDataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCommitReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreateDataSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2821,6 +3686,60 @@ func (m *MsgEditOracleScriptResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateDataSourceAccessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceID != 0 {
+		n += 1 + sovTx(uint64(m.DataSourceID))
+	}
+	l = m.AccessPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDataSourceAccessPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTx(uint64(m.OracleScriptID))
+	}
+	l = m.AccessPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgActivate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3148,6 +4067,60 @@ func (m *MsgRequestData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tip = append(m.Tip, types.Coin{})
+			if err := m.Tip[len(m.Tip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitReveal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3333,21 +4306,55 @@ func (m *MsgReportData) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -3404,6 +4411,191 @@ func (m *MsgReportDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCommitReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= RequestID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgCreateDataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4750,6 +5942,374 @@ func (m *MsgEditOracleScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateDataSourceAccessPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDataSourceAccessPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDataSourceAccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= DataSourceID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDataSourceAccessPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDataSourceAccessPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDataSourceAccessPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOracleScriptAccessPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOracleScriptAccessPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOracleScriptAccessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= OracleScriptID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOracleScriptAccessPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOracleScriptAccessPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOracleScriptAccessPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgActivate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,225 @@
+package yoda
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/store"
+)
+
+// requestStatus is the status of an in-flight request returned by the admin API.
+type requestStatus struct {
+	RequestID  types.RequestID `json:"request_id"`
+	SeenHeight int64           `json:"seen_height"`
+	Results    []store.Result  `json:"results"`              // Raw requests executed so far
+	Submission string          `json:"submission,omitempty"` // Empty if the reports are not executed yet
+}
+
+// keyStatus is the status of a key returned by the admin API.
+type keyStatus struct {
+	Name       string            `json:"name"`
+	Address    string            `json:"address"`
+	Paused     bool              `json:"paused"`
+	Submitting bool              `json:"submitting"`
+	InFlight   uint64            `json:"in_flight"`
+	Waiting    []types.RequestID `json:"waiting"`
+}
+
+// executorStatus is the status of the executor returned by the admin API.
+type executorStatus struct {
+	ExecCacheEnabled bool                    `json:"exec_cache_enabled"`
+	Backends         []executor.BackendStats `json:"backends,omitempty"` // Only for the multi executor
+}
+
+// adminListen serves the admin API on the given address. The API has no authentication and is meant
+// for the operator of the validator only, so it only listens on a loopback address.
+func adminListen(listenAddr string, c *Context, l *Logger) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"validator":       c.validator.String(),
+			"scanned_height":  atomic.LoadInt64(&c.scannedHeight),
+			"handling_count":  atomic.LoadInt64(&c.handlingGauge),
+			"pending_count":   atomic.LoadInt64(&c.pendingGauge),
+			"error_count":     atomic.LoadInt64(&c.errorCount),
+			"submitted_count": atomic.LoadInt64(&c.submittedCount),
+		})
+	})
+	mux.HandleFunc("GET /requests", func(w http.ResponseWriter, r *http.Request) {
+		statuses, err := getRequestStatuses(c)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, statuses)
+	})
+	mux.HandleFunc("POST /requests/{id}/resubmit", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request ID")
+			return
+		}
+
+		rid := types.RequestID(id)
+		if c.isRequestActive(rid) {
+			writeError(w, http.StatusConflict, "request is being handled or its report is being submitted")
+			return
+		}
+		// Only the requests still waiting for the report of this validator can be resubmitted.
+		pending, err := GetPendingRequests(c, l)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if !slices.Contains(pending, rid) {
+			writeError(w, http.StatusConflict, "request is not pending for this validator")
+			return
+		}
+
+		// Handle the request again, reusing its stored results if any.
+		l.Info(":repeat: Resubmitting request %d by operator", id)
+		c.trackRequest(rid, atomic.LoadInt64(&c.scannedHeight))
+		go handleRequest(c, l, rid)
+		writeJSON(w, http.StatusAccepted, map[string]uint64{"request_id": id})
+	})
+	mux.HandleFunc("GET /keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, getKeyStatuses(c))
+	})
+	mux.HandleFunc("POST /keys/{name}/pause", func(w http.ResponseWriter, r *http.Request) {
+		setKeyPausedByName(c, l, w, r.PathValue("name"), true)
+	})
+	mux.HandleFunc("POST /keys/{name}/resume", func(w http.ResponseWriter, r *http.Request) {
+		setKeyPausedByName(c, l, w, r.PathValue("name"), false)
+	})
+	mux.HandleFunc("GET /txs", func(w http.ResponseWriter, r *http.Request) {
+		c.recentTxsMu.Lock()
+		txs := append([]TxRecord{}, c.recentTxs...)
+		c.recentTxsMu.Unlock()
+		writeJSON(w, http.StatusOK, txs)
+	})
+	mux.HandleFunc("GET /executor", func(w http.ResponseWriter, r *http.Request) {
+		status := executorStatus{ExecCacheEnabled: c.execCache != nil}
+		if multiExec, ok := c.executor.(*executor.MultiExec); ok {
+			status.Backends = multiExec.Stats()
+		}
+		writeJSON(w, http.StatusOK, status)
+	})
+
+	server := &http.Server{
+		Addr:              listenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	if err := server.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
+
+// getRequestStatuses returns the statuses of all in-flight requests sorted by their IDs.
+func getRequestStatuses(c *Context) ([]requestStatus, error) {
+	c.pendingRequestsMu.Lock()
+	statuses := make([]requestStatus, 0, len(c.pendingRequests))
+	for id, height := range c.pendingRequests {
+		statuses = append(statuses, requestStatus{RequestID: id, SeenHeight: height})
+	}
+	c.pendingRequestsMu.Unlock()
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].RequestID < statuses[j].RequestID })
+
+	for i := range statuses {
+		results, err := c.store.GetResults(statuses[i].RequestID)
+		if err != nil {
+			return nil, err
+		}
+		statuses[i].Results = results
+
+		submission, found, err := c.store.GetSubmission(statuses[i].RequestID)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			statuses[i].Submission = "executed"
+			if submission.Status == store.StatusCommitted {
+				statuses[i].Submission = "committed"
+			}
+		}
+	}
+
+	return statuses, nil
+}
+
+// getKeyStatuses returns the statuses of all keys.
+func getKeyStatuses(c *Context) []keyStatus {
+	c.keyStatesMu.Lock()
+	defer c.keyStatesMu.Unlock()
+
+	statuses := make([]keyStatus, len(c.keys))
+	for i, key := range c.keys {
+		address, _ := key.GetAddress()
+		statuses[i] = keyStatus{
+			Name:       key.Name,
+			Address:    address.String(),
+			Paused:     c.keyStates[i].paused,
+			Submitting: c.keyStates[i].inFlight > 0,
+			InFlight:   c.keyStates[i].inFlight,
+			Waiting:    c.keyStates[i].waiting,
+		}
+	}
+
+	return statuses
+}
+
+// setKeyPausedByName pauses or resumes the submission of the key with the given name.
+func setKeyPausedByName(c *Context, l *Logger, w http.ResponseWriter, name string, paused bool) {
+	for i, key := range c.keys {
+		if key.Name != name {
+			continue
+		}
+
+		if paused {
+			l.Info(":pause_button: Pausing key %s by operator", name)
+		} else {
+			l.Info(":play_button: Resuming key %s by operator", name)
+		}
+		c.setKeyPaused(int64(i), paused)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"name": name, "paused": paused})
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "key not found")
+}
+
+// checkLoopbackAddr returns an error if the given listen address is not a loopback address.
+func checkLoopbackAddr(listenAddr string) error {
+	host, _, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return fmt.Errorf("invalid admin listen address: %w", err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("admin API has no authentication and must listen on a loopback address: %s", listenAddr)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, map[string]string{"error": msg})
+}
@@ -0,0 +1,117 @@
+package yoda
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/audit"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
+)
+
+// auditExecution appends the record of the execution of a raw request to the audit archive.
+func auditExecution(
+	c *Context,
+	l *Logger,
+	id types.RequestID,
+	req rawRequest,
+	result executor.ExecResult,
+	cached bool,
+	execErr error,
+) {
+	outputHash := sha256.Sum256(result.Output)
+	record := audit.Record{
+		Type:            audit.RecordTypeExecution,
+		RequestID:       id,
+		Time:            time.Now(),
+		ExternalID:      req.externalID,
+		DataSourceID:    req.dataSourceID,
+		DataSourceHash:  req.dataSourceHash,
+		Calldata:        req.calldata,
+		ExitCode:        result.Code,
+		Output:          result.Output,
+		OutputHash:      hex.EncodeToString(outputHash[:]),
+		ExecutorVersion: result.Version,
+		Cached:          cached,
+	}
+	if execErr != nil {
+		record.Error = execErr.Error()
+	}
+
+	if err := c.audit.Append(record); err != nil {
+		l.Error(":skull: Failed to append audit record with error: %s", c, err.Error())
+	}
+}
+
+// auditSubmission appends the records of the submission of the given reports to the audit archive.
+func auditSubmission(c *Context, l *Logger, reports []ReportMsgWithKey, txHash string, height int64, errMsg string) {
+	for _, report := range reports {
+		err := c.audit.Append(audit.Record{
+			Type:      audit.RecordTypeSubmission,
+			RequestID: report.msg.GetRequestID(),
+			Time:      time.Now(),
+			MsgType:   sdk.MsgTypeURL(report.msg),
+			TxHash:    txHash,
+			Height:    height,
+			Error:     errMsg,
+		})
+		if err != nil {
+			l.Error(":skull: Failed to append audit record with error: %s", c, err.Error())
+		}
+	}
+}
+
+func auditCmd(c *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect the audit archive of the executed and submitted reports",
+	}
+	cmd.AddCommand(auditShowCmd(c))
+	return cmd
+}
+
+func auditShowCmd(c *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show [request-id]",
+		Aliases: []string{"s"},
+		Short:   "Show the audit records of the request",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			archive, err := audit.NewArchive(filepath.Join(c.home, "audit"))
+			if err != nil {
+				return err
+			}
+			records, err := archive.Records(types.RequestID(id))
+			if err != nil {
+				return err
+			}
+			if len(records) == 0 {
+				return fmt.Errorf("no audit record of request %d", id)
+			}
+
+			for _, record := range records {
+				bz, err := json.MarshalIndent(record, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(bz))
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// requestsPerFile is the number of consecutive request IDs whose records share an archive file.
+const requestsPerFile = 10000
+
+// Archive is an append-only archive of audit records stored as JSON lines files. The records of
+// consecutive request IDs are grouped into the same file, so that the records of a request can be
+// found without scanning the whole archive.
+type Archive struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewArchive creates a new Archive in the given directory.
+func NewArchive(dir string) (*Archive, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	return &Archive{dir: dir}, nil
+}
+
+// filePath returns the path of the archive file of the given request ID.
+func (a *Archive) filePath(requestID types.RequestID) string {
+	bucket := uint64(requestID) / requestsPerFile
+	return filepath.Join(a.dir, fmt.Sprintf("%d-%d.jsonl", bucket*requestsPerFile, (bucket+1)*requestsPerFile-1))
+}
+
+// Append appends the record to the archive and flushes it to disk.
+func (a *Archive) Append(record Record) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	f, err := os.OpenFile(a.filePath(record.RequestID), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// Terminate the line left incomplete by a crash while appending, so that it does not corrupt
+	// the new record.
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() > 0 {
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, info.Size()-1); err != nil {
+			return err
+		}
+		if last[0] != '\n' {
+			bz = append([]byte{'\n'}, bz...)
+		}
+	}
+
+	if _, err := f.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// Records returns all records of the given request ID in the order they were appended.
+func (a *Archive) Records(requestID types.RequestID) ([]Record, error) {
+	f, err := os.Open(a.filePath(requestID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	// A record may contain an output of the maximum report data size, which exceeds the default
+	// buffer size of the scanner.
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		// Skip the lines left incomplete by a crash while appending.
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		if record.RequestID == requestID {
+			records = append(records, record)
+		}
+	}
+
+	return records, scanner.Err()
+}
@@ -0,0 +1,77 @@
+package audit_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/yoda/audit"
+)
+
+func TestArchive(t *testing.T) {
+	archive, err := audit.NewArchive(filepath.Join(t.TempDir(), "audit"))
+	require.NoError(t, err)
+
+	records, err := archive.Records(1)
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	now := time.Now().UTC().Round(0)
+	execution := audit.Record{
+		Type:            audit.RecordTypeExecution,
+		RequestID:       1,
+		Time:            now,
+		ExternalID:      2,
+		DataSourceID:    3,
+		DataSourceHash:  "hash",
+		Calldata:        "BTC ETH",
+		Output:          []byte("1 2"),
+		OutputHash:      "outputhash",
+		ExecutorVersion: "v1",
+	}
+	submission := audit.Record{
+		Type:      audit.RecordTypeSubmission,
+		RequestID: 1,
+		Time:      now,
+		MsgType:   "/band.oracle.v1.MsgReportData",
+		TxHash:    "TXHASH",
+		Height:    100,
+	}
+	other := audit.Record{Type: audit.RecordTypeExecution, RequestID: 2, Time: now, Error: "error"}
+	farAway := audit.Record{Type: audit.RecordTypeExecution, RequestID: 123456, Time: now}
+	for _, record := range []audit.Record{execution, other, submission, farAway} {
+		require.NoError(t, archive.Append(record))
+	}
+
+	records, err = archive.Records(1)
+	require.NoError(t, err)
+	require.Equal(t, []audit.Record{execution, submission}, records)
+
+	records, err = archive.Records(123456)
+	require.NoError(t, err)
+	require.Equal(t, []audit.Record{farAway}, records)
+}
+
+func TestArchiveSkipsIncompleteLine(t *testing.T) {
+	dir := t.TempDir()
+	archive, err := audit.NewArchive(dir)
+	require.NoError(t, err)
+
+	record := audit.Record{Type: audit.RecordTypeExecution, RequestID: 1, Time: time.Now().UTC().Round(0)}
+	require.NoError(t, archive.Append(record))
+
+	// Simulate a crash while appending a record.
+	f, err := os.OpenFile(filepath.Join(dir, "0-9999.jsonl"), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"type":"execution","request_id":1,`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	require.NoError(t, archive.Append(record))
+	records, err := archive.Records(1)
+	require.NoError(t, err)
+	require.Equal(t, []audit.Record{record, record}, records)
+}
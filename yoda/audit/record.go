@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Types of audit records.
+const (
+	RecordTypeExecution  = "execution"  // A data source script is executed for a raw request.
+	RecordTypeSubmission = "submission" // A report transaction of the request is submitted.
+)
+
+// Record is an entry of the audit archive of a request.
+type Record struct {
+	Type      string          `json:"type"`
+	RequestID types.RequestID `json:"request_id"`
+	Time      time.Time       `json:"time"`
+
+	// Fields of execution records
+	ExternalID      types.ExternalID   `json:"external_id,omitempty"`
+	DataSourceID    types.DataSourceID `json:"data_source_id,omitempty"`
+	DataSourceHash  string             `json:"data_source_hash,omitempty"`
+	Calldata        string             `json:"calldata,omitempty"`
+	ExitCode        uint32             `json:"exit_code,omitempty"`
+	Output          []byte             `json:"output,omitempty"`
+	OutputHash      string             `json:"output_hash,omitempty"` // Hex encoded SHA256 hash of the output
+	ExecutorVersion string             `json:"executor_version,omitempty"`
+	Cached          bool               `json:"cached,omitempty"` // Whether the output is shared by the execution cache
+
+	// Fields of submission records
+	MsgType string `json:"msg_type,omitempty"`
+	TxHash  string `json:"tx_hash,omitempty"`
+	Height  int64  `json:"height,omitempty"`
+
+	// Error of the execution or submission, empty if it succeeded
+	Error string `json:"error,omitempty"`
+}
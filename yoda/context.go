@@ -1,20 +1,29 @@
 package yoda
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
 	"github.com/bandprotocol/chain/v3/pkg/filecache"
+	"github.com/bandprotocol/chain/v3/pkg/gasprice"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/audit"
 	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/store"
 )
 
+// maxRecentTxs is the number of the most recent report transactions kept for the admin API.
+const maxRecentTxs = 100
+
 type FeeEstimationData struct {
 	askCount    int64
 	minCount    int64
@@ -23,45 +32,206 @@ type FeeEstimationData struct {
 	clientID    string
 }
 
+// ReportMsg is a message that yoda submits on behalf of its validator to serve a request,
+// either MsgReportData or MsgCommitReport.
+type ReportMsg interface {
+	sdk.Msg
+	ValidateBasic() error
+	GetRequestID() types.RequestID
+}
+
 type ReportMsgWithKey struct {
-	msg               *types.MsgReportData
+	msg               ReportMsg
 	execVersion       []string
 	keyIndex          int64
 	feeEstimationData FeeEstimationData
+	tip               math.Int
+}
+
+// keyState is the submission state of a key.
+type keyState struct {
+	paused   bool
+	inFlight uint64 // Number of report transactions in flight
+	waiting  []types.RequestID
+}
+
+// TxRecord is the outcome of a report transaction.
+type TxRecord struct {
+	TxHash     string            `json:"tx_hash"`
+	Key        string            `json:"key"`
+	RequestIDs []types.RequestID `json:"request_ids"`
+	Error      string            `json:"error,omitempty"`
+	Time       time.Time         `json:"time"`
 }
 
 type Context struct {
-	bandApp          *band.BandApp
-	client           rpcclient.Client
-	validator        sdk.ValAddress
-	gasPrices        string
-	keys             []*keyring.Record
-	executor         executor.Executor
-	fileCache        filecache.Cache
-	broadcastTimeout time.Duration
-	maxTry           uint64
-	rpcPollInterval  time.Duration
-	maxReport        uint64
+	bandApp           *band.BandApp
+	client            rpcclient.Client
+	validator         sdk.ValAddress
+	gasPrices         *gasprice.Provider
+	keys              []*keyring.Record
+	feeGranters       []sdk.AccAddress // Fee granter of each key, nil if the key pays its own fees
+	executor          executor.Executor
+	execCache         *executor.Cache // Nil if the execution cache is disabled
+	execCacheSkip     map[types.DataSourceID]bool
+	fileCache         filecache.Cache
+	store             *store.Store
+	audit             *audit.Archive
+	broadcastTimeout  time.Duration
+	maxTry            uint64
+	rpcPollInterval   time.Duration
+	scanInterval      time.Duration
+	reconcileInterval time.Duration
+	gasPricesRefresh  time.Duration
+	maxReport         uint64
+	maxInFlightTxs    uint64
+	tipDenom          string
+	executions        *executionSlots // Limits the requests executed at the same time, the highest tipped first
 
 	pendingMsgs        chan ReportMsgWithKey
 	freeKeys           chan int64
+	resumedKeys        chan int64
 	keyRoundRobinIndex int64 // Must use in conjunction with sync/atomic
+	sequences          []*sequenceTracker
+
+	keyStates   []keyState // Snapshots of the submission queues of the keys
+	keyStatesMu sync.Mutex
+	recentTxs   []TxRecord // The most recent report transactions, oldest first
+	recentTxsMu sync.Mutex
 
-	pendingRequests map[types.RequestID]bool
+	pendingRequests   map[types.RequestID]int64 // Request ID => height at which the request was seen
+	pendingRequestsMu sync.Mutex
+	activeRequests    map[types.RequestID]bool // Requests being handled or waiting for their report
+	activeRequestsMu  sync.Mutex
+	scannedHeight     int64 // Must use in conjunction with sync/atomic
 
 	metricsEnabled bool
 	handlingGauge  int64
 	pendingGauge   int64
 	errorCount     int64
 	submittedCount int64
+	execCacheHits  int64
+	execCacheMiss  int64
 	home           string
 }
 
 func (c *Context) nextKeyIndex() int64 {
 	keyIndex := atomic.AddInt64(&c.keyRoundRobinIndex, 1) % int64(len(c.keys))
+	// Skip the paused keys unless all keys are paused, in which case the reports wait in the queue.
+	for i := 1; i < len(c.keys) && c.isKeyPaused(keyIndex); i++ {
+		keyIndex = atomic.AddInt64(&c.keyRoundRobinIndex, 1) % int64(len(c.keys))
+	}
 	return keyIndex
 }
 
+// trackRequest marks the request seen at the given height as handled. It returns false if the
+// request is already handled, so that a request seen by multiple sources is only handled once.
+func (c *Context) trackRequest(id types.RequestID, height int64) bool {
+	c.pendingRequestsMu.Lock()
+	defer c.pendingRequestsMu.Unlock()
+
+	if _, ok := c.pendingRequests[id]; ok {
+		return false
+	}
+	c.pendingRequests[id] = height
+	return true
+}
+
+// untrackRequests stops tracking the requests seen at or below the given height that are no longer
+// pending, as their events can no longer be seen again.
+func (c *Context) untrackRequests(height int64, pending map[types.RequestID]bool) {
+	c.pendingRequestsMu.Lock()
+	defer c.pendingRequestsMu.Unlock()
+
+	for id, seenHeight := range c.pendingRequests {
+		if seenHeight <= height && !pending[id] {
+			delete(c.pendingRequests, id)
+		}
+	}
+}
+
+// startHandling marks the request as active until its report is submitted or its handling fails.
+// It returns false if the request is already active.
+func (c *Context) startHandling(id types.RequestID) bool {
+	c.activeRequestsMu.Lock()
+	defer c.activeRequestsMu.Unlock()
+
+	if c.activeRequests[id] {
+		return false
+	}
+	c.activeRequests[id] = true
+	return true
+}
+
+// finishHandling marks the request as no longer active.
+func (c *Context) finishHandling(id types.RequestID) {
+	c.activeRequestsMu.Lock()
+	defer c.activeRequestsMu.Unlock()
+
+	delete(c.activeRequests, id)
+}
+
+// isRequestActive returns whether the request is being handled or waiting for its report.
+func (c *Context) isRequestActive(id types.RequestID) bool {
+	c.activeRequestsMu.Lock()
+	defer c.activeRequestsMu.Unlock()
+
+	return c.activeRequests[id]
+}
+
+// isRequestTracked returns whether the request is still tracked as handled.
+func (c *Context) isRequestTracked(id types.RequestID) bool {
+	c.pendingRequestsMu.Lock()
+	defer c.pendingRequestsMu.Unlock()
+
+	_, ok := c.pendingRequests[id]
+	return ok
+}
+
+// isKeyPaused returns whether the submission of the key is paused by the operator.
+func (c *Context) isKeyPaused(keyIndex int64) bool {
+	c.keyStatesMu.Lock()
+	defer c.keyStatesMu.Unlock()
+
+	return c.keyStates[keyIndex].paused
+}
+
+// setKeyPaused pauses or resumes the submission of the key.
+func (c *Context) setKeyPaused(keyIndex int64, paused bool) {
+	c.keyStatesMu.Lock()
+	c.keyStates[keyIndex].paused = paused
+	c.keyStatesMu.Unlock()
+
+	if !paused {
+		c.resumedKeys <- keyIndex
+	}
+}
+
+// updateKeyQueue updates the snapshot of the submission queue of the key.
+func (c *Context) updateKeyQueue(keyIndex int64, waitingMsgs []ReportMsgWithKey, inFlight uint64) {
+	waiting := make([]types.RequestID, len(waitingMsgs))
+	for i, msg := range waitingMsgs {
+		waiting[i] = msg.msg.GetRequestID()
+	}
+
+	c.keyStatesMu.Lock()
+	defer c.keyStatesMu.Unlock()
+
+	c.keyStates[keyIndex].inFlight = inFlight
+	c.keyStates[keyIndex].waiting = waiting
+}
+
+// addTxRecord records the outcome of a report transaction, keeping only the most recent ones.
+func (c *Context) addTxRecord(record TxRecord) {
+	c.recentTxsMu.Lock()
+	defer c.recentTxsMu.Unlock()
+
+	c.recentTxs = append(c.recentTxs, record)
+	if len(c.recentTxs) > maxRecentTxs {
+		c.recentTxs = c.recentTxs[len(c.recentTxs)-maxRecentTxs:]
+	}
+}
+
 func (c *Context) updateHandlingGauge(amount int64) {
 	if c.metricsEnabled {
 		atomic.AddInt64(&c.handlingGauge, amount)
@@ -85,3 +255,15 @@ func (c *Context) updateSubmittedCount(amount int64) {
 		atomic.AddInt64(&c.submittedCount, amount)
 	}
 }
+
+func (c *Context) updateExecCacheHitCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.execCacheHits, amount)
+	}
+}
+
+func (c *Context) updateExecCacheMissCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.execCacheMiss, amount)
+	}
+}
@@ -19,11 +19,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/store"
 )
 
+// signAndBroadcast signs and broadcasts the messages with the given key, using the next sequence
+// tracked for the key. It returns the hash of the transaction, the sequence used and the bytes of
+// the transaction.
 func signAndBroadcast(
-	c *Context, key *keyring.Record, msgs []sdk.Msg, gasLimit uint64, memo string,
-) (string, error) {
+	c *Context, keyIndex int64, msgs []sdk.Msg, gasLimit uint64, memo string,
+) (string, uint64, []byte, error) {
+	key := c.keys[keyIndex]
 	clientCtx := client.Context{
 		Client:            c.client,
 		Codec:             c.bandApp.AppCodec(),
@@ -31,54 +36,61 @@ func signAndBroadcast(
 		BroadcastMode:     "sync",
 		InterfaceRegistry: c.bandApp.InterfaceRegistry(),
 	}
-	acc, err := queryAccount(clientCtx, key)
-	if err != nil {
-		return "", fmt.Errorf("unable to get account: %w", err)
-	}
-
-	txf := tx.Factory{}.
-		WithAccountNumber(acc.GetAccountNumber()).
-		WithSequence(acc.GetSequence()).
-		WithTxConfig(clientCtx.TxConfig).
-		WithGas(gasLimit).WithGasAdjustment(1).
-		WithChainID(cfg.ChainID).
-		WithMemo(memo).
-		WithGasPrices(c.gasPrices).
-		WithKeybase(kb).
-		WithAccountRetriever(clientCtx.AccountRetriever)
 
 	address, err := key.GetAddress()
 	if err != nil {
-		return "", err
+		return "", 0, nil, err
 	}
 
 	execMsg := authz.NewMsgExec(address, msgs)
 
-	txb, err := txf.BuildUnsignedTx(&execMsg)
-	if err != nil {
-		return "", err
-	}
+	var txBytes []byte
+	txHash, sequence, err := c.sequences[keyIndex].broadcast(
+		func() (client.Account, error) { return queryAccount(clientCtx, key) },
+		func(accountNumber uint64, sequence uint64) (string, error) {
+			txf := tx.Factory{}.
+				WithAccountNumber(accountNumber).
+				WithSequence(sequence).
+				WithTxConfig(clientCtx.TxConfig).
+				WithGas(gasLimit).WithGasAdjustment(1).
+				WithChainID(cfg.ChainID).
+				WithMemo(memo).
+				WithGasPrices(c.gasPrices.GasPrices()).
+				WithFeeGranter(c.feeGranters[keyIndex]).
+				WithKeybase(kb).
+				WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txb, err := txf.BuildUnsignedTx(&execMsg)
+			if err != nil {
+				return "", err
+			}
 
-	err = tx.Sign(context.Background(), txf, key.Name, txb, true)
-	if err != nil {
-		return "", err
-	}
+			err = tx.Sign(context.Background(), txf, key.Name, txb, true)
+			if err != nil {
+				return "", err
+			}
 
-	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
-	if err != nil {
-		return "", err
-	}
+			txBytes, err = clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+			if err != nil {
+				return "", err
+			}
 
-	// broadcast to a Tendermint node
-	res, err := clientCtx.BroadcastTx(txBytes)
-	if err != nil {
-		return "", err
-	}
-	// out, err := txBldr.WithKeybase(keybase).BuildAndSign(key.GetName(), ckeys.DefaultKeyPass, msgs)
-	// if err != nil {
-	// 	return "", fmt.Errorf("Failed to build tx with error: %s", err.Error())
-	// }
-	return res.TxHash, nil
+			// broadcast to a Tendermint node
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return "", err
+			}
+			// The same transaction is already in the mempool, e.g. from a previous try whose
+			// response was lost, so it is as good as accepted.
+			if res.Code != 0 && !(res.Codespace == sdkerrors.RootCodespace &&
+				res.Code == sdkerrors.ErrTxInMempoolCache.ABCICode()) {
+				return "", &checkTxError{codespace: res.Codespace, code: res.Code, rawLog: res.RawLog}
+			}
+			return res.TxHash, nil
+		},
+	)
+
+	return txHash, sequence, txBytes, err
 }
 
 func queryAccount(clientCtx client.Context, key *keyring.Record) (client.Account, error) {
@@ -103,6 +115,13 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 		c.freeKeys <- keyIndex
 	}()
 	defer c.updatePendingGauge(int64(-len(reports)))
+	defer func() {
+		for _, report := range reports {
+			if _, ok := report.msg.(*types.MsgReportData); ok {
+				c.finishHandling(report.msg.GetRequestID())
+			}
+		}
+	}()
 
 	// Summarize execute version
 	versionMap := make(map[string]bool)
@@ -116,7 +135,7 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 			return
 		}
 		msgs[i] = report.msg
-		ids[i] = report.msg.RequestID
+		ids[i] = report.msg.GetRequestID()
 		feeEstimations[i] = report.feeEstimationData
 		for _, exec := range report.execVersion {
 			versionMap[exec] = true
@@ -130,9 +149,14 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 	}
 	memo := fmt.Sprintf("yoda:%s/exec:%s", version.Version, strings.Join(versions, ","))
 	key := c.keys[keyIndex]
+	recordTx := func(txHash string, height int64, errMsg string) {
+		c.addTxRecord(TxRecord{TxHash: txHash, Key: key.Name, RequestIDs: ids, Error: errMsg, Time: time.Now()})
+		auditSubmission(c, l, reports, txHash, height, errMsg)
+	}
 
 	clientCtx := client.Context{
 		Client:            c.client,
+		Codec:             c.bandApp.AppCodec(),
 		TxConfig:          c.bandApp.GetTxConfig(),
 		InterfaceRegistry: c.bandApp.InterfaceRegistry(),
 	}
@@ -141,10 +165,12 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 	// We want to resend transaction only if tx returns Out of gas error.
 	for sendAttempt := uint64(1); sendAttempt <= c.maxTry; sendAttempt++ {
 		var txHash string
+		var sequence uint64
+		var txBytes []byte
 		l.Info(":e-mail: Sending report transaction attempt: (%d/%d)", sendAttempt, c.maxTry)
 		for broadcastTry := uint64(1); broadcastTry <= c.maxTry; broadcastTry++ {
 			l.Info(":writing_hand: Try to sign and broadcast report transaction(%d/%d)", broadcastTry, c.maxTry)
-			hash, err := signAndBroadcast(c, key, msgs, gasLimit, memo)
+			hash, seq, bz, err := signAndBroadcast(c, keyIndex, msgs, gasLimit, memo)
 			if err != nil {
 				// Use info level because this error can happen and retry process can solve this error.
 				l.Info(":warning: %s", err.Error())
@@ -153,10 +179,13 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 			}
 			// Transaction passed CheckTx process and wait to include in block.
 			txHash = hash
+			sequence = seq
+			txBytes = bz
 			break
 		}
 		if txHash == "" {
 			l.Error(":exploding_head: Cannot try to broadcast more than %d try", c, c.maxTry)
+			recordTx("", 0, "failed to broadcast")
 			return
 		}
 		txFound := false
@@ -172,6 +201,8 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 			if txRes.Code == 0 {
 				l.Info(":smiling_face_with_sunglasses: Successfully broadcast tx with hash: %s", txHash)
 				c.updateSubmittedCount(int64(len(reports)))
+				recordTx(txHash, txRes.Height, "")
+				updateSubmissions(c, l, reports)
 				return
 			}
 			if txRes.Codespace == sdkerrors.RootCodespace &&
@@ -183,19 +214,37 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 				break FindTx
 			} else {
 				l.Error(":exploding_head: Tx returned nonzero code %d with log %s, tx hash: %s", c, txRes.Code, txRes.RawLog, txRes.TxHash)
+				recordTx(txHash, txRes.Height, fmt.Sprintf("nonzero code %d: %s", txRes.Code, txRes.RawLog))
 				return
 			}
 		}
 		if !txFound {
+			if isSequenceGap(
+				sequence,
+				txBytes,
+				func() (client.Account, error) { return queryAccount(clientCtx, key) },
+				func() (*ctypes.ResultUnconfirmedTxs, error) {
+					limit := unconfirmedTxsLimit
+					return c.client.UnconfirmedTxs(context.Background(), &limit)
+				},
+			) {
+				// The transaction was dropped from the mempool, so its sequence is free again and the
+				// later transactions of the key are stuck until it is used.
+				l.Info(":hole: Tx(%s) with sequence %d was dropped and will be rebroadcasted", txHash, sequence)
+				c.sequences[keyIndex].reset()
+				continue
+			}
 			l.Error(
 				":question_mark: Cannot get transaction response from hash: %s transaction might be included in the next few blocks or check your node's health.",
 				c,
 				txHash,
 			)
+			recordTx(txHash, 0, "transaction not found")
 			return
 		}
 	}
 	l.Error(":anxious_face_with_sweat: Cannot send reports with adjusted gas: %d", c, gasLimit)
+	recordTx("", 0, "out of gas")
 }
 
 // GetExecutable fetches data source executable using the provided client.
@@ -255,6 +304,97 @@ func GetRequest(c *Context, l *Logger, id types.RequestID) (types.Request, error
 	return r, nil
 }
 
+// GetParams fetches the parameters of the oracle module
+func GetParams(c *Context, l *Logger) (types.Params, error) {
+	bz := c.bandApp.AppCodec().MustMarshal(&types.QueryParamsRequest{})
+	res, err := abciQuery(c, l, "/band.oracle.v1.Query/Params", bz)
+	if err != nil {
+		return types.Params{}, err
+	}
+	if res.Response.Code != 0 {
+		return types.Params{}, fmt.Errorf("failed to query params: %s", res.Response.Log)
+	}
+
+	var r types.QueryParamsResponse
+	if err := c.bandApp.AppCodec().Unmarshal(res.Response.GetValue(), &r); err != nil {
+		return types.Params{}, err
+	}
+
+	return r.Params, nil
+}
+
+// GetPendingRequests fetches the IDs of the requests that are waiting for the report of the validator
+func GetPendingRequests(c *Context, l *Logger) ([]types.RequestID, error) {
+	bz := c.bandApp.AppCodec().MustMarshal(&types.QueryPendingRequestsRequest{
+		ValidatorAddress: c.validator.String(),
+	})
+	res, err := abciQuery(c, l, "/band.oracle.v1.Query/PendingRequests", bz)
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.Code != 0 {
+		return nil, fmt.Errorf("failed to query pending requests: %s", res.Response.Log)
+	}
+
+	var r types.QueryPendingRequestsResponse
+	if err := c.bandApp.AppCodec().Unmarshal(res.Response.GetValue(), &r); err != nil {
+		return nil, err
+	}
+
+	ids := make([]types.RequestID, len(r.RequestIDs))
+	for i, id := range r.RequestIDs {
+		ids[i] = types.RequestID(id)
+	}
+
+	return ids, nil
+}
+
+// GetReportCommits fetches report commits of the commit-reveal request by id
+func GetReportCommits(c *Context, l *Logger, id types.RequestID) (*types.QueryReportCommitsResponse, error) {
+	bz := c.bandApp.AppCodec().MustMarshal(&types.QueryReportCommitsRequest{
+		RequestId: uint64(id),
+	})
+	res, err := abciQuery(c, l, "/band.oracle.v1.Query/ReportCommits", bz)
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.Code != 0 {
+		return nil, fmt.Errorf("failed to query report commits: %s", res.Response.Log)
+	}
+
+	var r types.QueryReportCommitsResponse
+	if err := c.bandApp.AppCodec().Unmarshal(res.Response.GetValue(), &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// updateSubmissions updates the stored submission states of the given reports once they are
+// included on chain. The stored data of a request is no longer needed after its reports are
+// revealed or reported.
+func updateSubmissions(c *Context, l *Logger, reports []ReportMsgWithKey) {
+	for _, report := range reports {
+		id := report.msg.GetRequestID()
+		switch report.msg.(type) {
+		case *types.MsgCommitReport:
+			submission, found, err := c.store.GetSubmission(id)
+			if err != nil || !found {
+				l.Error(":exploding_head: Failed to get submission state of request %d", c, id)
+				continue
+			}
+			submission.Status = store.StatusCommitted
+			if err := c.store.SetSubmission(submission); err != nil {
+				l.Error(":exploding_head: Failed to save submission state with error: %s", c, err.Error())
+			}
+		default:
+			if err := c.store.DeleteRequest(id); err != nil {
+				l.Error(":exploding_head: Failed to delete stored request %d with error: %s", c, id, err.Error())
+			}
+		}
+	}
+}
+
 // abciQuery will try to query data from BandChain node maxTry time before give up and return error
 func abciQuery(c *Context, l *Logger, path string, data []byte) (*ctypes.ResultABCIQuery, error) {
 	var lastErr error
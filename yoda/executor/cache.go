@@ -0,0 +1,82 @@
+package executor
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Cache keeps the successful results of executions for a limited time and coalesces concurrent
+// executions with the same key into one.
+type Cache struct {
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	result ExecResult
+	expiry time.Time
+}
+
+// NewCache creates a new Cache that keeps results for the given duration.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// Exec returns the cached result of the given key if it is not expired. Otherwise, it runs exec,
+// or waits for the running execution of the same key, and caches the result if the execution
+// succeeded with zero exit code. The returned flag tells whether the result is from the cache.
+func (c *Cache) Exec(key string, exec func() (ExecResult, error)) (ExecResult, bool, error) {
+	if result, ok := c.get(key); ok {
+		return result, true, nil
+	}
+
+	executed := false
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		executed = true
+		result, err := exec()
+		if err == nil && result.Code == 0 {
+			c.set(key, result)
+		}
+		return result, err
+	})
+
+	return v.(ExecResult), !executed, err
+}
+
+func (c *Cache) get(key string) (ExecResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return ExecResult{}, false
+	}
+	if time.Now().After(entry.expiry) {
+		delete(c.entries, key)
+		return ExecResult{}, false
+	}
+
+	return entry.result, true
+}
+
+func (c *Cache) set(key string, result ExecResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Drop the expired entries, so that the cache does not grow with the keys that are never used again.
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiry) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{result: result, expiry: now.Add(c.ttl)}
+}
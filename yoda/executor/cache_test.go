@@ -0,0 +1,88 @@
+package executor
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheHitAndExpiry(t *testing.T) {
+	cache := NewCache(50 * time.Millisecond)
+	exec := newMockExec([]byte("output"), 0, nil)
+
+	result, hit, err := cache.Exec("key", func() (ExecResult, error) { return exec.Exec(nil, "", nil) })
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, []byte("output"), result.Output)
+
+	result, hit, err = cache.Exec("key", func() (ExecResult, error) { return exec.Exec(nil, "", nil) })
+	require.NoError(t, err)
+	require.True(t, hit)
+	require.Equal(t, []byte("output"), result.Output)
+	require.Equal(t, 1, exec.called)
+
+	_, hit, err = cache.Exec("other", func() (ExecResult, error) { return exec.Exec(nil, "", nil) })
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, 2, exec.called)
+
+	time.Sleep(60 * time.Millisecond)
+	_, hit, err = cache.Exec("key", func() (ExecResult, error) { return exec.Exec(nil, "", nil) })
+	require.NoError(t, err)
+	require.False(t, hit)
+	require.Equal(t, 3, exec.called)
+}
+
+func TestCacheSkipsFailures(t *testing.T) {
+	cache := NewCache(time.Minute)
+
+	failed := newMockExec([]byte("error"), 1, nil)
+	for i := 0; i < 2; i++ {
+		_, hit, err := cache.Exec("code", func() (ExecResult, error) { return failed.Exec(nil, "", nil) })
+		require.NoError(t, err)
+		require.False(t, hit)
+	}
+	require.Equal(t, 2, failed.called)
+
+	errored := newMockExec(nil, 0, errors.New("error"))
+	for i := 0; i < 2; i++ {
+		_, hit, err := cache.Exec("err", func() (ExecResult, error) { return errored.Exec(nil, "", nil) })
+		require.Error(t, err)
+		require.False(t, hit)
+	}
+	require.Equal(t, 2, errored.called)
+}
+
+func TestCacheCoalescesConcurrentExecutions(t *testing.T) {
+	cache := NewCache(time.Minute)
+	var called, hits int64
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, hit, err := cache.Exec("key", func() (ExecResult, error) {
+				atomic.AddInt64(&called, 1)
+				<-release
+				return ExecResult{Output: []byte("output")}, nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, []byte("output"), result.Output)
+			if hit {
+				atomic.AddInt64(&hits, 1)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int64(1), called)
+	require.Equal(t, int64(9), hits)
+}
@@ -27,12 +27,29 @@ type Executor interface {
 	Exec(exec []byte, arg string, env interface{}) (ExecResult, error)
 }
 
+// OutputLimiter is implemented by the executors that cap the output of the scripts themselves, so
+// that the cap follows the MaxReportDataSize parameter of the chain.
+type OutputLimiter interface {
+	SetMaxOutputSize(size uint64)
+}
+
+// PathHider is implemented by the executors that run the scripts on the local machine, so that
+// the paths holding secrets, such as the home of yoda with its keyring, are hidden from them.
+type PathHider interface {
+	HidePaths(paths ...string)
+}
+
 var testProgram []byte = []byte(
 	"#!/usr/bin/env python3\nimport os\nimport sys\nprint(sys.argv[1], os.getenv('BAND_CHAIN_ID'))",
 )
 
 // NewExecutor returns executor by name and executor URL
 func NewExecutor(executor string) (exec Executor, err error) {
+	// The underlying executors of a multi executor are checked on their creation.
+	if strings.HasPrefix(executor, multiExecPrefix) {
+		return newMultiExecFromString(executor)
+	}
+
 	name, base, timeout, err := parseExecutor(executor)
 	if err != nil {
 		return nil, err
@@ -40,12 +57,26 @@ func NewExecutor(executor string) (exec Executor, err error) {
 	switch name {
 	case "rest":
 		exec = NewRestExec(base, timeout)
+	case "local":
+		exec, err = NewLocalExec(base, timeout)
+		if err != nil {
+			return nil, err
+		}
 	case "docker":
 		return nil, fmt.Errorf("docker executor is currently not supported")
 	default:
 		return nil, fmt.Errorf("invalid executor name: %s, base: %s", name, base)
 	}
 
+	if err := checkExecutor(exec); err != nil {
+		return nil, err
+	}
+
+	return exec, nil
+}
+
+// checkExecutor runs the test program on the executor and checks its output.
+func checkExecutor(exec Executor) error {
 	res, err := exec.Exec(testProgram, "TEST_ARG", map[string]interface{}{
 		"BAND_CHAIN_ID":    "test-chain-id",
 		"BAND_VALIDATOR":   "test-validator",
@@ -55,16 +86,16 @@ func NewExecutor(executor string) (exec Executor, err error) {
 		"BAND_SIGNATURE":   "test-signature",
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to run test program: %s", err.Error())
+		return fmt.Errorf("failed to run test program: %s", err.Error())
 	}
 	if res.Code != 0 {
-		return nil, fmt.Errorf("test program returned nonzero code: %d", res.Code)
+		return fmt.Errorf("test program returned nonzero code: %d", res.Code)
 	}
 	if string(res.Output) != "TEST_ARG test-chain-id\n" {
-		return nil, fmt.Errorf("test program returned wrong output: %s", res.Output)
+		return fmt.Errorf("test program returned wrong output: %s", res.Output)
 	}
 
-	return exec, nil
+	return nil
 }
 
 // parseExecutor splits the executor string in the form of "name:base?timeout=" into parts.
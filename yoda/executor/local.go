@@ -0,0 +1,308 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/shlex"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Sandbox modes supported by LocalExec.
+const (
+	SandboxAuto  = "auto"  // Use bubblewrap, failing if it is not installed.
+	SandboxBwrap = "bwrap" // Run the script with the system paths only, in bubblewrap with a seccomp filter.
+	// Run the script in new user, PID, IPC and UTS namespaces. The script still sees the whole
+	// filesystem, including the home directory of yoda, so this mode must be selected explicitly.
+	SandboxNamespace = "namespace"
+	SandboxNone      = "none" // Run the script as a plain subprocess with resource limits only.
+)
+
+const (
+	flagQueryMemory   = "memory"
+	flagQueryCPUTime  = "cpu"
+	flagQueryFileSize = "file-size"
+
+	defaultMemoryLimitKB = 512 * 1024 // 512MB
+	defaultFileSizeKB    = 10 * 1024  // 10MB
+
+	scratchExecPath = "/scratch/exec"
+	// The file descriptor of the seccomp program in bubblewrap, the first extra file of the command.
+	seccompFD = "3"
+)
+
+// bwrapSystemPaths are the system paths mounted read-only in the bubblewrap sandbox if they exist,
+// which are the programs and libraries run by the scripts and the files they need for network
+// access. Nothing else of the host filesystem is visible to the scripts.
+var bwrapSystemPaths = []string{
+	"/usr",
+	"/bin",
+	"/sbin",
+	"/lib",
+	"/lib32",
+	"/lib64",
+	"/etc/alternatives",
+	"/etc/ssl",
+	"/etc/pki",
+	"/etc/ca-certificates",
+	"/etc/resolv.conf",
+	"/etc/hosts",
+	"/etc/nsswitch.conf",
+	"/etc/localtime",
+}
+
+// LocalExec runs data source scripts as sandboxed subprocesses on the local machine.
+type LocalExec struct {
+	sandbox  string
+	timeout  time.Duration
+	memoryKB uint64
+	cpuSec   uint64
+	fileKB   uint64
+	seccomp  []byte // The seccomp program of the bwrap sandbox.
+	// The paths hidden from the scripts in the bwrap sandbox, in addition to the home directory.
+	hiddenPaths atomic.Pointer[[]string]
+	// The maximum size of the output kept from the scripts, following the MaxReportDataSize parameter.
+	maxOutputSize atomic.Uint64
+}
+
+var (
+	_ OutputLimiter = &LocalExec{}
+	_ PathHider     = &LocalExec{}
+)
+
+// NewLocalExec creates a new LocalExec from the base of the executor string, which is in the
+// form of "sandbox?memory=[KB]&cpu=[seconds]&file-size=[KB]" with all parts optional.
+func NewLocalExec(base string, timeout time.Duration) (*LocalExec, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+
+	sandbox := u.Path
+	if sandbox == "" {
+		sandbox = SandboxAuto
+	}
+	// The namespace sandbox does not hide the filesystem, so it is never selected automatically.
+	if sandbox == SandboxAuto {
+		if _, err := exec.LookPath("bwrap"); err != nil {
+			return nil, fmt.Errorf(
+				"auto sandbox requires bubblewrap to be installed, or select the namespace sandbox "+
+					"explicitly, which does not hide the filesystem from the scripts: %s",
+				err.Error(),
+			)
+		}
+		sandbox = SandboxBwrap
+	}
+	var seccomp []byte
+	switch sandbox {
+	case SandboxBwrap:
+		if _, err := exec.LookPath("bwrap"); err != nil {
+			return nil, fmt.Errorf("bwrap sandbox requires bubblewrap to be installed: %s", err.Error())
+		}
+		seccomp, err = seccompFilter()
+		if err != nil {
+			return nil, err
+		}
+	case SandboxNamespace:
+		if !namespaceSupported {
+			return nil, fmt.Errorf("namespace sandbox is only supported on linux")
+		}
+	case SandboxNone:
+	default:
+		return nil, fmt.Errorf("invalid local executor sandbox: %s", sandbox)
+	}
+
+	// CPU time is limited to the timeout rounded up by default, as the process is killed then anyway.
+	e := &LocalExec{
+		sandbox:  sandbox,
+		timeout:  timeout,
+		memoryKB: defaultMemoryLimitKB,
+		cpuSec:   uint64((timeout + time.Second - 1) / time.Second),
+		fileKB:   defaultFileSizeKB,
+		seccomp:  seccomp,
+	}
+	e.maxOutputSize.Store(types.DefaultMaxReportDataSize)
+	query := u.Query()
+	for flag, limit := range map[string]*uint64{
+		flagQueryMemory:   &e.memoryKB,
+		flagQueryCPUTime:  &e.cpuSec,
+		flagQueryFileSize: &e.fileKB,
+	} {
+		if value := query.Get(flag); value != "" {
+			*limit, err = strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s limit: %s", flag, err.Error())
+			}
+		}
+	}
+
+	return e, nil
+}
+
+// Exec implements Executor interface for LocalExec.
+func (e *LocalExec) Exec(code []byte, arg string, env interface{}) (ExecResult, error) {
+	dir, err := os.MkdirTemp("", "executor")
+	if err != nil {
+		return ExecResult{}, err
+	}
+	defer os.RemoveAll(dir)
+	execPath := filepath.Join(dir, "exec")
+	err = os.WriteFile(execPath, code, 0o500)
+	if err != nil {
+		return ExecResult{}, err
+	}
+	args, err := shlex.Split(arg)
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+	cmd := e.command(ctx, dir, execPath, args)
+	cmd.Env = envList(env)
+	cmd.Dir = dir
+	if e.sandbox == SandboxBwrap {
+		filter, err := seccompFile(e.seccomp)
+		if err != nil {
+			return ExecResult{}, err
+		}
+		defer filter.Close()
+		cmd.ExtraFiles = []*os.File{filter}
+	}
+	maxOutputSize := int(e.maxOutputSize.Load())
+	stdout := &limitedBuffer{limit: maxOutputSize}
+	stderr := &limitedBuffer{limit: maxOutputSize}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	setProcessGroup(cmd)
+	cmd.Cancel = func() error { return killProcessGroup(cmd) }
+	// Do not wait forever for the output of processes that escaped the process group.
+	cmd.WaitDelay = time.Second
+
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return ExecResult{}, ErrExecutionimeout
+	}
+	exitCode := uint32(0)
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			exitCode = uint32(exitError.ExitCode())
+		} else {
+			return ExecResult{}, err
+		}
+	}
+
+	version := fmt.Sprintf("local-%s", e.sandbox)
+	if exitCode == 0 {
+		return ExecResult{Output: stdout.Bytes(), Code: 0, Version: version}, nil
+	}
+	return ExecResult{Output: stderr.Bytes(), Code: exitCode, Version: version}, nil
+}
+
+// SetMaxOutputSize implements OutputLimiter interface for LocalExec.
+func (e *LocalExec) SetMaxOutputSize(size uint64) {
+	e.maxOutputSize.Store(size)
+}
+
+// HidePaths implements PathHider interface for LocalExec. The paths are hidden in the bwrap
+// sandbox only, as the other sandboxes do not hide the filesystem.
+func (e *LocalExec) HidePaths(paths ...string) {
+	hidden := append([]string{}, paths...)
+	e.hiddenPaths.Store(&hidden)
+}
+
+// command returns the command to run the script at execPath in the configured sandbox, with
+// resource limits applied by the shell before it is executed.
+func (e *LocalExec) command(ctx context.Context, dir string, execPath string, args []string) *exec.Cmd {
+	limits := fmt.Sprintf(
+		`ulimit -v %d && ulimit -t %d && ulimit -f %d && exec "$0" "$@"`,
+		e.memoryKB,
+		e.cpuSec,
+		// The file size limit of ulimit is in 512-byte blocks.
+		e.fileKB*2,
+	)
+
+	switch e.sandbox {
+	case SandboxBwrap:
+		var bwrapArgs []string
+		for _, path := range bwrapSystemPaths {
+			bwrapArgs = append(bwrapArgs, "--ro-bind-try", path, path)
+		}
+		bwrapArgs = append(bwrapArgs,
+			"--dev", "/dev",
+			"--proc", "/proc",
+			"--tmpfs", "/tmp",
+			"--ro-bind", dir, filepath.Dir(scratchExecPath),
+			"--chdir", "/tmp",
+			"--unshare-all",
+			"--share-net",
+			"--die-with-parent",
+			"--new-session",
+			"--seccomp", seccompFD,
+		)
+		// Hide the home directories, which may contain the keys of the validator, in case they are
+		// under the system paths.
+		if home, err := os.UserHomeDir(); err == nil {
+			bwrapArgs = append(bwrapArgs, "--tmpfs", home)
+		}
+		if hidden := e.hiddenPaths.Load(); hidden != nil {
+			for _, path := range *hidden {
+				bwrapArgs = append(bwrapArgs, "--tmpfs", path)
+			}
+		}
+		bwrapArgs = append(bwrapArgs, "/bin/sh", "-c", limits, scratchExecPath)
+		return exec.CommandContext(ctx, "bwrap", append(bwrapArgs, args...)...)
+	case SandboxNamespace:
+		cmd := exec.CommandContext(ctx, "/bin/sh", append([]string{"-c", limits, execPath}, args...)...)
+		setNamespaces(cmd)
+		return cmd
+	default:
+		return exec.CommandContext(ctx, "/bin/sh", append([]string{"-c", limits, execPath}, args...)...)
+	}
+}
+
+// envList converts the env of an execution into a sorted list of "key=value" strings.
+func envList(env interface{}) []string {
+	list := []string{"PATH=/usr/local/bin:/usr/bin:/bin"}
+	envMap, ok := env.(map[string]interface{})
+	if !ok {
+		return list
+	}
+	for key, value := range envMap {
+		list = append(list, fmt.Sprintf("%s=%v", key, value))
+	}
+	sort.Strings(list)
+	return list
+}
+
+// limitedBuffer is a writer that keeps at most limit bytes and silently drops the rest, so that
+// the process is never blocked on a full pipe.
+type limitedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.buf.Len(); remaining > 0 {
+		if len(p) > remaining {
+			b.buf.Write(p[:remaining])
+		} else {
+			b.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+func (b *limitedBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
@@ -0,0 +1,40 @@
+//go:build linux
+
+package executor
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+const namespaceSupported = true
+
+// setProcessGroup runs the command in its own process group, so that all of its children can
+// be killed on timeout.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+	cmd.SysProcAttr.Pdeathsig = syscall.SIGKILL
+}
+
+// killProcessGroup kills the process group of the command.
+func killProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+// setNamespaces runs the command in new user, PID, IPC and UTS namespaces, keeping the network
+// namespace so that data sources can still query their APIs.
+func setNamespaces(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Cloneflags = syscall.CLONE_NEWUSER |
+		syscall.CLONE_NEWPID |
+		syscall.CLONE_NEWIPC |
+		syscall.CLONE_NEWUTS
+	cmd.SysProcAttr.UidMappings = []syscall.SysProcIDMap{{ContainerID: 65534, HostID: os.Getuid(), Size: 1}}
+	cmd.SysProcAttr.GidMappings = []syscall.SysProcIDMap{{ContainerID: 65534, HostID: os.Getgid(), Size: 1}}
+}
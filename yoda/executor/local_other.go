@@ -0,0 +1,32 @@
+//go:build !linux
+
+package executor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+const namespaceSupported = false
+
+// setProcessGroup is a no-op on platforms other than linux.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills the process of the command.
+func killProcessGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
+
+// setNamespaces is a no-op on platforms other than linux.
+func setNamespaces(cmd *exec.Cmd) {}
+
+// seccompFilter is not supported on platforms other than linux.
+func seccompFilter() ([]byte, error) {
+	return nil, fmt.Errorf("seccomp filter is only supported on linux")
+}
+
+// seccompFile is not supported on platforms other than linux.
+func seccompFile(filter []byte) (*os.File, error) {
+	return nil, fmt.Errorf("seccomp filter is only supported on linux")
+}
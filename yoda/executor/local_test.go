@@ -0,0 +1,114 @@
+package executor
+
+import (
+	"context"
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+func TestLocalExecSuccess(t *testing.T) {
+	e, err := NewLocalExec(SandboxNone, 5*time.Second)
+	require.NoError(t, err)
+	res, err := e.Exec([]byte("#!/bin/sh\necho $1 $BAND_CHAIN_ID\n"), "TEST_ARG", map[string]interface{}{
+		"BAND_CHAIN_ID": "test-chain-id",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, []byte("TEST_ARG test-chain-id\n"), res.Output)
+	require.Equal(t, "local-none", res.Version)
+}
+
+func TestLocalExecFailure(t *testing.T) {
+	e, err := NewLocalExec(SandboxNone, 5*time.Second)
+	require.NoError(t, err)
+	res, err := e.Exec([]byte("#!/bin/sh\necho out\necho err >&2\nexit 3\n"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), res.Code)
+	require.Equal(t, []byte("err\n"), res.Output)
+}
+
+func TestLocalExecTimeout(t *testing.T) {
+	e, err := NewLocalExec(SandboxNone, 200*time.Millisecond)
+	require.NoError(t, err)
+	start := time.Now()
+	_, err = e.Exec([]byte("#!/bin/sh\nsleep 10 &\nsleep 10\n"), "", nil)
+	require.ErrorIs(t, err, ErrExecutionimeout)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestLocalExecOutputLimit(t *testing.T) {
+	e, err := NewLocalExec(SandboxNone, 5*time.Second)
+	require.NoError(t, err)
+	res, err := e.Exec([]byte("#!/bin/sh\nhead -c 1000000 /dev/zero\n"), "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Len(t, res.Output, int(types.DefaultMaxReportDataSize))
+
+	e.SetMaxOutputSize(1000)
+	res, err = e.Exec([]byte("#!/bin/sh\nhead -c 1000000 /dev/zero\n"), "", nil)
+	require.NoError(t, err)
+	require.Len(t, res.Output, 1000)
+}
+
+func TestNewLocalExecAuto(t *testing.T) {
+	e, err := NewLocalExec(SandboxAuto, 5*time.Second)
+	if _, lookErr := exec.LookPath("bwrap"); lookErr != nil {
+		// The namespace sandbox is never selected automatically.
+		require.ErrorContains(t, err, "auto sandbox requires bubblewrap to be installed")
+		return
+	}
+	require.NoError(t, err)
+	require.Equal(t, SandboxBwrap, e.sandbox)
+	require.NotEmpty(t, e.seccomp)
+}
+
+func TestLocalExecNamespace(t *testing.T) {
+	e, err := NewLocalExec(SandboxNamespace, 5*time.Second)
+	if err != nil {
+		t.Skip(err.Error())
+	}
+	res, err := e.Exec([]byte("#!/bin/sh\necho $$\n"), "", nil)
+	if err != nil {
+		t.Skipf("user namespaces are not available: %s", err.Error())
+	}
+	require.Equal(t, uint32(0), res.Code)
+	// The shell is the first process of the new PID namespace.
+	require.Equal(t, []byte("1\n"), res.Output)
+}
+
+func TestNewLocalExecInvalid(t *testing.T) {
+	_, err := NewLocalExec("unknown", 5*time.Second)
+	require.EqualError(t, err, "invalid local executor sandbox: unknown")
+
+	_, err = NewLocalExec("none?memory=abc", 5*time.Second)
+	require.Error(t, err)
+
+	e, err := NewLocalExec("none?memory=1024&cpu=2", 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1024), e.memoryKB)
+	require.Equal(t, uint64(2), e.cpuSec)
+	require.Equal(t, uint64(defaultFileSizeKB), e.fileKB)
+}
+
+func TestLocalExecBwrapCommand(t *testing.T) {
+	e := &LocalExec{sandbox: SandboxBwrap}
+	e.HidePaths("/opt/yoda")
+
+	cmd := e.command(context.Background(), "/tmp/executor", "/tmp/executor/exec", []string{"TEST_ARG"})
+	args := cmd.Args[1:]
+
+	// Only the system paths are mounted, so the yoda home is hidden wherever it is.
+	require.NotContains(t, strings.Join(args, " "), "--ro-bind / /")
+	for _, path := range bwrapSystemPaths {
+		require.True(t, slices.Contains(args, path), path)
+	}
+	require.Contains(t, strings.Join(args, " "), "--tmpfs /opt/yoda")
+	require.Equal(t, []string{scratchExecPath, "TEST_ARG"}, args[len(args)-2:])
+}
@@ -2,17 +2,105 @@ package executor
 
 import (
 	"fmt"
+	"math/rand"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 )
 
+const (
+	multiExecPrefix = "multi:"
+
+	flagQueryWeight        = "weight"
+	flagQueryProbeInterval = "probe"
+
+	defaultProbeInterval = time.Minute
+
+	// A backend becomes unhealthy after this number of consecutive failures.
+	breakerThreshold = 3
+	// The backoff of an unhealthy backend starts at breakerMinBackoff and doubles on every failure
+	// while unhealthy, up to breakerMaxBackoff.
+	breakerMinBackoff = 5 * time.Second
+	breakerMaxBackoff = 5 * time.Minute
+	// latencySmoothing is the weight of a new sample in the moving average of the latency.
+	latencySmoothing = 0.2
+	// minLatency is the lower bound of the latency used to weight backends.
+	minLatency = time.Millisecond
+)
+
+// Backend is an underlying executor of MultiExec with its name and selection weight.
+type Backend struct {
+	Name     string
+	Executor Executor
+	Weight   float64 // Only used by the "weighted" strategy.
+}
+
+// BackendStats is a snapshot of the health of a backend of MultiExec.
+type BackendStats struct {
+	Name      string
+	Healthy   bool
+	Successes uint64
+	Failures  uint64
+	Latency   time.Duration // Moving average of the latency of successful executions.
+}
+
+// backendHealth tracks the health of a backend with a circuit breaker. A backend is skipped while
+// its breaker is open, and gets a trial execution again once its backoff has elapsed.
+type backendHealth struct {
+	mu                  sync.Mutex
+	consecutiveFailures int
+	backoff             time.Duration
+	openUntil           time.Time
+	latency             time.Duration
+	successes           uint64
+	failures            uint64
+}
+
+// available returns whether the backend may be tried first at the given time.
+func (h *backendHealth) available(now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return !now.Before(h.openUntil)
+}
+
+// record updates the health of the backend with the outcome of an execution.
+func (h *backendHealth) record(err error, latency time.Duration, now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err == nil {
+		h.successes++
+		h.consecutiveFailures = 0
+		h.backoff = 0
+		h.openUntil = time.Time{}
+		if h.latency == 0 {
+			h.latency = latency
+		} else {
+			h.latency = time.Duration(latencySmoothing*float64(latency) + (1-latencySmoothing)*float64(h.latency))
+		}
+		return
+	}
+
+	h.failures++
+	h.consecutiveFailures++
+	if h.consecutiveFailures >= breakerThreshold {
+		h.backoff = min(max(h.backoff*2, breakerMinBackoff), breakerMaxBackoff)
+		h.openUntil = now.Add(h.backoff)
+	}
+}
+
 // MutliExec is a higher-order executor that utlizes the underlying executors to perform Exec.
 type MultiExec struct {
-	execs    []Executor // The underlying executors (duplicated if strategy is round-robin).
-	strategy string     // Execution strategy. Can be "order" or "round-robin".
+	backends []Backend
+	health   []*backendHealth
+	strategy string // Execution strategy. Can be "order", "round-robin" or "weighted".
 	// Round-robin specific state variables.
-	rIndex  int64 // Current round-robin starting index (need to mod rLength).
-	rLength int64 // Total number of available executors.
+	rIndex int64 // Current round-robin starting index (need to mod the number of backends).
 }
 
 // MultiError encapsulates error messages from the underlying executors into one error.
@@ -20,22 +108,106 @@ type MultiError struct {
 	errs []error
 }
 
-// NewMultiExec creates a new MultiExec instance.
+// NewMultiExec creates a new MultiExec instance with equally weighted executors.
 func NewMultiExec(execs []Executor, strategy string) (*MultiExec, error) {
+	backends := make([]Backend, len(execs))
+	for i, exec := range execs {
+		backends[i] = Backend{Name: strconv.Itoa(i), Executor: exec, Weight: 1}
+	}
+	return NewMultiExecWithBackends(backends, strategy)
+}
+
+// NewMultiExecWithBackends creates a new MultiExec instance with the given backends.
+func NewMultiExecWithBackends(backends []Backend, strategy string) (*MultiExec, error) {
 	switch strategy {
-	case "order":
-		return &MultiExec{execs: execs, strategy: strategy, rIndex: 0, rLength: 0}, nil
-	case "round-robin":
-		rExecs := append(append([]Executor{}, execs...), execs...)
-		return &MultiExec{
-			execs:    rExecs,
-			strategy: strategy,
-			rIndex:   -1,
-			rLength:  int64(len(execs)),
-		}, nil
+	case "order", "round-robin", "weighted":
 	default:
 		return &MultiExec{}, fmt.Errorf("unknown MultiExec strategy: %s", strategy)
 	}
+
+	health := make([]*backendHealth, len(backends))
+	for i, backend := range backends {
+		if backend.Weight <= 0 {
+			return &MultiExec{}, fmt.Errorf("weight of executor %s must be positive", backend.Name)
+		}
+		health[i] = &backendHealth{}
+	}
+
+	return &MultiExec{backends: backends, health: health, strategy: strategy, rIndex: -1}, nil
+}
+
+// newMultiExecFromString creates a MultiExec from the executor string in the form of
+// "multi:strategy?probe=[interval] exec1?weight=[weight] exec2 ...", where each underlying
+// executor string is separated by whitespace. The health of the backends is probed periodically
+// unless the probe interval is zero.
+func newMultiExecFromString(executor string) (*MultiExec, error) {
+	parts := strings.Fields(strings.TrimPrefix(executor, multiExecPrefix))
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("multi executor requires a strategy and at least one executor: %s", executor)
+	}
+
+	u, err := url.Parse(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid multi executor strategy: %s", err.Error())
+	}
+	probeInterval := defaultProbeInterval
+	if value := u.Query().Get(flagQueryProbeInterval); value != "" {
+		probeInterval, err = time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid probe interval: %s", err.Error())
+		}
+	}
+
+	backends := make([]Backend, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		backend, err := newBackend(part)
+		if err != nil {
+			return nil, err
+		}
+		backends = append(backends, backend)
+	}
+
+	exec, err := NewMultiExecWithBackends(backends, u.Path)
+	if err != nil {
+		return nil, err
+	}
+	if probeInterval > 0 {
+		go exec.probeLoop(probeInterval)
+	}
+
+	return exec, nil
+}
+
+// newBackend creates a backend from an executor string with an optional weight query.
+func newBackend(executor string) (Backend, error) {
+	name, rest, ok := strings.Cut(executor, ":")
+	if !ok {
+		return Backend{}, fmt.Errorf("invalid executor, cannot parse executor: %s", executor)
+	}
+	u, err := url.Parse(rest)
+	if err != nil {
+		return Backend{}, fmt.Errorf("invalid url, cannot parse %s to url with error: %s", rest, err.Error())
+	}
+
+	weight := 1.0
+	query := u.Query()
+	if value := query.Get(flagQueryWeight); value != "" {
+		weight, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return Backend{}, fmt.Errorf("invalid weight: %s", err.Error())
+		}
+	}
+	query.Del(flagQueryWeight)
+	u.RawQuery = query.Encode()
+
+	exec, err := NewExecutor(fmt.Sprintf("%s:%s", name, u.String()))
+	if err != nil {
+		return Backend{}, err
+	}
+
+	// Do not expose the query and credentials of the executor in its name.
+	u.RawQuery = ""
+	return Backend{Name: fmt.Sprintf("%s:%s", name, u.Redacted()), Executor: exec, Weight: weight}, nil
 }
 
 // Error implements error interface for MultiError by returning all error messages concatenated.
@@ -51,24 +223,64 @@ func (e *MultiError) Error() string {
 	return s.String()
 }
 
-// nextExecOrder returns the next order of executors to be used by MultiExec.
-func (e *MultiExec) nextExecOrder() []Executor {
+// nextExecOrder returns the indexes of the backends in the order to be used by MultiExec. Backends
+// with an open circuit breaker are moved to the end, so they are only tried as a last resort.
+func (e *MultiExec) nextExecOrder() []int {
+	n := len(e.backends)
+	order := make([]int, n)
 	switch e.strategy {
 	case "order":
-		return e.execs
+		for i := range order {
+			order[i] = i
+		}
 	case "round-robin":
-		rIndex := atomic.AddInt64(&e.rIndex, 1) % e.rLength
-		return e.execs[rIndex : rIndex+e.rLength]
+		rIndex := int(atomic.AddInt64(&e.rIndex, 1) % int64(n))
+		for i := range order {
+			order[i] = (rIndex + i) % n
+		}
+	case "weighted":
+		order = e.weightedOrder()
 	default:
 		panic("unknown MultiExec strategy") // We should never reach here.
 	}
+
+	now := time.Now()
+	sort.SliceStable(order, func(i, j int) bool {
+		return e.health[order[i]].available(now) && !e.health[order[j]].available(now)
+	})
+	return order
+}
+
+// weightedOrder returns a random order of the backends, where a backend is more likely to come
+// first the higher its weight and the lower its latency.
+func (e *MultiExec) weightedOrder() []int {
+	weights := make([]float64, len(e.backends))
+	for i, backend := range e.backends {
+		weights[i] = backend.Weight
+		// Backends without latency samples yet are treated as the fastest ones, so they get tried.
+		e.health[i].mu.Lock()
+		weights[i] /= max(e.health[i].latency.Seconds(), minLatency.Seconds())
+		e.health[i].mu.Unlock()
+	}
+
+	// Weighted random sampling without replacement (Efraimidis-Spirakis).
+	keys := make([]float64, len(weights))
+	order := make([]int, len(weights))
+	for i, weight := range weights {
+		keys[i] = -rand.ExpFloat64() / weight
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return keys[order[i]] > keys[order[j]] })
+	return order
 }
 
 // Exec implements Executor interface for MultiExec.
 func (e *MultiExec) Exec(code []byte, arg string, env interface{}) (ExecResult, error) {
 	errs := []error{}
-	for _, each := range e.nextExecOrder() {
-		res, err := each.Exec(code, arg, env)
+	for _, idx := range e.nextExecOrder() {
+		start := time.Now()
+		res, err := e.backends[idx].Executor.Exec(code, arg, env)
+		e.health[idx].record(err, time.Since(start), time.Now())
 		if err == nil || err == ErrExecutionimeout {
 			return res, err
 		} else {
@@ -77,3 +289,58 @@ func (e *MultiExec) Exec(code []byte, arg string, env interface{}) (ExecResult,
 	}
 	return ExecResult{}, &MultiError{errs: errs}
 }
+
+// SetMaxOutputSize implements OutputLimiter interface for MultiExec by setting the maximum output
+// size of its backends that cap their output.
+func (e *MultiExec) SetMaxOutputSize(size uint64) {
+	for _, backend := range e.backends {
+		if limiter, ok := backend.Executor.(OutputLimiter); ok {
+			limiter.SetMaxOutputSize(size)
+		}
+	}
+}
+
+// HidePaths implements PathHider interface for MultiExec by hiding the paths from its backends
+// that run the scripts locally.
+func (e *MultiExec) HidePaths(paths ...string) {
+	for _, backend := range e.backends {
+		if hider, ok := backend.Executor.(PathHider); ok {
+			hider.HidePaths(paths...)
+		}
+	}
+}
+
+// Stats returns the health snapshots of all backends.
+func (e *MultiExec) Stats() []BackendStats {
+	now := time.Now()
+	stats := make([]BackendStats, len(e.backends))
+	for i, backend := range e.backends {
+		h := e.health[i]
+		h.mu.Lock()
+		stats[i] = BackendStats{
+			Name:      backend.Name,
+			Healthy:   !now.Before(h.openUntil) && h.consecutiveFailures < breakerThreshold,
+			Successes: h.successes,
+			Failures:  h.failures,
+			Latency:   h.latency,
+		}
+		h.mu.Unlock()
+	}
+	return stats
+}
+
+// probe checks every backend with the test program and records the outcomes.
+func (e *MultiExec) probe() {
+	for i, backend := range e.backends {
+		start := time.Now()
+		err := checkExecutor(backend.Executor)
+		e.health[i].record(err, time.Since(start), time.Now())
+	}
+}
+
+func (e *MultiExec) probeLoop(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		e.probe()
+	}
+}
@@ -3,6 +3,7 @@ package executor
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -105,3 +106,121 @@ func TestMultiExecAllErrors(t *testing.T) {
 	_, err = exec.Exec(nil, "", nil)
 	require.EqualError(t, err, "MultiError: error3, error1, error2")
 }
+
+func TestMultiExecCircuitBreaker(t *testing.T) {
+	exec1 := newMockExec(nil, 0, errors.New("error1"))
+	exec2 := newMockExec([]byte("output2"), 0, nil)
+	exec, err := NewMultiExec([]Executor{exec1, exec2}, "order")
+	require.NoError(t, err)
+	// The failing exec1 is tried first until its breaker opens.
+	for i := 1; i <= breakerThreshold; i++ {
+		result, err := exec.Exec(nil, "", nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("output2"), result.Output)
+		require.Equal(t, i, exec1.called)
+	}
+	require.False(t, exec.Stats()[0].Healthy)
+	require.True(t, exec.Stats()[1].Healthy)
+	// The breaker of exec1 is open, so it is skipped.
+	_, err = exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, breakerThreshold, exec1.called)
+	require.Equal(t, breakerThreshold+1, exec2.called)
+	// exec1 is tried again after its backoff and recovers on success.
+	exec.health[0].openUntil = time.Now().Add(-time.Second)
+	exec1.err = nil
+	exec1.result = ExecResult{Output: []byte("output1")}
+	result, err := exec.Exec(nil, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("output1"), result.Output)
+	stats := exec.Stats()[0]
+	require.True(t, stats.Healthy)
+	require.Equal(t, uint64(1), stats.Successes)
+	require.Equal(t, uint64(breakerThreshold), stats.Failures)
+}
+
+func TestMultiExecBreakerBackoff(t *testing.T) {
+	h := &backendHealth{}
+	now := time.Now()
+	for i := 0; i < breakerThreshold; i++ {
+		require.True(t, h.available(now))
+		h.record(errors.New("error"), 0, now)
+	}
+	require.Equal(t, breakerMinBackoff, h.backoff)
+	require.False(t, h.available(now))
+	require.True(t, h.available(now.Add(breakerMinBackoff)))
+	// Failing while unhealthy doubles the backoff up to the maximum.
+	h.record(errors.New("error"), 0, now)
+	require.Equal(t, 2*breakerMinBackoff, h.backoff)
+	for i := 0; i < 10; i++ {
+		h.record(errors.New("error"), 0, now)
+	}
+	require.Equal(t, breakerMaxBackoff, h.backoff)
+	h.record(nil, time.Second, now)
+	require.True(t, h.available(now))
+	require.Equal(t, time.Duration(0), h.backoff)
+}
+
+func TestMultiExecAllUnhealthy(t *testing.T) {
+	exec1 := newMockExec(nil, 0, errors.New("error1"))
+	exec2 := newMockExec(nil, 0, errors.New("error2"))
+	exec, err := NewMultiExec([]Executor{exec1, exec2}, "order")
+	require.NoError(t, err)
+	for i := 0; i < breakerThreshold; i++ {
+		_, err = exec.Exec(nil, "", nil)
+		require.EqualError(t, err, "MultiError: error1, error2")
+	}
+	// Unhealthy executors are still tried as a last resort.
+	_, err = exec.Exec(nil, "", nil)
+	require.EqualError(t, err, "MultiError: error1, error2")
+	require.Equal(t, breakerThreshold+1, exec1.called)
+	require.Equal(t, breakerThreshold+1, exec2.called)
+}
+
+func TestMultiExecWeightedStrategy(t *testing.T) {
+	exec1 := newMockExec([]byte("output1"), 0, nil)
+	exec2 := newMockExec([]byte("output2"), 0, nil)
+	exec, err := NewMultiExecWithBackends([]Backend{
+		{Name: "exec1", Executor: exec1, Weight: 9},
+		{Name: "exec2", Executor: exec2, Weight: 1},
+	}, "weighted")
+	require.NoError(t, err)
+	for i := 0; i < 1000; i++ {
+		_, err := exec.Exec(nil, "", nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1000, exec1.called+exec2.called)
+	require.Greater(t, exec1.called, 800)
+	require.Greater(t, exec2.called, 0)
+}
+
+func TestMultiExecInvalidWeight(t *testing.T) {
+	_, err := NewMultiExecWithBackends([]Backend{
+		{Name: "exec1", Executor: newMockExec(nil, 0, nil), Weight: 0},
+	}, "weighted")
+	require.EqualError(t, err, "weight of executor exec1 must be positive")
+}
+
+func TestMultiExecProbe(t *testing.T) {
+	exec1 := newMockExec(nil, 0, errors.New("error1"))
+	exec, err := NewMultiExec([]Executor{exec1}, "order")
+	require.NoError(t, err)
+	exec.probe()
+	require.Equal(t, uint64(1), exec.Stats()[0].Failures)
+
+	exec1.err = nil
+	exec1.result = ExecResult{Output: []byte("TEST_ARG test-chain-id\n")}
+	exec.probe()
+	stats := exec.Stats()[0]
+	require.True(t, stats.Healthy)
+	require.Equal(t, uint64(1), stats.Successes)
+}
+
+func TestNewMultiExecFromStringInvalid(t *testing.T) {
+	_, err := newMultiExecFromString("multi:order")
+	require.EqualError(t, err, "multi executor requires a strategy and at least one executor: multi:order")
+	_, err = newMultiExecFromString("multi:order?probe=bad rest:https://executor.url?timeout=1s")
+	require.EqualError(t, err, `invalid probe interval: time: invalid duration "bad"`)
+	_, err = newMultiExecFromString("multi:order rest:https://executor.url?timeout=1s&weight=bad")
+	require.EqualError(t, err, `invalid weight: strconv.ParseFloat: parsing "bad": invalid syntax`)
+}
@@ -0,0 +1,111 @@
+//go:build linux
+
+package executor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"runtime"
+	"syscall"
+)
+
+// Offsets of the fields of struct seccomp_data, the input of a seccomp filter.
+const (
+	seccompDataNr   = 0
+	seccompDataArch = 4
+	// The low 32 bits of the first argument on little-endian architectures.
+	seccompDataArg0 = 16
+)
+
+const (
+	seccompRetAllow = 0x7fff0000
+	seccompRetErrno = 0x00050000
+
+	// Syscall numbers at or above this bound belong to the x32 ABI on amd64, which would
+	// otherwise bypass the filter.
+	x32SyscallBit = 0x40000000
+
+	// The clone flags creating new namespaces, including CLONE_NEWTIME and CLONE_NEWCGROUP.
+	cloneNamespaceFlags = 0x80 | syscall.CLONE_NEWNS | 0x02000000 | syscall.CLONE_NEWUTS |
+		syscall.CLONE_NEWIPC | syscall.CLONE_NEWUSER | syscall.CLONE_NEWPID | syscall.CLONE_NEWNET
+)
+
+// seccompFilter returns the seccomp program applied by bubblewrap to data source scripts. It
+// denies the syscalls that scripts never need and that widen the attack surface of the kernel,
+// such as ptrace, mount, bpf or module loading, and the creation of new namespaces. clone3 fails
+// with ENOSYS so that the C library falls back to clone, whose flags can be checked.
+func seccompFilter() ([]byte, error) {
+	if seccompAuditArch == 0 {
+		return nil, fmt.Errorf("seccomp filter is not supported on %s", runtime.GOARCH)
+	}
+
+	var prog []syscall.SockFilter
+	// The jumps to the deny instructions, resolved once they are appended at the end.
+	denyJumps := make(map[int]uint32)
+	stmt := func(code uint16, k uint32) {
+		prog = append(prog, syscall.SockFilter{Code: code, K: k})
+	}
+	jumpToRet := func(code uint16, k uint32, ret uint32) {
+		denyJumps[len(prog)] = ret
+		stmt(syscall.BPF_JMP|code|syscall.BPF_K, k)
+	}
+	eperm := uint32(seccompRetErrno | syscall.EPERM)
+	enosys := uint32(seccompRetErrno | syscall.ENOSYS)
+
+	// Deny the syscalls of other architectures, whose numbers differ.
+	stmt(syscall.BPF_LD|syscall.BPF_W|syscall.BPF_ABS, seccompDataArch)
+	prog = append(prog, syscall.SockFilter{
+		Code: syscall.BPF_JMP | syscall.BPF_JEQ | syscall.BPF_K,
+		Jt:   1,
+		K:    seccompAuditArch,
+	})
+	stmt(syscall.BPF_RET|syscall.BPF_K, eperm)
+
+	stmt(syscall.BPF_LD|syscall.BPF_W|syscall.BPF_ABS, seccompDataNr)
+	jumpToRet(syscall.BPF_JGE, x32SyscallBit, eperm)
+	jumpToRet(syscall.BPF_JEQ, sysClone3, enosys)
+	for _, nr := range deniedSyscalls {
+		jumpToRet(syscall.BPF_JEQ, nr, eperm)
+	}
+	// Allow clone unless it creates a new namespace.
+	prog = append(prog, syscall.SockFilter{
+		Code: syscall.BPF_JMP | syscall.BPF_JEQ | syscall.BPF_K,
+		Jf:   2,
+		K:    sysClone,
+	})
+	stmt(syscall.BPF_LD|syscall.BPF_W|syscall.BPF_ABS, seccompDataArg0)
+	jumpToRet(syscall.BPF_JSET, cloneNamespaceFlags, eperm)
+	stmt(syscall.BPF_RET|syscall.BPF_K, seccompRetAllow)
+
+	rets := map[uint32]int{}
+	for _, ret := range []uint32{eperm, enosys} {
+		rets[ret] = len(prog)
+		stmt(syscall.BPF_RET|syscall.BPF_K, ret)
+	}
+	for i, ret := range denyJumps {
+		prog[i].Jt = uint8(rets[ret] - i - 1)
+	}
+
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.NativeEndian, prog); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// seccompFile returns the read end of a pipe holding the given seccomp program, to be passed to
+// bubblewrap as an extra file. The program is small enough to fit in the pipe buffer.
+func seccompFile(filter []byte) (*os.File, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+	if _, err := w.Write(filter); err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,37 @@
+package executor
+
+// AUDIT_ARCH_X86_64
+const seccompAuditArch = 0xc000003e
+
+const (
+	sysClone  = 56
+	sysClone3 = 435
+)
+
+// deniedSyscalls are the numbers of the syscalls denied by the seccomp filter on amd64.
+var deniedSyscalls = []uint32{
+	101, // ptrace
+	155, // pivot_root
+	163, // acct
+	165, // mount
+	166, // umount2
+	167, // swapon
+	168, // swapoff
+	169, // reboot
+	175, // init_module
+	176, // delete_module
+	246, // kexec_load
+	248, // add_key
+	249, // request_key
+	250, // keyctl
+	272, // unshare
+	298, // perf_event_open
+	304, // open_by_handle_at
+	308, // setns
+	310, // process_vm_readv
+	311, // process_vm_writev
+	313, // finit_module
+	320, // kexec_file_load
+	321, // bpf
+	323, // userfaultfd
+}
@@ -0,0 +1,12 @@
+//go:build linux && !amd64 && !arm64
+
+package executor
+
+// The seccomp filter is only built for amd64 and arm64.
+const (
+	seccompAuditArch = 0
+	sysClone         = 0
+	sysClone3        = 0
+)
+
+var deniedSyscalls []uint32
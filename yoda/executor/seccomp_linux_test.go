@@ -0,0 +1,71 @@
+//go:build linux && (amd64 || arm64)
+
+package executor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// runSeccompFilter interprets the subset of classic BPF used by the seccomp filter on the given
+// syscall and returns the action of the filter.
+func runSeccompFilter(t *testing.T, filter []byte, arch uint32, nr uint32, arg0 uint32) uint32 {
+	prog := make([]syscall.SockFilter, len(filter)/8)
+	require.NoError(t, binary.Read(bytes.NewReader(filter), binary.NativeEndian, prog))
+
+	data := map[uint32]uint32{seccompDataNr: nr, seccompDataArch: arch, seccompDataArg0: arg0}
+	var acc uint32
+	for pc := 0; pc < len(prog); pc++ {
+		inst := prog[pc]
+		switch inst.Code {
+		case syscall.BPF_LD | syscall.BPF_W | syscall.BPF_ABS:
+			acc = data[inst.K]
+		case syscall.BPF_RET | syscall.BPF_K:
+			return inst.K
+		default:
+			var match bool
+			switch inst.Code {
+			case syscall.BPF_JMP | syscall.BPF_JEQ | syscall.BPF_K:
+				match = acc == inst.K
+			case syscall.BPF_JMP | syscall.BPF_JGE | syscall.BPF_K:
+				match = acc >= inst.K
+			case syscall.BPF_JMP | syscall.BPF_JSET | syscall.BPF_K:
+				match = acc&inst.K != 0
+			default:
+				t.Fatalf("unexpected instruction %v", inst)
+			}
+			if match {
+				pc += int(inst.Jt)
+			} else {
+				pc += int(inst.Jf)
+			}
+		}
+	}
+	t.Fatal("filter ended without returning")
+	return 0
+}
+
+func TestSeccompFilter(t *testing.T) {
+	filter, err := seccompFilter()
+	require.NoError(t, err)
+	require.Zero(t, len(filter)%8)
+
+	eperm := uint32(seccompRetErrno | syscall.EPERM)
+	run := func(nr uint32, arg0 uint32) uint32 {
+		return runSeccompFilter(t, filter, seccompAuditArch, nr, arg0)
+	}
+
+	require.Equal(t, uint32(seccompRetAllow), run(uint32(syscall.SYS_GETPID), 0))
+	for _, nr := range deniedSyscalls {
+		require.Equal(t, eperm, run(nr, 0))
+	}
+	require.Equal(t, uint32(seccompRetErrno|syscall.ENOSYS), run(sysClone3, 0))
+	require.Equal(t, uint32(seccompRetAllow), run(sysClone, syscall.CLONE_VM|syscall.CLONE_THREAD))
+	require.Equal(t, eperm, run(sysClone, syscall.CLONE_NEWUSER))
+	require.Equal(t, eperm, run(x32SyscallBit|uint32(syscall.SYS_GETPID), 0))
+	require.Equal(t, eperm, runSeccompFilter(t, filter, 0x40000003, uint32(syscall.SYS_GETPID), 0))
+}
@@ -0,0 +1,111 @@
+package yoda
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// parseFeeGranters parses the given comma-separated list of fee granter addresses.
+func parseFeeGranters(list string) ([]sdk.AccAddress, error) {
+	var granters []sdk.AccAddress
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		granter, err := sdk.AccAddressFromBech32(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee granter %s: %w", s, err)
+		}
+		granters = append(granters, granter)
+	}
+
+	return granters, nil
+}
+
+// feeAllowancesFunc returns the addresses of the granters of all fee allowances of the grantee.
+type feeAllowancesFunc func(grantee sdk.AccAddress) ([]string, error)
+
+// queryFeeAllowances returns a feeAllowancesFunc querying the fee allowances of the grantee on chain.
+// Listing the allowances of the grantee does not fail when there is none, unlike querying a single
+// allowance.
+func queryFeeAllowances(clientCtx client.Context) feeAllowancesFunc {
+	queryClient := feegrant.NewQueryClient(clientCtx)
+	return func(grantee sdk.AccAddress) ([]string, error) {
+		var granters []string
+		var nextKey []byte
+		for {
+			res, err := queryClient.Allowances(
+				context.Background(),
+				&feegrant.QueryAllowancesRequest{Grantee: grantee.String(), Pagination: &query.PageRequest{Key: nextKey}},
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, grant := range res.Allowances {
+				granters = append(granters, grant.Granter)
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return granters, nil
+			}
+			nextKey = res.Pagination.NextKey
+		}
+	}
+}
+
+// findFeeGranter returns the first of the given granters that grants a fee allowance to the
+// grantee, or nil if there is none.
+func findFeeGranter(
+	allowances feeAllowancesFunc,
+	granters []sdk.AccAddress,
+	grantee sdk.AccAddress,
+) (sdk.AccAddress, error) {
+	allowed, err := allowances(grantee)
+	if err != nil {
+		return nil, err
+	}
+	for _, granter := range granters {
+		if slices.Contains(allowed, granter.String()) {
+			return granter, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// detectFeeGranters finds the fee granter of every key, so that the reporters with a fee allowance
+// do not have to hold their own fee balance. The keys without any allowance pay their own fees.
+func detectFeeGranters(c *Context, l *Logger, granters []sdk.AccAddress, allowances feeAllowancesFunc) error {
+	c.feeGranters = make([]sdk.AccAddress, len(c.keys))
+	if len(granters) == 0 {
+		return nil
+	}
+
+	for i, key := range c.keys {
+		address, err := key.GetAddress()
+		if err != nil {
+			return err
+		}
+
+		c.feeGranters[i], err = findFeeGranter(allowances, granters, address)
+		if err != nil {
+			return err
+		}
+		if c.feeGranters[i] == nil {
+			l.Info(":money_with_wings: Key %s has no fee allowance and pays its own fees", key.Name)
+		} else {
+			l.Info(":handshake: Key %s pays fees through fee granter %s", key.Name, c.feeGranters[i])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package yoda
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newTestLogger(t *testing.T) *Logger {
+	allowLevel, err := log.ParseLogLevel("error")
+	require.NoError(t, err)
+	return NewLogger(allowLevel)
+}
+
+func newTestKey(t *testing.T, name string) (*keyring.Record, sdk.AccAddress) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	key, err := keyring.NewOfflineRecord(name, pubKey)
+	require.NoError(t, err)
+	return key, sdk.AccAddress(pubKey.Address())
+}
+
+func TestParseFeeGranters(t *testing.T) {
+	granter1 := sdk.AccAddress("granter1____________")
+	granter2 := sdk.AccAddress("granter2____________")
+
+	granters, err := parseFeeGranters("")
+	require.NoError(t, err)
+	require.Empty(t, granters)
+
+	granters, err = parseFeeGranters(fmt.Sprintf(" %s, ,%s ", granter1, granter2))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{granter1, granter2}, granters)
+
+	_, err = parseFeeGranters(fmt.Sprintf("%s,invalid", granter1))
+	require.ErrorContains(t, err, "invalid fee granter invalid")
+}
+
+func TestDetectFeeGranters(t *testing.T) {
+	granter1 := sdk.AccAddress("granter1____________")
+	granter2 := sdk.AccAddress("granter2____________")
+	other := sdk.AccAddress("other_______________")
+	key1, address1 := newTestKey(t, "key1")
+	key2, address2 := newTestKey(t, "key2")
+	key3, address3 := newTestKey(t, "key3")
+
+	allowances := map[string][]string{
+		// the configured order of the granters is preferred over the order of the allowances
+		address1.String(): {other.String(), granter2.String(), granter1.String()},
+		address2.String(): {granter2.String()},
+		address3.String(): {other.String()},
+	}
+	queryAllowances := func(grantee sdk.AccAddress) ([]string, error) {
+		return allowances[grantee.String()], nil
+	}
+
+	c := &Context{keys: []*keyring.Record{key1, key2, key3}}
+	err := detectFeeGranters(c, newTestLogger(t), []sdk.AccAddress{granter1, granter2}, queryAllowances)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{granter1, granter2, nil}, c.feeGranters)
+
+	// the keys pay their own fees without any configured granter
+	err = detectFeeGranters(c, newTestLogger(t), nil, queryAllowances)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{nil, nil, nil}, c.feeGranters)
+
+	failingAllowances := func(sdk.AccAddress) ([]string, error) {
+		return nil, fmt.Errorf("connection refused")
+	}
+	err = detectFeeGranters(c, newTestLogger(t), []sdk.AccAddress{granter1}, failingAllowances)
+	require.ErrorContains(t, err, "connection refused")
+}
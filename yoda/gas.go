@@ -48,6 +48,16 @@ const (
 	baseReportDataHandlerGas = hasFlatGas*3 + readFlatGas*3 + requestIDByteLength*readGasPerByte + writeFlatGas
 	readPendingListGas       = pendingResolveListByteLength*readGasPerByte + readFlatGas
 	writePendingListGas      = (pendingResolveListByteLength+pendingRequestIDByteLength)*writeGasPerByte + writeFlatGas
+
+	// Report Commit byte lengths
+	reportCommitByteLength = 90
+	oracleParamsByteLength = 60
+
+	// Report Commit handlers
+	readReportCommitGas         = reportCommitByteLength*readGasPerByte + readFlatGas
+	readOracleParamsGas         = oracleParamsByteLength*readGasPerByte + readFlatGas
+	baseCommitReportHandlerGas  = hasFlatGas + readFlatGas + requestIDByteLength*readGasPerByte + readOracleParamsGas + reportCommitByteLength*writeGasPerByte + writeFlatGas
+	baseRevealReportsHandlerGas = readReportCommitGas + readOracleParamsGas
 )
 
 func getTxByteLength(cdc codec.Codec, msgs []sdk.Msg) uint64 {
@@ -55,11 +65,6 @@ func getTxByteLength(cdc codec.Codec, msgs []sdk.Msg) uint64 {
 	size := baseTransactionSize
 
 	for _, msg := range msgs {
-		msg, ok := msg.(*types.MsgReportData)
-		if !ok {
-			panic("Don't support non-report data message")
-		}
-
 		ser := cdc.MustMarshal(msg)
 		size += uint64(len(ser))
 	}
@@ -104,16 +109,29 @@ func estimateReportHandlerGas(cdc codec.Codec, msg *types.MsgReportData, f FeeEs
 		cost += costWhenReachAskCountFirst
 	}
 
+	// Revealing reports also reads the commit and iterates all commits of the request.
+	if len(msg.Salt) > 0 {
+		cost += baseRevealReportsHandlerGas + (reportCommitByteLength*readGasPerByte+iterateFlatGas)*uint64(f.askCount)
+	}
+
 	return cost
 }
 
+func estimateCommitReportHandlerGas(f FeeEstimationData) uint64 {
+	requestByteLength := getRequestByteLength(f)
+
+	// The request is read twice and the commits are iterated twice to check the reveal phase.
+	return 2*readGasPerByte*requestByteLength + baseCommitReportHandlerGas +
+		2*(reportCommitByteLength*readGasPerByte+iterateFlatGas)*uint64(f.askCount)
+}
+
 func estimateAuthAnteHandlerGas(c *Context, msgs []sdk.Msg) uint64 {
 	gas := baseAuthAnteGas
 
 	txByteLength := getTxByteLength(c.bandApp.AppCodec(), msgs)
 	gas += txCostPerByte * txByteLength
 
-	if len(c.gasPrices) > 0 {
+	if len(c.gasPrices.GasPrices()) > 0 {
 		gas += payingFeeGasCost
 	}
 
@@ -124,11 +142,14 @@ func estimateGas(c *Context, l *Logger, msgs []sdk.Msg, feeEstimations []FeeEsti
 	gas := estimateAuthAnteHandlerGas(c, msgs)
 
 	for i, msg := range msgs {
-		msg, ok := msg.(*types.MsgReportData)
-		if !ok {
+		switch msg := msg.(type) {
+		case *types.MsgReportData:
+			gas += estimateReportHandlerGas(c.bandApp.AppCodec(), msg, feeEstimations[i])
+		case *types.MsgCommitReport:
+			gas += estimateCommitReportHandlerGas(feeEstimations[i])
+		default:
 			panic("Don't support non-report data message")
 		}
-		gas += estimateReportHandlerGas(c.bandApp.AppCodec(), msg, feeEstimations[i])
 	}
 
 	l.Debug(":fuel_pump: Estimated gas is %d", gas)
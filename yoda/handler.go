@@ -1,8 +1,11 @@
 package yoda
 
 import (
+	"crypto/rand"
 	"encoding/hex"
+	"fmt"
 	"strconv"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -11,8 +14,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/store"
 )
 
+// saltSize is the number of random bytes used to salt report commits.
+const saltSize = 32
+
 type processingResult struct {
 	rawReport types.RawReport
 	version   string
@@ -34,7 +42,12 @@ func handleTransaction(c *Context, l *Logger, tx abci.TxResult) {
 		return
 	}
 
-	events := tx.Result.Events
+	handleRequestEvents(c, l, tx.Height, tx.Result.Events)
+}
+
+// handleRequestEvents handles the requests created by the given events of a transaction included
+// at the given height.
+func handleRequestEvents(c *Context, l *Logger, height int64, events []abci.Event) {
 	idStrs := GetEventValues(events, types.EventTypeRequest, types.AttributeKeyID)
 	for _, idStr := range idStrs {
 		id, err := strconv.Atoi(idStr)
@@ -43,10 +56,10 @@ func handleTransaction(c *Context, l *Logger, tx abci.TxResult) {
 			return
 		}
 
-		// If id is in pending requests list, then skip it.
-		if c.pendingRequests[types.RequestID(id)] {
-			l.Debug(":eyes: Request is in pending list, then skip")
-			return
+		// If the request is already being handled, then skip it.
+		if !c.trackRequest(types.RequestID(id), height) {
+			l.Debug(":eyes: Request %d is already handled, then skip", id)
+			continue
 		}
 
 		go handleRequest(c, l, types.RequestID(id))
@@ -56,6 +69,19 @@ func handleTransaction(c *Context, l *Logger, tx abci.TxResult) {
 func handleRequest(c *Context, l *Logger, id types.RequestID) {
 	l = l.With("rid", id)
 
+	// The request stays active until SubmitReport is done with its report, or until its handling
+	// fails before the report is queued.
+	if !c.startHandling(id) {
+		l.Debug(":eyes: Request is already active, then skip")
+		return
+	}
+	queued := false
+	defer func() {
+		if !queued {
+			c.finishHandling(id)
+		}
+	}()
+
 	req, err := GetRequest(c, l, id)
 	if err != nil {
 		l.Error(":skull: Failed to get request with error: %s", c, err.Error())
@@ -74,6 +100,18 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 		return
 	}
 
+	// The requests are executed, and assigned to their keys, in the order of their tips. The
+	// execution slot is released before committing or reporting, so that waiting for the reveal
+	// phase does not hold it.
+	tip := req.Tip.AmountOf(c.tipDenom)
+	c.executions.acquire(tip)
+	executing := true
+	defer func() {
+		if executing {
+			c.executions.release()
+		}
+	}()
+
 	l.Info(":delivery_truck: Processing request")
 
 	keyIndex := c.nextKeyIndex()
@@ -99,18 +137,102 @@ func handleRequest(c *Context, l *Logger, id types.RequestID) {
 
 	// process raw requests
 	reports, execVersions := handleRawRequests(c, l, id, rawRequests, key)
+	c.executions.release()
+	executing = false
+
+	feeEstimationData := FeeEstimationData{
+		askCount:    int64(len(req.RequestedValidators)),
+		minCount:    int64(req.MinCount),
+		callData:    req.Calldata,
+		rawRequests: rawRequests,
+		clientID:    req.ClientID,
+	}
+	msg := types.NewMsgReportData(id, reports, c.validator)
+
+	submission, found, err := c.store.GetSubmission(id)
+	if err != nil {
+		l.Error(":skull: Failed to get submission state with error: %s", c, err.Error())
+		return
+	}
+	if !found {
+		submission = store.Submission{RequestID: id, Status: store.StatusExecuted}
+		// The salt is stored along with the submission state, so that the reports can still be
+		// revealed after a restart.
+		if req.CommitReveal {
+			submission.Salt = make([]byte, saltSize)
+			if _, err := rand.Read(submission.Salt); err != nil {
+				l.Error(":skull: Failed to generate salt with error: %s", c, err.Error())
+				return
+			}
+		}
+		if err := c.store.SetSubmission(submission); err != nil {
+			l.Error(":skull: Failed to save submission state with error: %s", c, err.Error())
+			return
+		}
+	}
+
+	// In commit-reveal mode, commit to the reports first and only reveal them once the reveal
+	// phase has started.
+	if req.CommitReveal {
+		msg.Salt = submission.Salt
 
+		if submission.Status != store.StatusCommitted {
+			c.pendingMsgs <- ReportMsgWithKey{
+				msg: types.NewMsgCommitReport(
+					id,
+					types.ReportCommitHash(id, c.validator, reports, submission.Salt),
+					c.validator,
+				),
+				keyIndex:          keyIndex,
+				feeEstimationData: feeEstimationData,
+				tip:               tip,
+			}
+		}
+
+		params, err := GetParams(c, l)
+		if err != nil {
+			l.Error(":skull: Failed to get params with error: %s", c, err.Error())
+			return
+		}
+		expirationHeight := req.RequestHeight + int64(params.ExpirationBlockCount)
+		if err := waitForReveal(c, l, id, expirationHeight); err != nil {
+			l.Error(":skull: Failed to wait for reveal phase with error: %s", c, err.Error())
+			return
+		}
+	}
+
+	queued = true
 	c.pendingMsgs <- ReportMsgWithKey{
-		msg:         types.NewMsgReportData(id, reports, c.validator),
-		execVersion: execVersions,
-		keyIndex:    keyIndex,
-		feeEstimationData: FeeEstimationData{
-			askCount:    int64(len(req.RequestedValidators)),
-			minCount:    int64(req.MinCount),
-			callData:    req.Calldata,
-			rawRequests: rawRequests,
-			clientID:    req.ClientID,
-		},
+		msg:               msg,
+		execVersion:       execVersions,
+		keyIndex:          keyIndex,
+		feeEstimationData: feeEstimationData,
+		tip:               tip,
+	}
+}
+
+// waitForReveal polls the report commits of the given request until its reveal phase starts, or
+// until the request expires at the given height.
+func waitForReveal(c *Context, l *Logger, id types.RequestID, expirationHeight int64) error {
+	l.Info(":lock: Waiting for reveal phase")
+	for {
+		res, err := GetReportCommits(c, l, id)
+		if err != nil {
+			return err
+		}
+		if res.RevealStarted {
+			l.Info(":unlock: Reveal phase started")
+			return nil
+		}
+
+		height, err := getLatestHeight(c)
+		if err != nil {
+			return err
+		}
+		if height >= expirationHeight {
+			return fmt.Errorf("request expired at height %d before its reveal phase started", expirationHeight)
+		}
+		time.Sleep(c.rpcPollInterval)
 	}
 }
 
@@ -161,6 +283,19 @@ func handleRawRequest(
 	c.updateHandlingGauge(1)
 	defer c.updateHandlingGauge(-1)
 
+	// Reuse the result of a previous run to avoid executing the data source script again.
+	cached, found, err := c.store.GetResult(id, req.externalID)
+	if err != nil {
+		l.Error(":skull: Failed to get stored result with error: %s", c, err.Error())
+	} else if found {
+		l.Debug(":floppy_disk: Reuse stored result with exitCode: %d", cached.ExitCode)
+		processingResultCh <- processingResult{
+			rawReport: types.NewRawReport(req.externalID, cached.ExitCode, cached.Data),
+			version:   cached.Version,
+		}
+		return
+	}
+
 	exec, err := GetExecutable(c, l, req.dataSourceHash)
 	if err != nil {
 		l.Error(":skull: Failed to load data source with error: %s", c, err.Error())
@@ -184,15 +319,33 @@ func handleRawRequest(
 		return
 	}
 
-	result, err := c.executor.Exec(exec, req.calldata, map[string]interface{}{
-		"BAND_CHAIN_ID":       vmsg.ChainID,
-		"BAND_DATA_SOURCE_ID": strconv.Itoa(int(vmsg.DataSourceID)),
-		"BAND_VALIDATOR":      vmsg.Validator,
-		"BAND_REQUEST_ID":     strconv.Itoa(int(vmsg.RequestID)),
-		"BAND_EXTERNAL_ID":    strconv.Itoa(int(vmsg.ExternalID)),
-		"BAND_REPORTER":       hex.EncodeToString(pubkey.Bytes()),
-		"BAND_SIGNATURE":      sig,
-	})
+	execute := func() (executor.ExecResult, error) {
+		return c.executor.Exec(exec, req.calldata, map[string]interface{}{
+			"BAND_CHAIN_ID":       vmsg.ChainID,
+			"BAND_DATA_SOURCE_ID": strconv.Itoa(int(vmsg.DataSourceID)),
+			"BAND_VALIDATOR":      vmsg.Validator,
+			"BAND_REQUEST_ID":     strconv.Itoa(int(vmsg.RequestID)),
+			"BAND_EXTERNAL_ID":    strconv.Itoa(int(vmsg.ExternalID)),
+			"BAND_REPORTER":       hex.EncodeToString(pubkey.Bytes()),
+			"BAND_SIGNATURE":      sig,
+		})
+	}
+
+	var result executor.ExecResult
+	hit := false
+	if c.execCache != nil && !c.execCacheSkip[req.dataSourceID] {
+		// Identical executions share the result, which is produced with the env of one of them.
+		result, hit, err = c.execCache.Exec(req.dataSourceHash+"\x00"+req.calldata, execute)
+		if hit {
+			l.Debug(":zap: Reuse cached execution result")
+			c.updateExecCacheHitCount(1)
+		} else {
+			c.updateExecCacheMissCount(1)
+		}
+	} else {
+		result, err = execute()
+	}
+	auditExecution(c, l, id, req, result, hit, err)
 
 	if err != nil {
 		l.Error(":skull: Failed to execute data source script: %s", c, err.Error())
@@ -206,6 +359,16 @@ func handleRawRequest(
 			":sparkles: Query data done with calldata: %q, result: %q, exitCode: %d",
 			req.calldata, result.Output, result.Code,
 		)
+		err = c.store.SetResult(store.Result{
+			RequestID:  id,
+			ExternalID: req.externalID,
+			ExitCode:   result.Code,
+			Data:       result.Output,
+			Version:    result.Version,
+		})
+		if err != nil {
+			l.Error(":skull: Failed to save result with error: %s", c, err.Error())
+		}
 		processingResultCh <- processingResult{
 			rawReport: types.NewRawReport(req.externalID, result.Code, result.Output),
 			version:   result.Version,
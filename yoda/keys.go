@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	band "github.com/bandprotocol/chain/v3/app"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
@@ -177,7 +178,7 @@ func keysListCmd() *cobra.Command {
 							s = ":x:"
 						}
 					}
-					emoji.Printf("%s%s => %s\n", s, key.Name, address.String())
+					emoji.Printf("%s%s => %s%s\n", s, key.Name, address.String(), feeGranterStatus(clientCtx, address))
 				}
 			}
 
@@ -192,6 +193,25 @@ func keysListCmd() *cobra.Command {
 	return cmd
 }
 
+// feeGranterStatus returns the description of the fee granter of the reporter if fee granters
+// are configured.
+func feeGranterStatus(clientCtx client.Context, address sdk.AccAddress) string {
+	granters, err := parseFeeGranters(cfg.FeeGranters)
+	if err != nil || len(granters) == 0 {
+		return ""
+	}
+
+	granter, err := findFeeGranter(queryFeeAllowances(clientCtx), granters, address)
+	switch {
+	case err != nil:
+		return emoji.Sprint(" (fee granter :question:)")
+	case granter == nil:
+		return emoji.Sprint(" (fee granter :x:)")
+	default:
+		return emoji.Sprintf(" (fee granter :white_check_mark: %s)", granter)
+	}
+}
+
 func keysShowCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "show [name]",
@@ -32,3 +32,17 @@ func (l *Logger) Error(format string, c *Context, args ...interface{}) {
 func (l *Logger) With(keyvals ...interface{}) *Logger {
 	return &Logger{logger: l.logger.With(keyvals...)}
 }
+
+// gasPricesLogger adapts Logger to the logger of the gas price provider.
+type gasPricesLogger struct {
+	c *Context
+	l *Logger
+}
+
+func (g gasPricesLogger) Info(format string, args ...interface{}) {
+	g.l.Info(format, args...)
+}
+
+func (g gasPricesLogger) Error(format string, args ...interface{}) {
+	g.l.Error(format, g.c, args...)
+}
@@ -22,28 +22,48 @@ import (
 )
 
 const (
-	flagValidator        = "validator"
-	flagLogLevel         = "log-level"
-	flagExecutor         = "executor"
-	flagBroadcastTimeout = "broadcast-timeout"
-	flagRPCPollInterval  = "rpc-poll-interval"
-	flagMaxTry           = "max-try"
-	flagMaxReport        = "max-report"
+	flagValidator         = "validator"
+	flagLogLevel          = "log-level"
+	flagExecutor          = "executor"
+	flagBroadcastTimeout  = "broadcast-timeout"
+	flagRPCPollInterval   = "rpc-poll-interval"
+	flagScanInterval      = "scan-interval"
+	flagReconcileInterval = "reconcile-interval"
+	flagGasPricesRefresh  = "gas-prices-refresh-interval"
+	flagMaxTry            = "max-try"
+	flagMaxReport         = "max-report"
+	flagMaxInFlightTxs    = "max-in-flight-txs"
+	flagMaxExecutions     = "max-executions"
+	flagTipDenom          = "tip-denom"
+	flagExecCacheTTL      = "exec-cache-ttl"
+	flagExecCacheSkip     = "exec-cache-skip"
+	flagAdminListenAddr   = "admin-listen-addr"
+	flagFeeGranters       = "fee-granters"
 )
 
 // Config data structure for yoda daemon.
 type Config struct {
-	ChainID           string `mapstructure:"chain-id"`            // ChainID of the target chain
-	NodeURI           string `mapstructure:"node"`                // Remote RPC URI of BandChain node to connect to
-	Validator         string `mapstructure:"validator"`           // The validator address that I'm responsible for
-	GasPrices         string `mapstructure:"gas-prices"`          // Gas prices of the transaction
-	LogLevel          string `mapstructure:"log-level"`           // Log level of the logger
-	Executor          string `mapstructure:"executor"`            // Executor name and URL (example: "Executor name:URL")
-	BroadcastTimeout  string `mapstructure:"broadcast-timeout"`   // The time that Yoda will wait for tx commit
-	RPCPollInterval   string `mapstructure:"rpc-poll-interval"`   // The duration of rpc poll interval
-	MaxTry            uint64 `mapstructure:"max-try"`             // The maximum number of tries to submit a report transaction
-	MaxReport         uint64 `mapstructure:"max-report"`          // The maximum number of reports in one transaction
-	MetricsListenAddr string `mapstructure:"metrics-listen-addr"` // Address to listen on for prometheus metrics
+	ChainID           string `mapstructure:"chain-id"`                    // ChainID of the target chain
+	NodeURI           string `mapstructure:"node"`                        // Remote RPC URI of BandChain node to connect to
+	Validator         string `mapstructure:"validator"`                   // The validator address that I'm responsible for
+	GasPrices         string `mapstructure:"gas-prices"`                  // Gas prices of the transaction
+	GasPricesRefresh  string `mapstructure:"gas-prices-refresh-interval"` // The duration between refreshes of the gas price requirement
+	FeeGranters       string `mapstructure:"fee-granters"`                // Comma-separated addresses of the fee granters of the reporters
+	LogLevel          string `mapstructure:"log-level"`                   // Log level of the logger
+	Executor          string `mapstructure:"executor"`                    // Executor name and URL (example: "Executor name:URL")
+	BroadcastTimeout  string `mapstructure:"broadcast-timeout"`           // The time that Yoda will wait for tx commit
+	RPCPollInterval   string `mapstructure:"rpc-poll-interval"`           // The duration of rpc poll interval
+	ScanInterval      string `mapstructure:"scan-interval"`               // The duration between scans of new blocks for missed requests
+	ReconcileInterval string `mapstructure:"reconcile-interval"`          // The duration between reconciliations with the pending requests
+	MaxTry            uint64 `mapstructure:"max-try"`                     // The maximum number of tries to submit a report transaction
+	MaxReport         uint64 `mapstructure:"max-report"`                  // The maximum number of reports in one transaction
+	MaxInFlightTxs    uint64 `mapstructure:"max-in-flight-txs"`           // The maximum number of report transactions in flight per key
+	MaxExecutions     uint64 `mapstructure:"max-executions"`              // The maximum number of requests executed at the same time
+	MetricsListenAddr string `mapstructure:"metrics-listen-addr"`         // Address to listen on for prometheus metrics
+	AdminListenAddr   string `mapstructure:"admin-listen-addr"`           // Address to listen on for the admin API
+	TipDenom          string `mapstructure:"tip-denom"`                   // The denom of request tips used to order the request executions and reports
+	ExecCacheTTL      string `mapstructure:"exec-cache-ttl"`              // The duration to cache execution results (0 to disable)
+	ExecCacheSkip     string `mapstructure:"exec-cache-skip"`             // Comma-separated IDs of the data sources never cached
 }
 
 // Global instances.
@@ -85,6 +105,7 @@ func Main() {
 		configCmd(),
 		keysCmd(),
 		runCmd(ctx),
+		auditCmd(ctx),
 		version.NewVersionCommand(),
 	)
 	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
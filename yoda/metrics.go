@@ -7,6 +7,8 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/bandprotocol/chain/v3/yoda/executor"
 )
 
 type yodaCollector struct {
@@ -15,6 +17,12 @@ type yodaCollector struct {
 	reportsPendingGaugeDesc   *prometheus.Desc
 	reportsErrorCountDesc     *prometheus.Desc
 	reportsSubmittedCountDesc *prometheus.Desc
+	execCacheHitCountDesc     *prometheus.Desc
+	execCacheMissCountDesc    *prometheus.Desc
+	executorHealthyGaugeDesc  *prometheus.Desc
+	executorSuccessCountDesc  *prometheus.Desc
+	executorFailureCountDesc  *prometheus.Desc
+	executorLatencyGaugeDesc  *prometheus.Desc
 }
 
 func NewYodaCollector(c *Context) prometheus.Collector {
@@ -36,6 +44,30 @@ func NewYodaCollector(c *Context) prometheus.Collector {
 			"yoda_reports_submitted_total",
 			"Number of reports submitted since last yoda restart",
 			nil, nil),
+		execCacheHitCountDesc: prometheus.NewDesc(
+			"yoda_exec_cache_hit_total",
+			"Number of data source executions served by the execution cache since last yoda restart",
+			nil, nil),
+		execCacheMissCountDesc: prometheus.NewDesc(
+			"yoda_exec_cache_miss_total",
+			"Number of data source executions missed the execution cache since last yoda restart",
+			nil, nil),
+		executorHealthyGaugeDesc: prometheus.NewDesc(
+			"yoda_executor_healthy",
+			"Whether the executor of the multi executor is healthy (1) or not (0)",
+			[]string{"executor"}, nil),
+		executorSuccessCountDesc: prometheus.NewDesc(
+			"yoda_executor_success_total",
+			"Number of successful executions of the executor since last yoda restart",
+			[]string{"executor"}, nil),
+		executorFailureCountDesc: prometheus.NewDesc(
+			"yoda_executor_failure_total",
+			"Number of failed executions of the executor since last yoda restart",
+			[]string{"executor"}, nil),
+		executorLatencyGaugeDesc: prometheus.NewDesc(
+			"yoda_executor_latency_seconds",
+			"Moving average of the latency of successful executions of the executor",
+			[]string{"executor"}, nil),
 	}
 }
 
@@ -44,6 +76,12 @@ func (collector yodaCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- collector.reportsPendingGaugeDesc
 	ch <- collector.reportsErrorCountDesc
 	ch <- collector.reportsSubmittedCountDesc
+	ch <- collector.execCacheHitCountDesc
+	ch <- collector.execCacheMissCountDesc
+	ch <- collector.executorHealthyGaugeDesc
+	ch <- collector.executorSuccessCountDesc
+	ch <- collector.executorFailureCountDesc
+	ch <- collector.executorLatencyGaugeDesc
 }
 
 func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
@@ -55,6 +93,29 @@ func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
 		float64(atomic.LoadInt64(&collector.context.errorCount)))
 	ch <- prometheus.MustNewConstMetric(collector.reportsSubmittedCountDesc, prometheus.CounterValue,
 		float64(atomic.LoadInt64(&collector.context.submittedCount)))
+	ch <- prometheus.MustNewConstMetric(collector.execCacheHitCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.execCacheHits)))
+	ch <- prometheus.MustNewConstMetric(collector.execCacheMissCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.execCacheMiss)))
+
+	multiExec, ok := collector.context.executor.(*executor.MultiExec)
+	if !ok {
+		return
+	}
+	for _, stats := range multiExec.Stats() {
+		healthy := 0.0
+		if stats.Healthy {
+			healthy = 1
+		}
+		ch <- prometheus.MustNewConstMetric(collector.executorHealthyGaugeDesc, prometheus.GaugeValue,
+			healthy, stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.executorSuccessCountDesc, prometheus.CounterValue,
+			float64(stats.Successes), stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.executorFailureCountDesc, prometheus.CounterValue,
+			float64(stats.Failures), stats.Name)
+		ch <- prometheus.MustNewConstMetric(collector.executorLatencyGaugeDesc, prometheus.GaugeValue,
+			stats.Latency.Seconds(), stats.Name)
+	}
 }
 
 func metricsListen(listenAddr string, c *Context) {
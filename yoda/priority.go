@@ -0,0 +1,90 @@
+package yoda
+
+import (
+	"container/heap"
+	"sync"
+
+	"cosmossdk.io/math"
+)
+
+// executionSlots limits the number of requests executed at the same time. The requests waiting
+// for a slot get it in descending order of their tips, and in arrival order for equal tips.
+type executionSlots struct {
+	mu        sync.Mutex
+	available uint64
+	waiters   slotWaiters
+	arrivals  uint64
+}
+
+// newExecutionSlots creates new execution slots of the given size.
+func newExecutionSlots(size uint64) *executionSlots {
+	return &executionSlots{available: size}
+}
+
+// acquire blocks until a slot is given to the request with the given tip.
+func (s *executionSlots) acquire(tip math.Int) {
+	s.mu.Lock()
+	if s.available > 0 && len(s.waiters) == 0 {
+		s.available--
+		s.mu.Unlock()
+		return
+	}
+
+	w := &slotWaiter{tip: tip, arrival: s.arrivals, ready: make(chan struct{})}
+	s.arrivals++
+	heap.Push(&s.waiters, w)
+	s.mu.Unlock()
+
+	<-w.ready
+}
+
+// release gives the slot to the highest tipped waiting request, or frees it if none is waiting.
+func (s *executionSlots) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.waiters) == 0 {
+		s.available++
+		return
+	}
+	close(heap.Pop(&s.waiters).(*slotWaiter).ready)
+}
+
+// waiting returns the number of requests waiting for a slot.
+func (s *executionSlots) waiting() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.waiters)
+}
+
+type slotWaiter struct {
+	tip     math.Int
+	arrival uint64
+	ready   chan struct{}
+}
+
+// slotWaiters is a heap of the waiting requests, the highest tipped first.
+type slotWaiters []*slotWaiter
+
+func (w slotWaiters) Len() int { return len(w) }
+
+func (w slotWaiters) Less(i, j int) bool {
+	if !w[i].tip.Equal(w[j].tip) {
+		return w[i].tip.GT(w[j].tip)
+	}
+	return w[i].arrival < w[j].arrival
+}
+
+func (w slotWaiters) Swap(i, j int) { w[i], w[j] = w[j], w[i] }
+
+func (w *slotWaiters) Push(x any) { *w = append(*w, x.(*slotWaiter)) }
+
+func (w *slotWaiters) Pop() any {
+	old := *w
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*w = old[:n-1]
+	return x
+}
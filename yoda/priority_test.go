@@ -0,0 +1,37 @@
+package yoda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestExecutionSlots(t *testing.T) {
+	slots := newExecutionSlots(1)
+	slots.acquire(math.NewInt(1))
+
+	// The requests wait for the only slot, and get it by tip and then by arrival.
+	order := make(chan int64, 4)
+	for i, tip := range []int64{10, 30, 20, 30} {
+		go func() {
+			slots.acquire(math.NewInt(tip))
+			order <- int64(i)
+		}()
+		require.Eventually(t, func() bool { return slots.waiting() == i+1 }, time.Second, time.Millisecond)
+	}
+
+	var got []int64
+	for range 4 {
+		slots.release()
+		got = append(got, <-order)
+	}
+	require.Equal(t, []int64{1, 3, 2, 0}, got)
+	require.Zero(t, slots.waiting())
+
+	// The slot is freed once no request is waiting.
+	slots.release()
+	slots.acquire(math.ZeroInt())
+}
@@ -0,0 +1,61 @@
+package yoda
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// isReporterFunc returns whether the reporter is granted to report on behalf of the validator.
+type isReporterFunc func(reporter sdk.AccAddress) (bool, error)
+
+// queryIsReporter returns an isReporterFunc querying the authz grants of the validator on chain.
+func queryIsReporter(clientCtx client.Context, validator sdk.ValAddress) isReporterFunc {
+	queryClient := types.NewQueryClient(clientCtx)
+	return func(reporter sdk.AccAddress) (bool, error) {
+		res, err := queryClient.IsReporter(
+			context.Background(),
+			&types.QueryIsReporterRequest{ValidatorAddress: validator.String(), ReporterAddress: reporter.String()},
+		)
+		if err != nil {
+			return false, err
+		}
+
+		return res.IsReporter, nil
+	}
+}
+
+// detectReporters keeps only the keys granted through authz to report on behalf of the validator,
+// as the reports of the other keys are always rejected. The granter of the grants is always the
+// validator account, which is the signer of MsgReportData.
+func detectReporters(c *Context, l *Logger, isReporter isReporterFunc) error {
+	reporters := make([]*keyring.Record, 0, len(c.keys))
+	for _, key := range c.keys {
+		address, err := key.GetAddress()
+		if err != nil {
+			return err
+		}
+
+		ok, err := isReporter(address)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			l.Info(":no_entry: Key %s is not granted to report for the validator and is not used", key.Name)
+			continue
+		}
+		reporters = append(reporters, key)
+	}
+
+	if len(reporters) == 0 {
+		return errors.New("no key is granted to report for the validator")
+	}
+	c.keys = reporters
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package yoda
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDetectReporters(t *testing.T) {
+	key1, address1 := newTestKey(t, "key1")
+	key2, _ := newTestKey(t, "key2")
+	key3, address3 := newTestKey(t, "key3")
+	isReporter := func(reporter sdk.AccAddress) (bool, error) {
+		return reporter.Equals(address1) || reporter.Equals(address3), nil
+	}
+
+	c := &Context{keys: []*keyring.Record{key1, key2, key3}}
+	require.NoError(t, detectReporters(c, newTestLogger(t), isReporter))
+	require.Equal(t, []*keyring.Record{key1, key3}, c.keys)
+
+	c = &Context{keys: []*keyring.Record{key2}}
+	err := detectReporters(c, newTestLogger(t), isReporter)
+	require.EqualError(t, err, "no key is granted to report for the validator")
+
+	failing := func(sdk.AccAddress) (bool, error) {
+		return false, fmt.Errorf("connection refused")
+	}
+	c = &Context{keys: []*keyring.Record{key1}}
+	require.ErrorContains(t, detectReporters(c, newTestLogger(t), failing), "connection refused")
+}
@@ -3,23 +3,32 @@ package yoda
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	dbm "github.com/cometbft/cometbft-db"
 	httpclient "github.com/cometbft/cometbft/rpc/client/http"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bandprotocol/chain/v3/pkg/filecache"
+	"github.com/bandprotocol/chain/v3/pkg/gasprice"
 	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/audit"
 	"github.com/bandprotocol/chain/v3/yoda/executor"
+	"github.com/bandprotocol/chain/v3/yoda/store"
 )
 
 const (
@@ -50,27 +59,77 @@ func runImpl(c *Context, l *Logger) error {
 		go metricsListen(cfg.MetricsListenAddr, c)
 	}
 
-	availiableKeys := make([]bool, len(c.keys))
+	if cfg.AdminListenAddr != "" {
+		l.Info(":wrench: Starting admin API listener")
+		go adminListen(cfg.AdminListenAddr, c, l)
+	}
+
+	inFlightTxs := make([]uint64, len(c.keys))
 	waitingMsgs := make([][]ReportMsgWithKey, len(c.keys))
-	for i := range availiableKeys {
-		availiableKeys[i] = true
+	for i := range waitingMsgs {
 		waitingMsgs[i] = []ReportMsgWithKey{}
 	}
 
-	bz := c.bandApp.AppCodec().MustMarshal(&types.QueryPendingRequestsRequest{
-		ValidatorAddress: c.validator.String(),
-	})
-	resBz, err := c.client.ABCIQuery(context.Background(), "/band.oracle.v1.Query/PendingRequests", bz)
+	// Requests before this height are covered by the pending requests, but the block scanner resumes
+	// from the height scanned before the restart, so that no request event is lost in between. It
+	// goes back no further than the expiration of the requests, as the older requests are expired.
+	latestHeight, err := getLatestHeight(c)
+	if err != nil {
+		return err
+	}
+	c.scannedHeight = latestHeight
+	storedHeight, found, err := c.store.GetScannedHeight()
+	if err != nil {
+		l.Error(":exploding_head: Failed to get scanned height with error: %s", c, err.Error())
+		return err
+	}
+	if found && storedHeight < latestHeight {
+		params, err := GetParams(c, l)
+		if err != nil {
+			l.Error(":exploding_head: Failed to get params with error: %s", c, err.Error())
+			return err
+		}
+		c.scannedHeight = max(storedHeight, latestHeight-int64(params.ExpirationBlockCount))
+	}
+	pendingRequests, err := GetPendingRequests(c, l)
 	if err != nil {
 		l.Error(":exploding_head: Failed to get pending requests with error: %s", c, err.Error())
+		return err
 	}
-	pendingRequests := types.QueryPendingRequestsResponse{}
-	c.bandApp.AppCodec().MustUnmarshal(resBz.Response.Value, &pendingRequests)
 
-	l.Info(":mag: Found %d pending requests", len(pendingRequests.RequestIDs))
-	for _, id := range pendingRequests.RequestIDs {
-		c.pendingRequests[types.RequestID(id)] = true
-		go handleRequest(c, l, types.RequestID(id))
+	c.gasPrices.Start(c.gasPricesRefresh, gasPricesLogger{c: c, l: l})
+	updateMaxOutputSize(c, l)
+
+	l.Info(":mag: Found %d pending requests", len(pendingRequests))
+	pruneStore(c, l, pendingRequests)
+	for _, id := range pendingRequests {
+		// The events of the pending requests are at or below the latest height.
+		c.trackRequest(id, latestHeight)
+		go handleRequest(c, l, id)
+	}
+
+	go scanBlocks(c, l)
+	go reconcileRequests(c, l)
+
+	// submitWaitingMsgs submits the waiting reports of the key in as many transactions as the key
+	// may have in flight at the same time.
+	submitWaitingMsgs := func(keyIndex int64) {
+		if c.isKeyPaused(keyIndex) {
+			return
+		}
+		// Submit the reports of the highest tipped requests first. The requests are also executed,
+		// and so assigned to the keys, in the order of their tips.
+		sortByTip(waitingMsgs[keyIndex])
+		for inFlightTxs[keyIndex] < c.maxInFlightTxs && len(waitingMsgs[keyIndex]) != 0 {
+			inFlightTxs[keyIndex]++
+			if uint64(len(waitingMsgs[keyIndex])) > c.maxReport {
+				go SubmitReport(c, l, keyIndex, waitingMsgs[keyIndex][:c.maxReport])
+				waitingMsgs[keyIndex] = waitingMsgs[keyIndex][c.maxReport:]
+			} else {
+				go SubmitReport(c, l, keyIndex, waitingMsgs[keyIndex])
+				waitingMsgs[keyIndex] = []ReportMsgWithKey{}
+			}
+		}
 	}
 
 	for {
@@ -78,25 +137,17 @@ func runImpl(c *Context, l *Logger) error {
 		case ev := <-eventChan:
 			go handleTransaction(c, l, ev.Data.(cmttypes.EventDataTx).TxResult)
 		case keyIndex := <-c.freeKeys:
-			if len(waitingMsgs[keyIndex]) != 0 {
-				if uint64(len(waitingMsgs[keyIndex])) > c.maxReport {
-					go SubmitReport(c, l, keyIndex, waitingMsgs[keyIndex][:c.maxReport])
-					waitingMsgs[keyIndex] = waitingMsgs[keyIndex][c.maxReport:]
-				} else {
-					go SubmitReport(c, l, keyIndex, waitingMsgs[keyIndex])
-					waitingMsgs[keyIndex] = []ReportMsgWithKey{}
-				}
-			} else {
-				availiableKeys[keyIndex] = true
-			}
+			inFlightTxs[keyIndex]--
+			submitWaitingMsgs(keyIndex)
+			c.updateKeyQueue(keyIndex, waitingMsgs[keyIndex], inFlightTxs[keyIndex])
+		case keyIndex := <-c.resumedKeys:
+			submitWaitingMsgs(keyIndex)
+			c.updateKeyQueue(keyIndex, waitingMsgs[keyIndex], inFlightTxs[keyIndex])
 		case pm := <-c.pendingMsgs:
 			c.updatePendingGauge(1)
-			if availiableKeys[pm.keyIndex] {
-				availiableKeys[pm.keyIndex] = false
-				go SubmitReport(c, l, pm.keyIndex, []ReportMsgWithKey{pm})
-			} else {
-				waitingMsgs[pm.keyIndex] = append(waitingMsgs[pm.keyIndex], pm)
-			}
+			waitingMsgs[pm.keyIndex] = append(waitingMsgs[pm.keyIndex], pm)
+			submitWaitingMsgs(pm.keyIndex)
+			c.updateKeyQueue(pm.keyIndex, waitingMsgs[pm.keyIndex], inFlightTxs[pm.keyIndex])
 		}
 	}
 }
@@ -128,7 +179,11 @@ func runCmd(c *Context) *cobra.Command {
 				return err
 			}
 
-			c.gasPrices = cfg.GasPrices
+			if cfg.AdminListenAddr != "" {
+				if err := checkLoopbackAddr(cfg.AdminListenAddr); err != nil {
+					return err
+				}
+			}
 
 			allowLevel, err := log.ParseLogLevel(cfg.LogLevel)
 			if err != nil {
@@ -139,26 +194,106 @@ func runCmd(c *Context) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			// Hide the home of yoda, which holds the keyring, from the scripts run locally.
+			if hider, ok := c.executor.(executor.PathHider); ok {
+				home, err := filepath.Abs(c.home)
+				if err != nil {
+					return err
+				}
+				hider.HidePaths(home)
+			}
 			l.Info(":star: Creating HTTP client with node URI: %s", cfg.NodeURI)
 			c.client, err = httpclient.New(cfg.NodeURI, "/websocket")
 			if err != nil {
 				return err
 			}
+			c.gasPrices, err = gasprice.NewProvider(cfg.GasPrices, gasprice.NewGRPCQuerier(client.Context{
+				Client:            c.client,
+				Codec:             c.bandApp.AppCodec(),
+				InterfaceRegistry: c.bandApp.InterfaceRegistry(),
+			}))
+			if err != nil {
+				return err
+			}
+			c.gasPricesRefresh, err = time.ParseDuration(cfg.GasPricesRefresh)
+			if err != nil {
+				return err
+			}
+			queryCtx := client.Context{
+				Client:            c.client,
+				Codec:             c.bandApp.AppCodec(),
+				InterfaceRegistry: c.bandApp.InterfaceRegistry(),
+			}
+			if err := detectReporters(c, l, queryIsReporter(queryCtx, c.validator)); err != nil {
+				return err
+			}
+			feeGranters, err := parseFeeGranters(cfg.FeeGranters)
+			if err != nil {
+				return err
+			}
+			if err := detectFeeGranters(c, l, feeGranters, queryFeeAllowances(queryCtx)); err != nil {
+				return err
+			}
 			c.fileCache = filecache.New(filepath.Join(c.home, "files"))
+			db, err := dbm.NewDB("yoda", dbm.GoLevelDBBackend, filepath.Join(c.home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			c.store = store.NewStore(db)
+			c.audit, err = audit.NewArchive(filepath.Join(c.home, "audit"))
+			if err != nil {
+				return err
+			}
 			c.broadcastTimeout, err = time.ParseDuration(cfg.BroadcastTimeout)
 			if err != nil {
 				return err
 			}
 			c.maxTry = cfg.MaxTry
 			c.maxReport = cfg.MaxReport
+			c.tipDenom = cfg.TipDenom
 			c.rpcPollInterval, err = time.ParseDuration(cfg.RPCPollInterval)
 			if err != nil {
 				return err
 			}
+			execCacheTTL, err := time.ParseDuration(cfg.ExecCacheTTL)
+			if err != nil {
+				return err
+			}
+			if execCacheTTL > 0 {
+				c.execCache = executor.NewCache(execCacheTTL)
+			}
+			c.execCacheSkip, err = parseDataSourceIDs(cfg.ExecCacheSkip)
+			if err != nil {
+				return err
+			}
 			c.pendingMsgs = make(chan ReportMsgWithKey)
-			c.freeKeys = make(chan int64, len(keys))
+			c.maxInFlightTxs = cfg.MaxInFlightTxs
+			if c.maxInFlightTxs == 0 {
+				return errors.New("max in-flight transactions must be positive")
+			}
+			if cfg.MaxExecutions == 0 {
+				return errors.New("max executions must be positive")
+			}
+			c.executions = newExecutionSlots(cfg.MaxExecutions)
+			c.sequences = make([]*sequenceTracker, len(c.keys))
+			for i := range c.sequences {
+				c.sequences[i] = &sequenceTracker{}
+			}
+			c.freeKeys = make(chan int64, uint64(len(c.keys))*c.maxInFlightTxs)
+			c.resumedKeys = make(chan int64, len(c.keys))
+			c.keyStates = make([]keyState, len(c.keys))
 			c.keyRoundRobinIndex = -1
-			c.pendingRequests = make(map[types.RequestID]bool)
+			c.scanInterval, err = time.ParseDuration(cfg.ScanInterval)
+			if err != nil {
+				return err
+			}
+			c.reconcileInterval, err = time.ParseDuration(cfg.ReconcileInterval)
+			if err != nil {
+				return err
+			}
+			c.pendingRequests = make(map[types.RequestID]int64)
+			c.activeRequests = make(map[types.RequestID]bool)
 			c.metricsEnabled = cfg.MetricsListenAddr != ""
 			return runImpl(c, l)
 		},
@@ -166,23 +301,108 @@ func runCmd(c *Context) *cobra.Command {
 	cmd.Flags().String(flags.FlagChainID, "", "chain ID of BandChain network")
 	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "RPC url to BandChain node")
 	cmd.Flags().String(flagValidator, "", "validator address")
-	cmd.Flags().String(flagExecutor, "", "executor name and url for executing the data source script")
+	cmd.Flags().String(flagExecutor, "", "executor name and url for executing the data source script (e.g. rest:https://executor.url?timeout=10s, local:auto?timeout=10s or \"multi:weighted rest:https://a.url?timeout=10s&weight=2 rest:https://b.url?timeout=10s\")")
 	cmd.Flags().String(flags.FlagGasPrices, "", "gas prices for report transaction")
+	cmd.Flags().String(flagGasPricesRefresh, "1m", "The duration between refreshes of the gas prices required by globalfee and the node (0 to disable)")
+	cmd.Flags().String(flagFeeGranters, "", "comma-separated addresses of the accounts granting fee allowances to the reporters (e.g. the validator account)")
 	cmd.Flags().String(flagLogLevel, "info", "set the logger level")
 	cmd.Flags().String(flagBroadcastTimeout, "5m", "The time that Yoda will wait for tx commit")
 	cmd.Flags().String(flagRPCPollInterval, "1s", "The duration of rpc poll interval")
+	cmd.Flags().String(flagScanInterval, "10s", "The duration between scans of new blocks for missed requests")
+	cmd.Flags().String(flagReconcileInterval, "1m", "The duration between reconciliations with the pending requests on chain")
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of tries to submit a report transaction")
 	cmd.Flags().Uint64(flagMaxReport, 10, "The maximum number of reports in one transaction")
+	cmd.Flags().Uint64(flagMaxInFlightTxs, 1, "The maximum number of report transactions in flight per key")
+	cmd.Flags().Uint64(flagMaxExecutions, 64, "The maximum number of requests executed at the same time, the highest tipped first")
+	cmd.Flags().String(flagTipDenom, "uband", "The denom of request tips used to order the request executions and reports")
+	cmd.Flags().String(flagAdminListenAddr, "", "Loopback address to listen on for the admin API, which has no authentication (e.g. 127.0.0.1:8081), disabled if empty")
+	cmd.Flags().String(flagExecCacheTTL, "0s", "The duration to cache execution results by data source and calldata (0 to disable)")
+	cmd.Flags().String(flagExecCacheSkip, "", "Comma-separated IDs of the data sources whose results are never cached (e.g. 1,2,3)")
 	_ = viper.BindPFlag(flags.FlagChainID, cmd.Flags().Lookup(flags.FlagChainID))
 	_ = viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
 	_ = viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
 	_ = viper.BindPFlag(flags.FlagGasPrices, cmd.Flags().Lookup(flags.FlagGasPrices))
+	_ = viper.BindPFlag(flagGasPricesRefresh, cmd.Flags().Lookup(flagGasPricesRefresh))
+	_ = viper.BindPFlag(flagFeeGranters, cmd.Flags().Lookup(flagFeeGranters))
 	_ = viper.BindPFlag(flagLogLevel, cmd.Flags().Lookup(flagLogLevel))
 	_ = viper.BindPFlag(flagExecutor, cmd.Flags().Lookup(flagExecutor))
 	_ = viper.BindPFlag(flagBroadcastTimeout, cmd.Flags().Lookup(flagBroadcastTimeout))
 	_ = viper.BindPFlag(flagRPCPollInterval, cmd.Flags().Lookup(flagRPCPollInterval))
+	_ = viper.BindPFlag(flagScanInterval, cmd.Flags().Lookup(flagScanInterval))
+	_ = viper.BindPFlag(flagReconcileInterval, cmd.Flags().Lookup(flagReconcileInterval))
 	_ = viper.BindPFlag(flagMaxTry, cmd.Flags().Lookup(flagMaxTry))
 	_ = viper.BindPFlag(flagMaxReport, cmd.Flags().Lookup(flagMaxReport))
+	_ = viper.BindPFlag(flagMaxInFlightTxs, cmd.Flags().Lookup(flagMaxInFlightTxs))
+	_ = viper.BindPFlag(flagMaxExecutions, cmd.Flags().Lookup(flagMaxExecutions))
+	_ = viper.BindPFlag(flagTipDenom, cmd.Flags().Lookup(flagTipDenom))
+	_ = viper.BindPFlag(flagAdminListenAddr, cmd.Flags().Lookup(flagAdminListenAddr))
+	_ = viper.BindPFlag(flagExecCacheTTL, cmd.Flags().Lookup(flagExecCacheTTL))
+	_ = viper.BindPFlag(flagExecCacheSkip, cmd.Flags().Lookup(flagExecCacheSkip))
 
 	return cmd
 }
+
+// parseDataSourceIDs parses the given comma-separated list of data source IDs into a set.
+func parseDataSourceIDs(list string) (map[types.DataSourceID]bool, error) {
+	ids := make(map[types.DataSourceID]bool)
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data source ID %q: %w", s, err)
+		}
+		ids[types.DataSourceID(id)] = true
+	}
+
+	return ids, nil
+}
+
+// pruneStore deletes the stored data of all requests that are no longer pending for this validator,
+// as they are either reported, resolved or expired. The requests still tracked as handled are kept,
+// as they may be newer than the pending requests.
+func pruneStore(c *Context, l *Logger, pendingIDs []types.RequestID) {
+	pending := make(map[types.RequestID]bool, len(pendingIDs))
+	for _, id := range pendingIDs {
+		pending[id] = true
+	}
+
+	ids, err := c.store.GetAllRequestIDs()
+	if err != nil {
+		l.Error(":exploding_head: Failed to get stored requests with error: %s", c, err.Error())
+		return
+	}
+	for _, id := range ids {
+		if pending[id] || c.isRequestTracked(id) {
+			continue
+		}
+		if err := c.store.DeleteRequest(id); err != nil {
+			l.Error(":exploding_head: Failed to delete stored request %d with error: %s", c, id, err.Error())
+		}
+	}
+}
+
+// updateMaxOutputSize caps the output of the executor at the MaxReportDataSize parameter, as the
+// chain rejects longer reports.
+func updateMaxOutputSize(c *Context, l *Logger) {
+	limiter, ok := c.executor.(executor.OutputLimiter)
+	if !ok {
+		return
+	}
+	params, err := GetParams(c, l)
+	if err != nil {
+		l.Error(":exploding_head: Failed to get params with error: %s", c, err.Error())
+		return
+	}
+	limiter.SetMaxOutputSize(params.MaxReportDataSize)
+}
+
+// sortByTip sorts the given report messages of a key by their request tips in descending order
+// while keeping the arrival order of the reports with equal tips.
+func sortByTip(msgs []ReportMsgWithKey) {
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].tip.GT(msgs[j].tip)
+	})
+}
@@ -0,0 +1,100 @@
+package yoda
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// getLatestHeight fetches the height of the latest block of the node.
+func getLatestHeight(c *Context) (int64, error) {
+	status, err := c.client.Status(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// scanBlocks periodically scans the results of the blocks after the last scanned height for request
+// events, so that the requests missed by the websocket subscription (e.g. while it is reconnecting
+// or when its event channel overflows) are still handled.
+func scanBlocks(c *Context, l *Logger) {
+	for {
+		time.Sleep(c.scanInterval)
+
+		latest, err := getLatestHeight(c)
+		if err != nil {
+			l.Error(":exploding_head: Failed to get latest height with error: %s", c, err.Error())
+			continue
+		}
+
+		scanned := atomic.LoadInt64(&c.scannedHeight)
+		for height := scanned + 1; height <= latest; height++ {
+			res, err := c.client.BlockResults(context.Background(), &height)
+			if err != nil {
+				l.Error(":exploding_head: Failed to get block results at height %d with error: %s", c, height, err.Error())
+				break
+			}
+
+			for _, txRes := range res.TxsResults {
+				if txRes.Code != 0 {
+					continue
+				}
+				handleRequestEvents(c, l, height, txRes.Events)
+			}
+			atomic.StoreInt64(&c.scannedHeight, height)
+		}
+
+		// Persist the scanned height, so that the scanner resumes from it after a restart.
+		if height := atomic.LoadInt64(&c.scannedHeight); height > scanned {
+			if err := c.store.SetScannedHeight(height); err != nil {
+				l.Error(":exploding_head: Failed to save scanned height with error: %s", c, err.Error())
+			}
+		}
+	}
+}
+
+// reconcileRequests periodically reconciles the handled requests with the pending requests of the
+// validator on chain. It handles the pending requests that are not handled yet and forgets the
+// requests that are no longer pending, along with their stored data.
+func reconcileRequests(c *Context, l *Logger) {
+	for {
+		time.Sleep(c.reconcileInterval)
+
+		// The pending requests are queried at or after this height, so every request seen at or below
+		// it is already reported, resolved or expired if it is not pending.
+		height, err := getLatestHeight(c)
+		if err != nil {
+			l.Error(":exploding_head: Failed to get latest height with error: %s", c, err.Error())
+			continue
+		}
+		ids, err := GetPendingRequests(c, l)
+		if err != nil {
+			l.Error(":exploding_head: Failed to get pending requests with error: %s", c, err.Error())
+			continue
+		}
+
+		pending := make(map[types.RequestID]bool, len(ids))
+		for _, id := range ids {
+			pending[id] = true
+			if c.trackRequest(id, height) {
+				l.Info(":mag: Found missed pending request %d", id)
+				go handleRequest(c, l, id)
+			}
+		}
+
+		// Only forget the requests whose events are already scanned, so that they are not handled
+		// again by the block scanner.
+		if scanned := atomic.LoadInt64(&c.scannedHeight); scanned < height {
+			height = scanned
+		}
+		c.untrackRequests(height, pending)
+		pruneStore(c, l, ids)
+
+		// The parameters may change by governance.
+		updateMaxOutputSize(c, l)
+	}
+}
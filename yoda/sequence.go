@@ -0,0 +1,127 @@
+package yoda
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// unconfirmedTxsLimit is the number of mempool transactions fetched to look for a transaction,
+// which is the most the node returns at once.
+const unconfirmedTxsLimit = 100
+
+// expectedSequenceRegex extracts the sequence expected by the node from a sequence mismatch error.
+var expectedSequenceRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
+// checkTxError is the error of a transaction rejected by CheckTx.
+type checkTxError struct {
+	codespace string
+	code      uint32
+	rawLog    string
+}
+
+func (e *checkTxError) Error() string {
+	return fmt.Sprintf("transaction rejected with codespace: %s, code: %d, log: %s", e.codespace, e.code, e.rawLog)
+}
+
+// sequenceTracker tracks the account sequence of a key locally, so that several report transactions
+// of the key can be in flight at the same time. Broadcasts of the same key are serialized, so that
+// they reach the mempool in the order of their sequences.
+type sequenceTracker struct {
+	mu            sync.Mutex
+	synced        bool // Whether the sequence is known, otherwise it is queried on the next broadcast
+	accountNumber uint64
+	sequence      uint64 // The sequence of the next transaction
+}
+
+// broadcast sends a transaction with the next sequence of the key using the send function. The
+// sequence is only consumed if the transaction is accepted by CheckTx. If the node expects another
+// sequence, the tracker follows the node and the error is returned, so the caller can try again.
+func (t *sequenceTracker) broadcast(
+	queryAccount func() (client.Account, error),
+	send func(accountNumber uint64, sequence uint64) (string, error),
+) (string, uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.synced {
+		acc, err := queryAccount()
+		if err != nil {
+			return "", 0, fmt.Errorf("unable to get account: %w", err)
+		}
+		t.accountNumber = acc.GetAccountNumber()
+		t.sequence = acc.GetSequence()
+		t.synced = true
+	}
+
+	sequence := t.sequence
+	txHash, err := send(t.accountNumber, sequence)
+	if err != nil {
+		if checkTxErr, ok := err.(*checkTxError); ok && checkTxErr.codespace == sdkerrors.RootCodespace &&
+			checkTxErr.code == sdkerrors.ErrWrongSequence.ABCICode() {
+			t.recover(checkTxErr.rawLog)
+		}
+		return "", sequence, err
+	}
+
+	t.sequence++
+	return txHash, sequence, nil
+}
+
+// recover resets the sequence after a sequence mismatch. The sequence expected by the node already
+// counts the transactions in its mempool, so it is preferred over querying the account again.
+func (t *sequenceTracker) recover(rawLog string) {
+	matches := expectedSequenceRegex.FindStringSubmatch(rawLog)
+	if matches == nil {
+		t.synced = false
+		return
+	}
+	expected, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		t.synced = false
+		return
+	}
+	t.sequence = expected
+}
+
+// reset makes the tracker query the sequence of the account again on the next broadcast, e.g. when
+// a transaction is dropped from the mempool and leaves a gap in the sequences.
+func (t *sequenceTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.synced = false
+}
+
+// isSequenceGap returns whether the transaction with the given sequence and bytes, which is not
+// found on chain, was dropped from the mempool and left a gap in the sequences of the key. It only
+// reads the state of the node: the transaction must be missing from the mempool while the account
+// has not used the sequence yet. The mempool is read first, so that a transaction included in
+// between is seen through the sequence of the account. If the mempool holds more transactions than
+// the node returns at once, the transaction may be among the others, so no gap is assumed.
+func isSequenceGap(
+	sequence uint64,
+	txBytes []byte,
+	queryAccount func() (client.Account, error),
+	unconfirmedTxs func() (*ctypes.ResultUnconfirmedTxs, error),
+) bool {
+	mempool, err := unconfirmedTxs()
+	if err != nil || mempool.Total > len(mempool.Txs) {
+		return false
+	}
+	for _, tx := range mempool.Txs {
+		if bytes.Equal(tx, txBytes) {
+			return false
+		}
+	}
+
+	acc, err := queryAccount()
+	return err == nil && acc.GetSequence() <= sequence
+}
@@ -0,0 +1,226 @@
+package yoda
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// fakeChain is an account whose sequence is checked by the broadcasts of a sequenceTracker.
+type fakeChain struct {
+	accountNumber uint64
+	sequence      uint64 // The sequence expected by the node
+	queries       int
+	sent          []uint64
+}
+
+func (f *fakeChain) queryAccount() (client.Account, error) {
+	f.queries++
+	return authtypes.NewBaseAccount(nil, nil, f.accountNumber, f.sequence), nil
+}
+
+func (f *fakeChain) send(rawLog func(expected uint64) string) func(uint64, uint64) (string, error) {
+	return func(accountNumber uint64, sequence uint64) (string, error) {
+		if accountNumber != f.accountNumber {
+			return "", fmt.Errorf("wrong account number")
+		}
+		if sequence != f.sequence {
+			return "", &checkTxError{
+				codespace: sdkerrors.RootCodespace,
+				code:      sdkerrors.ErrWrongSequence.ABCICode(),
+				rawLog:    rawLog(f.sequence),
+			}
+		}
+		f.sent = append(f.sent, sequence)
+		f.sequence++
+		return fmt.Sprintf("tx%d", sequence), nil
+	}
+}
+
+func mismatchLog(expected uint64) string {
+	return fmt.Sprintf("account sequence mismatch, expected %d, got 0: incorrect account sequence", expected)
+}
+
+func TestSequenceTrackerBroadcast(t *testing.T) {
+	chain := &fakeChain{accountNumber: 7, sequence: 3}
+	tracker := &sequenceTracker{}
+
+	// the account is only queried once, and the sequences are then tracked locally
+	for i := uint64(0); i < 3; i++ {
+		txHash, sequence, err := tracker.broadcast(chain.queryAccount, chain.send(mismatchLog))
+		require.NoError(t, err)
+		require.Equal(t, 3+i, sequence)
+		require.Equal(t, fmt.Sprintf("tx%d", 3+i), txHash)
+	}
+	require.Equal(t, 1, chain.queries)
+	require.Equal(t, []uint64{3, 4, 5}, chain.sent)
+
+	// a failure other than a sequence mismatch does not consume the sequence
+	_, sequence, err := tracker.broadcast(chain.queryAccount, func(uint64, uint64) (string, error) {
+		return "", fmt.Errorf("connection refused")
+	})
+	require.Error(t, err)
+	require.Equal(t, uint64(6), sequence)
+	_, sequence, err = tracker.broadcast(chain.queryAccount, chain.send(mismatchLog))
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), sequence)
+}
+
+func TestSequenceTrackerMismatchRecovery(t *testing.T) {
+	chain := &fakeChain{accountNumber: 7, sequence: 3}
+	tracker := &sequenceTracker{}
+	_, _, err := tracker.broadcast(chain.queryAccount, chain.send(mismatchLog))
+	require.NoError(t, err)
+
+	// another process of the key used some sequences
+	chain.sequence = 10
+	_, sequence, err := tracker.broadcast(chain.queryAccount, chain.send(mismatchLog))
+	require.Error(t, err)
+	require.Equal(t, uint64(4), sequence)
+
+	// the tracker follows the sequence expected by the node without querying the account
+	_, sequence, err = tracker.broadcast(chain.queryAccount, chain.send(mismatchLog))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), sequence)
+	require.Equal(t, 1, chain.queries)
+}
+
+func TestSequenceTrackerUnparsableMismatch(t *testing.T) {
+	chain := &fakeChain{accountNumber: 7, sequence: 3}
+	tracker := &sequenceTracker{}
+	_, _, err := tracker.broadcast(chain.queryAccount, chain.send(mismatchLog))
+	require.NoError(t, err)
+
+	// the tracker queries the account again when the expected sequence is not in the log
+	chain.sequence = 10
+	unparsable := func(uint64) string { return "incorrect account sequence" }
+	_, _, err = tracker.broadcast(chain.queryAccount, chain.send(unparsable))
+	require.Error(t, err)
+
+	_, sequence, err := tracker.broadcast(chain.queryAccount, chain.send(unparsable))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), sequence)
+	require.Equal(t, 2, chain.queries)
+}
+
+func TestSequenceTrackerReset(t *testing.T) {
+	chain := &fakeChain{accountNumber: 7, sequence: 3}
+	tracker := &sequenceTracker{}
+	for i := 0; i < 2; i++ {
+		_, _, err := tracker.broadcast(chain.queryAccount, chain.send(mismatchLog))
+		require.NoError(t, err)
+	}
+
+	// the transaction with sequence 4 is dropped from the mempool, leaving a gap
+	chain.sequence = 4
+	tracker.reset()
+	_, sequence, err := tracker.broadcast(chain.queryAccount, chain.send(mismatchLog))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), sequence)
+	require.Equal(t, 2, chain.queries)
+}
+
+// fakeNode is a node serving the mempool and the account of a key. Like the RPC CheckTx, its
+// checkTx runs the ante handler on the check state without adding the transaction to the mempool.
+type fakeNode struct {
+	committed  uint64 // The sequence of the account on chain
+	checkState uint64 // The sequence expected by CheckTx, counting the transactions in the mempool
+	mempool    [][]byte
+	total      int // The number of transactions in the mempool, including the ones not returned
+}
+
+func fakeTx(sequence uint64) []byte {
+	return []byte(fmt.Sprintf("tx%d", sequence))
+}
+
+func (n *fakeNode) checkTx(sequence uint64) error {
+	if sequence != n.checkState {
+		return &checkTxError{
+			codespace: sdkerrors.RootCodespace,
+			code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			rawLog:    mismatchLog(n.checkState),
+		}
+	}
+	n.checkState++
+	return nil
+}
+
+func (n *fakeNode) send(accountNumber uint64, sequence uint64) (string, error) {
+	if err := n.checkTx(sequence); err != nil {
+		return "", err
+	}
+	n.mempool = append(n.mempool, fakeTx(sequence))
+	return string(fakeTx(sequence)), nil
+}
+
+// commit includes the first transaction of the mempool in a block.
+func (n *fakeNode) commit() {
+	n.mempool = n.mempool[1:]
+	n.committed++
+}
+
+// drop removes the last transaction from the mempool, which rechecks the others.
+func (n *fakeNode) drop() {
+	n.mempool = n.mempool[:len(n.mempool)-1]
+	n.checkState = n.committed + uint64(len(n.mempool))
+}
+
+func (n *fakeNode) queryAccount() (client.Account, error) {
+	return authtypes.NewBaseAccount(nil, nil, 7, n.committed), nil
+}
+
+func (n *fakeNode) unconfirmedTxs() (*ctypes.ResultUnconfirmedTxs, error) {
+	txs := make([]cmttypes.Tx, 0, len(n.mempool))
+	for _, tx := range n.mempool {
+		txs = append(txs, tx)
+	}
+	return &ctypes.ResultUnconfirmedTxs{Count: len(txs), Total: max(n.total, len(txs)), Txs: txs}, nil
+}
+
+func TestIsSequenceGap(t *testing.T) {
+	node := &fakeNode{committed: 3, checkState: 3}
+	tracker := &sequenceTracker{}
+	for i := 0; i < 2; i++ {
+		_, _, err := tracker.broadcast(node.queryAccount, node.send)
+		require.NoError(t, err)
+	}
+	node.commit()
+
+	// the transaction still waits in the mempool
+	require.False(t, isSequenceGap(4, fakeTx(4), node.queryAccount, node.unconfirmedTxs))
+
+	// the transaction may be among the mempool transactions not returned
+	node.total = unconfirmedTxsLimit + 1
+	node.mempool = node.mempool[:0]
+	require.False(t, isSequenceGap(4, fakeTx(4), node.queryAccount, node.unconfirmedTxs))
+	node.total = 0
+	node.mempool = append(node.mempool, fakeTx(4))
+
+	// the transaction is dropped, which is detected without changing the check state, so it is
+	// accepted again with the same sequence
+	node.drop()
+	require.True(t, isSequenceGap(4, fakeTx(4), node.queryAccount, node.unconfirmedTxs))
+	require.Equal(t, uint64(4), node.checkState)
+	tracker.reset()
+	_, sequence, err := tracker.broadcast(node.queryAccount, node.send)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), sequence)
+
+	// the transaction is included in a block
+	node.commit()
+	require.False(t, isSequenceGap(4, fakeTx(4), node.queryAccount, node.unconfirmedTxs))
+
+	// the state is unknown
+	failingAccount := func() (client.Account, error) { return nil, fmt.Errorf("failed") }
+	failingMempool := func() (*ctypes.ResultUnconfirmedTxs, error) { return nil, fmt.Errorf("failed") }
+	require.False(t, isSequenceGap(5, fakeTx(5), failingAccount, node.unconfirmedTxs))
+	require.False(t, isSequenceGap(5, fakeTx(5), node.queryAccount, failingMempool))
+}
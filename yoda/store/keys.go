@@ -0,0 +1,31 @@
+package store
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+var (
+	// ResultStoreKeyPrefix is the prefix for execution result store.
+	ResultStoreKeyPrefix = []byte{0x01}
+	// SubmissionStoreKeyPrefix is the prefix for submission store.
+	SubmissionStoreKeyPrefix = []byte{0x02}
+	// ScannedHeightStoreKey is the key for the height of the last block scanned for requests.
+	ScannedHeightStoreKey = []byte{0x03}
+)
+
+// ResultsStoreKey returns the key prefix to retrieve all execution results of a request.
+func ResultsStoreKey(requestID types.RequestID) []byte {
+	return append(ResultStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
+
+// ResultStoreKey returns the key to retrieve the execution result of a raw request.
+func ResultStoreKey(requestID types.RequestID, externalID types.ExternalID) []byte {
+	return append(ResultsStoreKey(requestID), sdk.Uint64ToBigEndian(uint64(externalID))...)
+}
+
+// SubmissionStoreKey returns the key to retrieve the submission state of a request.
+func SubmissionStoreKey(requestID types.RequestID) []byte {
+	return append(SubmissionStoreKeyPrefix, sdk.Uint64ToBigEndian(uint64(requestID))...)
+}
@@ -0,0 +1,14 @@
+package store
+
+import (
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Result represents the execution result of a data source script for a raw request.
+type Result struct {
+	RequestID  types.RequestID  `json:"request_id"`  // Request ID of the raw request
+	ExternalID types.ExternalID `json:"external_id"` // External ID of the raw request
+	ExitCode   uint32           `json:"exit_code"`   // Exit code of the data source script
+	Data       []byte           `json:"data"`        // Output of the data source script
+	Version    string           `json:"version"`     // Version of the executor that ran the script
+}
@@ -0,0 +1,179 @@
+package store
+
+import (
+	"encoding/json"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// Store represents a data store for persisting execution results and submission states of Yoda
+// so that they survive a restart.
+type Store struct {
+	DB dbm.DB
+}
+
+// NewStore creates a new instance of Store with the provided database.
+func NewStore(db dbm.DB) *Store {
+	return &Store{
+		DB: db,
+	}
+}
+
+// SetResult stores the execution result of a raw request.
+func (s *Store) SetResult(result Result) error {
+	bytes, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	return s.DB.SetSync(ResultStoreKey(result.RequestID, result.ExternalID), bytes)
+}
+
+// GetResult retrieves the execution result of a raw request. The returned flag is false if
+// the raw request has not been executed yet.
+func (s *Store) GetResult(requestID types.RequestID, externalID types.ExternalID) (Result, bool, error) {
+	bytes, err := s.DB.Get(ResultStoreKey(requestID, externalID))
+	if err != nil {
+		return Result{}, false, err
+	}
+
+	if bytes == nil {
+		return Result{}, false, nil
+	}
+
+	var result Result
+	err = json.Unmarshal(bytes, &result)
+	if err != nil {
+		return Result{}, false, err
+	}
+
+	return result, true, nil
+}
+
+// GetResults retrieves all stored execution results of a request.
+func (s *Store) GetResults(requestID types.RequestID) ([]Result, error) {
+	prefix := ResultsStoreKey(requestID)
+	iterator, err := s.DB.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var results []Result
+	for ; iterator.Valid(); iterator.Next() {
+		var result Result
+		err = json.Unmarshal(iterator.Value(), &result)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// SetSubmission stores the submission state of a request.
+func (s *Store) SetSubmission(submission Submission) error {
+	bytes, err := json.Marshal(submission)
+	if err != nil {
+		return err
+	}
+
+	return s.DB.SetSync(SubmissionStoreKey(submission.RequestID), bytes)
+}
+
+// GetSubmission retrieves the submission state of a request. The returned flag is false if
+// there is no submission state of the request.
+func (s *Store) GetSubmission(requestID types.RequestID) (Submission, bool, error) {
+	bytes, err := s.DB.Get(SubmissionStoreKey(requestID))
+	if err != nil {
+		return Submission{}, false, err
+	}
+
+	if bytes == nil {
+		return Submission{}, false, nil
+	}
+
+	var submission Submission
+	err = json.Unmarshal(bytes, &submission)
+	if err != nil {
+		return Submission{}, false, err
+	}
+
+	return submission, true, nil
+}
+
+// SetScannedHeight stores the height of the last block scanned for requests.
+func (s *Store) SetScannedHeight(height int64) error {
+	return s.DB.SetSync(ScannedHeightStoreKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetScannedHeight retrieves the height of the last block scanned for requests. The returned flag
+// is false if no block has been scanned yet.
+func (s *Store) GetScannedHeight() (int64, bool, error) {
+	bytes, err := s.DB.Get(ScannedHeightStoreKey)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if bytes == nil {
+		return 0, false, nil
+	}
+
+	return int64(sdk.BigEndianToUint64(bytes)), true, nil
+}
+
+// GetAllRequestIDs retrieves the IDs of all requests that have any data in the store.
+func (s *Store) GetAllRequestIDs() ([]types.RequestID, error) {
+	seen := make(map[types.RequestID]bool)
+	var ids []types.RequestID
+	for _, prefix := range [][]byte{ResultStoreKeyPrefix, SubmissionStoreKeyPrefix} {
+		iterator, err := s.DB.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+		if err != nil {
+			return nil, err
+		}
+
+		for ; iterator.Valid(); iterator.Next() {
+			id := types.RequestID(sdk.BigEndianToUint64(iterator.Key()[len(prefix) : len(prefix)+8]))
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		iterator.Close()
+	}
+
+	return ids, nil
+}
+
+// DeleteRequest deletes all execution results and the submission state of a request.
+func (s *Store) DeleteRequest(requestID types.RequestID) error {
+	batch := s.DB.NewBatch()
+	defer batch.Close()
+
+	prefix := ResultsStoreKey(requestID)
+	iterator, err := s.DB.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		if err := batch.Delete(iterator.Key()); err != nil {
+			iterator.Close()
+			return err
+		}
+	}
+	iterator.Close()
+
+	if err := batch.Delete(SubmissionStoreKey(requestID)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
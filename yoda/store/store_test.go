@@ -0,0 +1,101 @@
+package store_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+	"github.com/bandprotocol/chain/v3/yoda/store"
+)
+
+func TestResult(t *testing.T) {
+	s := store.NewStore(dbm.NewMemDB())
+
+	_, found, err := s.GetResult(1, 1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	results := []store.Result{
+		{RequestID: 1, ExternalID: 2, ExitCode: 0, Data: []byte("data2"), Version: "v1"},
+		{RequestID: 1, ExternalID: 1, ExitCode: 1, Data: []byte("error1"), Version: "v1"},
+		{RequestID: 2, ExternalID: 1, ExitCode: 0, Data: []byte("data1"), Version: "v2"},
+	}
+	for _, result := range results {
+		require.NoError(t, s.SetResult(result))
+	}
+
+	result, found, err := s.GetResult(1, 2)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, results[0], result)
+
+	got, err := s.GetResults(1)
+	require.NoError(t, err)
+	require.Equal(t, []store.Result{results[1], results[0]}, got)
+}
+
+func TestSubmission(t *testing.T) {
+	s := store.NewStore(dbm.NewMemDB())
+
+	_, found, err := s.GetSubmission(1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	submission := store.Submission{RequestID: 1, Salt: []byte("salt"), Status: store.StatusExecuted}
+	require.NoError(t, s.SetSubmission(submission))
+	got, found, err := s.GetSubmission(1)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, submission, got)
+
+	submission.Status = store.StatusCommitted
+	require.NoError(t, s.SetSubmission(submission))
+	got, _, err = s.GetSubmission(1)
+	require.NoError(t, err)
+	require.Equal(t, store.StatusCommitted, got.Status)
+}
+
+func TestScannedHeight(t *testing.T) {
+	s := store.NewStore(dbm.NewMemDB())
+
+	_, found, err := s.GetScannedHeight()
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, s.SetScannedHeight(100))
+	require.NoError(t, s.SetScannedHeight(120))
+
+	height, found, err := s.GetScannedHeight()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(120), height)
+}
+
+func TestDeleteRequest(t *testing.T) {
+	s := store.NewStore(dbm.NewMemDB())
+
+	require.NoError(t, s.SetResult(store.Result{RequestID: 1, ExternalID: 1}))
+	require.NoError(t, s.SetResult(store.Result{RequestID: 1, ExternalID: 2}))
+	require.NoError(t, s.SetResult(store.Result{RequestID: 2, ExternalID: 1}))
+	require.NoError(t, s.SetSubmission(store.Submission{RequestID: 1}))
+	require.NoError(t, s.SetSubmission(store.Submission{RequestID: 3}))
+
+	ids, err := s.GetAllRequestIDs()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.RequestID{1, 2, 3}, ids)
+
+	require.NoError(t, s.DeleteRequest(1))
+
+	results, err := s.GetResults(1)
+	require.NoError(t, err)
+	require.Empty(t, results)
+	_, found, err := s.GetSubmission(1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	ids, err = s.GetAllRequestIDs()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.RequestID{2, 3}, ids)
+}
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"github.com/bandprotocol/chain/v3/x/oracle/types"
+)
+
+// SubmissionStatus is the progress of submitting the reports of a request.
+type SubmissionStatus uint8
+
+const (
+	// StatusExecuted means the reports are executed but nothing is included on chain yet.
+	StatusExecuted SubmissionStatus = iota
+	// StatusCommitted means the commit of the reports is included on chain and the reports
+	// are waiting to be revealed.
+	StatusCommitted
+)
+
+// Submission represents the submission state of the reports of a request.
+type Submission struct {
+	RequestID types.RequestID  `json:"request_id"` // Request ID of the reports
+	Salt      []byte           `json:"salt"`       // Salt of the report commit in commit-reveal mode
+	Status    SubmissionStatus `json:"status"`     // Submission status of the reports
+}